@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/tktanisha/booking_system/internal/api/routes"
 	"github.com/tktanisha/booking_system/internal/config"
 	"github.com/tktanisha/booking_system/internal/db"
 	"github.com/tktanisha/booking_system/internal/initializer"
+	"github.com/tktanisha/booking_system/internal/jobs"
 )
 
 func main() {
@@ -32,6 +35,13 @@ func main() {
 	// Initializing services
 	initializer.Initialize(database)
 
+	// Background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobs.StartNoShowJob(ctx, initializer.BookingService, time.Hour)
+	jobs.StartWaitlistJob(ctx, initializer.WaitlistService, 5*time.Minute)
+	jobs.StartGroupBlockJob(ctx, initializer.GroupBlockService, 15*time.Minute)
+
 	// Setting routes
 	mux := http.NewServeMux()
 	routes.RegisterAllRoutes(mux,
@@ -39,6 +49,21 @@ func main() {
 		routes.RegisterBookingRoutes,
 		routes.RegisterHotelRoutes,
 		routes.RegisterRoomRoutes,
+		routes.RegisterCancellationPolicyRoutes,
+		routes.RegisterHousekeepingRoutes,
+		routes.RegisterStayRestrictionRoutes,
+		routes.RegisterPromoRoutes,
+		routes.RegisterTaxRoutes,
+		routes.RegisterFolioRoutes,
+		routes.RegisterInvoiceRoutes,
+		routes.RegisterReviewRoutes,
+		routes.RegisterMediaRoutes,
+		routes.RegisterWaitlistRoutes,
+		routes.RegisterNotificationRoutes,
+		routes.RegisterGroupBlockRoutes,
+		routes.RegisterOverbookingRoutes,
+		routes.RegisterReportRoutes,
+		routes.RegisterExportRoutes,
 	)
 
 	// Starting server
@@ -1,16 +1,26 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/tktanisha/booking_system/internal/constants"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
 	"github.com/tktanisha/booking_system/internal/utils"
 	error_handler "github.com/tktanisha/booking_system/internal/utils"
 	write_response "github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
 	validators "github.com/tktanisha/booking_system/internal/utils/validators/booking_validators"
 
 	"github.com/tktanisha/booking_system/internal/services/booking_service"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
 )
 
 type BookingHandler struct {
@@ -37,6 +47,63 @@ func (b *BookingHandler) CreateBooking(w http.ResponseWriter, r *http.Request) {
 
 	createdBooking, err := b.BookingService.CreateBooking(userContext, payload)
 	if err != nil {
+		if errors.Is(err, booking_service.ErrOccupancyExceeded) {
+			error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Occupancy exceeded", err.Error())
+			return
+		}
+		if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+			error_handler.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+			return
+		}
+		if errors.Is(err, booking_service.ErrCheckInInPast) {
+			error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid stay dates", err.Error())
+			return
+		}
+		if errors.Is(err, promo_service.ErrInvalidPromoCode) {
+			error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid promo code", err.Error())
+			return
+		}
+		if errors.Is(err, promo_service.ErrPromoCodeUnavailable) {
+			error_handler.WriteErrorResponse(w, http.StatusConflict, "Promo code unavailable", err.Error())
+			return
+		}
+		var violation *stay_restriction_service.ViolationError
+		if errors.As(err, &violation) {
+			error_handler.WriteErrorResponseWithCode(w, http.StatusUnprocessableEntity, "Stay restriction violated", err.Error(), string(violation.Code))
+			return
+		}
+		if errors.Is(err, booking_service.ErrRoomsNotAvailable) {
+			error_handler.WriteErrorResponse(w, http.StatusConflict, "Rooms not available", "rooms not available, join the waitlist to be offered rooms that free up")
+			return
+		}
+		if errors.Is(err, waitlist_repo.ErrEntryNotFound) {
+			error_handler.WriteErrorResponse(w, http.StatusNotFound, "Waitlist entry not found", err.Error())
+			return
+		}
+		if errors.Is(err, waitlist_service.ErrWaitlistAccessDenied) {
+			error_handler.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+			return
+		}
+		if errors.Is(err, waitlist_service.ErrOfferUnavailable) {
+			error_handler.WriteErrorResponse(w, http.StatusConflict, "Waitlist offer unavailable", err.Error())
+			return
+		}
+		if errors.Is(err, waitlist_service.ErrOfferMismatch) {
+			error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Booking does not match the waitlist offer", err.Error())
+			return
+		}
+		if errors.Is(err, group_block_repo.ErrGroupBlockNotFound) {
+			error_handler.WriteErrorResponse(w, http.StatusNotFound, "Group block not found", err.Error())
+			return
+		}
+		if errors.Is(err, group_block_service.ErrGroupRoomsUnavailable) {
+			error_handler.WriteErrorResponse(w, http.StatusConflict, "Group rooms unavailable", err.Error())
+			return
+		}
+		if errors.Is(err, group_block_service.ErrGroupStayMismatch) {
+			error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Booking does not match the group block", err.Error())
+			return
+		}
 		error_handler.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create booking", err.Error())
 		return
 	}
@@ -61,8 +128,12 @@ func (b *BookingHandler) CancelBooking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	booking, err := b.BookingService.CancelBooking(bookingId)
+	booking, err := b.BookingService.CancelBooking(userContext, bookingId)
 	if err != nil {
+		if errors.Is(err, booking_service.ErrBookingAccessDenied) {
+			error_handler.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+			return
+		}
 		error_handler.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to cancel booking", err.Error())
 		return
 	}
@@ -70,6 +141,41 @@ func (b *BookingHandler) CancelBooking(w http.ResponseWriter, r *http.Request) {
 	write_response.WriteSuccessResponse(w, http.StatusOK, "Booking canceled successfully", booking)
 }
 
+func (b *BookingHandler) CheckInBooking(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		error_handler.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		error_handler.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only front desk staff can check in guests")
+		return
+	}
+
+	bookingId, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	payload, err := validators.ValidateCheckInPayload(r)
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	booking, units, err := b.BookingService.CheckInBooking(userContext, bookingId, payload)
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to check in booking", err.Error())
+		return
+	}
+	write_response.WriteSuccessResponse(w, http.StatusOK, "Booking checked in successfully", map[string]any{
+		"booking":    booking,
+		"room_units": units,
+	})
+}
+
 func (b *BookingHandler) CheckoutBooking(w http.ResponseWriter, r *http.Request) {
 	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
 	if !ok || userContext == nil {
@@ -82,10 +188,73 @@ func (b *BookingHandler) CheckoutBooking(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	booking, err := b.BookingService.CheckoutBooking(bookingId)
+	payload, err := validators.ValidateCheckoutPayload(r)
 	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	if payload.WaiveBalance && !permissions.IsManager(userContext) {
+		error_handler.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can waive a folio balance")
+		return
+	}
+
+	booking, err := b.BookingService.CheckoutBooking(userContext, bookingId, payload)
+	if err != nil {
+		if errors.Is(err, folio_service.ErrBalanceOutstanding) {
+			error_handler.WriteErrorResponse(w, http.StatusConflict, "Folio balance outstanding", err.Error())
+			return
+		}
 		error_handler.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to checkout booking", err.Error())
 		return
 	}
 	write_response.WriteSuccessResponse(w, http.StatusOK, "Booking checked out successfully", booking)
 }
+
+func (b *BookingHandler) GetBooking(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		error_handler.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+	bookingId, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	booking, err := b.BookingService.GetBooking(userContext, bookingId)
+	if errors.Is(err, booking_service.ErrBookingAccessDenied) {
+		error_handler.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve booking", err.Error())
+		return
+	}
+	write_response.WriteSuccessResponse(w, http.StatusOK, "Booking retrieved successfully", booking)
+}
+
+func (b *BookingHandler) GetBookingHistory(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		error_handler.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+	bookingId, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	history, err := b.BookingService.GetBookingHistory(userContext, bookingId)
+	if errors.Is(err, booking_service.ErrBookingAccessDenied) {
+		error_handler.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		error_handler.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve booking history", err.Error())
+		return
+	}
+	write_response.WriteSuccessResponse(w, http.StatusOK, "Booking history retrieved successfully", history)
+}
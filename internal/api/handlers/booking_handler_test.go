@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,9 +15,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/api/handlers"
 	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
 	"github.com/tktanisha/booking_system/internal/enums/room"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
 	bookingMocks "github.com/tktanisha/booking_system/internal/mocks"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+	"github.com/tktanisha/booking_system/internal/services/booking_service"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+	"github.com/tktanisha/booking_system/internal/utils"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -38,8 +51,8 @@ func TestBookingHandler_CreateBooking(t *testing.T) {
 	hotelID := uuid.New()
 	validPayload := &payloads.BookingPayload{
 		HotelId:  hotelID,
-		CheckIn:  time.Now(),
-		CheckOut: time.Now(),
+		CheckIn:  payloads.NewDate(time.Now()),
+		CheckOut: payloads.NewDate(time.Now().AddDate(0, 0, 1)),
 		Rooms: []*payloads.RoomPayload{
 			{RoomType: room.Single, Quantity: 2},
 		},
@@ -51,6 +64,7 @@ func TestBookingHandler_CreateBooking(t *testing.T) {
 		body           any
 		mockService    func()
 		wantStatusCode int
+		wantCode       string
 	}{
 		{
 			name:           "unauthorized",
@@ -66,6 +80,17 @@ func TestBookingHandler_CreateBooking(t *testing.T) {
 			mockService:    func() {},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name: "unknown room type",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
 		{
 			name: "service error",
 			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
@@ -77,6 +102,150 @@ func TestBookingHandler_CreateBooking(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name: "occupancy exceeded",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, booking_service.ErrOccupancyExceeded)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "check-in date already passed",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, booking_service.ErrCheckInInPast)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid promo code",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, promo_service.ErrInvalidPromoCode)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "promo code used up",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, promo_service.ErrPromoCodeUnavailable)
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "stay restriction violated",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, &stay_restriction_service.ViolationError{Code: restriction.MinLengthOfStay, Message: "min 2 nights"})
+			},
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantCode:       "MIN_LOS",
+		},
+		{
+			name: "rooms sold out",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, booking_service.ErrRoomsNotAvailable)
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "waitlist offer expired",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, waitlist_service.ErrOfferUnavailable)
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "booking differs from the waitlist offer",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, waitlist_service.ErrOfferMismatch)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown group code",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, group_block_repo.ErrGroupBlockNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "group block picked up in full",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, group_block_service.ErrGroupRoomsUnavailable)
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "stay outside the group block",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, group_block_service.ErrGroupStayMismatch)
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "someone else's waitlist offer",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, waitlist_service.ErrWaitlistAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "unknown waitlist entry",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body: validPayload,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CreateBooking(userCtx, gomock.Any()).
+					Return(nil, waitlist_repo.ErrEntryNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
 		{
 			name: "success",
 			ctx:  context.WithValue(context.Background(), constants.UserContextKey, userCtx),
@@ -104,6 +273,12 @@ func TestBookingHandler_CreateBooking(t *testing.T) {
 			if w.Code != tt.wantStatusCode {
 				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
 			}
+			if tt.wantCode != "" {
+				var body utils.ErrorResponse
+				if err := json.NewDecoder(w.Body).Decode(&body); err != nil || body.Code != tt.wantCode {
+					t.Errorf("expected error code %q, got %q (%v)", tt.wantCode, body.Code, err)
+				}
+			}
 		})
 	}
 }
@@ -144,18 +319,29 @@ func TestBookingHandler_CancelBooking(t *testing.T) {
 			bookingIDStr: bookingID.String(),
 			mockService: func() {
 				mockBookingService.EXPECT().
-					CancelBooking(bookingID).
+					CancelBooking(gomock.Any(), bookingID).
 					Return(nil, errors.New("service failed"))
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:         "not the guest or the hotel's manager",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CancelBooking(gomock.Any(), bookingID).
+					Return(nil, booking_service.ErrBookingAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
 		{
 			name:         "success",
 			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
 			bookingIDStr: bookingID.String(),
 			mockService: func() {
 				mockBookingService.EXPECT().
-					CancelBooking(bookingID).
+					CancelBooking(gomock.Any(), bookingID).
 					Return(&models.Bookings{Id: bookingID}, nil)
 			},
 			wantStatusCode: http.StatusOK,
@@ -178,6 +364,87 @@ func TestBookingHandler_CancelBooking(t *testing.T) {
 	}
 }
 
+func TestBookingHandler_CheckInBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingService := bookingMocks.NewMockBookingServiceInterface(ctrl)
+	handler := handlers.NewBookingHandler(mockBookingService)
+
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	bookingID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		bookingIDStr   string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			bookingIDStr:   bookingID.String(),
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden for guests",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, guestCtx),
+			bookingIDStr:   bookingID.String(),
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid booking id",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx),
+			bookingIDStr:   "invalid-uuid",
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "service error",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CheckInBooking(gomock.Any(), bookingID, gomock.Any()).
+					Return(nil, nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "success",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CheckInBooking(gomock.Any(), bookingID, gomock.Any()).
+					Return(&models.Bookings{Id: bookingID}, []*models.RoomUnits{{Id: uuid.New(), Number: "101"}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/booking/checkin/", nil)
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			req.SetPathValue("bookingId", tt.bookingIDStr)
+
+			handler.CheckInBooking(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
 func TestBookingHandler_CheckoutBooking(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -186,12 +453,15 @@ func TestBookingHandler_CheckoutBooking(t *testing.T) {
 	handler := handlers.NewBookingHandler(mockBookingService)
 
 	userCtx := &models.UserContext{Id: uuid.New()}
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
 	bookingID := uuid.New()
+	waiver := `{"waive_balance":true,"waiver_reason":"service recovery"}`
 
 	tests := []struct {
 		name           string
 		ctx            context.Context
 		bookingIDStr   string
+		body           string
 		mockService    func()
 		wantStatusCode int
 	}{
@@ -209,13 +479,40 @@ func TestBookingHandler_CheckoutBooking(t *testing.T) {
 			mockService:    func() {},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name:           "waiver without reason",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			bookingIDStr:   bookingID.String(),
+			body:           `{"waive_balance":true}`,
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "waiver by non-manager",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr:   bookingID.String(),
+			body:           waiver,
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:         "balance outstanding",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CheckoutBooking(gomock.Any(), bookingID, gomock.Any()).
+					Return(nil, fmt.Errorf("%w: 1500 outstanding", folio_service.ErrBalanceOutstanding))
+			},
+			wantStatusCode: http.StatusConflict,
+		},
 		{
 			name:         "service error",
 			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
 			bookingIDStr: bookingID.String(),
 			mockService: func() {
 				mockBookingService.EXPECT().
-					CheckoutBooking(bookingID).
+					CheckoutBooking(gomock.Any(), bookingID, gomock.Any()).
 					Return(nil, errors.New("service failed"))
 			},
 			wantStatusCode: http.StatusInternalServerError,
@@ -226,7 +523,19 @@ func TestBookingHandler_CheckoutBooking(t *testing.T) {
 			bookingIDStr: bookingID.String(),
 			mockService: func() {
 				mockBookingService.EXPECT().
-					CheckoutBooking(bookingID).
+					CheckoutBooking(gomock.Any(), bookingID, &payloads.CheckoutPayload{}).
+					Return(&models.Bookings{Id: bookingID}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:         "manager waives the balance",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			bookingIDStr: bookingID.String(),
+			body:         waiver,
+			mockService: func() {
+				mockBookingService.EXPECT().
+					CheckoutBooking(managerCtx, bookingID, &payloads.CheckoutPayload{WaiveBalance: true, WaiverReason: "service recovery"}).
 					Return(&models.Bookings{Id: bookingID}, nil)
 			},
 			wantStatusCode: http.StatusOK,
@@ -236,7 +545,7 @@ func TestBookingHandler_CheckoutBooking(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockService()
-			req := httptest.NewRequest(http.MethodPost, "/booking/checkout/", nil)
+			req := httptest.NewRequest(http.MethodPost, "/booking/checkout/", bytes.NewBufferString(tt.body))
 			req = req.WithContext(tt.ctx)
 			w := httptest.NewRecorder()
 
@@ -250,3 +559,171 @@ func TestBookingHandler_CheckoutBooking(t *testing.T) {
 		})
 	}
 }
+
+func TestBookingHandler_GetBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingService := bookingMocks.NewMockBookingServiceInterface(ctrl)
+	handler := handlers.NewBookingHandler(mockBookingService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	bookingID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		bookingIDStr   string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			bookingIDStr:   bookingID.String(),
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid booking id",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr:   "invalid-uuid",
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "access denied",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					GetBooking(userCtx, bookingID).
+					Return(nil, booking_service.ErrBookingAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:         "service error",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					GetBooking(userCtx, bookingID).
+					Return(nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "success",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					GetBooking(userCtx, bookingID).
+					Return(&models.Bookings{Id: bookingID, Guests: []*models.BookingGuests{{FullName: "Asha Rao", IsLead: true}}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/bookings", nil)
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			req.SetPathValue("bookingId", tt.bookingIDStr)
+
+			handler.GetBooking(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestBookingHandler_GetBookingHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingService := bookingMocks.NewMockBookingServiceInterface(ctrl)
+	handler := handlers.NewBookingHandler(mockBookingService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	bookingID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		bookingIDStr   string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			bookingIDStr:   bookingID.String(),
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid booking id",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr:   "invalid-uuid",
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "access denied",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					GetBookingHistory(userCtx, bookingID).
+					Return(nil, booking_service.ErrBookingAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:         "service error",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					GetBookingHistory(userCtx, bookingID).
+					Return(nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:         "success",
+			ctx:          context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			bookingIDStr: bookingID.String(),
+			mockService: func() {
+				mockBookingService.EXPECT().
+					GetBookingHistory(userCtx, bookingID).
+					Return([]*models.BookingEvents{{BookingId: bookingID}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/bookings/history", nil)
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			req.SetPathValue("bookingId", tt.bookingIDStr)
+
+			handler.GetBookingHistory(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
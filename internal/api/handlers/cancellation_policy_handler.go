@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/cancellation_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/cancellation_policy_validators"
+)
+
+type CancellationPolicyHandler struct {
+	CancellationService cancellation_service.CancellationServiceInterface
+}
+
+func NewCancellationPolicyHandler(cancellationService cancellation_service.CancellationServiceInterface) *CancellationPolicyHandler {
+	return &CancellationPolicyHandler{
+		CancellationService: cancellationService,
+	}
+}
+
+func (h *CancellationPolicyHandler) CreatePolicy(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can set cancellation policies")
+		return
+	}
+
+	payload, err := cancellation_policy_validators.ValidateCancellationPolicyPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	policy, err := h.CancellationService.CreatePolicy(userContext, payload)
+	if errors.Is(err, cancellation_service.ErrPolicyAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create cancellation policy", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Cancellation policy saved successfully!", policy)
+}
+
+func (h *CancellationPolicyHandler) GetPoliciesByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	policies, err := h.CancellationService.GetPoliciesByHotelID(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve cancellation policies", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Cancellation policies retrieved successfully!", policies)
+}
@@ -0,0 +1,186 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/cancellation_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestCancellationPolicyHandler_CreatePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCancellationServiceInterface(ctrl)
+	handler := handlers.NewCancellationPolicyHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+
+	validPayload := &payloads.CancellationPolicyPayload{HotelId: uuid.New(), FreeCancelHours: 48, PenaltyNights: 1}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden for non-manager",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid payload",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body:           &payloads.CancellationPolicyPayload{},
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "another hotel's manager",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePolicy(managerCtx, gomock.Any()).Return(nil, cancellation_service.ErrPolicyAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "unknown room type",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePolicy(managerCtx, gomock.Any()).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "service error",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePolicy(managerCtx, gomock.Any()).Return(nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePolicy(managerCtx, gomock.Any()).Return(&models.CancellationPolicies{Id: uuid.New()}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/cancellation-policies/create", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreatePolicy(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestCancellationPolicyHandler_GetPoliciesByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCancellationServiceInterface(ctrl)
+	handler := handlers.NewCancellationPolicyHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			hotelIDStr:     hotelID.String(),
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid hotel id",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			hotelIDStr:     "invalid-uuid",
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "service error",
+			ctx:        context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			hotelIDStr: hotelID.String(),
+			mockService: func() {
+				mockService.EXPECT().GetPoliciesByHotelID(hotelID).Return(nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "success",
+			ctx:        context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			hotelIDStr: hotelID.String(),
+			mockService: func() {
+				mockService.EXPECT().GetPoliciesByHotelID(hotelID).Return([]*models.CancellationPolicies{{HotelId: hotelID}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/cancellation-policies/", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetPoliciesByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
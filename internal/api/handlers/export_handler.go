@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/export_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/export_validators"
+)
+
+type ExportHandler struct {
+	ExportService export_service.ExportServiceInterface
+}
+
+func NewExportHandler(exportService export_service.ExportServiceInterface) *ExportHandler {
+	return &ExportHandler{
+		ExportService: exportService,
+	}
+}
+
+func (h *ExportHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can export hotel data")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := export_validators.ValidateExportParams(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	out := &exportResponse{
+		w:           w,
+		contentType: payload.Format.ContentType(),
+		filename:    string(payload.Dataset) + "." + string(payload.Format),
+	}
+	err = h.ExportService.Export(userContext, hotelID, payload, out)
+	if err != nil && out.started {
+		// the status has gone out with the first rows, so the file is cut short instead
+		return
+	}
+	if errors.Is(err, export_service.ErrExportAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to export data", err.Error())
+		return
+	}
+
+	out.start()
+}
+
+// exportResponse sends the headers of an export with its first bytes, so an
+// export that fails before then can still be answered with a JSON error.
+type exportResponse struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportResponse) start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	e.w.Header().Set("Content-Type", e.contentType)
+	e.w.Header().Set("Content-Disposition", `attachment; filename="`+e.filename+`"`)
+	e.w.WriteHeader(http.StatusOK)
+}
+
+func (e *exportResponse) Write(p []byte) (int, error) {
+	e.start()
+	return e.w.Write(p)
+}
@@ -0,0 +1,95 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/export_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestExportHandler_ExportData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockExportServiceInterface(ctrl)
+	handler := handlers.NewExportHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	hotelID := uuid.New()
+	writeRows := func(rows string) func(*models.UserContext, uuid.UUID, *payloads.ExportPayload, io.Writer) error {
+		return func(_ *models.UserContext, _ uuid.UUID, _ *payloads.ExportPayload, w io.Writer) error {
+			_, err := io.WriteString(w, rows)
+			return err
+		}
+	}
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		dataset         string
+		query           string
+		mockService     func()
+		wantStatusCode  int
+		wantContentType string
+		wantBody        string
+	}{
+		{"unauthorized", context.Background(), "bookings", "", func() {}, http.StatusUnauthorized, "", ""},
+		{"forbidden for front desk", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), "bookings", "", func() {}, http.StatusForbidden, "", ""},
+		{"unknown dataset", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invoices", "", func() {}, http.StatusBadRequest, "", ""},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "bookings", "", func() {
+			mockService.EXPECT().Export(managerCtx, hotelID, gomock.Any(), gomock.Any()).Return(export_service.ErrExportAccessDenied)
+		}, http.StatusForbidden, "application/json", ""},
+		{"error before the first row", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "bookings", "", func() {
+			mockService.EXPECT().Export(managerCtx, hotelID, gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+		}, http.StatusInternalServerError, "application/json", ""},
+		{"csv export", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "guests", "?from=2030-05-01", func() {
+			mockService.EXPECT().Export(managerCtx, hotelID, gomock.Any(), gomock.Any()).DoAndReturn(writeRows("id,full_name\n1,Jane\n"))
+		}, http.StatusOK, "text/csv; charset=utf-8", "id,full_name\n1,Jane\n"},
+		{"empty json lines export", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "payments", "?format=ndjson", func() {
+			mockService.EXPECT().Export(managerCtx, hotelID, gomock.Any(), gomock.Any()).Return(nil)
+		}, http.StatusOK, "application/x-ndjson", ""},
+		{"error after the first rows cuts the file short", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "bookings", "", func() {
+			mockService.EXPECT().Export(managerCtx, hotelID, gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ *models.UserContext, _ uuid.UUID, _ *payloads.ExportPayload, w io.Writer) error {
+					io.WriteString(w, "id\n1\n")
+					return errors.New("connection reset")
+				})
+		}, http.StatusOK, "text/csv; charset=utf-8", "id\n1\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/exports"+tt.query, nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			req.SetPathValue("dataset", tt.dataset)
+			w := httptest.NewRecorder()
+
+			handler.ExportData(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+			if tt.wantContentType != "" && w.Header().Get("Content-Type") != tt.wantContentType {
+				t.Errorf("expected content type %q, got %q", tt.wantContentType, w.Header().Get("Content-Type"))
+			}
+			if tt.wantStatusCode == http.StatusOK && w.Body.String() != tt.wantBody {
+				t.Errorf("expected body %q, got %q", tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
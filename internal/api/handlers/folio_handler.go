@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/folio_repo"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/folio_validators"
+)
+
+type FolioHandler struct {
+	FolioService folio_service.FolioServiceInterface
+}
+
+func NewFolioHandler(folioService folio_service.FolioServiceInterface) *FolioHandler {
+	return &FolioHandler{
+		FolioService: folioService,
+	}
+}
+
+func (h *FolioHandler) GetFolio(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	bookingID, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	folio, err := h.FolioService.GetFolio(userContext, bookingID)
+	if errors.Is(err, folio_service.ErrFolioAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve folio", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Folio retrieved successfully!", folio)
+}
+
+func (h *FolioHandler) PostFolioLine(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only front desk staff can post to a folio")
+		return
+	}
+
+	bookingID, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	payload, err := folio_validators.ValidatePostFolioLinePayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	line, err := h.FolioService.PostLine(userContext, bookingID, payload)
+	if errors.Is(err, folio_service.ErrFolioClosed) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Folio closed", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to post folio line", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Folio line posted successfully!", line)
+}
+
+func (h *FolioHandler) VoidFolioLine(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only front desk staff can void folio lines")
+		return
+	}
+
+	bookingID, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	lineID, err := utils.GetUUIDFromParams(r, "lineId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid folio line ID", err.Error())
+		return
+	}
+
+	payload, err := folio_validators.ValidateVoidFolioLinePayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	err = h.FolioService.VoidLine(userContext, bookingID, lineID, payload)
+	if errors.Is(err, folio_service.ErrFolioClosed) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Folio closed", err.Error())
+		return
+	}
+	if errors.Is(err, folio_repo.ErrFolioLineNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Folio line not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to void folio line", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Folio line voided successfully!", nil)
+}
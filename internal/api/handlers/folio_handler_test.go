@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/folio_repo"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestFolioHandler_GetFolio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockFolioServiceInterface(ctrl)
+	handler := handlers.NewFolioHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	bookingID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		bookingIDStr   string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), bookingID.String(), func() {}, http.StatusUnauthorized},
+		{"invalid booking id", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"access denied", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), func() {
+			mockService.EXPECT().GetFolio(userCtx, bookingID).Return(nil, folio_service.ErrFolioAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), func() {
+			mockService.EXPECT().GetFolio(userCtx, bookingID).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), func() {
+			mockService.EXPECT().GetFolio(userCtx, bookingID).Return(&models.Folio{BookingId: bookingID, Balance: 1500}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/bookings/folio", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("bookingId", tt.bookingIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetFolio(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestFolioHandler_PostFolioLine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockFolioServiceInterface(ctrl)
+	handler := handlers.NewFolioHandler(mockService)
+
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	bookingID := uuid.New()
+	validPayload := &payloads.PostFolioLinePayload{LineType: folio.Charge, Category: folio.Parking, Description: "Parking", Amount: 15}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validPayload, func() {}, http.StatusUnauthorized},
+		{"forbidden for guest", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {}, http.StatusForbidden},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), &payloads.PostFolioLinePayload{LineType: folio.Charge}, func() {}, http.StatusBadRequest},
+		{"folio closed", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), validPayload, func() {
+			mockService.EXPECT().PostLine(frontDeskCtx, bookingID, gomock.Any()).Return(nil, folio_service.ErrFolioClosed)
+		}, http.StatusConflict},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), validPayload, func() {
+			mockService.EXPECT().PostLine(frontDeskCtx, bookingID, gomock.Any()).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), validPayload, func() {
+			mockService.EXPECT().PostLine(frontDeskCtx, bookingID, gomock.Any()).Return(&models.FolioLines{Id: uuid.New()}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/bookings/folio/lines", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("bookingId", bookingID.String())
+			w := httptest.NewRecorder()
+
+			handler.PostFolioLine(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestFolioHandler_VoidFolioLine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockFolioServiceInterface(ctrl)
+	handler := handlers.NewFolioHandler(mockService)
+
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	bookingID := uuid.New()
+	lineID := uuid.New()
+	validPayload := &payloads.VoidFolioLinePayload{Reason: "posted twice"}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		lineIDStr      string
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), lineID.String(), validPayload, func() {}, http.StatusUnauthorized},
+		{"forbidden for guest", context.WithValue(context.Background(), constants.UserContextKey, userCtx), lineID.String(), validPayload, func() {}, http.StatusForbidden},
+		{"invalid line id", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), "invalid-uuid", validPayload, func() {}, http.StatusBadRequest},
+		{"missing reason", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), lineID.String(), &payloads.VoidFolioLinePayload{}, func() {}, http.StatusBadRequest},
+		{"line not found", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), lineID.String(), validPayload, func() {
+			mockService.EXPECT().VoidLine(frontDeskCtx, bookingID, lineID, gomock.Any()).Return(folio_repo.ErrFolioLineNotFound)
+		}, http.StatusNotFound},
+		{"folio closed", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), lineID.String(), validPayload, func() {
+			mockService.EXPECT().VoidLine(frontDeskCtx, bookingID, lineID, gomock.Any()).Return(folio_service.ErrFolioClosed)
+		}, http.StatusConflict},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), lineID.String(), validPayload, func() {
+			mockService.EXPECT().VoidLine(frontDeskCtx, bookingID, lineID, gomock.Any()).Return(nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/bookings/folio/lines/void", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("bookingId", bookingID.String())
+			req.SetPathValue("lineId", tt.lineIDStr)
+			w := httptest.NewRecorder()
+
+			handler.VoidFolioLine(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/group_block_validators"
+)
+
+type GroupBlockHandler struct {
+	GroupBlockService group_block_service.GroupBlockServiceInterface
+}
+
+func NewGroupBlockHandler(groupBlockService group_block_service.GroupBlockServiceInterface) *GroupBlockHandler {
+	return &GroupBlockHandler{
+		GroupBlockService: groupBlockService,
+	}
+}
+
+func (h *GroupBlockHandler) CreateGroupBlock(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can hold rooms for groups")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := group_block_validators.ValidateGroupBlockPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	block, err := h.GroupBlockService.CreateGroupBlock(userContext, hotelID, payload)
+	if errors.Is(err, group_block_service.ErrGroupBlockAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, group_block_service.ErrCutoffInPast) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid cutoff date", err.Error())
+		return
+	}
+	if errors.Is(err, group_block_service.ErrInsufficientInventory) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Rooms not available", err.Error())
+		return
+	}
+	if errors.Is(err, group_block_repo.ErrGroupBlockExists) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Group block already exists", err.Error())
+		return
+	}
+	var violation *stay_restriction_service.ViolationError
+	if errors.As(err, &violation) {
+		utils.WriteErrorResponseWithCode(w, http.StatusUnprocessableEntity, "Stay restriction violated", err.Error(), string(violation.Code))
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create group block", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Group block created successfully!", block)
+}
+
+func (h *GroupBlockHandler) GetHotelGroupBlocks(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only hotel staff can view group blocks")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	blocks, err := h.GroupBlockService.GetHotelGroupBlocks(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve group blocks", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Group blocks retrieved successfully!", blocks)
+}
+
+func (h *GroupBlockHandler) GetGroupBlocksByCode(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	code, err := group_block_validators.NormalizeGroupCode(r.PathValue("code"))
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid group code", err.Error())
+		return
+	}
+
+	blocks, err := h.GroupBlockService.GetGroupBlocksByCode(hotelID, code)
+	if errors.Is(err, group_block_repo.ErrGroupBlockNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Group block not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve group blocks", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Group blocks retrieved successfully!", blocks)
+}
+
+func (h *GroupBlockHandler) ReleaseGroupBlock(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can release group blocks")
+		return
+	}
+
+	blockID, err := utils.GetUUIDFromParams(r, "blockId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid group block ID", err.Error())
+		return
+	}
+
+	block, err := h.GroupBlockService.ReleaseGroupBlock(userContext, blockID)
+	if errors.Is(err, group_block_repo.ErrGroupBlockNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Group block not found", err.Error())
+		return
+	}
+	if errors.Is(err, group_block_service.ErrGroupBlockAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, group_block_service.ErrGroupBlockReleased) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Group block already released", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to release group block", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Group block released successfully!", block)
+}
@@ -0,0 +1,238 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestGroupBlockHandler_CreateGroupBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	handler := handlers.NewGroupBlockHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+	validPayload := &payloads.GroupBlockPayload{
+		Code:       "smith-wedding",
+		Name:       "Smith wedding",
+		RoomType:   room.Double,
+		Quantity:   30,
+		CheckIn:    payloads.NewDate(time.Now().AddDate(0, 1, 0)),
+		CheckOut:   payloads.NewDate(time.Now().AddDate(0, 1, 2)),
+		CutoffDate: payloads.NewDate(time.Now().AddDate(0, 0, 14)),
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validPayload, func() {}, http.StatusUnauthorized},
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), validPayload, func() {}, http.StatusForbidden},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), &payloads.GroupBlockPayload{}, func() {}, http.StatusBadRequest},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).Return(nil, group_block_service.ErrGroupBlockAccessDenied)
+		}, http.StatusForbidden},
+		{"cutoff already passed", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).Return(nil, group_block_service.ErrCutoffInPast)
+		}, http.StatusBadRequest},
+		{"not enough rooms", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).Return(nil, group_block_service.ErrInsufficientInventory)
+		}, http.StatusConflict},
+		{"code already used", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).Return(nil, group_block_repo.ErrGroupBlockExists)
+		}, http.StatusConflict},
+		{"stay restriction violated", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).Return(nil, &stay_restriction_service.ViolationError{Code: restriction.MinLengthOfStay, Message: "min 3 nights"})
+		}, http.StatusUnprocessableEntity},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().CreateGroupBlock(managerCtx, hotelID, gomock.Any()).DoAndReturn(
+				func(_ *models.UserContext, _ uuid.UUID, payload *payloads.GroupBlockPayload) (*models.GroupBlocks, error) {
+					if payload.Code != "SMITH-WEDDING" {
+						t.Errorf("expected the group code upper-cased, got %q", payload.Code)
+					}
+					return &models.GroupBlocks{Id: uuid.New()}, nil
+				})
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/hotels/group-blocks", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.CreateGroupBlock(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestGroupBlockHandler_GetGroupBlocksByCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	handler := handlers.NewGroupBlockHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		code           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"invalid code", "a", func() {}, http.StatusBadRequest},
+		{"unknown code", "smith-wedding", func() {
+			mockService.EXPECT().GetGroupBlocksByCode(hotelID, "SMITH-WEDDING").Return(nil, group_block_repo.ErrGroupBlockNotFound)
+		}, http.StatusNotFound},
+		{"success", "smith-wedding", func() {
+			mockService.EXPECT().GetGroupBlocksByCode(hotelID, "SMITH-WEDDING").Return([]*models.GroupBlocks{{Id: uuid.New()}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/group-blocks", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, userCtx))
+			req.SetPathValue("hotel_id", hotelID.String())
+			req.SetPathValue("code", tt.code)
+			w := httptest.NewRecorder()
+
+			handler.GetGroupBlocksByCode(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestGroupBlockHandler_GetHotelGroupBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	handler := handlers.NewGroupBlockHandler(mockService)
+
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), func() {}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), func() {
+			mockService.EXPECT().GetHotelGroupBlocks(hotelID).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), func() {
+			mockService.EXPECT().GetHotelGroupBlocks(hotelID).Return([]*models.GroupBlocks{}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/group-blocks", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.GetHotelGroupBlocks(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestGroupBlockHandler_ReleaseGroupBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	handler := handlers.NewGroupBlockHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	blockID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), func() {}, http.StatusForbidden},
+		{"not found", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), func() {
+			mockService.EXPECT().ReleaseGroupBlock(managerCtx, blockID).Return(nil, group_block_repo.ErrGroupBlockNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), func() {
+			mockService.EXPECT().ReleaseGroupBlock(managerCtx, blockID).Return(nil, group_block_service.ErrGroupBlockAccessDenied)
+		}, http.StatusForbidden},
+		{"already released", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), func() {
+			mockService.EXPECT().ReleaseGroupBlock(managerCtx, blockID).Return(nil, group_block_service.ErrGroupBlockReleased)
+		}, http.StatusConflict},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), func() {
+			mockService.EXPECT().ReleaseGroupBlock(managerCtx, blockID).Return(&models.GroupBlocks{Id: blockID}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/group-blocks/release", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("blockId", blockID.String())
+			w := httptest.NewRecorder()
+
+			handler.ReleaseGroupBlock(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/api/handlers"
 	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
 	user_role "github.com/tktanisha/booking_system/internal/enums/user"
 	hotelMocks "github.com/tktanisha/booking_system/internal/mocks"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -144,7 +146,7 @@ func TestHotelHandler_GetHotelByID(t *testing.T) {
 			hotelIDStr: hotelID.String(),
 			mockService: func() {
 				mockHotelService.EXPECT().
-					GetHotelByID(hotelID).
+					GetHotelDetails(hotelID).
 					Return(nil, errors.New("service failed"))
 			},
 			wantStatusCode: http.StatusInternalServerError,
@@ -155,8 +157,8 @@ func TestHotelHandler_GetHotelByID(t *testing.T) {
 			hotelIDStr: hotelID.String(),
 			mockService: func() {
 				mockHotelService.EXPECT().
-					GetHotelByID(hotelID).
-					Return(&models.Hotels{Id: hotelID, Name: "Test Hotel"}, nil)
+					GetHotelDetails(hotelID).
+					Return(&models.Hotels{Id: hotelID, Name: "Test Hotel", Rating: &models.RatingSummary{Average: 4.5, Count: 2}}, nil)
 			},
 			wantStatusCode: http.StatusOK,
 		},
@@ -178,3 +180,112 @@ func TestHotelHandler_GetHotelByID(t *testing.T) {
 		})
 	}
 }
+
+func TestHotelHandler_SearchHotels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHotelService := hotelMocks.NewMockHotelServiceInterface(ctrl)
+	handler := handlers.NewHotelHandler(mockHotelService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		query          string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), "", func() {}, http.StatusUnauthorized},
+		{"invalid sort", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "?sort=price", func() {}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "", func() {
+			mockHotelService.EXPECT().SearchHotels(gomock.Any()).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"sorted by rating", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "?sort=rating", func() {
+			mockHotelService.EXPECT().SearchHotels(&payloads.HotelSearchPayload{Sort: hotel.SortByRating}).
+				Return([]*models.Hotels{{Name: "Test Hotel", Rating: &models.RatingSummary{Average: 4.5, Count: 2}}}, nil)
+		}, http.StatusOK},
+		{"unknown amenity", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "?amenities=casino", func() {}, http.StatusBadRequest},
+		{"malformed near", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "?near=airport", func() {}, http.StatusBadRequest},
+		{"nearest first", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "?near=19.0896,72.8656&radius_km=5", func() {
+			mockHotelService.EXPECT().SearchHotels(&payloads.HotelSearchPayload{
+				Near:     &payloads.GeoPointPayload{Latitude: 19.0896, Longitude: 72.8656},
+				RadiusKm: 5,
+				Sort:     hotel.SortByDistance,
+			}).Return([]*models.Hotels{{Name: "Airport Hotel"}}, nil)
+		}, http.StatusOK},
+		{"filtered on amenities", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "?amenities=wifi,pool", func() {
+			mockHotelService.EXPECT().SearchHotels(&payloads.HotelSearchPayload{Amenities: []hotel.Amenity{hotel.Wifi, hotel.Pool}, Sort: hotel.SortByName}).
+				Return([]*models.Hotels{{Name: "Test Hotel", Amenities: []hotel.Amenity{hotel.Wifi, hotel.Pool}}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels"+tt.query, nil)
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.SearchHotels(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHotelHandler_UpdateHotel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockHotelService := hotelMocks.NewMockHotelServiceInterface(ctrl)
+	handler := handlers.NewHotelHandler(mockHotelService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	validBody := `{"name":"Grand Plaza","address":"456 Avenue, Old Town","star_rating":4,"amenities":["wifi","parking"]}`
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		body           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), hotelID.String(), validBody, func() {}, http.StatusUnauthorized},
+		{"not a manager", context.WithValue(context.Background(), constants.UserContextKey, userCtx), hotelID.String(), validBody, func() {}, http.StatusForbidden},
+		{"invalid hotel id", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invalid-uuid", validBody, func() {}, http.StatusBadRequest},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), `{"name":"Grand Plaza","address":"456 Avenue, Old Town","star_rating":7}`, func() {}, http.StatusBadRequest},
+		{"another manager's hotel", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), validBody, func() {
+			mockHotelService.EXPECT().UpdateHotel(managerCtx, hotelID, gomock.Any()).Return(nil, hotel_service.ErrHotelAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), validBody, func() {
+			mockHotelService.EXPECT().UpdateHotel(managerCtx, hotelID, gomock.Any()).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), validBody, func() {
+			mockHotelService.EXPECT().UpdateHotel(managerCtx, hotelID, gomock.Any()).Return(&models.Hotels{Id: hotelID, Name: "Grand Plaza", StarRating: 4}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPut, "/hotels/", bytes.NewBufferString(tt.body))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.UpdateHotel(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/tktanisha/booking_system/internal/constants"
@@ -61,7 +62,7 @@ func (h *HotelHandler) GetHotelByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hotel, err := h.HotelService.GetHotelByID(hotelID)
+	hotel, err := h.HotelService.GetHotelDetails(hotelID)
 	if err != nil {
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve hotel", err.Error())
 		return
@@ -69,3 +70,62 @@ func (h *HotelHandler) GetHotelByID(w http.ResponseWriter, r *http.Request) {
 
 	utils.WriteSuccessResponse(w, http.StatusOK, "Hotel retrieved successfully", hotel)
 }
+
+func (h *HotelHandler) SearchHotels(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	payload, err := hotel_validators.ValidateHotelSearchParams(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid search parameters", err.Error())
+		return
+	}
+
+	hotels, err := h.HotelService.SearchHotels(payload)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to search hotels", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Hotels retrieved successfully", hotels)
+}
+
+func (h *HotelHandler) UpdateHotel(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can edit hotels")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := hotel_validators.ValidateUpdateHotelPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	hotel, err := h.HotelService.UpdateHotel(userContext, hotelID, payload)
+	if errors.Is(err, hotel_service.ErrHotelAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to update hotel", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Hotel updated successfully", hotel)
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/housekeeping_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+)
+
+type HousekeepingHandler struct {
+	HousekeepingService housekeeping_service.HousekeepingServiceInterface
+}
+
+func NewHousekeepingHandler(housekeepingService housekeeping_service.HousekeepingServiceInterface) *HousekeepingHandler {
+	return &HousekeepingHandler{
+		HousekeepingService: housekeepingService,
+	}
+}
+
+func (h *HousekeepingHandler) GetOpenTasksByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsHousekeeping(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only housekeeping staff can view tasks")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	tasks, err := h.HousekeepingService.GetOpenTasksByHotelID(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve housekeeping tasks", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Housekeeping tasks retrieved successfully!", tasks)
+}
+
+func (h *HousekeepingHandler) ClaimTask(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsHousekeeping(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only housekeeping staff can claim tasks")
+		return
+	}
+
+	taskID, err := utils.GetUUIDFromParams(r, "taskId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	task, err := h.HousekeepingService.ClaimTask(userContext, taskID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to claim task", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Task claimed successfully!", task)
+}
+
+func (h *HousekeepingHandler) CompleteTask(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsHousekeeping(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only housekeeping staff can complete tasks")
+		return
+	}
+
+	taskID, err := utils.GetUUIDFromParams(r, "taskId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	task, err := h.HousekeepingService.CompleteTask(userContext, taskID)
+	if errors.Is(err, housekeeping_service.ErrTaskNotAssigned) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to complete task", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Task completed successfully!", task)
+}
+
+func (h *HousekeepingHandler) InspectUnit(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsHousekeeping(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only housekeeping staff can inspect units")
+		return
+	}
+
+	unitID, err := utils.GetUUIDFromParams(r, "unitId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid unit ID", err.Error())
+		return
+	}
+
+	unit, err := h.HousekeepingService.InspectUnit(unitID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to inspect unit", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Unit inspected successfully!", unit)
+}
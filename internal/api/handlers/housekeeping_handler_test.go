@@ -0,0 +1,192 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/housekeeping_service"
+)
+
+func TestHousekeepingHandler_GetOpenTasksByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	handler := handlers.NewHousekeepingHandler(mockService)
+
+	staffCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleHousekeeping}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), hotelID.String(), func() {}, http.StatusUnauthorized},
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), hotelID.String(), func() {}, http.StatusForbidden},
+		{"invalid hotel id", context.WithValue(context.Background(), constants.UserContextKey, staffCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, staffCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetOpenTasksByHotelID(hotelID).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, staffCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetOpenTasksByHotelID(hotelID).Return([]*models.HousekeepingTasks{{Id: uuid.New()}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/housekeeping/tasks", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetOpenTasksByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHousekeepingHandler_ClaimTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	handler := handlers.NewHousekeepingHandler(mockService)
+
+	staffCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleHousekeeping}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	taskID := uuid.New()
+
+	tests := []struct {
+		name           string
+		userCtx        *models.UserContext
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"forbidden for front desk", frontDeskCtx, func() {}, http.StatusForbidden},
+		{"already claimed", staffCtx, func() {
+			mockService.EXPECT().ClaimTask(staffCtx, taskID).Return(nil, errors.New("task not found or already claimed"))
+		}, http.StatusInternalServerError},
+		{"success", staffCtx, func() {
+			mockService.EXPECT().ClaimTask(staffCtx, taskID).Return(&models.HousekeepingTasks{Id: taskID}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/housekeeping/tasks/claim", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, tt.userCtx))
+			req.SetPathValue("taskId", taskID.String())
+			w := httptest.NewRecorder()
+
+			handler.ClaimTask(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHousekeepingHandler_CompleteTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	handler := handlers.NewHousekeepingHandler(mockService)
+
+	staffCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleHousekeeping}
+	taskID := uuid.New()
+
+	tests := []struct {
+		name           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"not the assignee", func() {
+			mockService.EXPECT().CompleteTask(staffCtx, taskID).Return(nil, housekeeping_service.ErrTaskNotAssigned)
+		}, http.StatusForbidden},
+		{"service error", func() {
+			mockService.EXPECT().CompleteTask(staffCtx, taskID).Return(nil, errors.New("only claimed tasks can be completed"))
+		}, http.StatusInternalServerError},
+		{"success", func() {
+			mockService.EXPECT().CompleteTask(staffCtx, taskID).Return(&models.HousekeepingTasks{Id: taskID}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/housekeeping/tasks/complete", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, staffCtx))
+			req.SetPathValue("taskId", taskID.String())
+			w := httptest.NewRecorder()
+
+			handler.CompleteTask(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestHousekeepingHandler_InspectUnit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	handler := handlers.NewHousekeepingHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	unitID := uuid.New()
+
+	tests := []struct {
+		name           string
+		unitIDStr      string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"invalid unit id", "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"unit not clean", unitID.String(), func() {
+			mockService.EXPECT().InspectUnit(unitID).Return(nil, errors.New("only clean units can be inspected"))
+		}, http.StatusInternalServerError},
+		{"success", unitID.String(), func() {
+			mockService.EXPECT().InspectUnit(unitID).Return(&models.RoomUnits{Id: unitID}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/housekeeping/units/inspect", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("unitId", tt.unitIDStr)
+			w := httptest.NewRecorder()
+
+			handler.InspectUnit(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/invoice_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+)
+
+type InvoiceHandler struct {
+	InvoiceService invoice_service.InvoiceServiceInterface
+}
+
+func NewInvoiceHandler(invoiceService invoice_service.InvoiceServiceInterface) *InvoiceHandler {
+	return &InvoiceHandler{
+		InvoiceService: invoiceService,
+	}
+}
+
+// GetInvoice serves the booking invoice as JSON, or as a PDF download with ?format=pdf.
+func (h *InvoiceHandler) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	bookingID, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "pdf" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid format", "format must be json or pdf")
+		return
+	}
+
+	invoice, err := h.InvoiceService.GetInvoice(userContext, bookingID)
+	if errors.Is(err, invoice_service.ErrInvoiceAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, invoice_service.ErrInvoiceNotAvailable) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Invoice not available", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve invoice", err.Error())
+		return
+	}
+
+	if format == "pdf" {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+invoice.InvoiceNumber+`.pdf"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write(h.InvoiceService.RenderPDF(invoice))
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Invoice retrieved successfully!", invoice)
+}
@@ -0,0 +1,78 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/invoice_service"
+)
+
+func TestInvoiceHandler_GetInvoice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockInvoiceServiceInterface(ctrl)
+	handler := handlers.NewInvoiceHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	bookingID := uuid.New()
+	invoice := &models.Invoices{BookingId: bookingID, InvoiceNumber: "INV-000001"}
+
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		bookingIDStr    string
+		format          string
+		mockService     func()
+		wantStatusCode  int
+		wantContentType string
+	}{
+		{"unauthorized", context.Background(), bookingID.String(), "", func() {}, http.StatusUnauthorized, ""},
+		{"invalid booking id", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "invalid-uuid", "", func() {}, http.StatusBadRequest, ""},
+		{"invalid format", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), "xml", func() {}, http.StatusBadRequest, ""},
+		{"access denied", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), "", func() {
+			mockService.EXPECT().GetInvoice(userCtx, bookingID).Return(nil, invoice_service.ErrInvoiceAccessDenied)
+		}, http.StatusForbidden, ""},
+		{"not checked out", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), "", func() {
+			mockService.EXPECT().GetInvoice(userCtx, bookingID).Return(nil, invoice_service.ErrInvoiceNotAvailable)
+		}, http.StatusConflict, ""},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), "", func() {
+			mockService.EXPECT().GetInvoice(userCtx, bookingID).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError, ""},
+		{"json", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), "", func() {
+			mockService.EXPECT().GetInvoice(userCtx, bookingID).Return(invoice, nil)
+		}, http.StatusOK, "application/json"},
+		{"pdf", context.WithValue(context.Background(), constants.UserContextKey, userCtx), bookingID.String(), "pdf", func() {
+			mockService.EXPECT().GetInvoice(userCtx, bookingID).Return(invoice, nil)
+			mockService.EXPECT().RenderPDF(invoice).Return([]byte("%PDF-1.4"))
+		}, http.StatusOK, "application/pdf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/bookings/invoice?format="+tt.format, nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("bookingId", tt.bookingIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetInvoice(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+			if tt.wantContentType != "" && w.Header().Get("Content-Type") != tt.wantContentType {
+				t.Errorf("expected content type %q, got %q", tt.wantContentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/blob_repo"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/media_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/media_validators"
+)
+
+type MediaHandler struct {
+	MediaService media_service.MediaServiceInterface
+}
+
+func NewMediaHandler(mediaService media_service.MediaServiceInterface) *MediaHandler {
+	return &MediaHandler{
+		MediaService: mediaService,
+	}
+}
+
+func (h *MediaHandler) UploadHotelMedia(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can upload photos")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := media_validators.ValidateMediaUpload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	media, err := h.MediaService.UploadHotelMedia(userContext, hotelID, payload)
+	if !writeUploadError(w, err) {
+		utils.WriteSuccessResponse(w, http.StatusCreated, "Photo uploaded successfully!", media)
+	}
+}
+
+func (h *MediaHandler) UploadRoomTypeMedia(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can upload photos")
+		return
+	}
+
+	typeID, err := utils.GetUUIDFromParams(r, "typeId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid room type ID", err.Error())
+		return
+	}
+
+	payload, err := media_validators.ValidateMediaUpload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	media, err := h.MediaService.UploadRoomTypeMedia(userContext, typeID, payload)
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
+	if !writeUploadError(w, err) {
+		utils.WriteSuccessResponse(w, http.StatusCreated, "Photo uploaded successfully!", media)
+	}
+}
+
+// writeUploadError reports a failed upload and returns whether there was one.
+func writeUploadError(w http.ResponseWriter, err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, media_service.ErrMediaAccessDenied):
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+	case errors.Is(err, media_service.ErrInvalidImage):
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid image", err.Error())
+	default:
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to upload photo", err.Error())
+	}
+	return true
+}
+
+func (h *MediaHandler) GetHotelMedia(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	media, err := h.MediaService.GetHotelMedia(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve photos", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Photos retrieved successfully!", media)
+}
+
+func (h *MediaHandler) ReorderMedia(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can reorder photos")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := media_validators.ValidateMediaOrderPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	media, err := h.MediaService.ReorderMedia(userContext, hotelID, payload)
+	if errors.Is(err, media_service.ErrMediaAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, media_repo.ErrMediaNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Photo not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to reorder photos", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Photos reordered successfully!", media)
+}
+
+func (h *MediaHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can delete photos")
+		return
+	}
+
+	mediaID, err := utils.GetUUIDFromParams(r, "mediaId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid photo ID", err.Error())
+		return
+	}
+
+	err = h.MediaService.DeleteMedia(userContext, mediaID)
+	if errors.Is(err, media_service.ErrMediaAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, media_repo.ErrMediaNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Photo not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to delete photo", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Photo deleted successfully!", nil)
+}
+
+// GetMediaFile serves the photo itself, or its thumbnail with ?size=thumbnail.
+func (h *MediaHandler) GetMediaFile(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	mediaID, err := utils.GetUUIDFromParams(r, "mediaId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid photo ID", err.Error())
+		return
+	}
+
+	size := r.URL.Query().Get("size")
+	if size != "" && size != "thumbnail" {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid size", "size must be thumbnail or omitted")
+		return
+	}
+
+	data, contentType, err := h.MediaService.GetMediaFile(mediaID, size == "thumbnail")
+	if errors.Is(err, media_repo.ErrMediaNotFound) || errors.Is(err, blob_repo.ErrBlobNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Photo not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve photo", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,282 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/blob_repo"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/media_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// photoUpload builds a multipart request carrying data in its "file" field.
+func photoUpload(t *testing.T, data []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "photo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(data)
+	writer.Close()
+	return &body, writer.FormDataContentType()
+}
+
+func TestMediaHandler_UploadHotelMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockMediaServiceInterface(ctrl)
+	handler := handlers.NewMediaHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	var photo bytes.Buffer
+	png.Encode(&photo, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		data           []byte
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), photo.Bytes(), func() {}, http.StatusUnauthorized},
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), photo.Bytes(), func() {}, http.StatusForbidden},
+		{"not an image", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), []byte("plain text"), func() {}, http.StatusBadRequest},
+		{"another manager's hotel", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), photo.Bytes(), func() {
+			mockService.EXPECT().UploadHotelMedia(managerCtx, hotelID, gomock.Any()).Return(nil, media_service.ErrMediaAccessDenied)
+		}, http.StatusForbidden},
+		{"undecodable image", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), photo.Bytes(), func() {
+			mockService.EXPECT().UploadHotelMedia(managerCtx, hotelID, gomock.Any()).Return(nil, media_service.ErrInvalidImage)
+		}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), photo.Bytes(), func() {
+			mockService.EXPECT().UploadHotelMedia(managerCtx, hotelID, gomock.Any()).Return(nil, errors.New("disk full"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), photo.Bytes(), func() {
+			mockService.EXPECT().UploadHotelMedia(managerCtx, hotelID, gomock.Any()).Return(&models.Media{Id: uuid.New()}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			body, contentType := photoUpload(t, tt.data)
+			req := httptest.NewRequest(http.MethodPost, "/hotels/media", body)
+			req.Header.Set("Content-Type", contentType)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.UploadHotelMedia(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestMediaHandler_UploadRoomTypeMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockMediaServiceInterface(ctrl)
+	handler := handlers.NewMediaHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	typeID := uuid.New()
+
+	var photo bytes.Buffer
+	png.Encode(&photo, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+
+	tests := []struct {
+		name           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"room type not found", func() {
+			mockService.EXPECT().UploadRoomTypeMedia(managerCtx, typeID, gomock.Any()).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+		}, http.StatusNotFound},
+		{"success", func() {
+			mockService.EXPECT().UploadRoomTypeMedia(managerCtx, typeID, gomock.Any()).Return(&models.Media{Id: uuid.New()}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			body, contentType := photoUpload(t, photo.Bytes())
+			req := httptest.NewRequest(http.MethodPost, "/rooms/types/media", body)
+			req.Header.Set("Content-Type", contentType)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("typeId", typeID.String())
+			w := httptest.NewRecorder()
+
+			handler.UploadRoomTypeMedia(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestMediaHandler_ReorderMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockMediaServiceInterface(ctrl)
+	handler := handlers.NewMediaHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	hotelID := uuid.New()
+	validPayload := &payloads.MediaOrderPayload{MediaIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+
+	tests := []struct {
+		name           string
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"empty order", &payloads.MediaOrderPayload{}, func() {}, http.StatusBadRequest},
+		{"photo from elsewhere", validPayload, func() {
+			mockService.EXPECT().ReorderMedia(managerCtx, hotelID, gomock.Any()).Return(nil, media_repo.ErrMediaNotFound)
+		}, http.StatusNotFound},
+		{"another manager's hotel", validPayload, func() {
+			mockService.EXPECT().ReorderMedia(managerCtx, hotelID, gomock.Any()).Return(nil, media_service.ErrMediaAccessDenied)
+		}, http.StatusForbidden},
+		{"success", validPayload, func() {
+			mockService.EXPECT().ReorderMedia(managerCtx, hotelID, gomock.Any()).Return([]*models.Media{}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPut, "/hotels/media/order", bytes.NewReader(jsonBody))
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.ReorderMedia(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestMediaHandler_DeleteMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockMediaServiceInterface(ctrl)
+	handler := handlers.NewMediaHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	mediaID := uuid.New()
+
+	tests := []struct {
+		name           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"not found", func() {
+			mockService.EXPECT().DeleteMedia(managerCtx, mediaID).Return(media_repo.ErrMediaNotFound)
+		}, http.StatusNotFound},
+		{"another manager's hotel", func() {
+			mockService.EXPECT().DeleteMedia(managerCtx, mediaID).Return(media_service.ErrMediaAccessDenied)
+		}, http.StatusForbidden},
+		{"success", func() {
+			mockService.EXPECT().DeleteMedia(managerCtx, mediaID).Return(nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodDelete, "/media", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("mediaId", mediaID.String())
+			w := httptest.NewRecorder()
+
+			handler.DeleteMedia(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestMediaHandler_GetMediaFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockMediaServiceInterface(ctrl)
+	handler := handlers.NewMediaHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	mediaID := uuid.New()
+
+	tests := []struct {
+		name            string
+		query           string
+		mockService     func()
+		wantStatusCode  int
+		wantContentType string
+	}{
+		{"invalid size", "?size=huge", func() {}, http.StatusBadRequest, ""},
+		{"missing file", "", func() {
+			mockService.EXPECT().GetMediaFile(mediaID, false).Return(nil, "", blob_repo.ErrBlobNotFound)
+		}, http.StatusNotFound, ""},
+		{"original", "", func() {
+			mockService.EXPECT().GetMediaFile(mediaID, false).Return([]byte("png"), "image/png", nil)
+		}, http.StatusOK, "image/png"},
+		{"thumbnail", "?size=thumbnail", func() {
+			mockService.EXPECT().GetMediaFile(mediaID, true).Return([]byte("jpeg"), "image/jpeg", nil)
+		}, http.StatusOK, "image/jpeg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/media"+tt.query, nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, userCtx))
+			req.SetPathValue("mediaId", mediaID.String())
+			w := httptest.NewRecorder()
+
+			handler.GetMediaFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+			if tt.wantContentType != "" && w.Header().Get("Content-Type") != tt.wantContentType {
+				t.Errorf("expected content type %s, got %s", tt.wantContentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/notification_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+)
+
+type NotificationHandler struct {
+	NotificationService notification_service.NotificationServiceInterface
+}
+
+func NewNotificationHandler(notificationService notification_service.NotificationServiceInterface) *NotificationHandler {
+	return &NotificationHandler{
+		NotificationService: notificationService,
+	}
+}
+
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	notifications, err := h.NotificationService.GetNotifications(userContext)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve notifications", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Notifications retrieved successfully!", notifications)
+}
@@ -0,0 +1,56 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+func TestNotificationHandler_GetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockNotificationServiceInterface(ctrl)
+	handler := handlers.NewNotificationHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), func() {}, http.StatusUnauthorized},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), func() {
+			mockService.EXPECT().GetNotifications(userCtx).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, userCtx), func() {
+			mockService.EXPECT().GetNotifications(userCtx).Return([]*models.Notifications{{Id: uuid.New()}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/notifications", nil)
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.GetNotifications(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
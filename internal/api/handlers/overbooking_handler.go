@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/overbooking_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/overbooking_validators"
+)
+
+type OverbookingHandler struct {
+	OverbookingService overbooking_service.OverbookingServiceInterface
+}
+
+func NewOverbookingHandler(overbookingService overbooking_service.OverbookingServiceInterface) *OverbookingHandler {
+	return &OverbookingHandler{
+		OverbookingService: overbookingService,
+	}
+}
+
+func (h *OverbookingHandler) SetOverbookingLimit(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can set overbooking limits")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := overbooking_validators.ValidateOverbookingLimitPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	limit, err := h.OverbookingService.SetLimit(userContext, hotelID, payload)
+	if errors.Is(err, overbooking_service.ErrOverbookingAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to set overbooking limit", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Overbooking limit set successfully!", limit)
+}
+
+func (h *OverbookingHandler) GetOverbookingLimits(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only hotel staff can view overbooking limits")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	limits, err := h.OverbookingService.GetLimits(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve overbooking limits", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Overbooking limits retrieved successfully!", limits)
+}
+
+func (h *OverbookingHandler) GetWalkList(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only hotel staff can view the walk list")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	payload, err := overbooking_validators.ValidateWalkListParams(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	walkList, err := h.OverbookingService.GetWalkList(hotelID, payload)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve walk list", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Walk list retrieved successfully!", walkList)
+}
+
+func (h *OverbookingHandler) GetOverbookingAudits(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can view oversold bookings")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	audits, err := h.OverbookingService.GetAudits(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve oversold bookings", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Oversold bookings retrieved successfully!", audits)
+}
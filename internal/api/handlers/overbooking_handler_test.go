@@ -0,0 +1,165 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/overbooking_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestOverbookingHandler_SetOverbookingLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	handler := handlers.NewOverbookingHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	hotelID := uuid.New()
+	validPayload := &payloads.OverbookingLimitPayload{RoomType: room.Double, LimitPercent: 10}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validPayload, func() {}, http.StatusUnauthorized},
+		{"forbidden for front desk", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), validPayload, func() {}, http.StatusForbidden},
+		{"limit too high", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), &payloads.OverbookingLimitPayload{RoomType: room.Double, LimitPercent: 80}, func() {}, http.StatusBadRequest},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().SetLimit(managerCtx, hotelID, validPayload).Return(nil, overbooking_service.ErrOverbookingAccessDenied)
+		}, http.StatusForbidden},
+		{"unknown room type", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().SetLimit(managerCtx, hotelID, validPayload).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+		}, http.StatusNotFound},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().SetLimit(managerCtx, hotelID, validPayload).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().SetLimit(managerCtx, hotelID, validPayload).Return(&models.OverbookingLimits{Id: uuid.New()}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPut, "/hotels/overbooking-limits", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.SetOverbookingLimit(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestOverbookingHandler_GetWalkList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	handler := handlers.NewOverbookingHandler(mockService)
+
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		query          string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), "", func() {}, http.StatusForbidden},
+		{"range too long", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), "?from=2030-05-01&to=2030-07-01", func() {}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), "", func() {
+			mockService.EXPECT().GetWalkList(hotelID, &payloads.WalkListPayload{}).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), "?from=2030-05-01", func() {
+			mockService.EXPECT().GetWalkList(hotelID, gomock.Any()).Return([]*models.WalkListNights{}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/walk-list"+tt.query, nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.GetWalkList(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestOverbookingHandler_GetOverbookingAudits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	handler := handlers.NewOverbookingHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"forbidden for front desk", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), func() {}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), func() {
+			mockService.EXPECT().GetAudits(hotelID).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), func() {
+			mockService.EXPECT().GetAudits(hotelID).Return([]*models.OverbookingAudits{}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/overbooking-audits", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.GetOverbookingAudits(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
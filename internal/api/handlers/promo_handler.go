@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/promo_validators"
+)
+
+type PromoHandler struct {
+	PromoService promo_service.PromoServiceInterface
+}
+
+func NewPromoHandler(promoService promo_service.PromoServiceInterface) *PromoHandler {
+	return &PromoHandler{
+		PromoService: promoService,
+	}
+}
+
+func (h *PromoHandler) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can create promo codes")
+		return
+	}
+
+	payload, err := promo_validators.ValidateCreatePromoCodePayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	promoCode, err := h.PromoService.CreatePromoCode(userContext, payload)
+	if errors.Is(err, promo_service.ErrPromoAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create promo code", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Promo code created successfully!", promoCode)
+}
+
+func (h *PromoHandler) GetPromoCodesByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can list promo codes")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	promoCodes, err := h.PromoService.GetPromoCodesByHotelID(userContext, hotelID)
+	if errors.Is(err, promo_service.ErrPromoAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve promo codes", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Promo codes retrieved successfully!", promoCodes)
+}
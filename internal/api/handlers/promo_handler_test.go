@@ -0,0 +1,160 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestPromoHandler_CreatePromoCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPromoServiceInterface(ctrl)
+	handler := handlers.NewPromoHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+
+	validPayload := &payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: uuid.New(), DiscountType: promo.Percentage, Value: 10,
+		ValidFrom: time.Now(), ValidTo: time.Now().Add(30 * 24 * time.Hour), MaxUses: 100, PerUserLimit: 1}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden for non-manager",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid payload",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body:           &payloads.CreatePromoCodePayload{Code: "SUMMER10"},
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "another hotel's manager",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePromoCode(managerCtx, gomock.Any()).Return(nil, promo_service.ErrPromoAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "service error",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePromoCode(managerCtx, gomock.Any()).Return(nil, errors.New("duplicate code"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreatePromoCode(managerCtx, gomock.Any()).Return(&models.PromoCodes{Id: uuid.New(), Code: "SUMMER10"}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/promo-codes/create", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreatePromoCode(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestPromoHandler_GetPromoCodesByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPromoServiceInterface(ctrl)
+	handler := handlers.NewPromoHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), hotelID.String(), func() {}, http.StatusUnauthorized},
+		{"forbidden for non-manager", context.WithValue(context.Background(), constants.UserContextKey, userCtx), hotelID.String(), func() {}, http.StatusForbidden},
+		{"invalid hotel id", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetPromoCodesByHotelID(managerCtx, hotelID).Return(nil, promo_service.ErrPromoAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetPromoCodesByHotelID(managerCtx, hotelID).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetPromoCodesByHotelID(managerCtx, hotelID).Return([]*models.PromoCodes{{Code: "SUMMER10"}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/promo-codes/", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetPromoCodesByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
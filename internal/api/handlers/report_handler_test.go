@@ -0,0 +1,76 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/report_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestReportHandler_GetReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportServiceInterface(ctrl)
+	handler := handlers.NewReportHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	hotelID := uuid.New()
+	validQuery := "?from=2030-05-01&to=2030-06-01&group_by=week"
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		query          string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validQuery, func() {}, http.StatusUnauthorized},
+		{"forbidden for front desk", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), validQuery, func() {}, http.StatusForbidden},
+		{"missing dates", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "", func() {}, http.StatusBadRequest},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validQuery, func() {
+			mockService.EXPECT().GetReport(managerCtx, hotelID, gomock.Any()).Return(nil, report_service.ErrReportAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validQuery, func() {
+			mockService.EXPECT().GetReport(managerCtx, hotelID, gomock.Any()).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validQuery, func() {
+			mockService.EXPECT().GetReport(managerCtx, hotelID, gomock.Any()).DoAndReturn(
+				func(_ *models.UserContext, _ uuid.UUID, payload *payloads.ReportPayload) (*models.Reports, error) {
+					if payload.GroupBy != report.GroupByWeek || payload.From.String() != "2030-05-01" {
+						t.Errorf("unexpected payload %+v", payload)
+					}
+					return &models.Reports{HotelId: hotelID}, nil
+				})
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/reports"+tt.query, nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.GetReport(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
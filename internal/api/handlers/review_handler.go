@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+	"github.com/tktanisha/booking_system/internal/services/review_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/review_validators"
+)
+
+type ReviewHandler struct {
+	ReviewService review_service.ReviewServiceInterface
+}
+
+func NewReviewHandler(reviewService review_service.ReviewServiceInterface) *ReviewHandler {
+	return &ReviewHandler{
+		ReviewService: reviewService,
+	}
+}
+
+func (h *ReviewHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	bookingID, err := utils.GetUUIDFromParams(r, "bookingId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid booking ID", err.Error())
+		return
+	}
+
+	payload, err := review_validators.ValidateReviewPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	review, err := h.ReviewService.CreateReview(userContext, bookingID, payload)
+	if errors.Is(err, review_service.ErrReviewAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, review_service.ErrStayNotVerified) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Stay not completed", err.Error())
+		return
+	}
+	if errors.Is(err, review_repo.ErrReviewExists) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Already reviewed", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create review", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Review created successfully!", review)
+}
+
+func (h *ReviewHandler) ReplyToReview(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can reply to reviews")
+		return
+	}
+
+	reviewID, err := utils.GetUUIDFromParams(r, "reviewId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid review ID", err.Error())
+		return
+	}
+
+	payload, err := review_validators.ValidateReviewReplyPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	review, err := h.ReviewService.ReplyToReview(userContext, reviewID, payload)
+	if errors.Is(err, review_repo.ErrReviewNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Review not found", err.Error())
+		return
+	}
+	if errors.Is(err, review_service.ErrReplyAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to reply to review", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Reply saved successfully!", review)
+}
+
+func (h *ReviewHandler) GetHotelReviews(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	reviews, err := h.ReviewService.GetHotelReviews(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve reviews", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Reviews retrieved successfully!", reviews)
+}
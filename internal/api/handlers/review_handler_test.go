@@ -0,0 +1,175 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+	"github.com/tktanisha/booking_system/internal/services/review_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestReviewHandler_CreateReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReviewServiceInterface(ctrl)
+	handler := handlers.NewReviewHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	bookingID := uuid.New()
+	validPayload := &payloads.ReviewPayload{Rating: 5, Body: "Wonderful stay"}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validPayload, func() {}, http.StatusUnauthorized},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, userCtx), &payloads.ReviewPayload{Rating: 9, Body: "?"}, func() {}, http.StatusBadRequest},
+		{"not the guest", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().CreateReview(userCtx, bookingID, gomock.Any()).Return(nil, review_service.ErrReviewAccessDenied)
+		}, http.StatusForbidden},
+		{"stay not completed", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().CreateReview(userCtx, bookingID, gomock.Any()).Return(nil, review_service.ErrStayNotVerified)
+		}, http.StatusConflict},
+		{"already reviewed", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().CreateReview(userCtx, bookingID, gomock.Any()).Return(nil, review_repo.ErrReviewExists)
+		}, http.StatusConflict},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().CreateReview(userCtx, bookingID, gomock.Any()).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().CreateReview(userCtx, bookingID, gomock.Any()).Return(&models.Reviews{Id: uuid.New(), Rating: 5}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/bookings/review", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("bookingId", bookingID.String())
+			w := httptest.NewRecorder()
+
+			handler.CreateReview(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestReviewHandler_ReplyToReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReviewServiceInterface(ctrl)
+	handler := handlers.NewReviewHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	reviewID := uuid.New()
+	validPayload := &payloads.ReviewReplyPayload{Reply: "Thank you!"}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validPayload, func() {}, http.StatusUnauthorized},
+		{"forbidden for front desk", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), validPayload, func() {}, http.StatusForbidden},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), &payloads.ReviewReplyPayload{}, func() {}, http.StatusBadRequest},
+		{"review not found", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().ReplyToReview(managerCtx, reviewID, gomock.Any()).Return(nil, review_repo.ErrReviewNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().ReplyToReview(managerCtx, reviewID, gomock.Any()).Return(nil, review_service.ErrReplyAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().ReplyToReview(managerCtx, reviewID, gomock.Any()).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validPayload, func() {
+			mockService.EXPECT().ReplyToReview(managerCtx, reviewID, gomock.Any()).Return(&models.Reviews{Id: reviewID, Reply: "Thank you!"}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/reviews/reply", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("reviewId", reviewID.String())
+			w := httptest.NewRecorder()
+
+			handler.ReplyToReview(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestReviewHandler_GetHotelReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReviewServiceInterface(ctrl)
+	handler := handlers.NewReviewHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), hotelID.String(), func() {}, http.StatusUnauthorized},
+		{"invalid hotel id", context.WithValue(context.Background(), constants.UserContextKey, userCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetHotelReviews(hotelID).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, userCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetHotelReviews(hotelID).Return([]*models.Reviews{{Rating: 4}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/reviews", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetHotelReviews(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
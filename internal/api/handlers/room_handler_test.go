@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -17,6 +18,10 @@ import (
 	user_role "github.com/tktanisha/booking_system/internal/enums/user"
 	roomMocks "github.com/tktanisha/booking_system/internal/mocks"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_block_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/exchange_service"
+	"github.com/tktanisha/booking_system/internal/services/room_service"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -25,7 +30,8 @@ func TestRoomHandler_CreateRoom(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRoomService := roomMocks.NewMockRoomServiceInterface(ctrl)
-	handler := handlers.NewRoomHandler(mockRoomService)
+	mockWaitlistService := roomMocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewRoomHandler(mockRoomService, mockWaitlistService)
 
 	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
 	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
@@ -65,6 +71,17 @@ func TestRoomHandler_CreateRoom(t *testing.T) {
 			mockService:    func() {},
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name:    "unknown room type",
+			ctx:     context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			payload: roomPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().
+					CreateRoom(roomPayload).
+					Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
 		{
 			name:    "service error",
 			ctx:     context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
@@ -111,7 +128,8 @@ func TestRoomHandler_GetAllRoomByHotelID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRoomService := roomMocks.NewMockRoomServiceInterface(ctrl)
-	handler := handlers.NewRoomHandler(mockRoomService)
+	mockWaitlistService := roomMocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewRoomHandler(mockRoomService, mockWaitlistService)
 
 	userCtx := &models.UserContext{Id: uuid.New()}
 	hotelID := uuid.New()
@@ -120,6 +138,7 @@ func TestRoomHandler_GetAllRoomByHotelID(t *testing.T) {
 		name           string
 		ctx            context.Context
 		pathHotelID    string
+		query          string
 		mockService    func()
 		wantStatusCode int
 	}{
@@ -156,6 +175,49 @@ func TestRoomHandler_GetAllRoomByHotelID(t *testing.T) {
 				mockRoomService.EXPECT().
 					GetAllRoomByHotelID(hotelID).
 					Return([]*models.Rooms{{Id: uuid.New(), RoomCategory: room.Double, AvailableQuantity: 5}}, nil)
+				mockRoomService.EXPECT().SetRoomMedia(gomock.Any(), hotelID).Return(nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:        "unable to load photos",
+			ctx:         context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			pathHotelID: hotelID.String(),
+			mockService: func() {
+				mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{Id: uuid.New()}}, nil)
+				mockRoomService.EXPECT().SetRoomMedia(gomock.Any(), hotelID).Return(errors.New("db error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:           "invalid display currency",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			pathHotelID:    hotelID.String(),
+			query:          "?currency=ABC",
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "display currency without a rate",
+			ctx:         context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			pathHotelID: hotelID.String(),
+			query:       "?currency=gbp",
+			mockService: func() {
+				mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{Price: 10000, Currency: "USD"}}, nil)
+				mockRoomService.EXPECT().SetRoomMedia(gomock.Any(), hotelID).Return(nil)
+				mockRoomService.EXPECT().SetDisplayPrices(gomock.Any(), "GBP").Return(exchange_service.ErrRateUnavailable)
+			},
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "success with display currency",
+			ctx:         context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			pathHotelID: hotelID.String(),
+			query:       "?currency=EUR",
+			mockService: func() {
+				mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{Price: 10000, Currency: "USD"}}, nil)
+				mockRoomService.EXPECT().SetRoomMedia(gomock.Any(), hotelID).Return(nil)
+				mockRoomService.EXPECT().SetDisplayPrices(gomock.Any(), "EUR").Return(nil)
 			},
 			wantStatusCode: http.StatusOK,
 		},
@@ -164,7 +226,7 @@ func TestRoomHandler_GetAllRoomByHotelID(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockService()
-			req := httptest.NewRequest(http.MethodGet, "/rooms/", nil)
+			req := httptest.NewRequest(http.MethodGet, "/rooms/"+tt.query, nil)
 			req = req.WithContext(tt.ctx)
 			req.SetPathValue("hotelId", tt.pathHotelID)
 			w := httptest.NewRecorder()
@@ -182,7 +244,8 @@ func TestRoomHandler_IncreaseRoomQuantity(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRoomService := roomMocks.NewMockRoomServiceInterface(ctrl)
-	handler := handlers.NewRoomHandler(mockRoomService)
+	mockWaitlistService := roomMocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewRoomHandler(mockRoomService, mockWaitlistService)
 
 	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
 	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
@@ -234,6 +297,21 @@ func TestRoomHandler_IncreaseRoomQuantity(t *testing.T) {
 			},
 			wantStatusCode: http.StatusInternalServerError,
 		},
+		{
+			name:        "waitlist offer failure does not fail the increase",
+			ctx:         context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			pathHotelID: hotelID.String(),
+			payload:     roomPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().
+					IncreaseRoomQuantity(roomPayload[0], hotelID).
+					Return(&models.Rooms{Id: uuid.New(), RoomCategory: room.Double, AvailableQuantity: 5}, nil)
+				mockWaitlistService.EXPECT().
+					OfferFreedRooms(hotelID, room.Double).
+					Return(nil, errors.New("notify failed"))
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name:        "success",
 			ctx:         context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
@@ -243,6 +321,9 @@ func TestRoomHandler_IncreaseRoomQuantity(t *testing.T) {
 				mockRoomService.EXPECT().
 					IncreaseRoomQuantity(roomPayload[0], hotelID).
 					Return(&models.Rooms{Id: uuid.New(), RoomCategory: room.Double, AvailableQuantity: 5}, nil)
+				mockWaitlistService.EXPECT().
+					OfferFreedRooms(hotelID, room.Double).
+					Return([]*models.WaitlistEntries{{Id: uuid.New()}}, nil)
 			},
 			wantStatusCode: http.StatusOK,
 		},
@@ -267,3 +348,169 @@ func TestRoomHandler_IncreaseRoomQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestRoomHandler_CreateBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoomService := roomMocks.NewMockRoomServiceInterface(ctrl)
+	mockWaitlistService := roomMocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewRoomHandler(mockRoomService, mockWaitlistService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	start := time.Now().Add(24 * time.Hour)
+	validPayload := &payloads.CreateRoomBlockPayload{
+		HotelID:   uuid.New(),
+		RoomType:  room.Double,
+		Quantity:  2,
+		StartDate: start,
+		EndDate:   start.Add(48 * time.Hour),
+		Reason:    "renovation",
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           interface{}
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid payload",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body:           map[string]interface{}{"hotel_id": uuid.New()},
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "another hotel's manager",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().CreateBlock(managerCtx, gomock.Any()).
+					Return(nil, room_service.ErrBlockAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "unknown room type",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().CreateBlock(managerCtx, gomock.Any()).
+					Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "conflicts with bookings",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().CreateBlock(managerCtx, gomock.Any()).
+					Return(nil, room_service.ErrBlockConflictsWithBookings)
+			},
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name: "service error",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().CreateBlock(managerCtx, gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockRoomService.EXPECT().CreateBlock(managerCtx, gomock.Any()).
+					Return(&models.RoomBlocks{Id: uuid.New()}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/rooms/blocks/create", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateBlock(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRoomHandler_DeleteBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRoomService := roomMocks.NewMockRoomServiceInterface(ctrl)
+	mockWaitlistService := roomMocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewRoomHandler(mockRoomService, mockWaitlistService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	blockID := uuid.New()
+
+	tests := []struct {
+		name           string
+		blockIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"invalid block id", "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"block not found", blockID.String(), func() {
+			mockRoomService.EXPECT().DeleteBlock(managerCtx, blockID).Return(room_block_repo.ErrRoomBlockNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", blockID.String(), func() {
+			mockRoomService.EXPECT().DeleteBlock(managerCtx, blockID).Return(room_service.ErrBlockAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", blockID.String(), func() {
+			mockRoomService.EXPECT().DeleteBlock(managerCtx, blockID).Return(errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", blockID.String(), func() {
+			mockRoomService.EXPECT().DeleteBlock(managerCtx, blockID).Return(nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodDelete, "/rooms/blocks/", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("blockId", tt.blockIDStr)
+			w := httptest.NewRecorder()
+
+			handler.DeleteBlock(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
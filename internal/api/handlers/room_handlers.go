@@ -1,23 +1,31 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/tktanisha/booking_system/internal/constants"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_block_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/exchange_service"
 	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
 	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
 	"github.com/tktanisha/booking_system/internal/utils/permissions"
 	validators "github.com/tktanisha/booking_system/internal/utils/validators/rooms_validators"
 )
 
 type RoomHandler struct {
-	RoomService room_service.RoomServiceInterface
+	RoomService     room_service.RoomServiceInterface
+	WaitlistService waitlist_service.WaitlistServiceInterface
 }
 
-func NewRoomHandler(roomService room_service.RoomServiceInterface) *RoomHandler {
+func NewRoomHandler(roomService room_service.RoomServiceInterface, waitlistService waitlist_service.WaitlistServiceInterface) *RoomHandler {
 	return &RoomHandler{
-		RoomService: roomService,
+		RoomService:     roomService,
+		WaitlistService: waitlistService,
 	}
 }
 
@@ -40,6 +48,10 @@ func (h *RoomHandler) CreateRoom(w http.ResponseWriter, r *http.Request) {
 	}
 
 	room, err := h.RoomService.CreateRoom(payload)
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
 	if err != nil {
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create room", err.Error())
 		return
@@ -61,12 +73,35 @@ func (h *RoomHandler) GetAllRoomByHotelID(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	displayCurrency := currency.Normalize(r.URL.Query().Get("currency"))
+	if displayCurrency != "" && !currency.IsValid(displayCurrency) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid currency", "currency must be a supported ISO 4217 code")
+		return
+	}
+
 	rooms, err := h.RoomService.GetAllRoomByHotelID(hotelID)
 	if err != nil {
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve rooms", err.Error())
 		return
 	}
 
+	if err := h.RoomService.SetRoomMedia(rooms, hotelID); err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve rooms", err.Error())
+		return
+	}
+
+	if displayCurrency != "" {
+		err = h.RoomService.SetDisplayPrices(rooms, displayCurrency)
+		if errors.Is(err, exchange_service.ErrRateUnavailable) {
+			utils.WriteErrorResponse(w, http.StatusUnprocessableEntity, "Currency not available", err.Error())
+			return
+		}
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to convert prices", err.Error())
+			return
+		}
+	}
+
 	utils.WriteSuccessResponse(w, http.StatusOK, "Rooms retrieved successfully!", rooms)
 }
 
@@ -104,5 +139,115 @@ func (h *RoomHandler) IncreaseRoomQuantity(w http.ResponseWriter, r *http.Reques
 		updatedRooms = append(updatedRooms, updated)
 	}
 
+	for _, roomToInc := range roomPayload {
+		// the rooms were added either way; a failed offer is retried by the waitlist job
+		_, _ = h.WaitlistService.OfferFreedRooms(hotelId, roomToInc.RoomType)
+	}
+
 	utils.WriteSuccessResponse(w, http.StatusOK, "Room quantity increased successfully!", updatedRooms)
 }
+
+func (h *RoomHandler) CreateBlock(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can block rooms")
+		return
+	}
+
+	payload, err := validators.ValidateCreateRoomBlockPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	block, err := h.RoomService.CreateBlock(userContext, payload)
+	if errors.Is(err, room_service.ErrBlockAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
+	if errors.Is(err, room_service.ErrBlockConflictsWithBookings) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Block conflicts with bookings", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to block rooms", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Rooms blocked successfully!", block)
+}
+
+func (h *RoomHandler) GetBlocksByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can view room blocks")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	blocks, err := h.RoomService.GetBlocksByHotelID(userContext, hotelID)
+	if errors.Is(err, room_service.ErrBlockAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve room blocks", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Room blocks retrieved successfully!", blocks)
+}
+
+func (h *RoomHandler) DeleteBlock(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can remove room blocks")
+		return
+	}
+
+	blockID, err := utils.GetUUIDFromParams(r, "blockId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid block ID", err.Error())
+		return
+	}
+
+	err = h.RoomService.DeleteBlock(userContext, blockID)
+	if errors.Is(err, room_block_repo.ErrRoomBlockNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room block not found", err.Error())
+		return
+	}
+	if errors.Is(err, room_service.ErrBlockAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to remove room block", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Room block removed successfully!", nil)
+}
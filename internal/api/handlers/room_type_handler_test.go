@@ -0,0 +1,206 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+)
+
+func TestRoomTypeHandler_CreateRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	handler := handlers.NewRoomTypeHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	validBody := `{"hotel_id":"` + uuid.New().String() + `","code":"villa","name":"Garden Villa","max_occupancy":6}`
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validBody, func() {}, http.StatusUnauthorized},
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), validBody, func() {}, http.StatusForbidden},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), `{"code":"villa"}`, func() {}, http.StatusBadRequest},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateRoomType(managerCtx, gomock.Any()).Return(nil, room_type_service.ErrRoomTypeAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateRoomType(managerCtx, gomock.Any()).Return(nil, errors.New("room type already registered for this hotel"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateRoomType(managerCtx, gomock.Any()).Return(&models.RoomTypes{Id: uuid.New(), Code: "villa"}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/rooms/types/create", bytes.NewBufferString(tt.body))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateRoomType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRoomTypeHandler_GetRoomTypesByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	handler := handlers.NewRoomTypeHandler(mockService)
+
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"invalid hotel id", "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"service error", hotelID.String(), func() {
+			mockService.EXPECT().GetRoomTypesByHotelID(hotelID).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", hotelID.String(), func() {
+			mockService.EXPECT().GetRoomTypesByHotelID(hotelID).Return([]*models.RoomTypes{{Code: "villa"}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/rooms/types", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, guestCtx))
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetRoomTypesByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRoomTypeHandler_UpdateRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	handler := handlers.NewRoomTypeHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	typeID := uuid.New()
+
+	tests := []struct {
+		name           string
+		typeIDStr      string
+		body           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"invalid type id", "invalid-uuid", `{"name":"Villa","max_occupancy":6}`, func() {}, http.StatusBadRequest},
+		{"invalid payload", typeID.String(), `{"name":"Villa"}`, func() {}, http.StatusBadRequest},
+		{"unknown type", typeID.String(), `{"name":"Villa","max_occupancy":6}`, func() {
+			mockService.EXPECT().UpdateRoomType(managerCtx, typeID, gomock.Any()).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", typeID.String(), `{"name":"Villa","max_occupancy":6}`, func() {
+			mockService.EXPECT().UpdateRoomType(managerCtx, typeID, gomock.Any()).Return(nil, room_type_service.ErrRoomTypeAccessDenied)
+		}, http.StatusForbidden},
+		{"success", typeID.String(), `{"name":"Villa","max_occupancy":6}`, func() {
+			mockService.EXPECT().UpdateRoomType(managerCtx, typeID, gomock.Any()).Return(&models.RoomTypes{Id: typeID}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPut, "/rooms/types", bytes.NewBufferString(tt.body))
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("typeId", tt.typeIDStr)
+			w := httptest.NewRecorder()
+
+			handler.UpdateRoomType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRoomTypeHandler_DeleteRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	handler := handlers.NewRoomTypeHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	typeID := uuid.New()
+
+	tests := []struct {
+		name           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"type in use", func() {
+			mockService.EXPECT().DeleteRoomType(managerCtx, typeID).Return(room_type_service.ErrRoomTypeInUse)
+		}, http.StatusConflict},
+		{"unknown type", func() {
+			mockService.EXPECT().DeleteRoomType(managerCtx, typeID).Return(room_type_repo.ErrRoomTypeNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", func() {
+			mockService.EXPECT().DeleteRoomType(managerCtx, typeID).Return(room_type_service.ErrRoomTypeAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", func() {
+			mockService.EXPECT().DeleteRoomType(managerCtx, typeID).Return(errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", func() {
+			mockService.EXPECT().DeleteRoomType(managerCtx, typeID).Return(nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodDelete, "/rooms/types", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("typeId", typeID.String())
+			w := httptest.NewRecorder()
+
+			handler.DeleteRoomType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
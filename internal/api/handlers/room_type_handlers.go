@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	validators "github.com/tktanisha/booking_system/internal/utils/validators/rooms_validators"
+)
+
+type RoomTypeHandler struct {
+	RoomTypeService room_type_service.RoomTypeServiceInterface
+}
+
+func NewRoomTypeHandler(roomTypeService room_type_service.RoomTypeServiceInterface) *RoomTypeHandler {
+	return &RoomTypeHandler{
+		RoomTypeService: roomTypeService,
+	}
+}
+
+func (h *RoomTypeHandler) CreateRoomType(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can create room types")
+		return
+	}
+
+	payload, err := validators.ValidateCreateRoomTypePayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	roomType, err := h.RoomTypeService.CreateRoomType(userContext, payload)
+	if errors.Is(err, room_type_service.ErrRoomTypeAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create room type", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Room type created successfully!", roomType)
+}
+
+func (h *RoomTypeHandler) GetRoomTypesByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	roomTypes, err := h.RoomTypeService.GetRoomTypesByHotelID(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve room types", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Room types retrieved successfully!", roomTypes)
+}
+
+func (h *RoomTypeHandler) UpdateRoomType(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can update room types")
+		return
+	}
+
+	typeID, err := utils.GetUUIDFromParams(r, "typeId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid room type ID", err.Error())
+		return
+	}
+
+	payload, err := validators.ValidateUpdateRoomTypePayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	roomType, err := h.RoomTypeService.UpdateRoomType(userContext, typeID, payload)
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
+	if errors.Is(err, room_type_service.ErrRoomTypeAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to update room type", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Room type updated successfully!", roomType)
+}
+
+func (h *RoomTypeHandler) DeleteRoomType(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can delete room types")
+		return
+	}
+
+	typeID, err := utils.GetUUIDFromParams(r, "typeId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid room type ID", err.Error())
+		return
+	}
+
+	if err := h.RoomTypeService.DeleteRoomType(userContext, typeID); err != nil {
+		if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+			return
+		}
+		if errors.Is(err, room_type_service.ErrRoomTypeAccessDenied) {
+			utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+			return
+		}
+		if errors.Is(err, room_type_service.ErrRoomTypeInUse) {
+			utils.WriteErrorResponse(w, http.StatusConflict, "Room type in use", err.Error())
+			return
+		}
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to delete room type", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Room type deleted successfully!", nil)
+}
@@ -0,0 +1,163 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/room_unit_service"
+)
+
+func TestRoomUnitHandler_CreateUnit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	handler := handlers.NewRoomUnitHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	validBody := `{"hotel_id":"` + uuid.New().String() + `","room_type":"single","number":"101","floor":1}`
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validBody, func() {}, http.StatusUnauthorized},
+		{"forbidden", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validBody, func() {}, http.StatusForbidden},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), `{"number":""}`, func() {}, http.StatusBadRequest},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateUnit(managerCtx, gomock.Any()).Return(nil, room_unit_service.ErrUnitAccessDenied)
+		}, http.StatusForbidden},
+		{"unknown room type", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateUnit(managerCtx, gomock.Any()).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+		}, http.StatusNotFound},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateUnit(managerCtx, gomock.Any()).Return(nil, errors.New("duplicate unit number"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), validBody, func() {
+			mockService.EXPECT().CreateUnit(managerCtx, gomock.Any()).Return(&models.RoomUnits{Id: uuid.New(), Number: "101"}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPost, "/rooms/units/create", bytes.NewBufferString(tt.body))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateUnit(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRoomUnitHandler_GetUnitsByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	handler := handlers.NewRoomUnitHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), hotelID.String(), func() {}, http.StatusUnauthorized},
+		{"forbidden", context.WithValue(context.Background(), constants.UserContextKey, userCtx), hotelID.String(), func() {}, http.StatusForbidden},
+		{"invalid hotel id", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetUnitsByHotelID(hotelID).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetUnitsByHotelID(hotelID).Return([]*models.RoomUnits{{Id: uuid.New(), Number: "101"}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/rooms/units", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetUnitsByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestRoomUnitHandler_UpdateUnitStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	handler := handlers.NewRoomUnitHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	unitID := uuid.New()
+
+	tests := []struct {
+		name           string
+		body           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"invalid status", `{"status":"broken"}`, func() {}, http.StatusBadRequest},
+		{"another hotel's manager", `{"status":"out_of_order"}`, func() {
+			mockService.EXPECT().UpdateUnitStatus(managerCtx, unitID, room.UnitOutOfOrder).Return(nil, room_unit_service.ErrUnitAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", `{"status":"out_of_order"}`, func() {
+			mockService.EXPECT().UpdateUnitStatus(managerCtx, unitID, room.UnitOutOfOrder).Return(nil, errors.New("room unit not found"))
+		}, http.StatusInternalServerError},
+		{"success", `{"status":"out_of_order"}`, func() {
+			mockService.EXPECT().UpdateUnitStatus(managerCtx, unitID, room.UnitOutOfOrder).Return(&models.RoomUnits{Id: unitID, Status: room.UnitOutOfOrder}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodPut, "/rooms/units/status", bytes.NewBufferString(tt.body))
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, managerCtx))
+			req.SetPathValue("unitId", unitID.String())
+			w := httptest.NewRecorder()
+
+			handler.UpdateUnitStatus(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
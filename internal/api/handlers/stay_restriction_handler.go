@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/stay_restriction_repo"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/stay_restriction_validators"
+)
+
+type StayRestrictionHandler struct {
+	RestrictionService stay_restriction_service.StayRestrictionServiceInterface
+}
+
+func NewStayRestrictionHandler(restrictionService stay_restriction_service.StayRestrictionServiceInterface) *StayRestrictionHandler {
+	return &StayRestrictionHandler{
+		RestrictionService: restrictionService,
+	}
+}
+
+func (h *StayRestrictionHandler) CreateRestriction(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can set stay restrictions")
+		return
+	}
+
+	payload, err := stay_restriction_validators.ValidateStayRestrictionPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	restriction, err := h.RestrictionService.CreateRestriction(userContext, payload)
+	if errors.Is(err, stay_restriction_service.ErrRestrictionAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create stay restriction", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Stay restriction saved successfully!", restriction)
+}
+
+func (h *StayRestrictionHandler) GetRestrictionsByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	restrictions, err := h.RestrictionService.GetRestrictionsByHotelID(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve stay restrictions", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Stay restrictions retrieved successfully!", restrictions)
+}
+
+func (h *StayRestrictionHandler) DeleteRestriction(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can remove stay restrictions")
+		return
+	}
+
+	restrictionID, err := utils.GetUUIDFromParams(r, "restrictionId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid restriction ID", err.Error())
+		return
+	}
+
+	err = h.RestrictionService.DeleteRestriction(userContext, restrictionID)
+	if errors.Is(err, stay_restriction_repo.ErrRestrictionNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Stay restriction not found", err.Error())
+		return
+	}
+	if errors.Is(err, stay_restriction_service.ErrRestrictionAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to remove stay restriction", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Stay restriction removed successfully!", nil)
+}
@@ -0,0 +1,231 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/stay_restriction_repo"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestStayRestrictionHandler_CreateRestriction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockStayRestrictionServiceInterface(ctrl)
+	handler := handlers.NewStayRestrictionHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+
+	newYearsEve := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	validPayload := &payloads.StayRestrictionPayload{HotelId: uuid.New(), StartDate: newYearsEve, EndDate: newYearsEve, ClosedToArrival: true}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden for non-manager",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid payload",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body:           &payloads.StayRestrictionPayload{},
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "another hotel's manager",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreateRestriction(managerCtx, gomock.Any()).Return(nil, stay_restriction_service.ErrRestrictionAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "service error",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreateRestriction(managerCtx, gomock.Any()).Return(nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreateRestriction(managerCtx, gomock.Any()).Return(&models.StayRestrictions{Id: uuid.New()}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/restrictions/create", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateRestriction(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestStayRestrictionHandler_GetRestrictionsByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockStayRestrictionServiceInterface(ctrl)
+	handler := handlers.NewStayRestrictionHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			hotelIDStr:     hotelID.String(),
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "invalid hotel id",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			hotelIDStr:     "invalid-uuid",
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "service error",
+			ctx:        context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			hotelIDStr: hotelID.String(),
+			mockService: func() {
+				mockService.EXPECT().GetRestrictionsByHotelID(hotelID).Return(nil, errors.New("service failed"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "success",
+			ctx:        context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			hotelIDStr: hotelID.String(),
+			mockService: func() {
+				mockService.EXPECT().GetRestrictionsByHotelID(hotelID).Return([]*models.StayRestrictions{{HotelId: hotelID}}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/restrictions/", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetRestrictionsByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestStayRestrictionHandler_DeleteRestriction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockStayRestrictionServiceInterface(ctrl)
+	handler := handlers.NewStayRestrictionHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	restrictionID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		idStr          string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), restrictionID.String(), func() {}, http.StatusUnauthorized},
+		{"forbidden for non-manager", context.WithValue(context.Background(), constants.UserContextKey, userCtx), restrictionID.String(), func() {}, http.StatusForbidden},
+		{"invalid id", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"not found", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), restrictionID.String(), func() {
+			mockService.EXPECT().DeleteRestriction(managerCtx, restrictionID).Return(stay_restriction_repo.ErrRestrictionNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), restrictionID.String(), func() {
+			mockService.EXPECT().DeleteRestriction(managerCtx, restrictionID).Return(stay_restriction_service.ErrRestrictionAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), restrictionID.String(), func() {
+			mockService.EXPECT().DeleteRestriction(managerCtx, restrictionID).Return(errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), restrictionID.String(), func() {
+			mockService.EXPECT().DeleteRestriction(managerCtx, restrictionID).Return(nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodDelete, "/restrictions/", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("restrictionId", tt.idStr)
+			w := httptest.NewRecorder()
+
+			handler.DeleteRestriction(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
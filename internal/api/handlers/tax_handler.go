@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/tax_rule_repo"
+	"github.com/tktanisha/booking_system/internal/services/tax_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/tax_validators"
+)
+
+type TaxHandler struct {
+	TaxService tax_service.TaxServiceInterface
+}
+
+func NewTaxHandler(taxService tax_service.TaxServiceInterface) *TaxHandler {
+	return &TaxHandler{
+		TaxService: taxService,
+	}
+}
+
+func (h *TaxHandler) CreateTaxRule(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can create tax rules")
+		return
+	}
+
+	payload, err := tax_validators.ValidateCreateTaxRulePayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	taxRule, err := h.TaxService.CreateTaxRule(userContext, payload)
+	if errors.Is(err, tax_service.ErrTaxRuleAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to create tax rule", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Tax rule created successfully!", taxRule)
+}
+
+func (h *TaxHandler) GetTaxRulesByHotelID(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can list tax rules")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotelId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	taxRules, err := h.TaxService.GetTaxRulesByHotelID(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve tax rules", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Tax rules retrieved successfully!", taxRules)
+}
+
+func (h *TaxHandler) DeleteTaxRule(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsManager(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only managers can remove tax rules")
+		return
+	}
+
+	taxRuleID, err := utils.GetUUIDFromParams(r, "taxRuleId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid tax rule ID", err.Error())
+		return
+	}
+
+	err = h.TaxService.DeleteTaxRule(userContext, taxRuleID)
+	if errors.Is(err, tax_rule_repo.ErrTaxRuleNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Tax rule not found", err.Error())
+		return
+	}
+	if errors.Is(err, tax_service.ErrTaxRuleAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to remove tax rule", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Tax rule removed successfully!", nil)
+}
@@ -0,0 +1,208 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/tax_rule_repo"
+	"github.com/tktanisha/booking_system/internal/services/tax_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestTaxHandler_CreateTaxRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaxServiceInterface(ctrl)
+	handler := handlers.NewTaxHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+
+	validPayload := &payloads.CreateTaxRulePayload{HotelId: uuid.New(), Name: "VAT", ChargeType: tax.Percentage, Value: 12}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{
+			name:           "unauthorized",
+			ctx:            context.Background(),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "forbidden for non-manager",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, userCtx),
+			body:           validPayload,
+			mockService:    func() {},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "invalid payload",
+			ctx:            context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body:           &payloads.CreateTaxRulePayload{HotelId: uuid.New(), Name: "VAT"},
+			mockService:    func() {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "another hotel's manager",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreateTaxRule(managerCtx, gomock.Any()).Return(nil, tax_service.ErrTaxRuleAccessDenied)
+			},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "service error",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreateTaxRule(managerCtx, gomock.Any()).Return(nil, errors.New("duplicate name"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "success",
+			ctx:  context.WithValue(context.Background(), constants.UserContextKey, managerCtx),
+			body: validPayload,
+			mockService: func() {
+				mockService.EXPECT().CreateTaxRule(managerCtx, gomock.Any()).Return(&models.TaxRules{Id: uuid.New(), Name: "VAT"}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/taxes/create", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateTaxRule(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestTaxHandler_GetTaxRulesByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaxServiceInterface(ctrl)
+	handler := handlers.NewTaxHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		hotelIDStr     string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), hotelID.String(), func() {}, http.StatusUnauthorized},
+		{"forbidden for non-manager", context.WithValue(context.Background(), constants.UserContextKey, userCtx), hotelID.String(), func() {}, http.StatusForbidden},
+		{"invalid hotel id", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetTaxRulesByHotelID(hotelID).Return(nil, errors.New("service failed"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), hotelID.String(), func() {
+			mockService.EXPECT().GetTaxRulesByHotelID(hotelID).Return([]*models.TaxRules{{Name: "VAT"}}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/taxes/", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotelId", tt.hotelIDStr)
+			w := httptest.NewRecorder()
+
+			handler.GetTaxRulesByHotelID(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestTaxHandler_DeleteTaxRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaxServiceInterface(ctrl)
+	handler := handlers.NewTaxHandler(mockService)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	taxRuleID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		taxRuleIDStr   string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), taxRuleID.String(), func() {}, http.StatusUnauthorized},
+		{"forbidden for non-manager", context.WithValue(context.Background(), constants.UserContextKey, userCtx), taxRuleID.String(), func() {}, http.StatusForbidden},
+		{"invalid tax rule id", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), "invalid-uuid", func() {}, http.StatusBadRequest},
+		{"not found", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), taxRuleID.String(), func() {
+			mockService.EXPECT().DeleteTaxRule(managerCtx, taxRuleID).Return(tax_rule_repo.ErrTaxRuleNotFound)
+		}, http.StatusNotFound},
+		{"another hotel's manager", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), taxRuleID.String(), func() {
+			mockService.EXPECT().DeleteTaxRule(managerCtx, taxRuleID).Return(tax_service.ErrTaxRuleAccessDenied)
+		}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), taxRuleID.String(), func() {
+			mockService.EXPECT().DeleteTaxRule(managerCtx, taxRuleID).Return(errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, managerCtx), taxRuleID.String(), func() {
+			mockService.EXPECT().DeleteTaxRule(managerCtx, taxRuleID).Return(nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodDelete, "/taxes/", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("taxRuleId", tt.taxRuleIDStr)
+			w := httptest.NewRecorder()
+
+			handler.DeleteTaxRule(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/waitlist_validators"
+)
+
+type WaitlistHandler struct {
+	WaitlistService waitlist_service.WaitlistServiceInterface
+}
+
+func NewWaitlistHandler(waitlistService waitlist_service.WaitlistServiceInterface) *WaitlistHandler {
+	return &WaitlistHandler{
+		WaitlistService: waitlistService,
+	}
+}
+
+func (h *WaitlistHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	payload, err := waitlist_validators.ValidateWaitlistPayload(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request payload", err.Error())
+		return
+	}
+
+	entry, err := h.WaitlistService.JoinWaitlist(userContext, payload)
+	if errors.Is(err, waitlist_service.ErrRoomsAvailable) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Rooms available", err.Error())
+		return
+	}
+	if errors.Is(err, waitlist_service.ErrStayInPast) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid stay dates", err.Error())
+		return
+	}
+	if errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Room type not found", err.Error())
+		return
+	}
+	var violation *stay_restriction_service.ViolationError
+	if errors.As(err, &violation) {
+		utils.WriteErrorResponseWithCode(w, http.StatusUnprocessableEntity, "Stay restriction violated", err.Error(), string(violation.Code))
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to join waitlist", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, "Joined waitlist successfully!", entry)
+}
+
+func (h *WaitlistHandler) GetMyWaitlist(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	entries, err := h.WaitlistService.GetMyWaitlist(userContext)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve waitlist", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Waitlist retrieved successfully!", entries)
+}
+
+func (h *WaitlistHandler) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	entryID, err := utils.GetUUIDFromParams(r, "entryId")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid waitlist entry ID", err.Error())
+		return
+	}
+
+	entry, err := h.WaitlistService.LeaveWaitlist(userContext, entryID)
+	if errors.Is(err, waitlist_repo.ErrEntryNotFound) {
+		utils.WriteErrorResponse(w, http.StatusNotFound, "Waitlist entry not found", err.Error())
+		return
+	}
+	if errors.Is(err, waitlist_service.ErrWaitlistAccessDenied) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", err.Error())
+		return
+	}
+	if errors.Is(err, waitlist_service.ErrEntryClosed) {
+		utils.WriteErrorResponse(w, http.StatusConflict, "Waitlist entry closed", err.Error())
+		return
+	}
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to leave waitlist", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Left waitlist successfully!", entry)
+}
+
+func (h *WaitlistHandler) GetHotelWaitlist(w http.ResponseWriter, r *http.Request) {
+	userContext, ok := r.Context().Value(constants.UserContextKey).(*models.UserContext)
+	if !ok || userContext == nil {
+		utils.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", "User not found in context")
+		return
+	}
+
+	if !permissions.IsFrontDesk(userContext) {
+		utils.WriteErrorResponse(w, http.StatusForbidden, "Forbidden", "Only hotel staff can view the waitlist")
+		return
+	}
+
+	hotelID, err := utils.GetUUIDFromParams(r, "hotel_id")
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid hotel ID", err.Error())
+		return
+	}
+
+	entries, err := h.WaitlistService.GetHotelWaitlist(hotelID)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve waitlist", err.Error())
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, "Waitlist retrieved successfully!", entries)
+}
@@ -0,0 +1,178 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/constants"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestWaitlistHandler_JoinWaitlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewWaitlistHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	validPayload := &payloads.WaitlistPayload{
+		HotelId:  uuid.New(),
+		RoomType: room.Suite,
+		Quantity: 1,
+		CheckIn:  payloads.NewDate(time.Now().AddDate(0, 0, 7)),
+		CheckOut: payloads.NewDate(time.Now().AddDate(0, 0, 9)),
+	}
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		body           any
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"unauthorized", context.Background(), validPayload, func() {}, http.StatusUnauthorized},
+		{"invalid payload", context.WithValue(context.Background(), constants.UserContextKey, userCtx), &payloads.WaitlistPayload{}, func() {}, http.StatusBadRequest},
+		{"rooms available", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().JoinWaitlist(userCtx, gomock.Any()).Return(nil, waitlist_service.ErrRoomsAvailable)
+		}, http.StatusConflict},
+		{"stay already begun", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().JoinWaitlist(userCtx, gomock.Any()).Return(nil, waitlist_service.ErrStayInPast)
+		}, http.StatusBadRequest},
+		{"unknown room type", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().JoinWaitlist(userCtx, gomock.Any()).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+		}, http.StatusNotFound},
+		{"stay restriction violated", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().JoinWaitlist(userCtx, gomock.Any()).Return(nil, &stay_restriction_service.ViolationError{Code: restriction.MinLengthOfStay, Message: "min 3 nights"})
+		}, http.StatusUnprocessableEntity},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().JoinWaitlist(userCtx, gomock.Any()).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, userCtx), validPayload, func() {
+			mockService.EXPECT().JoinWaitlist(userCtx, gomock.Any()).Return(&models.WaitlistEntries{Id: uuid.New()}, nil)
+		}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			jsonBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/waitlist", bytes.NewReader(jsonBody))
+			req = req.WithContext(tt.ctx)
+			w := httptest.NewRecorder()
+
+			handler.JoinWaitlist(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestWaitlistHandler_LeaveWaitlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewWaitlistHandler(mockService)
+
+	userCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	entryID := uuid.New()
+
+	tests := []struct {
+		name           string
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"not found", func() {
+			mockService.EXPECT().LeaveWaitlist(userCtx, entryID).Return(nil, waitlist_repo.ErrEntryNotFound)
+		}, http.StatusNotFound},
+		{"someone else's entry", func() {
+			mockService.EXPECT().LeaveWaitlist(userCtx, entryID).Return(nil, waitlist_service.ErrWaitlistAccessDenied)
+		}, http.StatusForbidden},
+		{"entry closed", func() {
+			mockService.EXPECT().LeaveWaitlist(userCtx, entryID).Return(nil, waitlist_service.ErrEntryClosed)
+		}, http.StatusConflict},
+		{"success", func() {
+			mockService.EXPECT().LeaveWaitlist(userCtx, entryID).Return(&models.WaitlistEntries{Id: entryID}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodDelete, "/waitlist", nil)
+			req = req.WithContext(context.WithValue(context.Background(), constants.UserContextKey, userCtx))
+			req.SetPathValue("entryId", entryID.String())
+			w := httptest.NewRecorder()
+
+			handler.LeaveWaitlist(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
+
+func TestWaitlistHandler_GetHotelWaitlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	handler := handlers.NewWaitlistHandler(mockService)
+
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockService    func()
+		wantStatusCode int
+	}{
+		{"forbidden for guests", context.WithValue(context.Background(), constants.UserContextKey, guestCtx), func() {}, http.StatusForbidden},
+		{"service error", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), func() {
+			mockService.EXPECT().GetHotelWaitlist(hotelID).Return(nil, errors.New("db error"))
+		}, http.StatusInternalServerError},
+		{"success", context.WithValue(context.Background(), constants.UserContextKey, frontDeskCtx), func() {
+			mockService.EXPECT().GetHotelWaitlist(hotelID).Return([]*models.WaitlistEntries{}, nil)
+		}, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockService()
+			req := httptest.NewRequest(http.MethodGet, "/hotels/waitlist", nil)
+			req = req.WithContext(tt.ctx)
+			req.SetPathValue("hotel_id", hotelID.String())
+			w := httptest.NewRecorder()
+
+			handler.GetHotelWaitlist(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("expected status %d, got %d", tt.wantStatusCode, w.Code)
+			}
+		})
+	}
+}
@@ -13,5 +13,8 @@ func RegisterBookingRoutes(r *http.ServeMux) {
 
 	r.HandleFunc("POST /bookings/create", middlewares.AuthMiddleware(bookingHandler.CreateBooking))
 	r.HandleFunc("PUT /bookings/cancel/{bookingId}", middlewares.AuthMiddleware(bookingHandler.CancelBooking))
+	r.HandleFunc("POST /bookings/checkin/{bookingId}", middlewares.AuthMiddleware(bookingHandler.CheckInBooking))
 	r.HandleFunc("POST /bookings/checkout/{bookingId}", middlewares.AuthMiddleware(bookingHandler.CheckoutBooking))
+	r.HandleFunc("GET /bookings/{bookingId}", middlewares.AuthMiddleware(bookingHandler.GetBooking))
+	r.HandleFunc("GET /bookings/{bookingId}/history", middlewares.AuthMiddleware(bookingHandler.GetBookingHistory))
 }
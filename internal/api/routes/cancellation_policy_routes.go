@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterCancellationPolicyRoutes(r *http.ServeMux) {
+	policyHandler := handlers.NewCancellationPolicyHandler(initializer.CancellationService)
+
+	r.HandleFunc("POST /cancellation-policies/create", middlewares.AuthMiddleware(policyHandler.CreatePolicy))
+	r.HandleFunc("GET /cancellation-policies/{hotelId}", middlewares.AuthMiddleware(policyHandler.GetPoliciesByHotelID))
+}
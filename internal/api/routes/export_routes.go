@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterExportRoutes(r *http.ServeMux) {
+	exportHandler := handlers.NewExportHandler(initializer.ExportService)
+
+	r.HandleFunc("GET /hotels/{hotel_id}/exports/{dataset}", middlewares.AuthMiddleware(exportHandler.ExportData))
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterFolioRoutes(r *http.ServeMux) {
+	folioHandler := handlers.NewFolioHandler(initializer.FolioService)
+
+	r.HandleFunc("GET /bookings/{bookingId}/folio", middlewares.AuthMiddleware(folioHandler.GetFolio))
+	r.HandleFunc("POST /bookings/{bookingId}/folio/lines", middlewares.AuthMiddleware(folioHandler.PostFolioLine))
+	r.HandleFunc("POST /bookings/{bookingId}/folio/lines/{lineId}/void", middlewares.AuthMiddleware(folioHandler.VoidFolioLine))
+}
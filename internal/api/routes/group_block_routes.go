@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterGroupBlockRoutes(r *http.ServeMux) {
+	groupBlockHandler := handlers.NewGroupBlockHandler(initializer.GroupBlockService)
+
+	r.HandleFunc("POST /hotels/{hotel_id}/group-blocks", middlewares.AuthMiddleware(groupBlockHandler.CreateGroupBlock))
+	r.HandleFunc("GET /hotels/{hotel_id}/group-blocks", middlewares.AuthMiddleware(groupBlockHandler.GetHotelGroupBlocks))
+	r.HandleFunc("GET /hotels/{hotel_id}/group-blocks/{code}", middlewares.AuthMiddleware(groupBlockHandler.GetGroupBlocksByCode))
+	r.HandleFunc("POST /group-blocks/{blockId}/release", middlewares.AuthMiddleware(groupBlockHandler.ReleaseGroupBlock))
+}
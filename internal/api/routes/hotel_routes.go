@@ -12,5 +12,7 @@ func RegisterHotelRoutes(r *http.ServeMux) {
 	hotelHandler := handlers.NewHotelHandler(initializer.HotelService)
 
 	r.HandleFunc("POST /hotels/create", middlewares.AuthMiddleware(hotelHandler.CreateHotel))
+	r.HandleFunc("GET /hotels", middlewares.AuthMiddleware(hotelHandler.SearchHotels))
 	r.HandleFunc("GET /hotels/{hotel_id}", middlewares.AuthMiddleware(hotelHandler.GetHotelByID))
+	r.HandleFunc("PUT /hotels/{hotel_id}", middlewares.AuthMiddleware(hotelHandler.UpdateHotel))
 }
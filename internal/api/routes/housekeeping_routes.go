@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterHousekeepingRoutes(r *http.ServeMux) {
+	housekeepingHandler := handlers.NewHousekeepingHandler(initializer.HousekeepingService)
+
+	r.HandleFunc("GET /housekeeping/{hotelId}/tasks", middlewares.AuthMiddleware(housekeepingHandler.GetOpenTasksByHotelID))
+	r.HandleFunc("POST /housekeeping/tasks/{taskId}/claim", middlewares.AuthMiddleware(housekeepingHandler.ClaimTask))
+	r.HandleFunc("POST /housekeeping/tasks/{taskId}/complete", middlewares.AuthMiddleware(housekeepingHandler.CompleteTask))
+	r.HandleFunc("POST /housekeeping/units/{unitId}/inspect", middlewares.AuthMiddleware(housekeepingHandler.InspectUnit))
+}
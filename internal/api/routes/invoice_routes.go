@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterInvoiceRoutes(r *http.ServeMux) {
+	invoiceHandler := handlers.NewInvoiceHandler(initializer.InvoiceService)
+
+	r.HandleFunc("GET /bookings/{bookingId}/invoice", middlewares.AuthMiddleware(invoiceHandler.GetInvoice))
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterMediaRoutes(r *http.ServeMux) {
+	mediaHandler := handlers.NewMediaHandler(initializer.MediaService)
+
+	r.HandleFunc("POST /hotels/{hotel_id}/media", middlewares.AuthMiddleware(mediaHandler.UploadHotelMedia))
+	r.HandleFunc("POST /rooms/types/{typeId}/media", middlewares.AuthMiddleware(mediaHandler.UploadRoomTypeMedia))
+	r.HandleFunc("GET /hotels/{hotel_id}/media", middlewares.AuthMiddleware(mediaHandler.GetHotelMedia))
+	r.HandleFunc("PUT /hotels/{hotel_id}/media/order", middlewares.AuthMiddleware(mediaHandler.ReorderMedia))
+	r.HandleFunc("DELETE /media/{mediaId}", middlewares.AuthMiddleware(mediaHandler.DeleteMedia))
+	r.HandleFunc("GET /media/{mediaId}", middlewares.AuthMiddleware(mediaHandler.GetMediaFile))
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterNotificationRoutes(r *http.ServeMux) {
+	notificationHandler := handlers.NewNotificationHandler(initializer.NotificationService)
+
+	r.HandleFunc("GET /notifications", middlewares.AuthMiddleware(notificationHandler.GetNotifications))
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterOverbookingRoutes(r *http.ServeMux) {
+	overbookingHandler := handlers.NewOverbookingHandler(initializer.OverbookingService)
+
+	r.HandleFunc("PUT /hotels/{hotel_id}/overbooking-limits", middlewares.AuthMiddleware(overbookingHandler.SetOverbookingLimit))
+	r.HandleFunc("GET /hotels/{hotel_id}/overbooking-limits", middlewares.AuthMiddleware(overbookingHandler.GetOverbookingLimits))
+	r.HandleFunc("GET /hotels/{hotel_id}/walk-list", middlewares.AuthMiddleware(overbookingHandler.GetWalkList))
+	r.HandleFunc("GET /hotels/{hotel_id}/overbooking-audits", middlewares.AuthMiddleware(overbookingHandler.GetOverbookingAudits))
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterPromoRoutes(r *http.ServeMux) {
+	promoHandler := handlers.NewPromoHandler(initializer.PromoService)
+
+	r.HandleFunc("POST /promo-codes/create", middlewares.AuthMiddleware(promoHandler.CreatePromoCode))
+	r.HandleFunc("GET /promo-codes/{hotelId}", middlewares.AuthMiddleware(promoHandler.GetPromoCodesByHotelID))
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterReportRoutes(r *http.ServeMux) {
+	reportHandler := handlers.NewReportHandler(initializer.ReportService)
+
+	r.HandleFunc("GET /hotels/{hotel_id}/reports", middlewares.AuthMiddleware(reportHandler.GetReport))
+}
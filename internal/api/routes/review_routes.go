@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterReviewRoutes(r *http.ServeMux) {
+	reviewHandler := handlers.NewReviewHandler(initializer.ReviewService)
+
+	r.HandleFunc("POST /bookings/review/{bookingId}", middlewares.AuthMiddleware(reviewHandler.CreateReview))
+	r.HandleFunc("GET /hotels/{hotel_id}/reviews", middlewares.AuthMiddleware(reviewHandler.GetHotelReviews))
+	r.HandleFunc("POST /reviews/{reviewId}/reply", middlewares.AuthMiddleware(reviewHandler.ReplyToReview))
+}
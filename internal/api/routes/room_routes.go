@@ -9,9 +9,24 @@ import (
 )
 
 func RegisterRoomRoutes(r *http.ServeMux) {
-	roomHandler := handlers.NewRoomHandler(initializer.RoomService)
+	roomHandler := handlers.NewRoomHandler(initializer.RoomService, initializer.WaitlistService)
+	roomUnitHandler := handlers.NewRoomUnitHandler(initializer.RoomUnitService)
+	roomTypeHandler := handlers.NewRoomTypeHandler(initializer.RoomTypeService)
 
 	r.HandleFunc("POST /rooms/create", middlewares.AuthMiddleware(roomHandler.CreateRoom))
 	r.HandleFunc("GET /rooms/{hotelId}", middlewares.AuthMiddleware(roomHandler.GetAllRoomByHotelID))
 	r.HandleFunc("PUT /rooms/increase-quantity/{hotelId}", middlewares.AuthMiddleware(roomHandler.IncreaseRoomQuantity))
+
+	r.HandleFunc("POST /rooms/units/create", middlewares.AuthMiddleware(roomUnitHandler.CreateUnit))
+	r.HandleFunc("GET /rooms/{hotelId}/units", middlewares.AuthMiddleware(roomUnitHandler.GetUnitsByHotelID))
+	r.HandleFunc("PUT /rooms/units/{unitId}/status", middlewares.AuthMiddleware(roomUnitHandler.UpdateUnitStatus))
+
+	r.HandleFunc("POST /rooms/blocks/create", middlewares.AuthMiddleware(roomHandler.CreateBlock))
+	r.HandleFunc("GET /rooms/{hotelId}/blocks", middlewares.AuthMiddleware(roomHandler.GetBlocksByHotelID))
+	r.HandleFunc("DELETE /rooms/blocks/{blockId}", middlewares.AuthMiddleware(roomHandler.DeleteBlock))
+
+	r.HandleFunc("POST /rooms/types/create", middlewares.AuthMiddleware(roomTypeHandler.CreateRoomType))
+	r.HandleFunc("GET /rooms/{hotelId}/types", middlewares.AuthMiddleware(roomTypeHandler.GetRoomTypesByHotelID))
+	r.HandleFunc("PUT /rooms/types/{typeId}", middlewares.AuthMiddleware(roomTypeHandler.UpdateRoomType))
+	r.HandleFunc("DELETE /rooms/types/{typeId}", middlewares.AuthMiddleware(roomTypeHandler.DeleteRoomType))
 }
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterStayRestrictionRoutes(r *http.ServeMux) {
+	restrictionHandler := handlers.NewStayRestrictionHandler(initializer.RestrictionService)
+
+	r.HandleFunc("POST /restrictions/create", middlewares.AuthMiddleware(restrictionHandler.CreateRestriction))
+	r.HandleFunc("GET /restrictions/{hotelId}", middlewares.AuthMiddleware(restrictionHandler.GetRestrictionsByHotelID))
+	r.HandleFunc("DELETE /restrictions/{restrictionId}", middlewares.AuthMiddleware(restrictionHandler.DeleteRestriction))
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterTaxRoutes(r *http.ServeMux) {
+	taxHandler := handlers.NewTaxHandler(initializer.TaxService)
+
+	r.HandleFunc("POST /taxes/create", middlewares.AuthMiddleware(taxHandler.CreateTaxRule))
+	r.HandleFunc("GET /taxes/{hotelId}", middlewares.AuthMiddleware(taxHandler.GetTaxRulesByHotelID))
+	r.HandleFunc("DELETE /taxes/{taxRuleId}", middlewares.AuthMiddleware(taxHandler.DeleteTaxRule))
+}
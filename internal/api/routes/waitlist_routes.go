@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/api/handlers"
+	"github.com/tktanisha/booking_system/internal/api/middlewares"
+	"github.com/tktanisha/booking_system/internal/initializer"
+)
+
+func RegisterWaitlistRoutes(r *http.ServeMux) {
+	waitlistHandler := handlers.NewWaitlistHandler(initializer.WaitlistService)
+
+	r.HandleFunc("POST /waitlist", middlewares.AuthMiddleware(waitlistHandler.JoinWaitlist))
+	r.HandleFunc("GET /waitlist", middlewares.AuthMiddleware(waitlistHandler.GetMyWaitlist))
+	r.HandleFunc("DELETE /waitlist/{entryId}", middlewares.AuthMiddleware(waitlistHandler.LeaveWaitlist))
+	r.HandleFunc("GET /hotels/{hotel_id}/waitlist", middlewares.AuthMiddleware(waitlistHandler.GetHotelWaitlist))
+}
@@ -21,3 +21,32 @@ func GetDBURL() string {
 	}
 	return dbURL
 }
+
+const defaultExchangeRatesFile = "./internal/config/exchange_rates.json"
+
+// GetExchangeRatesFile returns the path of the exchange-rate table, which can
+// be overridden with EXCHANGE_RATES_FILE.
+func GetExchangeRatesFile() string {
+	if path := os.Getenv("EXCHANGE_RATES_FILE"); path != "" {
+		return path
+	}
+	return defaultExchangeRatesFile
+}
+
+const defaultMediaDir = "./media"
+
+// GetMediaDir returns the directory uploaded photos are stored in, which can be
+// overridden with MEDIA_DIR.
+func GetMediaDir() string {
+	if dir := os.Getenv("MEDIA_DIR"); dir != "" {
+		return dir
+	}
+	return defaultMediaDir
+}
+
+// GetGeocodeFile returns the path of the offline gazetteer used to place hotels
+// on the map from their address, set with GEOCODE_FILE. Hotels are only
+// geocoded when it is set.
+func GetGeocodeFile() string {
+	return os.Getenv("GEOCODE_FILE")
+}
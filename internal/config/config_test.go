@@ -67,6 +67,60 @@ func TestGetDBURL(t *testing.T) {
 	})
 }
 
+func TestGetExchangeRatesFile(t *testing.T) {
+	original := os.Getenv("EXCHANGE_RATES_FILE")
+	defer os.Setenv("EXCHANGE_RATES_FILE", original)
+
+	t.Run("Returns path when set", func(t *testing.T) {
+		expected := "/etc/booking/rates.json"
+		os.Setenv("EXCHANGE_RATES_FILE", expected)
+
+		got := config.GetExchangeRatesFile()
+		if got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+	})
+
+	t.Run("Falls back to the bundled table", func(t *testing.T) {
+		os.Unsetenv("EXCHANGE_RATES_FILE")
+
+		got := config.GetExchangeRatesFile()
+		if got != "./internal/config/exchange_rates.json" {
+			t.Errorf("expected bundled table, got %q", got)
+		}
+	})
+}
+
+func TestGetMediaDir(t *testing.T) {
+	original := os.Getenv("MEDIA_DIR")
+	defer os.Setenv("MEDIA_DIR", original)
+
+	os.Setenv("MEDIA_DIR", "/var/lib/booking/media")
+	if got := config.GetMediaDir(); got != "/var/lib/booking/media" {
+		t.Errorf("expected the configured directory, got %q", got)
+	}
+
+	os.Unsetenv("MEDIA_DIR")
+	if got := config.GetMediaDir(); got != "./media" {
+		t.Errorf("expected the default directory, got %q", got)
+	}
+}
+
+func TestGetGeocodeFile(t *testing.T) {
+	original := os.Getenv("GEOCODE_FILE")
+	defer os.Setenv("GEOCODE_FILE", original)
+
+	os.Setenv("GEOCODE_FILE", "/etc/booking/gazetteer.json")
+	if got := config.GetGeocodeFile(); got != "/etc/booking/gazetteer.json" {
+		t.Errorf("expected the configured path, got %q", got)
+	}
+
+	os.Unsetenv("GEOCODE_FILE")
+	if got := config.GetGeocodeFile(); got != "" {
+		t.Errorf("expected geocoding to be off, got %q", got)
+	}
+}
+
 func splitEnv(env string) [2]string {
 	for i := 0; i < len(env); i++ {
 		if env[i] == '=' {
@@ -17,7 +17,27 @@ CREATE TABLE IF NOT EXISTS hotels (
     manager_id UUID NOT NULL,
     name TEXT NOT NULL,
     address TEXT NOT NULL,
+    no_show_cutoff_hours INT NOT NULL DEFAULT 24 CHECK (no_show_cutoff_hours >= 0),
+    no_show_charge_nights INT NOT NULL DEFAULT 1 CHECK (no_show_charge_nights >= 0),
+    hold_until_inspected BOOLEAN NOT NULL DEFAULT FALSE,
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    check_in_time TEXT NOT NULL DEFAULT '15:00',
+    check_out_time TEXT NOT NULL DEFAULT '11:00',
+    star_rating SMALLINT NOT NULL DEFAULT 0 CHECK (star_rating BETWEEN 0 AND 5),
+    description TEXT NOT NULL DEFAULT '',
+    amenities TEXT[] NOT NULL DEFAULT '{}',
+    pets_allowed BOOLEAN NOT NULL DEFAULT FALSE,
+    smoking_allowed BOOLEAN NOT NULL DEFAULT FALSE,
+    child_age_limit SMALLINT NOT NULL DEFAULT 12,
+    min_check_in_age SMALLINT NOT NULL DEFAULT 18,
+    phone TEXT NOT NULL DEFAULT '',
+    email TEXT NOT NULL DEFAULT '',
+    website TEXT NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_hotel_coordinates CHECK ((latitude IS NULL) = (longitude IS NULL)),
     CONSTRAINT fk_hotel_manager FOREIGN KEY (manager_id)
         REFERENCES users(id)
         ON DELETE CASCADE
@@ -27,8 +47,9 @@ CREATE TABLE IF NOT EXISTS hotels (
 CREATE TABLE IF NOT EXISTS rooms (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     hotel_id UUID NOT NULL,
-    available_quantity INT NOT NULL CHECK (available_quantity >= 0),
+    available_quantity INT NOT NULL, -- negative while the room type is oversold within its overbooking limit
     room_category TEXT NOT NULL,
+    price BIGINT NOT NULL DEFAULT 0 CHECK (price >= 0),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     CONSTRAINT fk_room_hotel FOREIGN KEY (hotel_id)
         REFERENCES hotels(id)
@@ -43,6 +64,13 @@ CREATE TABLE IF NOT EXISTS bookings (
     checkin TIMESTAMPTZ NOT NULL,
     checkout TIMESTAMPTZ NOT NULL,
     status TEXT NOT NULL,
+    total_amount BIGINT NOT NULL DEFAULT 0,
+    penalty_amount BIGINT NOT NULL DEFAULT 0,
+    refund_amount BIGINT NOT NULL DEFAULT 0,
+    estimated_arrival TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    group_code TEXT NOT NULL DEFAULT '',
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     CONSTRAINT fk_booking_user FOREIGN KEY (user_id)
         REFERENCES users(id)
@@ -52,14 +80,582 @@ CREATE TABLE IF NOT EXISTS bookings (
         ON DELETE CASCADE
 );
 
+CREATE INDEX IF NOT EXISTS idx_bookings_hotel_stay ON bookings (hotel_id, checkin, checkout);
+CREATE INDEX IF NOT EXISTS idx_bookings_hotel_created ON bookings (hotel_id, created_at);
+
 -- BookedRooms Table
 CREATE TABLE IF NOT EXISTS booked_rooms (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     booking_id UUID NOT NULL,
     room_type TEXT NOT NULL,
     room_quantity INT NOT NULL CHECK (room_quantity > 0),
+    price_per_night BIGINT NOT NULL DEFAULT 0,
+    adults INT NOT NULL DEFAULT 1 CHECK (adults > 0),
+    children INT NOT NULL DEFAULT 0 CHECK (children >= 0),
+    extra_guest_charge BIGINT NOT NULL DEFAULT 0,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     CONSTRAINT fk_booked_room_booking FOREIGN KEY (booking_id)
         REFERENCES bookings(id)
         ON DELETE CASCADE
 );
+
+CREATE INDEX IF NOT EXISTS idx_booked_rooms_booking ON booked_rooms (booking_id);
+
+-- Databases created before these columns existed skip the CREATE TABLEs above,
+-- so the columns are added to them here.
+ALTER TABLE hotels
+    ADD COLUMN IF NOT EXISTS no_show_cutoff_hours INT NOT NULL DEFAULT 24 CHECK (no_show_cutoff_hours >= 0),
+    ADD COLUMN IF NOT EXISTS no_show_charge_nights INT NOT NULL DEFAULT 1 CHECK (no_show_charge_nights >= 0),
+    ADD COLUMN IF NOT EXISTS hold_until_inspected BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN IF NOT EXISTS time_zone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN IF NOT EXISTS check_in_time TEXT NOT NULL DEFAULT '15:00',
+    ADD COLUMN IF NOT EXISTS check_out_time TEXT NOT NULL DEFAULT '11:00',
+    ADD COLUMN IF NOT EXISTS star_rating SMALLINT NOT NULL DEFAULT 0 CHECK (star_rating BETWEEN 0 AND 5),
+    ADD COLUMN IF NOT EXISTS description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS amenities TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS pets_allowed BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS smoking_allowed BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS child_age_limit SMALLINT NOT NULL DEFAULT 12,
+    ADD COLUMN IF NOT EXISTS min_check_in_age SMALLINT NOT NULL DEFAULT 18,
+    ADD COLUMN IF NOT EXISTS phone TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS email TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS website TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+
+-- Proximity searches narrow hotels to a bounding box before measuring distances.
+CREATE INDEX IF NOT EXISTS idx_hotels_coordinates ON hotels (latitude, longitude) WHERE latitude IS NOT NULL;
+
+ALTER TABLE rooms
+    ADD COLUMN IF NOT EXISTS price BIGINT NOT NULL DEFAULT 0 CHECK (price >= 0);
+
+-- Databases created before overbooking still refuse a negative available_quantity.
+ALTER TABLE rooms DROP CONSTRAINT IF EXISTS rooms_available_quantity_check;
+
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS total_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS penalty_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS refund_amount BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS estimated_arrival TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS notes TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS currency CHAR(3) NOT NULL DEFAULT 'USD',
+    ADD COLUMN IF NOT EXISTS group_code TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE booked_rooms
+    ADD COLUMN IF NOT EXISTS price_per_night BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS adults INT NOT NULL DEFAULT 1 CHECK (adults > 0),
+    ADD COLUMN IF NOT EXISTS children INT NOT NULL DEFAULT 0 CHECK (children >= 0),
+    ADD COLUMN IF NOT EXISTS extra_guest_charge BIGINT NOT NULL DEFAULT 0;
+
+-- BookingGuests Table
+CREATE TABLE IF NOT EXISTS booking_guests (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    full_name TEXT NOT NULL,
+    email TEXT NOT NULL DEFAULT '',
+    phone TEXT NOT NULL DEFAULT '',
+    is_lead BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_booking_guest_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_booking_guests_one_lead ON booking_guests (booking_id) WHERE is_lead;
+CREATE INDEX IF NOT EXISTS idx_booking_guests_booking ON booking_guests (booking_id);
+
+-- CancellationPolicies Table
+CREATE TABLE IF NOT EXISTS cancellation_policies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    room_category TEXT NOT NULL DEFAULT '',
+    free_cancel_hours INT NOT NULL CHECK (free_cancel_hours >= 0),
+    penalty_nights INT NOT NULL CHECK (penalty_nights >= 0),
+    non_refundable BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_cancellation_policy_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT uq_cancellation_policy UNIQUE (hotel_id, room_category)
+);
+
+-- BookingEvents Table
+CREATE TABLE IF NOT EXISTS booking_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    actor_id UUID,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_booking_event_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_events_booking ON booking_events (booking_id, created_at);
+
+-- RoomUnits Table
+CREATE TABLE IF NOT EXISTS room_units (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    room_id UUID NOT NULL,
+    hotel_id UUID NOT NULL,
+    number TEXT NOT NULL,
+    floor INT NOT NULL,
+    status TEXT NOT NULL,
+    cleanliness TEXT NOT NULL DEFAULT 'inspected',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_room_unit_room FOREIGN KEY (room_id)
+        REFERENCES rooms(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_room_unit_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT uq_room_unit_number UNIQUE (hotel_id, number)
+);
+
+-- BookedRoomUnits Table
+CREATE TABLE IF NOT EXISTS booked_room_units (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booked_room_id UUID NOT NULL,
+    room_unit_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_booked_room_unit_booked_room FOREIGN KEY (booked_room_id)
+        REFERENCES booked_rooms(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_booked_room_unit_unit FOREIGN KEY (room_unit_id)
+        REFERENCES room_units(id)
+        ON DELETE CASCADE
+);
+
+-- RoomBlocks Table
+CREATE TABLE IF NOT EXISTS room_blocks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    whole_hotel BOOLEAN NOT NULL DEFAULT FALSE,
+    room_category TEXT NOT NULL,
+    room_unit_id UUID,
+    quantity INT NOT NULL,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL,
+    forced BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_room_block_dates CHECK (end_date > start_date),
+    CONSTRAINT chk_room_block_scope CHECK (whole_hotel OR quantity > 0),
+    CONSTRAINT fk_room_block_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_room_block_unit FOREIGN KEY (room_unit_id)
+        REFERENCES room_units(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_room_block_creator FOREIGN KEY (created_by)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+-- HousekeepingTasks Table
+CREATE TABLE IF NOT EXISTS housekeeping_tasks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    room_unit_id UUID NOT NULL,
+    booking_id UUID,
+    status TEXT NOT NULL,
+    assigned_to UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    claimed_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    CONSTRAINT fk_housekeeping_task_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_housekeeping_task_unit FOREIGN KEY (room_unit_id)
+        REFERENCES room_units(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_housekeeping_task_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE SET NULL,
+    CONSTRAINT fk_housekeeping_task_staff FOREIGN KEY (assigned_to)
+        REFERENCES users(id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_housekeeping_tasks_hotel_status ON housekeeping_tasks (hotel_id, status);
+
+-- RoomTypes Table
+CREATE TABLE IF NOT EXISTS room_types (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    max_occupancy INT NOT NULL CHECK (max_occupancy > 0),
+    base_occupancy INT NOT NULL CHECK (base_occupancy > 0 AND base_occupancy <= max_occupancy),
+    extra_guest_fee BIGINT NOT NULL DEFAULT 0 CHECK (extra_guest_fee >= 0),
+    bed_configuration TEXT NOT NULL DEFAULT '',
+    size_sqm INT NOT NULL DEFAULT 0 CHECK (size_sqm >= 0),
+    amenities TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (hotel_id, code),
+    CONSTRAINT fk_room_type_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE
+);
+
+-- StayRestrictions Table
+CREATE TABLE IF NOT EXISTS stay_restrictions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    room_category TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    days_of_week INT[] NOT NULL DEFAULT '{}',
+    min_los INT NOT NULL DEFAULT 0 CHECK (min_los >= 0),
+    max_los INT NOT NULL DEFAULT 0 CHECK (max_los >= 0),
+    closed_to_arrival BOOLEAN NOT NULL DEFAULT FALSE,
+    closed_to_departure BOOLEAN NOT NULL DEFAULT FALSE,
+    stop_sell BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_stay_restriction_dates CHECK (end_date >= start_date),
+    CONSTRAINT fk_stay_restriction_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_stay_restriction_creator FOREIGN KEY (created_by)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_stay_restrictions_hotel_dates ON stay_restrictions (hotel_id, start_date, end_date);
+
+-- PromoCodes Table
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code TEXT NOT NULL UNIQUE,
+    hotel_id UUID,
+    discount_type TEXT NOT NULL CHECK (discount_type IN ('percentage', 'fixed')),
+    value BIGINT NOT NULL CHECK (value > 0),
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to TIMESTAMPTZ NOT NULL,
+    max_uses INT NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    per_user_limit INT NOT NULL DEFAULT 0 CHECK (per_user_limit >= 0),
+    used_count INT NOT NULL DEFAULT 0 CHECK (used_count >= 0),
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_promo_code_window CHECK (valid_to > valid_from),
+    CONSTRAINT chk_promo_code_cap CHECK (max_uses = 0 OR used_count <= max_uses),
+    CONSTRAINT fk_promo_code_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_promo_code_creator FOREIGN KEY (created_by)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+-- PromoRedemptions Table: booking_id has no foreign key because the code is
+-- redeemed before the booking row is written
+CREATE TABLE IF NOT EXISTS promo_redemptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    promo_code_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    booking_id UUID NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_promo_redemption_code FOREIGN KEY (promo_code_id)
+        REFERENCES promo_codes(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemptions_code_user ON promo_redemptions (promo_code_id, user_id);
+
+-- PromoUserUses Table: one counter per user and code; use_limit is copied from
+-- promo_codes.per_user_limit so the check can refuse a use past the limit
+CREATE TABLE IF NOT EXISTS promo_user_uses (
+    promo_code_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    use_count INT NOT NULL CHECK (use_count >= 0),
+    use_limit INT NOT NULL CHECK (use_limit >= 0),
+    PRIMARY KEY (promo_code_id, user_id),
+    CONSTRAINT chk_promo_user_limit CHECK (use_limit = 0 OR use_count <= use_limit),
+    CONSTRAINT fk_promo_user_use_code FOREIGN KEY (promo_code_id)
+        REFERENCES promo_codes(id)
+        ON DELETE CASCADE
+);
+
+INSERT INTO promo_user_uses (promo_code_id, user_id, use_count, use_limit)
+SELECT r.promo_code_id, r.user_id,
+    CASE WHEN p.per_user_limit = 0 THEN COUNT(*) ELSE LEAST(COUNT(*), p.per_user_limit) END,
+    p.per_user_limit
+FROM promo_redemptions r
+JOIN promo_codes p ON p.id = r.promo_code_id
+GROUP BY r.promo_code_id, r.user_id, p.per_user_limit
+ON CONFLICT (promo_code_id, user_id) DO NOTHING;
+
+-- BookingDiscounts Table
+CREATE TABLE IF NOT EXISTS booking_discounts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    promo_code_id UUID,
+    description TEXT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_booking_discount_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_booking_discount_promo FOREIGN KEY (promo_code_id)
+        REFERENCES promo_codes(id)
+        ON DELETE SET NULL
+);
+
+-- TaxRules Table
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    charge_type TEXT NOT NULL CHECK (charge_type IN ('per_night', 'per_person', 'percentage')),
+    value BIGINT NOT NULL CHECK (value > 0),
+    inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_tax_rule_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT uq_tax_rule_name UNIQUE (hotel_id, name)
+);
+
+-- BookingTaxes Table
+CREATE TABLE IF NOT EXISTS booking_taxes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    tax_rule_id UUID,
+    name TEXT NOT NULL,
+    charge_type TEXT NOT NULL,
+    inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_booking_tax_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_booking_tax_rule FOREIGN KEY (tax_rule_id)
+        REFERENCES tax_rules(id)
+        ON DELETE SET NULL
+);
+
+-- FolioLines Table
+CREATE TABLE IF NOT EXISTS folio_lines (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    line_type TEXT NOT NULL CHECK (line_type IN ('charge', 'payment', 'adjustment')),
+    category TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    posted_by UUID NOT NULL,
+    posted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    voided_by UUID,
+    voided_at TIMESTAMPTZ,
+    void_reason TEXT NOT NULL DEFAULT '',
+    CONSTRAINT fk_folio_line_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_folio_lines_booking ON folio_lines (booking_id, posted_at);
+
+-- InvoiceSequences Table
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+    hotel_id UUID PRIMARY KEY,
+    last_number BIGINT NOT NULL,
+    CONSTRAINT fk_invoice_sequence_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE
+);
+
+-- Invoices Table
+CREATE TABLE IF NOT EXISTS invoices (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    booking_id UUID NOT NULL UNIQUE,
+    number BIGINT NOT NULL,
+    invoice_number TEXT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    document JSONB NOT NULL,
+    CONSTRAINT fk_invoice_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id),
+    CONSTRAINT fk_invoice_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id),
+    CONSTRAINT uq_invoice_number UNIQUE (hotel_id, number)
+);
+
+-- Issued invoices are immutable
+CREATE OR REPLACE FUNCTION reject_invoice_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'issued invoices cannot be changed';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS invoices_immutable ON invoices;
+CREATE TRIGGER invoices_immutable
+    BEFORE UPDATE OR DELETE ON invoices
+    FOR EACH ROW EXECUTE FUNCTION reject_invoice_change();
+
+-- Reviews Table
+-- One review per checked-out booking; the manager reply is kept on the row
+CREATE TABLE IF NOT EXISTS reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    booking_id UUID NOT NULL UNIQUE,
+    user_id UUID NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    title TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    reply TEXT NOT NULL DEFAULT '',
+    replied_by UUID,
+    replied_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_review_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_review_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_review_user FOREIGN KEY (user_id)
+        REFERENCES users(id),
+    CONSTRAINT fk_review_replied_by FOREIGN KEY (replied_by)
+        REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_hotel ON reviews (hotel_id, created_at DESC);
+
+-- Media Table
+CREATE TABLE IF NOT EXISTS media (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    room_type_id UUID,
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    width INT NOT NULL CHECK (width > 0),
+    height INT NOT NULL CHECK (height > 0),
+    caption TEXT NOT NULL DEFAULT '',
+    position INT NOT NULL CHECK (position > 0),
+    storage_key TEXT NOT NULL UNIQUE,
+    thumbnail_key TEXT NOT NULL UNIQUE,
+    uploaded_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_media_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_media_room_type FOREIGN KEY (room_type_id)
+        REFERENCES room_types(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_media_uploaded_by FOREIGN KEY (uploaded_by)
+        REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_hotel_position ON media (hotel_id, room_type_id, position);
+
+-- WaitlistEntries Table
+-- Guests queued for sold-out rooms; an open offer holds quantity rooms until offer_expires_at
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    room_type TEXT NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    check_in TIMESTAMPTZ NOT NULL,
+    check_out TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'waiting',
+    offered_at TIMESTAMPTZ,
+    offer_expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_waitlist_dates CHECK (check_out > check_in),
+    CONSTRAINT chk_waitlist_offer CHECK (status <> 'offered' OR offer_expires_at IS NOT NULL),
+    CONSTRAINT fk_waitlist_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_waitlist_user FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_queue ON waitlist_entries (hotel_id, room_type, status, created_at);
+
+-- Notifications Table
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_notification_user FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications (user_id, created_at DESC);
+
+-- GroupBlocks Table
+-- Rooms held for a group code; quantity - picked_up stay out of general inventory until cutoff_at
+CREATE TABLE IF NOT EXISTS group_blocks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    room_type TEXT NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    picked_up INT NOT NULL DEFAULT 0 CHECK (picked_up >= 0),
+    check_in TIMESTAMPTZ NOT NULL,
+    check_out TIMESTAMPTZ NOT NULL,
+    cutoff_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'active',
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_group_block_code UNIQUE (hotel_id, code, room_type),
+    CONSTRAINT chk_group_block_dates CHECK (check_out > check_in),
+    CONSTRAINT chk_group_block_cutoff CHECK (cutoff_at <= check_in),
+    CONSTRAINT chk_group_block_pickup CHECK (picked_up <= quantity),
+    CONSTRAINT fk_group_block_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_group_block_creator FOREIGN KEY (created_by)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_blocks_hold ON group_blocks (hotel_id, room_type, status, cutoff_at);
+
+-- OverbookingLimits Table
+-- How far past its physical rooms a hotel may sell a room type, as a percentage
+CREATE TABLE IF NOT EXISTS overbooking_limits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    hotel_id UUID NOT NULL,
+    room_type TEXT NOT NULL,
+    limit_percent INT NOT NULL CHECK (limit_percent >= 0 AND limit_percent <= 50),
+    updated_by UUID NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_overbooking_limit UNIQUE (hotel_id, room_type),
+    CONSTRAINT fk_overbooking_limit_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_overbooking_limit_user FOREIGN KEY (updated_by)
+        REFERENCES users(id)
+);
+
+-- OverbookingAudits Table
+-- One row per room type of a booking that was sold past physical inventory
+CREATE TABLE IF NOT EXISTS overbooking_audits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    booking_id UUID NOT NULL,
+    hotel_id UUID NOT NULL,
+    room_type TEXT NOT NULL,
+    room_quantity INT NOT NULL CHECK (room_quantity > 0),
+    oversold_quantity INT NOT NULL CHECK (oversold_quantity > 0 AND oversold_quantity <= room_quantity),
+    physical_quantity INT NOT NULL CHECK (physical_quantity >= 0),
+    limit_percent INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_overbooking_audit_booking FOREIGN KEY (booking_id)
+        REFERENCES bookings(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_overbooking_audit_hotel FOREIGN KEY (hotel_id)
+        REFERENCES hotels(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_overbooking_audits_hotel ON overbooking_audits (hotel_id, created_at DESC);
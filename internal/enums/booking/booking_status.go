@@ -4,6 +4,8 @@ type BookingStatus string
 
 const (
 	StatusConfirmed  BookingStatus = "confirmed"
+	StatusCheckedIn  BookingStatus = "checked_in"
 	StatusCancelled  BookingStatus = "cancelled"
 	StatusCheckedOut BookingStatus = "checked_out"
+	StatusNoShow     BookingStatus = "no_show"
 )
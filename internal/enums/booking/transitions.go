@@ -0,0 +1,33 @@
+package booking_status
+
+import "fmt"
+
+// transitions lists, for every status, the statuses a booking may move to next.
+// The empty status is the state of a booking that has not been created yet.
+var transitions = map[BookingStatus][]BookingStatus{
+	"":               {StatusConfirmed},
+	StatusConfirmed:  {StatusCheckedIn, StatusCancelled, StatusNoShow},
+	StatusCheckedIn:  {StatusCheckedOut},
+	StatusCancelled:  {},
+	StatusCheckedOut: {},
+	StatusNoShow:     {},
+}
+
+func CanTransition(from, to BookingStatus) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func ValidateTransition(from, to BookingStatus) error {
+	if !CanTransition(from, to) {
+		if from == "" {
+			return fmt.Errorf("booking cannot be created as %s", to)
+		}
+		return fmt.Errorf("booking cannot move from %s to %s", from, to)
+	}
+	return nil
+}
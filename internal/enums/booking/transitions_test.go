@@ -0,0 +1,41 @@
+package booking_status_test
+
+import (
+	"testing"
+
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+)
+
+func TestValidateTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        booking_status.BookingStatus
+		to          booking_status.BookingStatus
+		expectError bool
+	}{
+		{"create as confirmed", "", booking_status.StatusConfirmed, false},
+		{"create as checked in", "", booking_status.StatusCheckedIn, true},
+		{"confirmed to checked in", booking_status.StatusConfirmed, booking_status.StatusCheckedIn, false},
+		{"confirmed to cancelled", booking_status.StatusConfirmed, booking_status.StatusCancelled, false},
+		{"confirmed to no show", booking_status.StatusConfirmed, booking_status.StatusNoShow, false},
+		{"confirmed to checked out", booking_status.StatusConfirmed, booking_status.StatusCheckedOut, true},
+		{"checked in to checked out", booking_status.StatusCheckedIn, booking_status.StatusCheckedOut, false},
+		{"checked in to cancelled", booking_status.StatusCheckedIn, booking_status.StatusCancelled, true},
+		{"cancelled is terminal", booking_status.StatusCancelled, booking_status.StatusConfirmed, true},
+		{"checked out is terminal", booking_status.StatusCheckedOut, booking_status.StatusCheckedIn, true},
+		{"no show is terminal", booking_status.StatusNoShow, booking_status.StatusCheckedIn, true},
+		{"unknown status", "archived", booking_status.StatusConfirmed, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := booking_status.ValidateTransition(tt.from, tt.to)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error=%v, got=%v", tt.expectError, err)
+			}
+			if booking_status.CanTransition(tt.from, tt.to) == tt.expectError {
+				t.Errorf("CanTransition(%q, %q) disagrees with ValidateTransition", tt.from, tt.to)
+			}
+		})
+	}
+}
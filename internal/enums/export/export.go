@@ -0,0 +1,34 @@
+package export
+
+// Dataset names a table of hotel data that can be exported.
+type Dataset string
+
+const (
+	Bookings    Dataset = "bookings"
+	BookedRooms Dataset = "booked_rooms"
+	Payments    Dataset = "payments"
+	Guests      Dataset = "guests"
+)
+
+func (d Dataset) IsValid() bool {
+	return d == Bookings || d == BookedRooms || d == Payments || d == Guests
+}
+
+// Format is the file format of an export.
+type Format string
+
+const (
+	CSV    Format = "csv"
+	NDJSON Format = "ndjson" // one JSON object per line
+)
+
+func (f Format) IsValid() bool {
+	return f == CSV || f == NDJSON
+}
+
+func (f Format) ContentType() string {
+	if f == NDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv; charset=utf-8"
+}
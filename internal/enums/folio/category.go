@@ -0,0 +1,15 @@
+package folio
+
+// Category groups incidental charges posted to a folio.
+type Category string
+
+const (
+	Minibar    Category = "minibar"
+	Restaurant Category = "restaurant"
+	Parking    Category = "parking"
+	Other      Category = "other"
+)
+
+func (c Category) IsValid() bool {
+	return c == Minibar || c == Restaurant || c == Parking || c == Other
+}
@@ -0,0 +1,13 @@
+package folio
+
+type LineType string
+
+const (
+	Charge     LineType = "charge"     // adds to what the guest owes
+	Payment    LineType = "payment"    // settles part of the balance
+	Adjustment LineType = "adjustment" // signed correction; negative amounts credit the guest
+)
+
+func (t LineType) IsValid() bool {
+	return t == Charge || t == Payment || t == Adjustment
+}
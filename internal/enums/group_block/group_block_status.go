@@ -0,0 +1,8 @@
+package group_block_status
+
+type GroupBlockStatus string
+
+const (
+	StatusActive   GroupBlockStatus = "active"   // unpicked rooms are held for the group
+	StatusReleased GroupBlockStatus = "released" // unpicked rooms went back to general inventory
+)
@@ -0,0 +1,28 @@
+package hotel
+
+// Amenity is a facility or service a hotel offers its guests.
+type Amenity string
+
+const (
+	Wifi            Amenity = "wifi"
+	Pool            Amenity = "pool"
+	Parking         Amenity = "parking"
+	Gym             Amenity = "gym"
+	Spa             Amenity = "spa"
+	Restaurant      Amenity = "restaurant"
+	Bar             Amenity = "bar"
+	Breakfast       Amenity = "breakfast"
+	AirConditioning Amenity = "air_conditioning"
+	AirportShuttle  Amenity = "airport_shuttle"
+	EVCharging      Amenity = "ev_charging"
+	Accessible      Amenity = "accessible" // step-free access and adapted rooms
+)
+
+var amenities = map[Amenity]bool{
+	Wifi: true, Pool: true, Parking: true, Gym: true, Spa: true, Restaurant: true,
+	Bar: true, Breakfast: true, AirConditioning: true, AirportShuttle: true, EVCharging: true, Accessible: true,
+}
+
+func (a Amenity) IsValid() bool {
+	return amenities[a]
+}
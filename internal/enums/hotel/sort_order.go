@@ -0,0 +1,15 @@
+package hotel
+
+// SortOrder says how hotel search results are ordered.
+type SortOrder string
+
+const (
+	SortByName     SortOrder = "name"     // alphabetical, the default
+	SortByRating   SortOrder = "rating"   // highest average rating first, unrated hotels last
+	SortByNewest   SortOrder = "newest"   // most recently listed first
+	SortByDistance SortOrder = "distance" // nearest first, proximity searches only
+)
+
+func (s SortOrder) IsValid() bool {
+	return s == SortByName || s == SortByRating || s == SortByNewest || s == SortByDistance
+}
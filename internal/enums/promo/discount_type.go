@@ -0,0 +1,12 @@
+package promo
+
+type DiscountType string
+
+const (
+	Percentage DiscountType = "percentage" // Value is a whole percent of the room subtotal
+	Fixed      DiscountType = "fixed"      // Value is an amount in minor units
+)
+
+func (t DiscountType) IsValid() bool {
+	return t == Percentage || t == Fixed
+}
@@ -0,0 +1,14 @@
+package report
+
+// Grouping says how report figures are split into periods. Weeks start on Monday.
+type Grouping string
+
+const (
+	GroupByDay   Grouping = "day"
+	GroupByWeek  Grouping = "week"
+	GroupByMonth Grouping = "month"
+)
+
+func (g Grouping) IsValid() bool {
+	return g == GroupByDay || g == GroupByWeek || g == GroupByMonth
+}
@@ -0,0 +1,12 @@
+package restriction
+
+// ViolationCode is the machine-readable reason a stay was refused by a restriction.
+type ViolationCode string
+
+const (
+	MinLengthOfStay   ViolationCode = "MIN_LOS"
+	MaxLengthOfStay   ViolationCode = "MAX_LOS"
+	ClosedToArrival   ViolationCode = "CLOSED_TO_ARRIVAL"
+	ClosedToDeparture ViolationCode = "CLOSED_TO_DEPARTURE"
+	StopSell          ViolationCode = "STOP_SELL"
+)
@@ -1,5 +1,9 @@
 package room
 
+import "regexp"
+
+// RoomType is the code of a room type. Single, Double and Suite are built in;
+// any other code has to be registered for the hotel in its room type registry.
 type RoomType string
 
 const (
@@ -7,3 +11,11 @@ const (
 	Double RoomType = "double"
 	Suite  RoomType = "suite"
 )
+
+var roomTypeCode = regexp.MustCompile(`^[a-z][a-z0-9_]{1,31}$`)
+
+// IsValid reports whether t is a well-formed room type code. Whether a hotel
+// actually sells the type is up to the registry.
+func (t RoomType) IsValid() bool {
+	return roomTypeCode.MatchString(string(t))
+}
@@ -0,0 +1,13 @@
+package room
+
+// Cleanliness follows a unit through housekeeping: a checkout leaves it dirty,
+// claiming the task moves it to cleaning, completing it makes it clean and a
+// supervisor's inspection marks it inspected.
+type Cleanliness string
+
+const (
+	CleanlinessDirty     Cleanliness = "dirty"
+	CleanlinessCleaning  Cleanliness = "cleaning"
+	CleanlinessClean     Cleanliness = "clean"
+	CleanlinessInspected Cleanliness = "inspected"
+)
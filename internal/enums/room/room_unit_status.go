@@ -0,0 +1,8 @@
+package room
+
+type UnitStatus string
+
+const (
+	UnitActive     UnitStatus = "active"
+	UnitOutOfOrder UnitStatus = "out_of_order"
+)
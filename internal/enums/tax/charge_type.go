@@ -0,0 +1,14 @@
+package tax
+
+// ChargeType says how a tax or fee rule is charged on a stay.
+type ChargeType string
+
+const (
+	PerNight   ChargeType = "per_night"  // Value in minor units per room per night
+	PerPerson  ChargeType = "per_person" // Value in minor units per guest per night
+	Percentage ChargeType = "percentage" // Value in basis points of the room charge
+)
+
+func (t ChargeType) IsValid() bool {
+	return t == PerNight || t == PerPerson || t == Percentage
+}
@@ -3,6 +3,8 @@ package user_role
 type UserRole string
 
 const (
-	RoleUser    UserRole = "user"
-	RoleManager UserRole = "manager"
+	RoleUser         UserRole = "user"
+	RoleManager      UserRole = "manager"
+	RoleFrontDesk    UserRole = "front_desk"
+	RoleHousekeeping UserRole = "housekeeping"
 )
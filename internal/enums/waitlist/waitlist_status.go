@@ -0,0 +1,11 @@
+package waitlist_status
+
+type WaitlistStatus string
+
+const (
+	StatusWaiting   WaitlistStatus = "waiting"   // in the queue for rooms
+	StatusOffered   WaitlistStatus = "offered"   // rooms are held until the offer expires
+	StatusBooked    WaitlistStatus = "booked"    // the offer was turned into a booking
+	StatusExpired   WaitlistStatus = "expired"   // the offer lapsed or the stay began
+	StatusCancelled WaitlistStatus = "cancelled" // the guest left the waitlist
+)
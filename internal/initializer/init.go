@@ -1,27 +1,106 @@
 package initializer
 
 import (
+	"github.com/tktanisha/booking_system/internal/config"
 	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/repository/blob_repo"
 	"github.com/tktanisha/booking_system/internal/repository/booking_repo"
+	"github.com/tktanisha/booking_system/internal/repository/cancellation_policy_repo"
+	"github.com/tktanisha/booking_system/internal/repository/exchange_rate_repo"
+	"github.com/tktanisha/booking_system/internal/repository/export_repo"
+	"github.com/tktanisha/booking_system/internal/repository/folio_repo"
+	"github.com/tktanisha/booking_system/internal/repository/geocode_repo"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
 	"github.com/tktanisha/booking_system/internal/repository/hotel_repo"
+	"github.com/tktanisha/booking_system/internal/repository/housekeeping_repo"
+	"github.com/tktanisha/booking_system/internal/repository/invoice_repo"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/repository/notification_repo"
+	"github.com/tktanisha/booking_system/internal/repository/overbooking_repo"
+	"github.com/tktanisha/booking_system/internal/repository/promo_code_repo"
+	"github.com/tktanisha/booking_system/internal/repository/report_repo"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_block_repo"
 	"github.com/tktanisha/booking_system/internal/repository/room_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_unit_repo"
+	"github.com/tktanisha/booking_system/internal/repository/stay_restriction_repo"
+	"github.com/tktanisha/booking_system/internal/repository/tax_rule_repo"
 	"github.com/tktanisha/booking_system/internal/repository/user_repo"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
 	"github.com/tktanisha/booking_system/internal/services/auth_service"
 	"github.com/tktanisha/booking_system/internal/services/booking_service"
+	"github.com/tktanisha/booking_system/internal/services/cancellation_service"
+	"github.com/tktanisha/booking_system/internal/services/exchange_service"
+	"github.com/tktanisha/booking_system/internal/services/export_service"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
 	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/housekeeping_service"
+	"github.com/tktanisha/booking_system/internal/services/invoice_service"
+	"github.com/tktanisha/booking_system/internal/services/media_service"
+	"github.com/tktanisha/booking_system/internal/services/notification_service"
+	"github.com/tktanisha/booking_system/internal/services/overbooking_service"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/services/report_service"
+	"github.com/tktanisha/booking_system/internal/services/review_service"
 	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/services/room_unit_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/tax_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
 )
 
 var (
-	userRepo    user_repo.UserRepoInterface
-	bookingRepo booking_repo.BookingRepoInterface
-	roomRepo    room_repo.RoomRepoInterface
-	hotelRepo   hotel_repo.HotelRepositoryInterface
+	userRepo               user_repo.UserRepoInterface
+	bookingRepo            booking_repo.BookingRepoInterface
+	roomRepo               room_repo.RoomRepoInterface
+	hotelRepo              hotel_repo.HotelRepositoryInterface
+	cancellationPolicyRepo cancellation_policy_repo.CancellationPolicyRepoInterface
+	roomUnitRepo           room_unit_repo.RoomUnitRepoInterface
+	roomBlockRepo          room_block_repo.RoomBlockRepoInterface
+	housekeepingRepo       housekeeping_repo.HousekeepingRepoInterface
+	roomTypeRepo           room_type_repo.RoomTypeRepoInterface
+	stayRestrictionRepo    stay_restriction_repo.StayRestrictionRepoInterface
+	promoCodeRepo          promo_code_repo.PromoCodeRepoInterface
+	taxRuleRepo            tax_rule_repo.TaxRuleRepoInterface
+	folioRepo              folio_repo.FolioRepoInterface
+	invoiceRepo            invoice_repo.InvoiceRepoInterface
+	exchangeRateRepo       exchange_rate_repo.ExchangeRateRepoInterface
+	reviewRepo             review_repo.ReviewRepoInterface
+	geocoder               geocode_repo.GeocoderInterface
+	mediaRepo              media_repo.MediaRepoInterface
+	waitlistRepo           waitlist_repo.WaitlistRepoInterface
+	notificationRepo       notification_repo.NotificationRepoInterface
+	groupBlockRepo         group_block_repo.GroupBlockRepoInterface
+	overbookingRepo        overbooking_repo.OverbookingRepoInterface
+	reportRepo             report_repo.ReportRepoInterface
+	exportRepo             export_repo.ExportRepoInterface
+	blobStore              blob_repo.BlobStoreInterface
 
-	AuthService    auth_service.AuthServiceInterface
-	RoomService    room_service.RoomServiceInterface
-	BookingService booking_service.BookingServiceInterface
-	HotelService   hotel_service.HotelServiceInterface
+	AuthService         auth_service.AuthServiceInterface
+	RoomService         room_service.RoomServiceInterface
+	BookingService      booking_service.BookingServiceInterface
+	HotelService        hotel_service.HotelServiceInterface
+	CancellationService cancellation_service.CancellationServiceInterface
+	RoomUnitService     room_unit_service.RoomUnitServiceInterface
+	HousekeepingService housekeeping_service.HousekeepingServiceInterface
+	RoomTypeService     room_type_service.RoomTypeServiceInterface
+	RestrictionService  stay_restriction_service.StayRestrictionServiceInterface
+	PromoService        promo_service.PromoServiceInterface
+	TaxService          tax_service.TaxServiceInterface
+	FolioService        folio_service.FolioServiceInterface
+	InvoiceService      invoice_service.InvoiceServiceInterface
+	ExchangeService     exchange_service.ExchangeServiceInterface
+	ReviewService       review_service.ReviewServiceInterface
+	MediaService        media_service.MediaServiceInterface
+	NotificationService notification_service.NotificationServiceInterface
+	WaitlistService     waitlist_service.WaitlistServiceInterface
+	GroupBlockService   group_block_service.GroupBlockServiceInterface
+	OverbookingService  overbooking_service.OverbookingServiceInterface
+	ReportService       report_service.ReportServiceInterface
+	ExportService       export_service.ExportServiceInterface
 )
 
 func Initialize(db db.DB) {
@@ -29,9 +108,50 @@ func Initialize(db db.DB) {
 	bookingRepo = booking_repo.NewBookingRepo(db)
 	hotelRepo = hotel_repo.NewHotelRepo(db)
 	roomRepo = room_repo.NewRoomRepo(db)
+	cancellationPolicyRepo = cancellation_policy_repo.NewCancellationPolicyRepo(db)
+	roomUnitRepo = room_unit_repo.NewRoomUnitRepo(db)
+	roomBlockRepo = room_block_repo.NewRoomBlockRepo(db)
+	housekeepingRepo = housekeeping_repo.NewHousekeepingRepo(db)
+	roomTypeRepo = room_type_repo.NewRoomTypeRepo(db)
+	stayRestrictionRepo = stay_restriction_repo.NewStayRestrictionRepo(db)
+	promoCodeRepo = promo_code_repo.NewPromoCodeRepo(db)
+	taxRuleRepo = tax_rule_repo.NewTaxRuleRepo(db)
+	folioRepo = folio_repo.NewFolioRepo(db)
+	invoiceRepo = invoice_repo.NewInvoiceRepo(db)
+	exchangeRateRepo = exchange_rate_repo.NewExchangeRateRepo(config.GetExchangeRatesFile())
+	reviewRepo = review_repo.NewReviewRepo(db)
+	if path := config.GetGeocodeFile(); path != "" {
+		geocoder = geocode_repo.NewGeocodeRepo(path)
+	}
+	mediaRepo = media_repo.NewMediaRepo(db)
+	waitlistRepo = waitlist_repo.NewWaitlistRepo(db)
+	notificationRepo = notification_repo.NewNotificationRepo(db)
+	groupBlockRepo = group_block_repo.NewGroupBlockRepo(db)
+	overbookingRepo = overbooking_repo.NewOverbookingRepo(db)
+	reportRepo = report_repo.NewReportRepo(db)
+	exportRepo = export_repo.NewExportRepo(db)
+	blobStore = blob_repo.NewLocalBlobStore(config.GetMediaDir())
 
 	AuthService = auth_service.NewAuthService(userRepo)
-	RoomService = room_service.NewRoomService(roomRepo)
-	BookingService = booking_service.NewBookingService(bookingRepo, RoomService)
-	HotelService = hotel_service.NewHotelService(hotelRepo)
+	HotelService = hotel_service.NewHotelService(hotelRepo, reviewRepo, mediaRepo, geocoder)
+	RoomTypeService = room_type_service.NewRoomTypeService(roomTypeRepo, roomRepo, mediaRepo, HotelService)
+	RestrictionService = stay_restriction_service.NewStayRestrictionService(stayRestrictionRepo, HotelService)
+	ExchangeService = exchange_service.NewExchangeService(exchangeRateRepo)
+	RoomService = room_service.NewRoomService(roomRepo, roomBlockRepo, roomUnitRepo, waitlistRepo, groupBlockRepo, overbookingRepo, RoomTypeService, RestrictionService, HotelService, ExchangeService)
+	CancellationService = cancellation_service.NewCancellationService(cancellationPolicyRepo, RoomTypeService, HotelService)
+	RoomUnitService = room_unit_service.NewRoomUnitService(roomUnitRepo, roomRepo, HotelService)
+	HousekeepingService = housekeeping_service.NewHousekeepingService(housekeepingRepo, roomUnitRepo)
+	PromoService = promo_service.NewPromoService(promoCodeRepo, HotelService)
+	TaxService = tax_service.NewTaxService(taxRuleRepo, HotelService)
+	FolioService = folio_service.NewFolioService(folioRepo, bookingRepo)
+	InvoiceService = invoice_service.NewInvoiceService(invoiceRepo, bookingRepo, FolioService, HotelService)
+	ReviewService = review_service.NewReviewService(reviewRepo, bookingRepo, HotelService)
+	MediaService = media_service.NewMediaService(mediaRepo, blobStore, roomTypeRepo, HotelService)
+	NotificationService = notification_service.NewNotificationService(notificationRepo)
+	WaitlistService = waitlist_service.NewWaitlistService(waitlistRepo, RoomService, RoomTypeService, HotelService, NotificationService)
+	GroupBlockService = group_block_service.NewGroupBlockService(groupBlockRepo, RoomService, HotelService, WaitlistService)
+	OverbookingService = overbooking_service.NewOverbookingService(overbookingRepo, RoomService, RoomTypeService, HotelService)
+	BookingService = booking_service.NewBookingService(bookingRepo, RoomService, CancellationService, HotelService, RoomUnitService, HousekeepingService, RoomTypeService, PromoService, TaxService, FolioService, WaitlistService, GroupBlockService, OverbookingService)
+	ReportService = report_service.NewReportService(reportRepo, RoomService, HotelService)
+	ExportService = export_service.NewExportService(exportRepo, HotelService)
 }
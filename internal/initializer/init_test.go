@@ -35,4 +35,58 @@ func TestInitialize(t *testing.T) {
 	if initializer.RoomService == nil {
 		t.Errorf("RoomService is nil")
 	}
+	if initializer.CancellationService == nil {
+		t.Errorf("CancellationService is nil")
+	}
+	if initializer.RoomUnitService == nil {
+		t.Errorf("RoomUnitService is nil")
+	}
+	if initializer.HousekeepingService == nil {
+		t.Errorf("HousekeepingService is nil")
+	}
+	if initializer.RoomTypeService == nil {
+		t.Errorf("RoomTypeService is nil")
+	}
+	if initializer.RestrictionService == nil {
+		t.Errorf("RestrictionService is nil")
+	}
+	if initializer.PromoService == nil {
+		t.Errorf("PromoService is nil")
+	}
+	if initializer.TaxService == nil {
+		t.Errorf("TaxService is nil")
+	}
+	if initializer.FolioService == nil {
+		t.Errorf("FolioService is nil")
+	}
+	if initializer.InvoiceService == nil {
+		t.Errorf("InvoiceService is nil")
+	}
+	if initializer.ExchangeService == nil {
+		t.Errorf("ExchangeService is nil")
+	}
+	if initializer.ReviewService == nil {
+		t.Errorf("ReviewService is nil")
+	}
+	if initializer.MediaService == nil {
+		t.Errorf("MediaService is nil")
+	}
+	if initializer.NotificationService == nil {
+		t.Errorf("NotificationService is nil")
+	}
+	if initializer.WaitlistService == nil {
+		t.Errorf("WaitlistService is nil")
+	}
+	if initializer.GroupBlockService == nil {
+		t.Errorf("GroupBlockService is nil")
+	}
+	if initializer.OverbookingService == nil {
+		t.Errorf("OverbookingService is nil")
+	}
+	if initializer.ReportService == nil {
+		t.Errorf("ReportService is nil")
+	}
+	if initializer.ExportService == nil {
+		t.Errorf("ExportService is nil")
+	}
 }
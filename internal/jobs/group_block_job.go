@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+)
+
+// StartGroupBlockJob releases group blocks whose cutoff has passed back to
+// general inventory every interval until ctx is cancelled.
+func StartGroupBlockJob(ctx context.Context, groupBlockService group_block_service.GroupBlockServiceInterface, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				RunGroupBlockJob(groupBlockService, now)
+			}
+		}
+	}()
+}
+
+func RunGroupBlockJob(groupBlockService group_block_service.GroupBlockServiceInterface, now time.Time) {
+	released, err := groupBlockService.ReleaseDueBlocks(now)
+	if err != nil {
+		log.Printf("group block job failed: %v", err)
+	}
+	if len(released) > 0 {
+		log.Printf("group block job released %d blocks", len(released))
+	}
+}
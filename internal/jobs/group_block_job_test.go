@@ -0,0 +1,56 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tktanisha/booking_system/internal/jobs"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+func TestRunGroupBlockJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	now := time.Now()
+
+	t.Run("releases due blocks", func(t *testing.T) {
+		mockGroupBlockService.EXPECT().ReleaseDueBlocks(now).Return([]*models.GroupBlocks{{}}, nil)
+		jobs.RunGroupBlockJob(mockGroupBlockService, now)
+	})
+
+	t.Run("service error is logged", func(t *testing.T) {
+		mockGroupBlockService.EXPECT().ReleaseDueBlocks(now).Return(nil, errors.New("db error"))
+		jobs.RunGroupBlockJob(mockGroupBlockService, now)
+	})
+}
+
+func TestStartGroupBlockJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	ran := make(chan struct{}, 1)
+	mockGroupBlockService.EXPECT().ReleaseDueBlocks(gomock.Any()).DoAndReturn(func(time.Time) ([]*models.GroupBlocks, error) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}).MinTimes(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobs.StartGroupBlockJob(ctx, mockGroupBlockService, 10*time.Millisecond)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("group block job did not run")
+	}
+}
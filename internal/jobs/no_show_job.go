@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/services/booking_service"
+)
+
+// StartNoShowJob marks unarrived bookings as no-shows every interval until ctx is cancelled.
+func StartNoShowJob(ctx context.Context, bookingService booking_service.BookingServiceInterface, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				RunNoShowJob(bookingService, now)
+			}
+		}
+	}()
+}
+
+func RunNoShowJob(bookingService booking_service.BookingServiceInterface, now time.Time) {
+	marked, err := bookingService.MarkNoShows(now)
+	if err != nil {
+		log.Printf("no-show job failed: %v", err)
+	}
+	if len(marked) > 0 {
+		log.Printf("no-show job marked %d bookings", len(marked))
+	}
+}
@@ -0,0 +1,56 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tktanisha/booking_system/internal/jobs"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+func TestRunNoShowJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingService := mocks.NewMockBookingServiceInterface(ctrl)
+	now := time.Now()
+
+	t.Run("marks bookings", func(t *testing.T) {
+		mockBookingService.EXPECT().MarkNoShows(now).Return([]*models.Bookings{{}}, nil)
+		jobs.RunNoShowJob(mockBookingService, now)
+	})
+
+	t.Run("service error is logged", func(t *testing.T) {
+		mockBookingService.EXPECT().MarkNoShows(now).Return(nil, errors.New("db error"))
+		jobs.RunNoShowJob(mockBookingService, now)
+	})
+}
+
+func TestStartNoShowJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingService := mocks.NewMockBookingServiceInterface(ctrl)
+	ran := make(chan struct{}, 1)
+	mockBookingService.EXPECT().MarkNoShows(gomock.Any()).DoAndReturn(func(time.Time) ([]*models.Bookings, error) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}).MinTimes(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobs.StartNoShowJob(ctx, mockBookingService, 10*time.Millisecond)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("no-show job did not run")
+	}
+}
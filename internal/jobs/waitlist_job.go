@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+)
+
+// StartWaitlistJob expires lapsed waitlist offers and offers freed rooms every
+// interval until ctx is cancelled.
+func StartWaitlistJob(ctx context.Context, waitlistService waitlist_service.WaitlistServiceInterface, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				RunWaitlistJob(waitlistService, now)
+			}
+		}
+	}()
+}
+
+func RunWaitlistJob(waitlistService waitlist_service.WaitlistServiceInterface, now time.Time) {
+	offered, err := waitlistService.ProcessWaitlist(now)
+	if err != nil {
+		log.Printf("waitlist job failed: %v", err)
+	}
+	if len(offered) > 0 {
+		log.Printf("waitlist job made %d offers", len(offered))
+	}
+}
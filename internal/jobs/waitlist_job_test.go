@@ -0,0 +1,56 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tktanisha/booking_system/internal/jobs"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+func TestRunWaitlistJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	now := time.Now()
+
+	t.Run("makes offers", func(t *testing.T) {
+		mockWaitlistService.EXPECT().ProcessWaitlist(now).Return([]*models.WaitlistEntries{{}}, nil)
+		jobs.RunWaitlistJob(mockWaitlistService, now)
+	})
+
+	t.Run("service error is logged", func(t *testing.T) {
+		mockWaitlistService.EXPECT().ProcessWaitlist(now).Return(nil, errors.New("db error"))
+		jobs.RunWaitlistJob(mockWaitlistService, now)
+	})
+}
+
+func TestStartWaitlistJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	ran := make(chan struct{}, 1)
+	mockWaitlistService.EXPECT().ProcessWaitlist(gomock.Any()).DoAndReturn(func(time.Time) ([]*models.WaitlistEntries, error) {
+		select {
+		case ran <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}).MinTimes(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	jobs.StartWaitlistJob(ctx, mockWaitlistService, 10*time.Millisecond)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("waitlist job did not run")
+	}
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: blob_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBlobStoreInterface is a mock of BlobStoreInterface interface.
+type MockBlobStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStoreInterfaceMockRecorder
+}
+
+// MockBlobStoreInterfaceMockRecorder is the mock recorder for MockBlobStoreInterface.
+type MockBlobStoreInterfaceMockRecorder struct {
+	mock *MockBlobStoreInterface
+}
+
+// NewMockBlobStoreInterface creates a new mock instance.
+func NewMockBlobStoreInterface(ctrl *gomock.Controller) *MockBlobStoreInterface {
+	mock := &MockBlobStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockBlobStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStoreInterface) EXPECT() *MockBlobStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStoreInterface) Delete(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStoreInterfaceMockRecorder) Delete(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStoreInterface)(nil).Delete), key)
+}
+
+// Get mocks base method.
+func (m *MockBlobStoreInterface) Get(key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStoreInterfaceMockRecorder) Get(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStoreInterface)(nil).Get), key)
+}
+
+// Put mocks base method.
+func (m *MockBlobStoreInterface) Put(key string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", key, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStoreInterfaceMockRecorder) Put(key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStoreInterface)(nil).Put), key, data)
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,18 +37,18 @@ func (m *MockBookingRepoInterface) EXPECT() *MockBookingRepoInterfaceMockRecorde
 }
 
 // CreateBookingWithRooms mocks base method.
-func (m *MockBookingRepoInterface) CreateBookingWithRooms(arg0 *models.Bookings, arg1 []*models.BookedRooms) (*models.Bookings, error) {
+func (m *MockBookingRepoInterface) CreateBookingWithRooms(arg0 *models.Bookings, arg1 []*models.BookedRooms, arg2 *models.BookingEvents) (*models.Bookings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBookingWithRooms", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateBookingWithRooms", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*models.Bookings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBookingWithRooms indicates an expected call of CreateBookingWithRooms.
-func (mr *MockBookingRepoInterfaceMockRecorder) CreateBookingWithRooms(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockBookingRepoInterfaceMockRecorder) CreateBookingWithRooms(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingWithRooms", reflect.TypeOf((*MockBookingRepoInterface)(nil).CreateBookingWithRooms), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBookingWithRooms", reflect.TypeOf((*MockBookingRepoInterface)(nil).CreateBookingWithRooms), arg0, arg1, arg2)
 }
 
 // GetBookedRoomsByBookingId mocks base method.
@@ -80,16 +81,105 @@ func (mr *MockBookingRepoInterfaceMockRecorder) GetBookingById(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingById", reflect.TypeOf((*MockBookingRepoInterface)(nil).GetBookingById), arg0)
 }
 
-// Save mocks base method.
-func (m *MockBookingRepoInterface) Save(arg0 *models.Bookings) error {
+// GetBookingDiscountsByBookingId mocks base method.
+func (m *MockBookingRepoInterface) GetBookingDiscountsByBookingId(arg0 uuid.UUID) ([]*models.BookingDiscounts, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", arg0)
+	ret := m.ctrl.Call(m, "GetBookingDiscountsByBookingId", arg0)
+	ret0, _ := ret[0].([]*models.BookingDiscounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingDiscountsByBookingId indicates an expected call of GetBookingDiscountsByBookingId.
+func (mr *MockBookingRepoInterfaceMockRecorder) GetBookingDiscountsByBookingId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingDiscountsByBookingId", reflect.TypeOf((*MockBookingRepoInterface)(nil).GetBookingDiscountsByBookingId), arg0)
+}
+
+// GetBookingEventsByBookingId mocks base method.
+func (m *MockBookingRepoInterface) GetBookingEventsByBookingId(arg0 uuid.UUID) ([]*models.BookingEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingEventsByBookingId", arg0)
+	ret0, _ := ret[0].([]*models.BookingEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingEventsByBookingId indicates an expected call of GetBookingEventsByBookingId.
+func (mr *MockBookingRepoInterfaceMockRecorder) GetBookingEventsByBookingId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingEventsByBookingId", reflect.TypeOf((*MockBookingRepoInterface)(nil).GetBookingEventsByBookingId), arg0)
+}
+
+// GetBookingGuestsByBookingId mocks base method.
+func (m *MockBookingRepoInterface) GetBookingGuestsByBookingId(arg0 uuid.UUID) ([]*models.BookingGuests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingGuestsByBookingId", arg0)
+	ret0, _ := ret[0].([]*models.BookingGuests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingGuestsByBookingId indicates an expected call of GetBookingGuestsByBookingId.
+func (mr *MockBookingRepoInterfaceMockRecorder) GetBookingGuestsByBookingId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingGuestsByBookingId", reflect.TypeOf((*MockBookingRepoInterface)(nil).GetBookingGuestsByBookingId), arg0)
+}
+
+// GetBookingTaxesByBookingId mocks base method.
+func (m *MockBookingRepoInterface) GetBookingTaxesByBookingId(arg0 uuid.UUID) ([]*models.BookingTaxes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingTaxesByBookingId", arg0)
+	ret0, _ := ret[0].([]*models.BookingTaxes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingTaxesByBookingId indicates an expected call of GetBookingTaxesByBookingId.
+func (mr *MockBookingRepoInterfaceMockRecorder) GetBookingTaxesByBookingId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingTaxesByBookingId", reflect.TypeOf((*MockBookingRepoInterface)(nil).GetBookingTaxesByBookingId), arg0)
+}
+
+// GetNoShowCandidates mocks base method.
+func (m *MockBookingRepoInterface) GetNoShowCandidates(now time.Time) ([]*models.Bookings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoShowCandidates", now)
+	ret0, _ := ret[0].([]*models.Bookings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoShowCandidates indicates an expected call of GetNoShowCandidates.
+func (mr *MockBookingRepoInterfaceMockRecorder) GetNoShowCandidates(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoShowCandidates", reflect.TypeOf((*MockBookingRepoInterface)(nil).GetNoShowCandidates), now)
+}
+
+// SaveCheckIn mocks base method.
+func (m *MockBookingRepoInterface) SaveCheckIn(arg0 *models.Bookings, arg1 *models.BookingEvents, arg2 []*models.BookedRoomUnits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCheckIn", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCheckIn indicates an expected call of SaveCheckIn.
+func (mr *MockBookingRepoInterfaceMockRecorder) SaveCheckIn(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCheckIn", reflect.TypeOf((*MockBookingRepoInterface)(nil).SaveCheckIn), arg0, arg1, arg2)
+}
+
+// SaveTransition mocks base method.
+func (m *MockBookingRepoInterface) SaveTransition(arg0 *models.Bookings, arg1 *models.BookingEvents) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTransition", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Save indicates an expected call of Save.
-func (mr *MockBookingRepoInterfaceMockRecorder) Save(arg0 interface{}) *gomock.Call {
+// SaveTransition indicates an expected call of SaveTransition.
+func (mr *MockBookingRepoInterfaceMockRecorder) SaveTransition(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBookingRepoInterface)(nil).Save), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransition", reflect.TypeOf((*MockBookingRepoInterface)(nil).SaveTransition), arg0, arg1)
 }
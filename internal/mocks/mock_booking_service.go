@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -37,33 +38,49 @@ func (m *MockBookingServiceInterface) EXPECT() *MockBookingServiceInterfaceMockR
 }
 
 // CancelBooking mocks base method.
-func (m *MockBookingServiceInterface) CancelBooking(arg0 uuid.UUID) (*models.Bookings, error) {
+func (m *MockBookingServiceInterface) CancelBooking(arg0 *models.UserContext, arg1 uuid.UUID) (*models.Bookings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CancelBooking", arg0)
+	ret := m.ctrl.Call(m, "CancelBooking", arg0, arg1)
 	ret0, _ := ret[0].(*models.Bookings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CancelBooking indicates an expected call of CancelBooking.
-func (mr *MockBookingServiceInterfaceMockRecorder) CancelBooking(arg0 interface{}) *gomock.Call {
+func (mr *MockBookingServiceInterfaceMockRecorder) CancelBooking(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).CancelBooking), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).CancelBooking), arg0, arg1)
+}
+
+// CheckInBooking mocks base method.
+func (m *MockBookingServiceInterface) CheckInBooking(arg0 *models.UserContext, arg1 uuid.UUID, arg2 *payloads.CheckInPayload) (*models.Bookings, []*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInBooking", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Bookings)
+	ret1, _ := ret[1].([]*models.RoomUnits)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CheckInBooking indicates an expected call of CheckInBooking.
+func (mr *MockBookingServiceInterfaceMockRecorder) CheckInBooking(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).CheckInBooking), arg0, arg1, arg2)
 }
 
 // CheckoutBooking mocks base method.
-func (m *MockBookingServiceInterface) CheckoutBooking(arg0 uuid.UUID) (*models.Bookings, error) {
+func (m *MockBookingServiceInterface) CheckoutBooking(arg0 *models.UserContext, arg1 uuid.UUID, arg2 *payloads.CheckoutPayload) (*models.Bookings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckoutBooking", arg0)
+	ret := m.ctrl.Call(m, "CheckoutBooking", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*models.Bookings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckoutBooking indicates an expected call of CheckoutBooking.
-func (mr *MockBookingServiceInterfaceMockRecorder) CheckoutBooking(arg0 interface{}) *gomock.Call {
+func (mr *MockBookingServiceInterfaceMockRecorder) CheckoutBooking(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckoutBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).CheckoutBooking), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckoutBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).CheckoutBooking), arg0, arg1, arg2)
 }
 
 // CreateBooking mocks base method.
@@ -80,3 +97,48 @@ func (mr *MockBookingServiceInterfaceMockRecorder) CreateBooking(arg0, arg1 inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).CreateBooking), arg0, arg1)
 }
+
+// GetBooking mocks base method.
+func (m *MockBookingServiceInterface) GetBooking(arg0 *models.UserContext, arg1 uuid.UUID) (*models.Bookings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBooking", arg0, arg1)
+	ret0, _ := ret[0].(*models.Bookings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBooking indicates an expected call of GetBooking.
+func (mr *MockBookingServiceInterfaceMockRecorder) GetBooking(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBooking", reflect.TypeOf((*MockBookingServiceInterface)(nil).GetBooking), arg0, arg1)
+}
+
+// GetBookingHistory mocks base method.
+func (m *MockBookingServiceInterface) GetBookingHistory(arg0 *models.UserContext, arg1 uuid.UUID) ([]*models.BookingEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingHistory", arg0, arg1)
+	ret0, _ := ret[0].([]*models.BookingEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingHistory indicates an expected call of GetBookingHistory.
+func (mr *MockBookingServiceInterfaceMockRecorder) GetBookingHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingHistory", reflect.TypeOf((*MockBookingServiceInterface)(nil).GetBookingHistory), arg0, arg1)
+}
+
+// MarkNoShows mocks base method.
+func (m *MockBookingServiceInterface) MarkNoShows(now time.Time) ([]*models.Bookings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNoShows", now)
+	ret0, _ := ret[0].([]*models.Bookings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNoShows indicates an expected call of MarkNoShows.
+func (mr *MockBookingServiceInterfaceMockRecorder) MarkNoShows(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNoShows", reflect.TypeOf((*MockBookingServiceInterface)(nil).MarkNoShows), now)
+}
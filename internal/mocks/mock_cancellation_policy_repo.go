@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cancellation_policy_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockCancellationPolicyRepoInterface is a mock of CancellationPolicyRepoInterface interface.
+type MockCancellationPolicyRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancellationPolicyRepoInterfaceMockRecorder
+}
+
+// MockCancellationPolicyRepoInterfaceMockRecorder is the mock recorder for MockCancellationPolicyRepoInterface.
+type MockCancellationPolicyRepoInterfaceMockRecorder struct {
+	mock *MockCancellationPolicyRepoInterface
+}
+
+// NewMockCancellationPolicyRepoInterface creates a new mock instance.
+func NewMockCancellationPolicyRepoInterface(ctrl *gomock.Controller) *MockCancellationPolicyRepoInterface {
+	mock := &MockCancellationPolicyRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockCancellationPolicyRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancellationPolicyRepoInterface) EXPECT() *MockCancellationPolicyRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreatePolicy mocks base method.
+func (m *MockCancellationPolicyRepoInterface) CreatePolicy(arg0 *models.CancellationPolicies) (*models.CancellationPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", arg0)
+	ret0, _ := ret[0].(*models.CancellationPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockCancellationPolicyRepoInterfaceMockRecorder) CreatePolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockCancellationPolicyRepoInterface)(nil).CreatePolicy), arg0)
+}
+
+// GetPoliciesByHotelID mocks base method.
+func (m *MockCancellationPolicyRepoInterface) GetPoliciesByHotelID(hotelID uuid.UUID) ([]*models.CancellationPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.CancellationPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesByHotelID indicates an expected call of GetPoliciesByHotelID.
+func (mr *MockCancellationPolicyRepoInterfaceMockRecorder) GetPoliciesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesByHotelID", reflect.TypeOf((*MockCancellationPolicyRepoInterface)(nil).GetPoliciesByHotelID), hotelID)
+}
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cancellation_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockCancellationServiceInterface is a mock of CancellationServiceInterface interface.
+type MockCancellationServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancellationServiceInterfaceMockRecorder
+}
+
+// MockCancellationServiceInterfaceMockRecorder is the mock recorder for MockCancellationServiceInterface.
+type MockCancellationServiceInterfaceMockRecorder struct {
+	mock *MockCancellationServiceInterface
+}
+
+// NewMockCancellationServiceInterface creates a new mock instance.
+func NewMockCancellationServiceInterface(ctrl *gomock.Controller) *MockCancellationServiceInterface {
+	mock := &MockCancellationServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCancellationServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancellationServiceInterface) EXPECT() *MockCancellationServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CalculatePenalty mocks base method.
+func (m *MockCancellationServiceInterface) CalculatePenalty(arg0 *models.Bookings, arg1 []*models.BookedRooms, arg2 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculatePenalty", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculatePenalty indicates an expected call of CalculatePenalty.
+func (mr *MockCancellationServiceInterfaceMockRecorder) CalculatePenalty(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculatePenalty", reflect.TypeOf((*MockCancellationServiceInterface)(nil).CalculatePenalty), arg0, arg1, arg2)
+}
+
+// CreatePolicy mocks base method.
+func (m *MockCancellationServiceInterface) CreatePolicy(arg0 *models.UserContext, arg1 *payloads.CancellationPolicyPayload) (*models.CancellationPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", arg0, arg1)
+	ret0, _ := ret[0].(*models.CancellationPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockCancellationServiceInterfaceMockRecorder) CreatePolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockCancellationServiceInterface)(nil).CreatePolicy), arg0, arg1)
+}
+
+// GetPoliciesByHotelID mocks base method.
+func (m *MockCancellationServiceInterface) GetPoliciesByHotelID(hotelID uuid.UUID) ([]*models.CancellationPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoliciesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.CancellationPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoliciesByHotelID indicates an expected call of GetPoliciesByHotelID.
+func (mr *MockCancellationServiceInterfaceMockRecorder) GetPoliciesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoliciesByHotelID", reflect.TypeOf((*MockCancellationServiceInterface)(nil).GetPoliciesByHotelID), hotelID)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exchange_rate_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockExchangeRateRepoInterface is a mock of ExchangeRateRepoInterface interface.
+type MockExchangeRateRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepoInterfaceMockRecorder
+}
+
+// MockExchangeRateRepoInterfaceMockRecorder is the mock recorder for MockExchangeRateRepoInterface.
+type MockExchangeRateRepoInterfaceMockRecorder struct {
+	mock *MockExchangeRateRepoInterface
+}
+
+// NewMockExchangeRateRepoInterface creates a new mock instance.
+func NewMockExchangeRateRepoInterface(ctrl *gomock.Controller) *MockExchangeRateRepoInterface {
+	mock := &MockExchangeRateRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepoInterface) EXPECT() *MockExchangeRateRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetExchangeRates mocks base method.
+func (m *MockExchangeRateRepoInterface) GetExchangeRates() (*models.ExchangeRates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRates")
+	ret0, _ := ret[0].(*models.ExchangeRates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRates indicates an expected call of GetExchangeRates.
+func (mr *MockExchangeRateRepoInterfaceMockRecorder) GetExchangeRates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRates", reflect.TypeOf((*MockExchangeRateRepoInterface)(nil).GetExchangeRates))
+}
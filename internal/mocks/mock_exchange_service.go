@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exchange_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockExchangeServiceInterface is a mock of ExchangeServiceInterface interface.
+type MockExchangeServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeServiceInterfaceMockRecorder
+}
+
+// MockExchangeServiceInterfaceMockRecorder is the mock recorder for MockExchangeServiceInterface.
+type MockExchangeServiceInterfaceMockRecorder struct {
+	mock *MockExchangeServiceInterface
+}
+
+// NewMockExchangeServiceInterface creates a new mock instance.
+func NewMockExchangeServiceInterface(ctrl *gomock.Controller) *MockExchangeServiceInterface {
+	mock := &MockExchangeServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockExchangeServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeServiceInterface) EXPECT() *MockExchangeServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockExchangeServiceInterface) GetRate(from, to string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", from, to)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockExchangeServiceInterfaceMockRecorder) GetRate(from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockExchangeServiceInterface)(nil).GetRate), from, to)
+}
+
+// ToDisplayPrice mocks base method.
+func (m *MockExchangeServiceInterface) ToDisplayPrice(amount int64, from, to string) (*models.DisplayPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToDisplayPrice", amount, from, to)
+	ret0, _ := ret[0].(*models.DisplayPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToDisplayPrice indicates an expected call of ToDisplayPrice.
+func (mr *MockExchangeServiceInterfaceMockRecorder) ToDisplayPrice(amount, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToDisplayPrice", reflect.TypeOf((*MockExchangeServiceInterface)(nil).ToDisplayPrice), amount, from, to)
+}
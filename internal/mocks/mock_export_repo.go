@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockExportRepoInterface is a mock of ExportRepoInterface interface.
+type MockExportRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportRepoInterfaceMockRecorder
+}
+
+// MockExportRepoInterfaceMockRecorder is the mock recorder for MockExportRepoInterface.
+type MockExportRepoInterfaceMockRecorder struct {
+	mock *MockExportRepoInterface
+}
+
+// NewMockExportRepoInterface creates a new mock instance.
+func NewMockExportRepoInterface(ctrl *gomock.Controller) *MockExportRepoInterface {
+	mock := &MockExportRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockExportRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportRepoInterface) EXPECT() *MockExportRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// StreamBookedRooms mocks base method.
+func (m *MockExportRepoInterface) StreamBookedRooms(hotelId uuid.UUID, from, to time.Time, visit func(*models.BookedRooms) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamBookedRooms", hotelId, from, to, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamBookedRooms indicates an expected call of StreamBookedRooms.
+func (mr *MockExportRepoInterfaceMockRecorder) StreamBookedRooms(hotelId, from, to, visit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamBookedRooms", reflect.TypeOf((*MockExportRepoInterface)(nil).StreamBookedRooms), hotelId, from, to, visit)
+}
+
+// StreamBookings mocks base method.
+func (m *MockExportRepoInterface) StreamBookings(hotelId uuid.UUID, from, to time.Time, visit func(*models.Bookings) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamBookings", hotelId, from, to, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamBookings indicates an expected call of StreamBookings.
+func (mr *MockExportRepoInterfaceMockRecorder) StreamBookings(hotelId, from, to, visit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamBookings", reflect.TypeOf((*MockExportRepoInterface)(nil).StreamBookings), hotelId, from, to, visit)
+}
+
+// StreamGuests mocks base method.
+func (m *MockExportRepoInterface) StreamGuests(hotelId uuid.UUID, from, to time.Time, visit func(*models.BookingGuests) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamGuests", hotelId, from, to, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamGuests indicates an expected call of StreamGuests.
+func (mr *MockExportRepoInterfaceMockRecorder) StreamGuests(hotelId, from, to, visit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamGuests", reflect.TypeOf((*MockExportRepoInterface)(nil).StreamGuests), hotelId, from, to, visit)
+}
+
+// StreamPayments mocks base method.
+func (m *MockExportRepoInterface) StreamPayments(hotelId uuid.UUID, from, to time.Time, visit func(*models.FolioLines) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamPayments", hotelId, from, to, visit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamPayments indicates an expected call of StreamPayments.
+func (mr *MockExportRepoInterfaceMockRecorder) StreamPayments(hotelId, from, to, visit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamPayments", reflect.TypeOf((*MockExportRepoInterface)(nil).StreamPayments), hotelId, from, to, visit)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: export_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockExportServiceInterface is a mock of ExportServiceInterface interface.
+type MockExportServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceInterfaceMockRecorder
+}
+
+// MockExportServiceInterfaceMockRecorder is the mock recorder for MockExportServiceInterface.
+type MockExportServiceInterfaceMockRecorder struct {
+	mock *MockExportServiceInterface
+}
+
+// NewMockExportServiceInterface creates a new mock instance.
+func NewMockExportServiceInterface(ctrl *gomock.Controller) *MockExportServiceInterface {
+	mock := &MockExportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockExportServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportServiceInterface) EXPECT() *MockExportServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportServiceInterface) Export(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.ExportPayload, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", userCtx, hotelID, payload, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportServiceInterfaceMockRecorder) Export(userCtx, hotelID, payload, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportServiceInterface)(nil).Export), userCtx, hotelID, payload, w)
+}
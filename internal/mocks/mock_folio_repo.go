@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: folio_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockFolioRepoInterface is a mock of FolioRepoInterface interface.
+type MockFolioRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolioRepoInterfaceMockRecorder
+}
+
+// MockFolioRepoInterfaceMockRecorder is the mock recorder for MockFolioRepoInterface.
+type MockFolioRepoInterfaceMockRecorder struct {
+	mock *MockFolioRepoInterface
+}
+
+// NewMockFolioRepoInterface creates a new mock instance.
+func NewMockFolioRepoInterface(ctrl *gomock.Controller) *MockFolioRepoInterface {
+	mock := &MockFolioRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockFolioRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolioRepoInterface) EXPECT() *MockFolioRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateFolioLine mocks base method.
+func (m *MockFolioRepoInterface) CreateFolioLine(arg0 *models.FolioLines) (*models.FolioLines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolioLine", arg0)
+	ret0, _ := ret[0].(*models.FolioLines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolioLine indicates an expected call of CreateFolioLine.
+func (mr *MockFolioRepoInterfaceMockRecorder) CreateFolioLine(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolioLine", reflect.TypeOf((*MockFolioRepoInterface)(nil).CreateFolioLine), arg0)
+}
+
+// GetFolioLinesByBookingId mocks base method.
+func (m *MockFolioRepoInterface) GetFolioLinesByBookingId(bookingId uuid.UUID) ([]*models.FolioLines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolioLinesByBookingId", bookingId)
+	ret0, _ := ret[0].([]*models.FolioLines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolioLinesByBookingId indicates an expected call of GetFolioLinesByBookingId.
+func (mr *MockFolioRepoInterfaceMockRecorder) GetFolioLinesByBookingId(bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolioLinesByBookingId", reflect.TypeOf((*MockFolioRepoInterface)(nil).GetFolioLinesByBookingId), bookingId)
+}
+
+// VoidFolioLine mocks base method.
+func (m *MockFolioRepoInterface) VoidFolioLine(lineId, bookingId, voidedBy uuid.UUID, reason string, voidedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidFolioLine", lineId, bookingId, voidedBy, reason, voidedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidFolioLine indicates an expected call of VoidFolioLine.
+func (mr *MockFolioRepoInterfaceMockRecorder) VoidFolioLine(lineId, bookingId, voidedBy, reason, voidedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidFolioLine", reflect.TypeOf((*MockFolioRepoInterface)(nil).VoidFolioLine), lineId, bookingId, voidedBy, reason, voidedAt)
+}
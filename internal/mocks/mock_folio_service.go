@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: folio_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockFolioServiceInterface is a mock of FolioServiceInterface interface.
+type MockFolioServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolioServiceInterfaceMockRecorder
+}
+
+// MockFolioServiceInterfaceMockRecorder is the mock recorder for MockFolioServiceInterface.
+type MockFolioServiceInterfaceMockRecorder struct {
+	mock *MockFolioServiceInterface
+}
+
+// NewMockFolioServiceInterface creates a new mock instance.
+func NewMockFolioServiceInterface(ctrl *gomock.Controller) *MockFolioServiceInterface {
+	mock := &MockFolioServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockFolioServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolioServiceInterface) EXPECT() *MockFolioServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckSettlement mocks base method.
+func (m *MockFolioServiceInterface) CheckSettlement(booking *models.Bookings, payload *payloads.CheckoutPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSettlement", booking, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSettlement indicates an expected call of CheckSettlement.
+func (mr *MockFolioServiceInterfaceMockRecorder) CheckSettlement(booking, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSettlement", reflect.TypeOf((*MockFolioServiceInterface)(nil).CheckSettlement), booking, payload)
+}
+
+// GetFolio mocks base method.
+func (m *MockFolioServiceInterface) GetFolio(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Folio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolio", userCtx, bookingId)
+	ret0, _ := ret[0].(*models.Folio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolio indicates an expected call of GetFolio.
+func (mr *MockFolioServiceInterfaceMockRecorder) GetFolio(userCtx, bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolio", reflect.TypeOf((*MockFolioServiceInterface)(nil).GetFolio), userCtx, bookingId)
+}
+
+// GetFolioForBooking mocks base method.
+func (m *MockFolioServiceInterface) GetFolioForBooking(booking *models.Bookings) (*models.Folio, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolioForBooking", booking)
+	ret0, _ := ret[0].(*models.Folio)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolioForBooking indicates an expected call of GetFolioForBooking.
+func (mr *MockFolioServiceInterfaceMockRecorder) GetFolioForBooking(booking interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolioForBooking", reflect.TypeOf((*MockFolioServiceInterface)(nil).GetFolioForBooking), booking)
+}
+
+// PostLine mocks base method.
+func (m *MockFolioServiceInterface) PostLine(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.PostFolioLinePayload) (*models.FolioLines, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostLine", userCtx, bookingId, payload)
+	ret0, _ := ret[0].(*models.FolioLines)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostLine indicates an expected call of PostLine.
+func (mr *MockFolioServiceInterfaceMockRecorder) PostLine(userCtx, bookingId, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostLine", reflect.TypeOf((*MockFolioServiceInterface)(nil).PostLine), userCtx, bookingId, payload)
+}
+
+// VoidLine mocks base method.
+func (m *MockFolioServiceInterface) VoidLine(userCtx *models.UserContext, bookingId, lineId uuid.UUID, payload *payloads.VoidFolioLinePayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidLine", userCtx, bookingId, lineId, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidLine indicates an expected call of VoidLine.
+func (mr *MockFolioServiceInterfaceMockRecorder) VoidLine(userCtx, bookingId, lineId, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidLine", reflect.TypeOf((*MockFolioServiceInterface)(nil).VoidLine), userCtx, bookingId, lineId, payload)
+}
+
+// WaiveBalance mocks base method.
+func (m *MockFolioServiceInterface) WaiveBalance(userCtx *models.UserContext, booking *models.Bookings, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaiveBalance", userCtx, booking, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaiveBalance indicates an expected call of WaiveBalance.
+func (mr *MockFolioServiceInterfaceMockRecorder) WaiveBalance(userCtx, booking, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaiveBalance", reflect.TypeOf((*MockFolioServiceInterface)(nil).WaiveBalance), userCtx, booking, reason)
+}
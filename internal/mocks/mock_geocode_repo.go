@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: geocode_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockGeocoderInterface is a mock of GeocoderInterface interface.
+type MockGeocoderInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeocoderInterfaceMockRecorder
+}
+
+// MockGeocoderInterfaceMockRecorder is the mock recorder for MockGeocoderInterface.
+type MockGeocoderInterfaceMockRecorder struct {
+	mock *MockGeocoderInterface
+}
+
+// NewMockGeocoderInterface creates a new mock instance.
+func NewMockGeocoderInterface(ctrl *gomock.Controller) *MockGeocoderInterface {
+	mock := &MockGeocoderInterface{ctrl: ctrl}
+	mock.recorder = &MockGeocoderInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGeocoderInterface) EXPECT() *MockGeocoderInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Geocode mocks base method.
+func (m *MockGeocoderInterface) Geocode(address string) (*models.GeoPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Geocode", address)
+	ret0, _ := ret[0].(*models.GeoPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Geocode indicates an expected call of Geocode.
+func (mr *MockGeocoderInterfaceMockRecorder) Geocode(address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Geocode", reflect.TypeOf((*MockGeocoderInterface)(nil).Geocode), address)
+}
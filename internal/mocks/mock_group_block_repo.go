@@ -0,0 +1,169 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_block_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockGroupBlockRepoInterface is a mock of GroupBlockRepoInterface interface.
+type MockGroupBlockRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupBlockRepoInterfaceMockRecorder
+}
+
+// MockGroupBlockRepoInterfaceMockRecorder is the mock recorder for MockGroupBlockRepoInterface.
+type MockGroupBlockRepoInterfaceMockRecorder struct {
+	mock *MockGroupBlockRepoInterface
+}
+
+// NewMockGroupBlockRepoInterface creates a new mock instance.
+func NewMockGroupBlockRepoInterface(ctrl *gomock.Controller) *MockGroupBlockRepoInterface {
+	mock := &MockGroupBlockRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockGroupBlockRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupBlockRepoInterface) EXPECT() *MockGroupBlockRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateBlock mocks base method.
+func (m *MockGroupBlockRepoInterface) CreateBlock(arg0 *models.GroupBlocks) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlock", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBlock indicates an expected call of CreateBlock.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) CreateBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).CreateBlock), arg0)
+}
+
+// GetBlockById mocks base method.
+func (m *MockGroupBlockRepoInterface) GetBlockById(blockId uuid.UUID) (*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockById", blockId)
+	ret0, _ := ret[0].(*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockById indicates an expected call of GetBlockById.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) GetBlockById(blockId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockById", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).GetBlockById), blockId)
+}
+
+// GetBlocksByCode mocks base method.
+func (m *MockGroupBlockRepoInterface) GetBlocksByCode(hotelId uuid.UUID, code string) ([]*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksByCode", hotelId, code)
+	ret0, _ := ret[0].([]*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByCode indicates an expected call of GetBlocksByCode.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) GetBlocksByCode(hotelId, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByCode", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).GetBlocksByCode), hotelId, code)
+}
+
+// GetBlocksByHotelId mocks base method.
+func (m *MockGroupBlockRepoInterface) GetBlocksByHotelId(hotelId uuid.UUID) ([]*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksByHotelId", hotelId)
+	ret0, _ := ret[0].([]*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByHotelId indicates an expected call of GetBlocksByHotelId.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) GetBlocksByHotelId(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByHotelId", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).GetBlocksByHotelId), hotelId)
+}
+
+// GetHeldQuantity mocks base method.
+func (m *MockGroupBlockRepoInterface) GetHeldQuantity(hotelId uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldQuantity", hotelId, roomType, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldQuantity indicates an expected call of GetHeldQuantity.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) GetHeldQuantity(hotelId, roomType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldQuantity", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).GetHeldQuantity), hotelId, roomType, from, to)
+}
+
+// PickUpRooms mocks base method.
+func (m *MockGroupBlockRepoInterface) PickUpRooms(blockId uuid.UUID, quantity int, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickUpRooms", blockId, quantity, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PickUpRooms indicates an expected call of PickUpRooms.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) PickUpRooms(blockId, quantity, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickUpRooms", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).PickUpRooms), blockId, quantity, now)
+}
+
+// ReleaseBlock mocks base method.
+func (m *MockGroupBlockRepoInterface) ReleaseBlock(blockId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBlock", blockId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseBlock indicates an expected call of ReleaseBlock.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) ReleaseBlock(blockId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBlock", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).ReleaseBlock), blockId)
+}
+
+// ReleaseDueBlocks mocks base method.
+func (m *MockGroupBlockRepoInterface) ReleaseDueBlocks(now time.Time) ([]*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDueBlocks", now)
+	ret0, _ := ret[0].([]*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDueBlocks indicates an expected call of ReleaseDueBlocks.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) ReleaseDueBlocks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDueBlocks", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).ReleaseDueBlocks), now)
+}
+
+// ReturnRooms mocks base method.
+func (m *MockGroupBlockRepoInterface) ReturnRooms(blockId uuid.UUID, quantity int, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnRooms", blockId, quantity, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnRooms indicates an expected call of ReturnRooms.
+func (mr *MockGroupBlockRepoInterfaceMockRecorder) ReturnRooms(blockId, quantity, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnRooms", reflect.TypeOf((*MockGroupBlockRepoInterface)(nil).ReturnRooms), blockId, quantity, now)
+}
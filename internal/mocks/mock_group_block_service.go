@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: group_block_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockGroupBlockServiceInterface is a mock of GroupBlockServiceInterface interface.
+type MockGroupBlockServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupBlockServiceInterfaceMockRecorder
+}
+
+// MockGroupBlockServiceInterfaceMockRecorder is the mock recorder for MockGroupBlockServiceInterface.
+type MockGroupBlockServiceInterfaceMockRecorder struct {
+	mock *MockGroupBlockServiceInterface
+}
+
+// NewMockGroupBlockServiceInterface creates a new mock instance.
+func NewMockGroupBlockServiceInterface(ctrl *gomock.Controller) *MockGroupBlockServiceInterface {
+	mock := &MockGroupBlockServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockGroupBlockServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupBlockServiceInterface) EXPECT() *MockGroupBlockServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateGroupBlock mocks base method.
+func (m *MockGroupBlockServiceInterface) CreateGroupBlock(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.GroupBlockPayload) (*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupBlock", userCtx, hotelID, payload)
+	ret0, _ := ret[0].(*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupBlock indicates an expected call of CreateGroupBlock.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) CreateGroupBlock(userCtx, hotelID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupBlock", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).CreateGroupBlock), userCtx, hotelID, payload)
+}
+
+// GetGroupBlocksByCode mocks base method.
+func (m *MockGroupBlockServiceInterface) GetGroupBlocksByCode(hotelID uuid.UUID, code string) ([]*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupBlocksByCode", hotelID, code)
+	ret0, _ := ret[0].([]*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupBlocksByCode indicates an expected call of GetGroupBlocksByCode.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) GetGroupBlocksByCode(hotelID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupBlocksByCode", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).GetGroupBlocksByCode), hotelID, code)
+}
+
+// GetHotelGroupBlocks mocks base method.
+func (m *MockGroupBlockServiceInterface) GetHotelGroupBlocks(hotelID uuid.UUID) ([]*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotelGroupBlocks", hotelID)
+	ret0, _ := ret[0].([]*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHotelGroupBlocks indicates an expected call of GetHotelGroupBlocks.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) GetHotelGroupBlocks(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelGroupBlocks", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).GetHotelGroupBlocks), hotelID)
+}
+
+// PickUpRooms mocks base method.
+func (m *MockGroupBlockServiceInterface) PickUpRooms(payload *payloads.BookingPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickUpRooms", payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PickUpRooms indicates an expected call of PickUpRooms.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) PickUpRooms(payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickUpRooms", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).PickUpRooms), payload)
+}
+
+// ReleaseDueBlocks mocks base method.
+func (m *MockGroupBlockServiceInterface) ReleaseDueBlocks(now time.Time) ([]*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDueBlocks", now)
+	ret0, _ := ret[0].([]*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDueBlocks indicates an expected call of ReleaseDueBlocks.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) ReleaseDueBlocks(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDueBlocks", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).ReleaseDueBlocks), now)
+}
+
+// ReleaseGroupBlock mocks base method.
+func (m *MockGroupBlockServiceInterface) ReleaseGroupBlock(userCtx *models.UserContext, blockID uuid.UUID) (*models.GroupBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseGroupBlock", userCtx, blockID)
+	ret0, _ := ret[0].(*models.GroupBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseGroupBlock indicates an expected call of ReleaseGroupBlock.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) ReleaseGroupBlock(userCtx, blockID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseGroupBlock", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).ReleaseGroupBlock), userCtx, blockID)
+}
+
+// ReturnRooms mocks base method.
+func (m *MockGroupBlockServiceInterface) ReturnRooms(hotelID uuid.UUID, code string, rooms []*payloads.RoomPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnRooms", hotelID, code, rooms)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnRooms indicates an expected call of ReturnRooms.
+func (mr *MockGroupBlockServiceInterfaceMockRecorder) ReturnRooms(hotelID, code, rooms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnRooms", reflect.TypeOf((*MockGroupBlockServiceInterface)(nil).ReturnRooms), hotelID, code, rooms)
+}
@@ -64,3 +64,32 @@ func (mr *MockHotelRepositoryInterfaceMockRecorder) GetHotelByID(arg0 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelByID", reflect.TypeOf((*MockHotelRepositoryInterface)(nil).GetHotelByID), arg0)
 }
+
+// SearchHotels mocks base method.
+func (m *MockHotelRepositoryInterface) SearchHotels(arg0 *models.HotelSearch) ([]*models.Hotels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchHotels", arg0)
+	ret0, _ := ret[0].([]*models.Hotels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchHotels indicates an expected call of SearchHotels.
+func (mr *MockHotelRepositoryInterfaceMockRecorder) SearchHotels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHotels", reflect.TypeOf((*MockHotelRepositoryInterface)(nil).SearchHotels), arg0)
+}
+
+// UpdateHotel mocks base method.
+func (m *MockHotelRepositoryInterface) UpdateHotel(arg0 *models.Hotels) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHotel", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHotel indicates an expected call of UpdateHotel.
+func (mr *MockHotelRepositoryInterfaceMockRecorder) UpdateHotel(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHotel", reflect.TypeOf((*MockHotelRepositoryInterface)(nil).UpdateHotel), arg0)
+}
@@ -65,3 +65,48 @@ func (mr *MockHotelServiceInterfaceMockRecorder) GetHotelByID(arg0 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelByID", reflect.TypeOf((*MockHotelServiceInterface)(nil).GetHotelByID), arg0)
 }
+
+// GetHotelDetails mocks base method.
+func (m *MockHotelServiceInterface) GetHotelDetails(arg0 uuid.UUID) (*models.Hotels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotelDetails", arg0)
+	ret0, _ := ret[0].(*models.Hotels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHotelDetails indicates an expected call of GetHotelDetails.
+func (mr *MockHotelServiceInterfaceMockRecorder) GetHotelDetails(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelDetails", reflect.TypeOf((*MockHotelServiceInterface)(nil).GetHotelDetails), arg0)
+}
+
+// SearchHotels mocks base method.
+func (m *MockHotelServiceInterface) SearchHotels(arg0 *payloads.HotelSearchPayload) ([]*models.Hotels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchHotels", arg0)
+	ret0, _ := ret[0].([]*models.Hotels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchHotels indicates an expected call of SearchHotels.
+func (mr *MockHotelServiceInterfaceMockRecorder) SearchHotels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHotels", reflect.TypeOf((*MockHotelServiceInterface)(nil).SearchHotels), arg0)
+}
+
+// UpdateHotel mocks base method.
+func (m *MockHotelServiceInterface) UpdateHotel(arg0 *models.UserContext, arg1 uuid.UUID, arg2 *payloads.UpdateHotelPayload) (*models.Hotels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHotel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.Hotels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHotel indicates an expected call of UpdateHotel.
+func (mr *MockHotelServiceInterfaceMockRecorder) UpdateHotel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHotel", reflect.TypeOf((*MockHotelServiceInterface)(nil).UpdateHotel), arg0, arg1, arg2)
+}
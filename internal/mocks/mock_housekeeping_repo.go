@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: housekeeping_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockHousekeepingRepoInterface is a mock of HousekeepingRepoInterface interface.
+type MockHousekeepingRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockHousekeepingRepoInterfaceMockRecorder
+}
+
+// MockHousekeepingRepoInterfaceMockRecorder is the mock recorder for MockHousekeepingRepoInterface.
+type MockHousekeepingRepoInterfaceMockRecorder struct {
+	mock *MockHousekeepingRepoInterface
+}
+
+// NewMockHousekeepingRepoInterface creates a new mock instance.
+func NewMockHousekeepingRepoInterface(ctrl *gomock.Controller) *MockHousekeepingRepoInterface {
+	mock := &MockHousekeepingRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockHousekeepingRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHousekeepingRepoInterface) EXPECT() *MockHousekeepingRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ClaimTask mocks base method.
+func (m *MockHousekeepingRepoInterface) ClaimTask(taskId, staffId uuid.UUID, claimedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTask", taskId, staffId, claimedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimTask indicates an expected call of ClaimTask.
+func (mr *MockHousekeepingRepoInterfaceMockRecorder) ClaimTask(taskId, staffId, claimedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTask", reflect.TypeOf((*MockHousekeepingRepoInterface)(nil).ClaimTask), taskId, staffId, claimedAt)
+}
+
+// CompleteTask mocks base method.
+func (m *MockHousekeepingRepoInterface) CompleteTask(taskId uuid.UUID, completedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTask", taskId, completedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTask indicates an expected call of CompleteTask.
+func (mr *MockHousekeepingRepoInterfaceMockRecorder) CompleteTask(taskId, completedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTask", reflect.TypeOf((*MockHousekeepingRepoInterface)(nil).CompleteTask), taskId, completedAt)
+}
+
+// CreateTask mocks base method.
+func (m *MockHousekeepingRepoInterface) CreateTask(arg0 *models.HousekeepingTasks) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTask", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTask indicates an expected call of CreateTask.
+func (mr *MockHousekeepingRepoInterfaceMockRecorder) CreateTask(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTask", reflect.TypeOf((*MockHousekeepingRepoInterface)(nil).CreateTask), arg0)
+}
+
+// GetOpenTasksByHotelID mocks base method.
+func (m *MockHousekeepingRepoInterface) GetOpenTasksByHotelID(hotelID uuid.UUID) ([]*models.HousekeepingTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenTasksByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.HousekeepingTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenTasksByHotelID indicates an expected call of GetOpenTasksByHotelID.
+func (mr *MockHousekeepingRepoInterfaceMockRecorder) GetOpenTasksByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenTasksByHotelID", reflect.TypeOf((*MockHousekeepingRepoInterface)(nil).GetOpenTasksByHotelID), hotelID)
+}
+
+// GetTaskById mocks base method.
+func (m *MockHousekeepingRepoInterface) GetTaskById(taskId uuid.UUID) (*models.HousekeepingTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskById", taskId)
+	ret0, _ := ret[0].(*models.HousekeepingTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskById indicates an expected call of GetTaskById.
+func (mr *MockHousekeepingRepoInterfaceMockRecorder) GetTaskById(taskId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskById", reflect.TypeOf((*MockHousekeepingRepoInterface)(nil).GetTaskById), taskId)
+}
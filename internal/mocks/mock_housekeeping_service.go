@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: housekeeping_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockHousekeepingServiceInterface is a mock of HousekeepingServiceInterface interface.
+type MockHousekeepingServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockHousekeepingServiceInterfaceMockRecorder
+}
+
+// MockHousekeepingServiceInterfaceMockRecorder is the mock recorder for MockHousekeepingServiceInterface.
+type MockHousekeepingServiceInterfaceMockRecorder struct {
+	mock *MockHousekeepingServiceInterface
+}
+
+// NewMockHousekeepingServiceInterface creates a new mock instance.
+func NewMockHousekeepingServiceInterface(ctrl *gomock.Controller) *MockHousekeepingServiceInterface {
+	mock := &MockHousekeepingServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockHousekeepingServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHousekeepingServiceInterface) EXPECT() *MockHousekeepingServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ClaimTask mocks base method.
+func (m *MockHousekeepingServiceInterface) ClaimTask(userCtx *models.UserContext, taskId uuid.UUID) (*models.HousekeepingTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTask", userCtx, taskId)
+	ret0, _ := ret[0].(*models.HousekeepingTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTask indicates an expected call of ClaimTask.
+func (mr *MockHousekeepingServiceInterfaceMockRecorder) ClaimTask(userCtx, taskId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTask", reflect.TypeOf((*MockHousekeepingServiceInterface)(nil).ClaimTask), userCtx, taskId)
+}
+
+// CompleteTask mocks base method.
+func (m *MockHousekeepingServiceInterface) CompleteTask(userCtx *models.UserContext, taskId uuid.UUID) (*models.HousekeepingTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTask", userCtx, taskId)
+	ret0, _ := ret[0].(*models.HousekeepingTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTask indicates an expected call of CompleteTask.
+func (mr *MockHousekeepingServiceInterfaceMockRecorder) CompleteTask(userCtx, taskId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTask", reflect.TypeOf((*MockHousekeepingServiceInterface)(nil).CompleteTask), userCtx, taskId)
+}
+
+// CreateCheckoutTasks mocks base method.
+func (m *MockHousekeepingServiceInterface) CreateCheckoutTasks(arg0 *models.Bookings) ([]*models.HousekeepingTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckoutTasks", arg0)
+	ret0, _ := ret[0].([]*models.HousekeepingTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckoutTasks indicates an expected call of CreateCheckoutTasks.
+func (mr *MockHousekeepingServiceInterfaceMockRecorder) CreateCheckoutTasks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckoutTasks", reflect.TypeOf((*MockHousekeepingServiceInterface)(nil).CreateCheckoutTasks), arg0)
+}
+
+// GetOpenTasksByHotelID mocks base method.
+func (m *MockHousekeepingServiceInterface) GetOpenTasksByHotelID(hotelID uuid.UUID) ([]*models.HousekeepingTasks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenTasksByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.HousekeepingTasks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenTasksByHotelID indicates an expected call of GetOpenTasksByHotelID.
+func (mr *MockHousekeepingServiceInterfaceMockRecorder) GetOpenTasksByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenTasksByHotelID", reflect.TypeOf((*MockHousekeepingServiceInterface)(nil).GetOpenTasksByHotelID), hotelID)
+}
+
+// InspectUnit mocks base method.
+func (m *MockHousekeepingServiceInterface) InspectUnit(unitId uuid.UUID) (*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InspectUnit", unitId)
+	ret0, _ := ret[0].(*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InspectUnit indicates an expected call of InspectUnit.
+func (mr *MockHousekeepingServiceInterfaceMockRecorder) InspectUnit(unitId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectUnit", reflect.TypeOf((*MockHousekeepingServiceInterface)(nil).InspectUnit), unitId)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: invoice_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockInvoiceRepoInterface is a mock of InvoiceRepoInterface interface.
+type MockInvoiceRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepoInterfaceMockRecorder
+}
+
+// MockInvoiceRepoInterfaceMockRecorder is the mock recorder for MockInvoiceRepoInterface.
+type MockInvoiceRepoInterfaceMockRecorder struct {
+	mock *MockInvoiceRepoInterface
+}
+
+// NewMockInvoiceRepoInterface creates a new mock instance.
+func NewMockInvoiceRepoInterface(ctrl *gomock.Controller) *MockInvoiceRepoInterface {
+	mock := &MockInvoiceRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepoInterface) EXPECT() *MockInvoiceRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateInvoice mocks base method.
+func (m *MockInvoiceRepoInterface) CreateInvoice(arg0 *models.Invoices) (*models.Invoices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", arg0)
+	ret0, _ := ret[0].(*models.Invoices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockInvoiceRepoInterfaceMockRecorder) CreateInvoice(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockInvoiceRepoInterface)(nil).CreateInvoice), arg0)
+}
+
+// GetInvoiceByBookingId mocks base method.
+func (m *MockInvoiceRepoInterface) GetInvoiceByBookingId(bookingId uuid.UUID) (*models.Invoices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceByBookingId", bookingId)
+	ret0, _ := ret[0].(*models.Invoices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceByBookingId indicates an expected call of GetInvoiceByBookingId.
+func (mr *MockInvoiceRepoInterfaceMockRecorder) GetInvoiceByBookingId(bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceByBookingId", reflect.TypeOf((*MockInvoiceRepoInterface)(nil).GetInvoiceByBookingId), bookingId)
+}
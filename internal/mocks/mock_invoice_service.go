@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: invoice_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockInvoiceServiceInterface is a mock of InvoiceServiceInterface interface.
+type MockInvoiceServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceServiceInterfaceMockRecorder
+}
+
+// MockInvoiceServiceInterfaceMockRecorder is the mock recorder for MockInvoiceServiceInterface.
+type MockInvoiceServiceInterfaceMockRecorder struct {
+	mock *MockInvoiceServiceInterface
+}
+
+// NewMockInvoiceServiceInterface creates a new mock instance.
+func NewMockInvoiceServiceInterface(ctrl *gomock.Controller) *MockInvoiceServiceInterface {
+	mock := &MockInvoiceServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockInvoiceServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceServiceInterface) EXPECT() *MockInvoiceServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetInvoice mocks base method.
+func (m *MockInvoiceServiceInterface) GetInvoice(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Invoices, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", userCtx, bookingId)
+	ret0, _ := ret[0].(*models.Invoices)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockInvoiceServiceInterfaceMockRecorder) GetInvoice(userCtx, bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockInvoiceServiceInterface)(nil).GetInvoice), userCtx, bookingId)
+}
+
+// RenderPDF mocks base method.
+func (m *MockInvoiceServiceInterface) RenderPDF(invoice *models.Invoices) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderPDF", invoice)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// RenderPDF indicates an expected call of RenderPDF.
+func (mr *MockInvoiceServiceInterfaceMockRecorder) RenderPDF(invoice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderPDF", reflect.TypeOf((*MockInvoiceServiceInterface)(nil).RenderPDF), invoice)
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockMediaRepoInterface is a mock of MediaRepoInterface interface.
+type MockMediaRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaRepoInterfaceMockRecorder
+}
+
+// MockMediaRepoInterfaceMockRecorder is the mock recorder for MockMediaRepoInterface.
+type MockMediaRepoInterfaceMockRecorder struct {
+	mock *MockMediaRepoInterface
+}
+
+// NewMockMediaRepoInterface creates a new mock instance.
+func NewMockMediaRepoInterface(ctrl *gomock.Controller) *MockMediaRepoInterface {
+	mock := &MockMediaRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockMediaRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaRepoInterface) EXPECT() *MockMediaRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateMedia mocks base method.
+func (m *MockMediaRepoInterface) CreateMedia(arg0 *models.Media) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMedia", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMedia indicates an expected call of CreateMedia.
+func (mr *MockMediaRepoInterfaceMockRecorder) CreateMedia(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMedia", reflect.TypeOf((*MockMediaRepoInterface)(nil).CreateMedia), arg0)
+}
+
+// DeleteMedia mocks base method.
+func (m *MockMediaRepoInterface) DeleteMedia(mediaId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMedia", mediaId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMedia indicates an expected call of DeleteMedia.
+func (mr *MockMediaRepoInterfaceMockRecorder) DeleteMedia(mediaId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMedia", reflect.TypeOf((*MockMediaRepoInterface)(nil).DeleteMedia), mediaId)
+}
+
+// GetMediaByHotelId mocks base method.
+func (m *MockMediaRepoInterface) GetMediaByHotelId(hotelId uuid.UUID) ([]*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaByHotelId", hotelId)
+	ret0, _ := ret[0].([]*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMediaByHotelId indicates an expected call of GetMediaByHotelId.
+func (mr *MockMediaRepoInterfaceMockRecorder) GetMediaByHotelId(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaByHotelId", reflect.TypeOf((*MockMediaRepoInterface)(nil).GetMediaByHotelId), hotelId)
+}
+
+// GetMediaById mocks base method.
+func (m *MockMediaRepoInterface) GetMediaById(mediaId uuid.UUID) (*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaById", mediaId)
+	ret0, _ := ret[0].(*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMediaById indicates an expected call of GetMediaById.
+func (mr *MockMediaRepoInterfaceMockRecorder) GetMediaById(mediaId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaById", reflect.TypeOf((*MockMediaRepoInterface)(nil).GetMediaById), mediaId)
+}
+
+// SetPositions mocks base method.
+func (m *MockMediaRepoInterface) SetPositions(hotelId uuid.UUID, mediaIds []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPositions", hotelId, mediaIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPositions indicates an expected call of SetPositions.
+func (mr *MockMediaRepoInterfaceMockRecorder) SetPositions(hotelId, mediaIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPositions", reflect.TypeOf((*MockMediaRepoInterface)(nil).SetPositions), hotelId, mediaIds)
+}
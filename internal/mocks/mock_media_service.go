@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: media_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockMediaServiceInterface is a mock of MediaServiceInterface interface.
+type MockMediaServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaServiceInterfaceMockRecorder
+}
+
+// MockMediaServiceInterfaceMockRecorder is the mock recorder for MockMediaServiceInterface.
+type MockMediaServiceInterfaceMockRecorder struct {
+	mock *MockMediaServiceInterface
+}
+
+// NewMockMediaServiceInterface creates a new mock instance.
+func NewMockMediaServiceInterface(ctrl *gomock.Controller) *MockMediaServiceInterface {
+	mock := &MockMediaServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockMediaServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaServiceInterface) EXPECT() *MockMediaServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteMedia mocks base method.
+func (m *MockMediaServiceInterface) DeleteMedia(userCtx *models.UserContext, mediaID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMedia", userCtx, mediaID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMedia indicates an expected call of DeleteMedia.
+func (mr *MockMediaServiceInterfaceMockRecorder) DeleteMedia(userCtx, mediaID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMedia", reflect.TypeOf((*MockMediaServiceInterface)(nil).DeleteMedia), userCtx, mediaID)
+}
+
+// GetHotelMedia mocks base method.
+func (m *MockMediaServiceInterface) GetHotelMedia(hotelID uuid.UUID) ([]*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotelMedia", hotelID)
+	ret0, _ := ret[0].([]*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHotelMedia indicates an expected call of GetHotelMedia.
+func (mr *MockMediaServiceInterfaceMockRecorder) GetHotelMedia(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelMedia", reflect.TypeOf((*MockMediaServiceInterface)(nil).GetHotelMedia), hotelID)
+}
+
+// GetMediaFile mocks base method.
+func (m *MockMediaServiceInterface) GetMediaFile(mediaID uuid.UUID, thumb bool) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaFile", mediaID, thumb)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMediaFile indicates an expected call of GetMediaFile.
+func (mr *MockMediaServiceInterfaceMockRecorder) GetMediaFile(mediaID, thumb interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaFile", reflect.TypeOf((*MockMediaServiceInterface)(nil).GetMediaFile), mediaID, thumb)
+}
+
+// ReorderMedia mocks base method.
+func (m *MockMediaServiceInterface) ReorderMedia(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.MediaOrderPayload) ([]*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderMedia", userCtx, hotelID, payload)
+	ret0, _ := ret[0].([]*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderMedia indicates an expected call of ReorderMedia.
+func (mr *MockMediaServiceInterfaceMockRecorder) ReorderMedia(userCtx, hotelID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderMedia", reflect.TypeOf((*MockMediaServiceInterface)(nil).ReorderMedia), userCtx, hotelID, payload)
+}
+
+// UploadHotelMedia mocks base method.
+func (m *MockMediaServiceInterface) UploadHotelMedia(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadHotelMedia", userCtx, hotelID, payload)
+	ret0, _ := ret[0].(*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadHotelMedia indicates an expected call of UploadHotelMedia.
+func (mr *MockMediaServiceInterfaceMockRecorder) UploadHotelMedia(userCtx, hotelID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadHotelMedia", reflect.TypeOf((*MockMediaServiceInterface)(nil).UploadHotelMedia), userCtx, hotelID, payload)
+}
+
+// UploadRoomTypeMedia mocks base method.
+func (m *MockMediaServiceInterface) UploadRoomTypeMedia(userCtx *models.UserContext, roomTypeID uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadRoomTypeMedia", userCtx, roomTypeID, payload)
+	ret0, _ := ret[0].(*models.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadRoomTypeMedia indicates an expected call of UploadRoomTypeMedia.
+func (mr *MockMediaServiceInterfaceMockRecorder) UploadRoomTypeMedia(userCtx, roomTypeID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadRoomTypeMedia", reflect.TypeOf((*MockMediaServiceInterface)(nil).UploadRoomTypeMedia), userCtx, roomTypeID, payload)
+}
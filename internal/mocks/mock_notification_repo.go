@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockNotificationRepoInterface is a mock of NotificationRepoInterface interface.
+type MockNotificationRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepoInterfaceMockRecorder
+}
+
+// MockNotificationRepoInterfaceMockRecorder is the mock recorder for MockNotificationRepoInterface.
+type MockNotificationRepoInterfaceMockRecorder struct {
+	mock *MockNotificationRepoInterface
+}
+
+// NewMockNotificationRepoInterface creates a new mock instance.
+func NewMockNotificationRepoInterface(ctrl *gomock.Controller) *MockNotificationRepoInterface {
+	mock := &MockNotificationRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepoInterface) EXPECT() *MockNotificationRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateNotification mocks base method.
+func (m *MockNotificationRepoInterface) CreateNotification(arg0 *models.Notifications) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockNotificationRepoInterfaceMockRecorder) CreateNotification(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockNotificationRepoInterface)(nil).CreateNotification), arg0)
+}
+
+// GetNotificationsByUserId mocks base method.
+func (m *MockNotificationRepoInterface) GetNotificationsByUserId(userId uuid.UUID) ([]*models.Notifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsByUserId", userId)
+	ret0, _ := ret[0].([]*models.Notifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationsByUserId indicates an expected call of GetNotificationsByUserId.
+func (mr *MockNotificationRepoInterfaceMockRecorder) GetNotificationsByUserId(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsByUserId", reflect.TypeOf((*MockNotificationRepoInterface)(nil).GetNotificationsByUserId), userId)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockNotificationServiceInterface is a mock of NotificationServiceInterface interface.
+type MockNotificationServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceInterfaceMockRecorder
+}
+
+// MockNotificationServiceInterfaceMockRecorder is the mock recorder for MockNotificationServiceInterface.
+type MockNotificationServiceInterfaceMockRecorder struct {
+	mock *MockNotificationServiceInterface
+}
+
+// NewMockNotificationServiceInterface creates a new mock instance.
+func NewMockNotificationServiceInterface(ctrl *gomock.Controller) *MockNotificationServiceInterface {
+	mock := &MockNotificationServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationServiceInterface) EXPECT() *MockNotificationServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationServiceInterface) GetNotifications(userCtx *models.UserContext) ([]*models.Notifications, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", userCtx)
+	ret0, _ := ret[0].([]*models.Notifications)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationServiceInterfaceMockRecorder) GetNotifications(userCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationServiceInterface)(nil).GetNotifications), userCtx)
+}
+
+// Notify mocks base method.
+func (m *MockNotificationServiceInterface) Notify(userId uuid.UUID, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", userId, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationServiceInterfaceMockRecorder) Notify(userId, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationServiceInterface)(nil).Notify), userId, subject, body)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: overbooking_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockOverbookingRepoInterface is a mock of OverbookingRepoInterface interface.
+type MockOverbookingRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOverbookingRepoInterfaceMockRecorder
+}
+
+// MockOverbookingRepoInterfaceMockRecorder is the mock recorder for MockOverbookingRepoInterface.
+type MockOverbookingRepoInterfaceMockRecorder struct {
+	mock *MockOverbookingRepoInterface
+}
+
+// NewMockOverbookingRepoInterface creates a new mock instance.
+func NewMockOverbookingRepoInterface(ctrl *gomock.Controller) *MockOverbookingRepoInterface {
+	mock := &MockOverbookingRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockOverbookingRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOverbookingRepoInterface) EXPECT() *MockOverbookingRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateAudit mocks base method.
+func (m *MockOverbookingRepoInterface) CreateAudit(arg0 *models.OverbookingAudits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAudit", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAudit indicates an expected call of CreateAudit.
+func (mr *MockOverbookingRepoInterfaceMockRecorder) CreateAudit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAudit", reflect.TypeOf((*MockOverbookingRepoInterface)(nil).CreateAudit), arg0)
+}
+
+// GetAuditsByHotelId mocks base method.
+func (m *MockOverbookingRepoInterface) GetAuditsByHotelId(hotelId uuid.UUID) ([]*models.OverbookingAudits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditsByHotelId", hotelId)
+	ret0, _ := ret[0].([]*models.OverbookingAudits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditsByHotelId indicates an expected call of GetAuditsByHotelId.
+func (mr *MockOverbookingRepoInterfaceMockRecorder) GetAuditsByHotelId(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditsByHotelId", reflect.TypeOf((*MockOverbookingRepoInterface)(nil).GetAuditsByHotelId), hotelId)
+}
+
+// GetBookedStays mocks base method.
+func (m *MockOverbookingRepoInterface) GetBookedStays(hotelId uuid.UUID, from, to time.Time) ([]*models.BookedStays, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookedStays", hotelId, from, to)
+	ret0, _ := ret[0].([]*models.BookedStays)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookedStays indicates an expected call of GetBookedStays.
+func (mr *MockOverbookingRepoInterfaceMockRecorder) GetBookedStays(hotelId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookedStays", reflect.TypeOf((*MockOverbookingRepoInterface)(nil).GetBookedStays), hotelId, from, to)
+}
+
+// GetLimit mocks base method.
+func (m *MockOverbookingRepoInterface) GetLimit(hotelId uuid.UUID, roomType room.RoomType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimit", hotelId, roomType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimit indicates an expected call of GetLimit.
+func (mr *MockOverbookingRepoInterfaceMockRecorder) GetLimit(hotelId, roomType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimit", reflect.TypeOf((*MockOverbookingRepoInterface)(nil).GetLimit), hotelId, roomType)
+}
+
+// GetLimitsByHotelId mocks base method.
+func (m *MockOverbookingRepoInterface) GetLimitsByHotelId(hotelId uuid.UUID) ([]*models.OverbookingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimitsByHotelId", hotelId)
+	ret0, _ := ret[0].([]*models.OverbookingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimitsByHotelId indicates an expected call of GetLimitsByHotelId.
+func (mr *MockOverbookingRepoInterfaceMockRecorder) GetLimitsByHotelId(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimitsByHotelId", reflect.TypeOf((*MockOverbookingRepoInterface)(nil).GetLimitsByHotelId), hotelId)
+}
+
+// UpsertLimit mocks base method.
+func (m *MockOverbookingRepoInterface) UpsertLimit(arg0 *models.OverbookingLimits) (*models.OverbookingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLimit", arg0)
+	ret0, _ := ret[0].(*models.OverbookingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertLimit indicates an expected call of UpsertLimit.
+func (mr *MockOverbookingRepoInterfaceMockRecorder) UpsertLimit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLimit", reflect.TypeOf((*MockOverbookingRepoInterface)(nil).UpsertLimit), arg0)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: overbooking_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockOverbookingServiceInterface is a mock of OverbookingServiceInterface interface.
+type MockOverbookingServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOverbookingServiceInterfaceMockRecorder
+}
+
+// MockOverbookingServiceInterfaceMockRecorder is the mock recorder for MockOverbookingServiceInterface.
+type MockOverbookingServiceInterfaceMockRecorder struct {
+	mock *MockOverbookingServiceInterface
+}
+
+// NewMockOverbookingServiceInterface creates a new mock instance.
+func NewMockOverbookingServiceInterface(ctrl *gomock.Controller) *MockOverbookingServiceInterface {
+	mock := &MockOverbookingServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockOverbookingServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOverbookingServiceInterface) EXPECT() *MockOverbookingServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetAudits mocks base method.
+func (m *MockOverbookingServiceInterface) GetAudits(hotelID uuid.UUID) ([]*models.OverbookingAudits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAudits", hotelID)
+	ret0, _ := ret[0].([]*models.OverbookingAudits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAudits indicates an expected call of GetAudits.
+func (mr *MockOverbookingServiceInterfaceMockRecorder) GetAudits(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAudits", reflect.TypeOf((*MockOverbookingServiceInterface)(nil).GetAudits), hotelID)
+}
+
+// GetLimits mocks base method.
+func (m *MockOverbookingServiceInterface) GetLimits(hotelID uuid.UUID) ([]*models.OverbookingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimits", hotelID)
+	ret0, _ := ret[0].([]*models.OverbookingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimits indicates an expected call of GetLimits.
+func (mr *MockOverbookingServiceInterfaceMockRecorder) GetLimits(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimits", reflect.TypeOf((*MockOverbookingServiceInterface)(nil).GetLimits), hotelID)
+}
+
+// GetWalkList mocks base method.
+func (m *MockOverbookingServiceInterface) GetWalkList(hotelID uuid.UUID, payload *payloads.WalkListPayload) ([]*models.WalkListNights, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWalkList", hotelID, payload)
+	ret0, _ := ret[0].([]*models.WalkListNights)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWalkList indicates an expected call of GetWalkList.
+func (mr *MockOverbookingServiceInterfaceMockRecorder) GetWalkList(hotelID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalkList", reflect.TypeOf((*MockOverbookingServiceInterface)(nil).GetWalkList), hotelID, payload)
+}
+
+// OversoldRooms mocks base method.
+func (m *MockOverbookingServiceInterface) OversoldRooms(hotelID uuid.UUID, rooms []*payloads.RoomPayload, checkIn, checkOut time.Time) ([]*models.OverbookingAudits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OversoldRooms", hotelID, rooms, checkIn, checkOut)
+	ret0, _ := ret[0].([]*models.OverbookingAudits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OversoldRooms indicates an expected call of OversoldRooms.
+func (mr *MockOverbookingServiceInterfaceMockRecorder) OversoldRooms(hotelID, rooms, checkIn, checkOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OversoldRooms", reflect.TypeOf((*MockOverbookingServiceInterface)(nil).OversoldRooms), hotelID, rooms, checkIn, checkOut)
+}
+
+// RecordOversoldBooking mocks base method.
+func (m *MockOverbookingServiceInterface) RecordOversoldBooking(bookingID uuid.UUID, audits []*models.OverbookingAudits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOversoldBooking", bookingID, audits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOversoldBooking indicates an expected call of RecordOversoldBooking.
+func (mr *MockOverbookingServiceInterfaceMockRecorder) RecordOversoldBooking(bookingID, audits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOversoldBooking", reflect.TypeOf((*MockOverbookingServiceInterface)(nil).RecordOversoldBooking), bookingID, audits)
+}
+
+// SetLimit mocks base method.
+func (m *MockOverbookingServiceInterface) SetLimit(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.OverbookingLimitPayload) (*models.OverbookingLimits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimit", userCtx, hotelID, payload)
+	ret0, _ := ret[0].(*models.OverbookingLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetLimit indicates an expected call of SetLimit.
+func (mr *MockOverbookingServiceInterfaceMockRecorder) SetLimit(userCtx, hotelID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimit", reflect.TypeOf((*MockOverbookingServiceInterface)(nil).SetLimit), userCtx, hotelID, payload)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: promo_code_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockPromoCodeRepoInterface is a mock of PromoCodeRepoInterface interface.
+type MockPromoCodeRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoCodeRepoInterfaceMockRecorder
+}
+
+// MockPromoCodeRepoInterfaceMockRecorder is the mock recorder for MockPromoCodeRepoInterface.
+type MockPromoCodeRepoInterfaceMockRecorder struct {
+	mock *MockPromoCodeRepoInterface
+}
+
+// NewMockPromoCodeRepoInterface creates a new mock instance.
+func NewMockPromoCodeRepoInterface(ctrl *gomock.Controller) *MockPromoCodeRepoInterface {
+	mock := &MockPromoCodeRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockPromoCodeRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoCodeRepoInterface) EXPECT() *MockPromoCodeRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoCodeRepoInterface) CreatePromoCode(arg0 *models.PromoCodes) (*models.PromoCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", arg0)
+	ret0, _ := ret[0].(*models.PromoCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoCodeRepoInterfaceMockRecorder) CreatePromoCode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoCodeRepoInterface)(nil).CreatePromoCode), arg0)
+}
+
+// GetPromoCodeByCode mocks base method.
+func (m *MockPromoCodeRepoInterface) GetPromoCodeByCode(code string) (*models.PromoCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodeByCode", code)
+	ret0, _ := ret[0].(*models.PromoCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodeByCode indicates an expected call of GetPromoCodeByCode.
+func (mr *MockPromoCodeRepoInterfaceMockRecorder) GetPromoCodeByCode(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodeByCode", reflect.TypeOf((*MockPromoCodeRepoInterface)(nil).GetPromoCodeByCode), code)
+}
+
+// GetPromoCodesByHotelID mocks base method.
+func (m *MockPromoCodeRepoInterface) GetPromoCodesByHotelID(hotelID uuid.UUID) ([]*models.PromoCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.PromoCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodesByHotelID indicates an expected call of GetPromoCodesByHotelID.
+func (mr *MockPromoCodeRepoInterfaceMockRecorder) GetPromoCodesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodesByHotelID", reflect.TypeOf((*MockPromoCodeRepoInterface)(nil).GetPromoCodesByHotelID), hotelID)
+}
+
+// RedeemPromoCode mocks base method.
+func (m *MockPromoCodeRepoInterface) RedeemPromoCode(promoCodeId, userId, bookingId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemPromoCode", promoCodeId, userId, bookingId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeemPromoCode indicates an expected call of RedeemPromoCode.
+func (mr *MockPromoCodeRepoInterfaceMockRecorder) RedeemPromoCode(promoCodeId, userId, bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemPromoCode", reflect.TypeOf((*MockPromoCodeRepoInterface)(nil).RedeemPromoCode), promoCodeId, userId, bookingId)
+}
+
+// ReleaseRedemption mocks base method.
+func (m *MockPromoCodeRepoInterface) ReleaseRedemption(bookingId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRedemption", bookingId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRedemption indicates an expected call of ReleaseRedemption.
+func (mr *MockPromoCodeRepoInterfaceMockRecorder) ReleaseRedemption(bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRedemption", reflect.TypeOf((*MockPromoCodeRepoInterface)(nil).ReleaseRedemption), bookingId)
+}
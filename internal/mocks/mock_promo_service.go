@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: promo_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockPromoServiceInterface is a mock of PromoServiceInterface interface.
+type MockPromoServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromoServiceInterfaceMockRecorder
+}
+
+// MockPromoServiceInterfaceMockRecorder is the mock recorder for MockPromoServiceInterface.
+type MockPromoServiceInterfaceMockRecorder struct {
+	mock *MockPromoServiceInterface
+}
+
+// NewMockPromoServiceInterface creates a new mock instance.
+func NewMockPromoServiceInterface(ctrl *gomock.Controller) *MockPromoServiceInterface {
+	mock := &MockPromoServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockPromoServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromoServiceInterface) EXPECT() *MockPromoServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ApplyPromoCode mocks base method.
+func (m *MockPromoServiceInterface) ApplyPromoCode(userId, bookingId, hotelId uuid.UUID, code string, subtotal int64) (*models.BookingDiscounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPromoCode", userId, bookingId, hotelId, code, subtotal)
+	ret0, _ := ret[0].(*models.BookingDiscounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPromoCode indicates an expected call of ApplyPromoCode.
+func (mr *MockPromoServiceInterfaceMockRecorder) ApplyPromoCode(userId, bookingId, hotelId, code, subtotal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPromoCode", reflect.TypeOf((*MockPromoServiceInterface)(nil).ApplyPromoCode), userId, bookingId, hotelId, code, subtotal)
+}
+
+// CreatePromoCode mocks base method.
+func (m *MockPromoServiceInterface) CreatePromoCode(arg0 *models.UserContext, arg1 *payloads.CreatePromoCodePayload) (*models.PromoCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromoCode", arg0, arg1)
+	ret0, _ := ret[0].(*models.PromoCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromoCode indicates an expected call of CreatePromoCode.
+func (mr *MockPromoServiceInterfaceMockRecorder) CreatePromoCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromoCode", reflect.TypeOf((*MockPromoServiceInterface)(nil).CreatePromoCode), arg0, arg1)
+}
+
+// GetPromoCodesByHotelID mocks base method.
+func (m *MockPromoServiceInterface) GetPromoCodesByHotelID(userCtx *models.UserContext, hotelID uuid.UUID) ([]*models.PromoCodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoCodesByHotelID", userCtx, hotelID)
+	ret0, _ := ret[0].([]*models.PromoCodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoCodesByHotelID indicates an expected call of GetPromoCodesByHotelID.
+func (mr *MockPromoServiceInterfaceMockRecorder) GetPromoCodesByHotelID(userCtx, hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoCodesByHotelID", reflect.TypeOf((*MockPromoServiceInterface)(nil).GetPromoCodesByHotelID), userCtx, hotelID)
+}
+
+// ReleasePromoCode mocks base method.
+func (m *MockPromoServiceInterface) ReleasePromoCode(bookingId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePromoCode", bookingId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePromoCode indicates an expected call of ReleasePromoCode.
+func (mr *MockPromoServiceInterfaceMockRecorder) ReleasePromoCode(bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePromoCode", reflect.TypeOf((*MockPromoServiceInterface)(nil).ReleasePromoCode), bookingId)
+}
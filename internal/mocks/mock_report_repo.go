@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	report "github.com/tktanisha/booking_system/internal/enums/report"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockReportRepoInterface is a mock of ReportRepoInterface interface.
+type MockReportRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepoInterfaceMockRecorder
+}
+
+// MockReportRepoInterfaceMockRecorder is the mock recorder for MockReportRepoInterface.
+type MockReportRepoInterfaceMockRecorder struct {
+	mock *MockReportRepoInterface
+}
+
+// NewMockReportRepoInterface creates a new mock instance.
+func NewMockReportRepoInterface(ctrl *gomock.Controller) *MockReportRepoInterface {
+	mock := &MockReportRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockReportRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepoInterface) EXPECT() *MockReportRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetPickup mocks base method.
+func (m *MockReportRepoInterface) GetPickup(hotelId uuid.UUID, timeZone string, from, to time.Time, groupBy report.Grouping) ([]*models.ReportNights, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickup", hotelId, timeZone, from, to, groupBy)
+	ret0, _ := ret[0].([]*models.ReportNights)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickup indicates an expected call of GetPickup.
+func (mr *MockReportRepoInterfaceMockRecorder) GetPickup(hotelId, timeZone, from, to, groupBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickup", reflect.TypeOf((*MockReportRepoInterface)(nil).GetPickup), hotelId, timeZone, from, to, groupBy)
+}
+
+// GetStayNights mocks base method.
+func (m *MockReportRepoInterface) GetStayNights(hotelId uuid.UUID, timeZone string, from, to time.Time, groupBy report.Grouping) ([]*models.ReportNights, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStayNights", hotelId, timeZone, from, to, groupBy)
+	ret0, _ := ret[0].([]*models.ReportNights)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStayNights indicates an expected call of GetStayNights.
+func (mr *MockReportRepoInterfaceMockRecorder) GetStayNights(hotelId, timeZone, from, to, groupBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStayNights", reflect.TypeOf((*MockReportRepoInterface)(nil).GetStayNights), hotelId, timeZone, from, to, groupBy)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: report_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockReportServiceInterface is a mock of ReportServiceInterface interface.
+type MockReportServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceInterfaceMockRecorder
+}
+
+// MockReportServiceInterfaceMockRecorder is the mock recorder for MockReportServiceInterface.
+type MockReportServiceInterfaceMockRecorder struct {
+	mock *MockReportServiceInterface
+}
+
+// NewMockReportServiceInterface creates a new mock instance.
+func NewMockReportServiceInterface(ctrl *gomock.Controller) *MockReportServiceInterface {
+	mock := &MockReportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockReportServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportServiceInterface) EXPECT() *MockReportServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetReport mocks base method.
+func (m *MockReportServiceInterface) GetReport(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.ReportPayload) (*models.Reports, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", userCtx, hotelID, payload)
+	ret0, _ := ret[0].(*models.Reports)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockReportServiceInterfaceMockRecorder) GetReport(userCtx, hotelID, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReportServiceInterface)(nil).GetReport), userCtx, hotelID, payload)
+}
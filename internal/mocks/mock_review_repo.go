@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockReviewRepoInterface is a mock of ReviewRepoInterface interface.
+type MockReviewRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepoInterfaceMockRecorder
+}
+
+// MockReviewRepoInterfaceMockRecorder is the mock recorder for MockReviewRepoInterface.
+type MockReviewRepoInterfaceMockRecorder struct {
+	mock *MockReviewRepoInterface
+}
+
+// NewMockReviewRepoInterface creates a new mock instance.
+func NewMockReviewRepoInterface(ctrl *gomock.Controller) *MockReviewRepoInterface {
+	mock := &MockReviewRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockReviewRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepoInterface) EXPECT() *MockReviewRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewRepoInterface) CreateReview(review *models.Reviews) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewRepoInterfaceMockRecorder) CreateReview(review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewRepoInterface)(nil).CreateReview), review)
+}
+
+// GetRatingSummary mocks base method.
+func (m *MockReviewRepoInterface) GetRatingSummary(hotelId uuid.UUID) (*models.RatingSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingSummary", hotelId)
+	ret0, _ := ret[0].(*models.RatingSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingSummary indicates an expected call of GetRatingSummary.
+func (mr *MockReviewRepoInterfaceMockRecorder) GetRatingSummary(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingSummary", reflect.TypeOf((*MockReviewRepoInterface)(nil).GetRatingSummary), hotelId)
+}
+
+// GetReviewById mocks base method.
+func (m *MockReviewRepoInterface) GetReviewById(reviewId uuid.UUID) (*models.Reviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewById", reviewId)
+	ret0, _ := ret[0].(*models.Reviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewById indicates an expected call of GetReviewById.
+func (mr *MockReviewRepoInterfaceMockRecorder) GetReviewById(reviewId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewById", reflect.TypeOf((*MockReviewRepoInterface)(nil).GetReviewById), reviewId)
+}
+
+// GetReviewsByHotelId mocks base method.
+func (m *MockReviewRepoInterface) GetReviewsByHotelId(hotelId uuid.UUID) ([]*models.Reviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewsByHotelId", hotelId)
+	ret0, _ := ret[0].([]*models.Reviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewsByHotelId indicates an expected call of GetReviewsByHotelId.
+func (mr *MockReviewRepoInterfaceMockRecorder) GetReviewsByHotelId(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByHotelId", reflect.TypeOf((*MockReviewRepoInterface)(nil).GetReviewsByHotelId), hotelId)
+}
+
+// SaveReply mocks base method.
+func (m *MockReviewRepoInterface) SaveReply(review *models.Reviews) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReply", review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveReply indicates an expected call of SaveReply.
+func (mr *MockReviewRepoInterfaceMockRecorder) SaveReply(review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReply", reflect.TypeOf((*MockReviewRepoInterface)(nil).SaveReply), review)
+}
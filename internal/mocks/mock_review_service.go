@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockReviewServiceInterface is a mock of ReviewServiceInterface interface.
+type MockReviewServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewServiceInterfaceMockRecorder
+}
+
+// MockReviewServiceInterfaceMockRecorder is the mock recorder for MockReviewServiceInterface.
+type MockReviewServiceInterfaceMockRecorder struct {
+	mock *MockReviewServiceInterface
+}
+
+// NewMockReviewServiceInterface creates a new mock instance.
+func NewMockReviewServiceInterface(ctrl *gomock.Controller) *MockReviewServiceInterface {
+	mock := &MockReviewServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockReviewServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewServiceInterface) EXPECT() *MockReviewServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewServiceInterface) CreateReview(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.ReviewPayload) (*models.Reviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", userCtx, bookingId, payload)
+	ret0, _ := ret[0].(*models.Reviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewServiceInterfaceMockRecorder) CreateReview(userCtx, bookingId, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewServiceInterface)(nil).CreateReview), userCtx, bookingId, payload)
+}
+
+// GetHotelReviews mocks base method.
+func (m *MockReviewServiceInterface) GetHotelReviews(hotelId uuid.UUID) ([]*models.Reviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotelReviews", hotelId)
+	ret0, _ := ret[0].([]*models.Reviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHotelReviews indicates an expected call of GetHotelReviews.
+func (mr *MockReviewServiceInterfaceMockRecorder) GetHotelReviews(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelReviews", reflect.TypeOf((*MockReviewServiceInterface)(nil).GetHotelReviews), hotelId)
+}
+
+// ReplyToReview mocks base method.
+func (m *MockReviewServiceInterface) ReplyToReview(userCtx *models.UserContext, reviewId uuid.UUID, payload *payloads.ReviewReplyPayload) (*models.Reviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyToReview", userCtx, reviewId, payload)
+	ret0, _ := ret[0].(*models.Reviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyToReview indicates an expected call of ReplyToReview.
+func (mr *MockReviewServiceInterfaceMockRecorder) ReplyToReview(userCtx, reviewId, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyToReview", reflect.TypeOf((*MockReviewServiceInterface)(nil).ReplyToReview), userCtx, reviewId, payload)
+}
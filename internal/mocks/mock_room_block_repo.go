@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: room_block_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockRoomBlockRepoInterface is a mock of RoomBlockRepoInterface interface.
+type MockRoomBlockRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomBlockRepoInterfaceMockRecorder
+}
+
+// MockRoomBlockRepoInterfaceMockRecorder is the mock recorder for MockRoomBlockRepoInterface.
+type MockRoomBlockRepoInterfaceMockRecorder struct {
+	mock *MockRoomBlockRepoInterface
+}
+
+// NewMockRoomBlockRepoInterface creates a new mock instance.
+func NewMockRoomBlockRepoInterface(ctrl *gomock.Controller) *MockRoomBlockRepoInterface {
+	mock := &MockRoomBlockRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockRoomBlockRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomBlockRepoInterface) EXPECT() *MockRoomBlockRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateBlock mocks base method.
+func (m *MockRoomBlockRepoInterface) CreateBlock(arg0 *models.RoomBlocks) (*models.RoomBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlock", arg0)
+	ret0, _ := ret[0].(*models.RoomBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlock indicates an expected call of CreateBlock.
+func (mr *MockRoomBlockRepoInterfaceMockRecorder) CreateBlock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockRoomBlockRepoInterface)(nil).CreateBlock), arg0)
+}
+
+// DeleteBlock mocks base method.
+func (m *MockRoomBlockRepoInterface) DeleteBlock(blockId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlock", blockId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlock indicates an expected call of DeleteBlock.
+func (mr *MockRoomBlockRepoInterfaceMockRecorder) DeleteBlock(blockId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockRoomBlockRepoInterface)(nil).DeleteBlock), blockId)
+}
+
+// GetBlockById mocks base method.
+func (m *MockRoomBlockRepoInterface) GetBlockById(blockId uuid.UUID) (*models.RoomBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockById", blockId)
+	ret0, _ := ret[0].(*models.RoomBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockById indicates an expected call of GetBlockById.
+func (mr *MockRoomBlockRepoInterfaceMockRecorder) GetBlockById(blockId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockById", reflect.TypeOf((*MockRoomBlockRepoInterface)(nil).GetBlockById), blockId)
+}
+
+// GetBlockedQuantity mocks base method.
+func (m *MockRoomBlockRepoInterface) GetBlockedQuantity(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedQuantity", hotelID, roomType, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedQuantity indicates an expected call of GetBlockedQuantity.
+func (mr *MockRoomBlockRepoInterfaceMockRecorder) GetBlockedQuantity(hotelID, roomType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedQuantity", reflect.TypeOf((*MockRoomBlockRepoInterface)(nil).GetBlockedQuantity), hotelID, roomType, from, to)
+}
+
+// GetBlocksByHotelID mocks base method.
+func (m *MockRoomBlockRepoInterface) GetBlocksByHotelID(hotelID uuid.UUID) ([]*models.RoomBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.RoomBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByHotelID indicates an expected call of GetBlocksByHotelID.
+func (mr *MockRoomBlockRepoInterfaceMockRecorder) GetBlocksByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByHotelID", reflect.TypeOf((*MockRoomBlockRepoInterface)(nil).GetBlocksByHotelID), hotelID)
+}
+
+// IsHotelClosed mocks base method.
+func (m *MockRoomBlockRepoInterface) IsHotelClosed(hotelID uuid.UUID, from, to time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHotelClosed", hotelID, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsHotelClosed indicates an expected call of IsHotelClosed.
+func (mr *MockRoomBlockRepoInterfaceMockRecorder) IsHotelClosed(hotelID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHotelClosed", reflect.TypeOf((*MockRoomBlockRepoInterface)(nil).IsHotelClosed), hotelID, from, to)
+}
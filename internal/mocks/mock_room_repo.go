@@ -6,9 +6,11 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
 	models "github.com/tktanisha/booking_system/internal/models"
 )
 
@@ -65,6 +67,50 @@ func (mr *MockRoomRepoInterfaceMockRecorder) GetAllRoomByHotelID(hotelID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRoomByHotelID", reflect.TypeOf((*MockRoomRepoInterface)(nil).GetAllRoomByHotelID), hotelID)
 }
 
+// GetBookedQuantity mocks base method.
+func (m *MockRoomRepoInterface) GetBookedQuantity(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookedQuantity", hotelID, roomType, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookedQuantity indicates an expected call of GetBookedQuantity.
+func (mr *MockRoomRepoInterfaceMockRecorder) GetBookedQuantity(hotelID, roomType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookedQuantity", reflect.TypeOf((*MockRoomRepoInterface)(nil).GetBookedQuantity), hotelID, roomType, from, to)
+}
+
+// GetTotalQuantity mocks base method.
+func (m *MockRoomRepoInterface) GetTotalQuantity(hotelID uuid.UUID, roomType room.RoomType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalQuantity", hotelID, roomType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalQuantity indicates an expected call of GetTotalQuantity.
+func (mr *MockRoomRepoInterfaceMockRecorder) GetTotalQuantity(hotelID, roomType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalQuantity", reflect.TypeOf((*MockRoomRepoInterface)(nil).GetTotalQuantity), hotelID, roomType)
+}
+
+// SyncAvailabilityFromUnits mocks base method.
+func (m *MockRoomRepoInterface) SyncAvailabilityFromUnits(roomID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncAvailabilityFromUnits", roomID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncAvailabilityFromUnits indicates an expected call of SyncAvailabilityFromUnits.
+func (mr *MockRoomRepoInterfaceMockRecorder) SyncAvailabilityFromUnits(roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAvailabilityFromUnits", reflect.TypeOf((*MockRoomRepoInterface)(nil).SyncAvailabilityFromUnits), roomID)
+}
+
 // UpdateRoom mocks base method.
 func (m *MockRoomRepoInterface) UpdateRoom(arg0 *models.Rooms) (*models.Rooms, error) {
 	m.ctrl.T.Helper()
@@ -6,9 +6,11 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
 	models "github.com/tktanisha/booking_system/internal/models"
 	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
@@ -36,6 +38,35 @@ func (m *MockRoomServiceInterface) EXPECT() *MockRoomServiceInterfaceMockRecorde
 	return m.recorder
 }
 
+// CheckRestrictions mocks base method.
+func (m *MockRoomServiceInterface) CheckRestrictions(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckRestrictions", room, hotelId, checkIn, checkOut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckRestrictions indicates an expected call of CheckRestrictions.
+func (mr *MockRoomServiceInterfaceMockRecorder) CheckRestrictions(room, hotelId, checkIn, checkOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRestrictions", reflect.TypeOf((*MockRoomServiceInterface)(nil).CheckRestrictions), room, hotelId, checkIn, checkOut)
+}
+
+// CreateBlock mocks base method.
+func (m *MockRoomServiceInterface) CreateBlock(arg0 *models.UserContext, arg1 *payloads.CreateRoomBlockPayload) (*models.RoomBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlock", arg0, arg1)
+	ret0, _ := ret[0].(*models.RoomBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlock indicates an expected call of CreateBlock.
+func (mr *MockRoomServiceInterfaceMockRecorder) CreateBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockRoomServiceInterface)(nil).CreateBlock), arg0, arg1)
+}
+
 // CreateRoom mocks base method.
 func (m *MockRoomServiceInterface) CreateRoom(arg0 *payloads.CreateRoomPayload) (*models.Rooms, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +82,20 @@ func (mr *MockRoomServiceInterfaceMockRecorder) CreateRoom(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoom", reflect.TypeOf((*MockRoomServiceInterface)(nil).CreateRoom), arg0)
 }
 
+// DeleteBlock mocks base method.
+func (m *MockRoomServiceInterface) DeleteBlock(userCtx *models.UserContext, blockId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlock", userCtx, blockId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlock indicates an expected call of DeleteBlock.
+func (mr *MockRoomServiceInterfaceMockRecorder) DeleteBlock(userCtx, blockId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockRoomServiceInterface)(nil).DeleteBlock), userCtx, blockId)
+}
+
 // GetAllRoomByHotelID mocks base method.
 func (m *MockRoomServiceInterface) GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Rooms, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +111,51 @@ func (mr *MockRoomServiceInterfaceMockRecorder) GetAllRoomByHotelID(hotelID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRoomByHotelID", reflect.TypeOf((*MockRoomServiceInterface)(nil).GetAllRoomByHotelID), hotelID)
 }
 
+// GetBlocksByHotelID mocks base method.
+func (m *MockRoomServiceInterface) GetBlocksByHotelID(userCtx *models.UserContext, hotelID uuid.UUID) ([]*models.RoomBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocksByHotelID", userCtx, hotelID)
+	ret0, _ := ret[0].([]*models.RoomBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocksByHotelID indicates an expected call of GetBlocksByHotelID.
+func (mr *MockRoomServiceInterfaceMockRecorder) GetBlocksByHotelID(userCtx, hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByHotelID", reflect.TypeOf((*MockRoomServiceInterface)(nil).GetBlocksByHotelID), userCtx, hotelID)
+}
+
+// GetOversoldQuantity mocks base method.
+func (m *MockRoomServiceInterface) GetOversoldQuantity(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOversoldQuantity", room, hotelId, checkIn, checkOut)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOversoldQuantity indicates an expected call of GetOversoldQuantity.
+func (mr *MockRoomServiceInterfaceMockRecorder) GetOversoldQuantity(room, hotelId, checkIn, checkOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOversoldQuantity", reflect.TypeOf((*MockRoomServiceInterface)(nil).GetOversoldQuantity), room, hotelId, checkIn, checkOut)
+}
+
+// GetPhysicalQuantity mocks base method.
+func (m *MockRoomServiceInterface) GetPhysicalQuantity(hotelId uuid.UUID, roomType room.RoomType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhysicalQuantity", hotelId, roomType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPhysicalQuantity indicates an expected call of GetPhysicalQuantity.
+func (mr *MockRoomServiceInterfaceMockRecorder) GetPhysicalQuantity(hotelId, roomType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalQuantity", reflect.TypeOf((*MockRoomServiceInterface)(nil).GetPhysicalQuantity), hotelId, roomType)
+}
+
 // IncreaseRoomQuantity mocks base method.
 func (m *MockRoomServiceInterface) IncreaseRoomQuantity(arg0 *payloads.RoomPayload, arg1 uuid.UUID) (*models.Rooms, error) {
 	m.ctrl.T.Helper()
@@ -82,17 +172,31 @@ func (mr *MockRoomServiceInterfaceMockRecorder) IncreaseRoomQuantity(arg0, arg1
 }
 
 // IsAvailable mocks base method.
-func (m *MockRoomServiceInterface) IsAvailable(arg0 *payloads.RoomPayload, arg1 uuid.UUID) bool {
+func (m *MockRoomServiceInterface) IsAvailable(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsAvailable", arg0, arg1)
+	ret := m.ctrl.Call(m, "IsAvailable", room, hotelId, checkIn, checkOut)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // IsAvailable indicates an expected call of IsAvailable.
-func (mr *MockRoomServiceInterfaceMockRecorder) IsAvailable(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRoomServiceInterfaceMockRecorder) IsAvailable(room, hotelId, checkIn, checkOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAvailable", reflect.TypeOf((*MockRoomServiceInterface)(nil).IsAvailable), room, hotelId, checkIn, checkOut)
+}
+
+// IsPhysicallyAvailable mocks base method.
+func (m *MockRoomServiceInterface) IsPhysicallyAvailable(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPhysicallyAvailable", room, hotelId, checkIn, checkOut)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsPhysicallyAvailable indicates an expected call of IsPhysicallyAvailable.
+func (mr *MockRoomServiceInterfaceMockRecorder) IsPhysicallyAvailable(room, hotelId, checkIn, checkOut interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAvailable", reflect.TypeOf((*MockRoomServiceInterface)(nil).IsAvailable), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPhysicallyAvailable", reflect.TypeOf((*MockRoomServiceInterface)(nil).IsPhysicallyAvailable), room, hotelId, checkIn, checkOut)
 }
 
 // ReduceRoomQuantity mocks base method.
@@ -108,3 +212,31 @@ func (mr *MockRoomServiceInterfaceMockRecorder) ReduceRoomQuantity(arg0, arg1 in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReduceRoomQuantity", reflect.TypeOf((*MockRoomServiceInterface)(nil).ReduceRoomQuantity), arg0, arg1)
 }
+
+// SetDisplayPrices mocks base method.
+func (m *MockRoomServiceInterface) SetDisplayPrices(rooms []*models.Rooms, currencyCode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDisplayPrices", rooms, currencyCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDisplayPrices indicates an expected call of SetDisplayPrices.
+func (mr *MockRoomServiceInterfaceMockRecorder) SetDisplayPrices(rooms, currencyCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDisplayPrices", reflect.TypeOf((*MockRoomServiceInterface)(nil).SetDisplayPrices), rooms, currencyCode)
+}
+
+// SetRoomMedia mocks base method.
+func (m *MockRoomServiceInterface) SetRoomMedia(rooms []*models.Rooms, hotelID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoomMedia", rooms, hotelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoomMedia indicates an expected call of SetRoomMedia.
+func (mr *MockRoomServiceInterfaceMockRecorder) SetRoomMedia(rooms, hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoomMedia", reflect.TypeOf((*MockRoomServiceInterface)(nil).SetRoomMedia), rooms, hotelID)
+}
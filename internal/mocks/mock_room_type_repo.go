@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: room_type_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockRoomTypeRepoInterface is a mock of RoomTypeRepoInterface interface.
+type MockRoomTypeRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomTypeRepoInterfaceMockRecorder
+}
+
+// MockRoomTypeRepoInterfaceMockRecorder is the mock recorder for MockRoomTypeRepoInterface.
+type MockRoomTypeRepoInterfaceMockRecorder struct {
+	mock *MockRoomTypeRepoInterface
+}
+
+// NewMockRoomTypeRepoInterface creates a new mock instance.
+func NewMockRoomTypeRepoInterface(ctrl *gomock.Controller) *MockRoomTypeRepoInterface {
+	mock := &MockRoomTypeRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockRoomTypeRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomTypeRepoInterface) EXPECT() *MockRoomTypeRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateRoomType mocks base method.
+func (m *MockRoomTypeRepoInterface) CreateRoomType(arg0 *models.RoomTypes) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomType", arg0)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoomType indicates an expected call of CreateRoomType.
+func (mr *MockRoomTypeRepoInterfaceMockRecorder) CreateRoomType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomType", reflect.TypeOf((*MockRoomTypeRepoInterface)(nil).CreateRoomType), arg0)
+}
+
+// DeleteRoomType mocks base method.
+func (m *MockRoomTypeRepoInterface) DeleteRoomType(typeId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomType", typeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoomType indicates an expected call of DeleteRoomType.
+func (mr *MockRoomTypeRepoInterfaceMockRecorder) DeleteRoomType(typeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomType", reflect.TypeOf((*MockRoomTypeRepoInterface)(nil).DeleteRoomType), typeId)
+}
+
+// GetRoomTypeByCode mocks base method.
+func (m *MockRoomTypeRepoInterface) GetRoomTypeByCode(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomTypeByCode", hotelID, code)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomTypeByCode indicates an expected call of GetRoomTypeByCode.
+func (mr *MockRoomTypeRepoInterfaceMockRecorder) GetRoomTypeByCode(hotelID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomTypeByCode", reflect.TypeOf((*MockRoomTypeRepoInterface)(nil).GetRoomTypeByCode), hotelID, code)
+}
+
+// GetRoomTypeById mocks base method.
+func (m *MockRoomTypeRepoInterface) GetRoomTypeById(typeId uuid.UUID) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomTypeById", typeId)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomTypeById indicates an expected call of GetRoomTypeById.
+func (mr *MockRoomTypeRepoInterfaceMockRecorder) GetRoomTypeById(typeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomTypeById", reflect.TypeOf((*MockRoomTypeRepoInterface)(nil).GetRoomTypeById), typeId)
+}
+
+// GetRoomTypesByHotelID mocks base method.
+func (m *MockRoomTypeRepoInterface) GetRoomTypesByHotelID(hotelID uuid.UUID) ([]*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomTypesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomTypesByHotelID indicates an expected call of GetRoomTypesByHotelID.
+func (mr *MockRoomTypeRepoInterfaceMockRecorder) GetRoomTypesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomTypesByHotelID", reflect.TypeOf((*MockRoomTypeRepoInterface)(nil).GetRoomTypesByHotelID), hotelID)
+}
+
+// UpdateRoomType mocks base method.
+func (m *MockRoomTypeRepoInterface) UpdateRoomType(arg0 *models.RoomTypes) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomType", arg0)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoomType indicates an expected call of UpdateRoomType.
+func (mr *MockRoomTypeRepoInterfaceMockRecorder) UpdateRoomType(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomType", reflect.TypeOf((*MockRoomTypeRepoInterface)(nil).UpdateRoomType), arg0)
+}
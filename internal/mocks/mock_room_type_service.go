@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: room_type_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockRoomTypeServiceInterface is a mock of RoomTypeServiceInterface interface.
+type MockRoomTypeServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomTypeServiceInterfaceMockRecorder
+}
+
+// MockRoomTypeServiceInterfaceMockRecorder is the mock recorder for MockRoomTypeServiceInterface.
+type MockRoomTypeServiceInterfaceMockRecorder struct {
+	mock *MockRoomTypeServiceInterface
+}
+
+// NewMockRoomTypeServiceInterface creates a new mock instance.
+func NewMockRoomTypeServiceInterface(ctrl *gomock.Controller) *MockRoomTypeServiceInterface {
+	mock := &MockRoomTypeServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockRoomTypeServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomTypeServiceInterface) EXPECT() *MockRoomTypeServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateRoomType mocks base method.
+func (m *MockRoomTypeServiceInterface) CreateRoomType(arg0 *models.UserContext, arg1 *payloads.CreateRoomTypePayload) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoomType", arg0, arg1)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoomType indicates an expected call of CreateRoomType.
+func (mr *MockRoomTypeServiceInterfaceMockRecorder) CreateRoomType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoomType", reflect.TypeOf((*MockRoomTypeServiceInterface)(nil).CreateRoomType), arg0, arg1)
+}
+
+// DeleteRoomType mocks base method.
+func (m *MockRoomTypeServiceInterface) DeleteRoomType(userCtx *models.UserContext, typeId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoomType", userCtx, typeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoomType indicates an expected call of DeleteRoomType.
+func (mr *MockRoomTypeServiceInterfaceMockRecorder) DeleteRoomType(userCtx, typeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoomType", reflect.TypeOf((*MockRoomTypeServiceInterface)(nil).DeleteRoomType), userCtx, typeId)
+}
+
+// GetRoomType mocks base method.
+func (m *MockRoomTypeServiceInterface) GetRoomType(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomType", hotelID, code)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomType indicates an expected call of GetRoomType.
+func (mr *MockRoomTypeServiceInterfaceMockRecorder) GetRoomType(hotelID, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomType", reflect.TypeOf((*MockRoomTypeServiceInterface)(nil).GetRoomType), hotelID, code)
+}
+
+// GetRoomTypesByHotelID mocks base method.
+func (m *MockRoomTypeServiceInterface) GetRoomTypesByHotelID(hotelID uuid.UUID) ([]*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoomTypesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoomTypesByHotelID indicates an expected call of GetRoomTypesByHotelID.
+func (mr *MockRoomTypeServiceInterfaceMockRecorder) GetRoomTypesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoomTypesByHotelID", reflect.TypeOf((*MockRoomTypeServiceInterface)(nil).GetRoomTypesByHotelID), hotelID)
+}
+
+// UpdateRoomType mocks base method.
+func (m *MockRoomTypeServiceInterface) UpdateRoomType(userCtx *models.UserContext, typeId uuid.UUID, payload *payloads.UpdateRoomTypePayload) (*models.RoomTypes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoomType", userCtx, typeId, payload)
+	ret0, _ := ret[0].(*models.RoomTypes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoomType indicates an expected call of UpdateRoomType.
+func (mr *MockRoomTypeServiceInterfaceMockRecorder) UpdateRoomType(userCtx, typeId, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoomType", reflect.TypeOf((*MockRoomTypeServiceInterface)(nil).UpdateRoomType), userCtx, typeId, payload)
+}
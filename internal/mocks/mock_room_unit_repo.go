@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: room_unit_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockRoomUnitRepoInterface is a mock of RoomUnitRepoInterface interface.
+type MockRoomUnitRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomUnitRepoInterfaceMockRecorder
+}
+
+// MockRoomUnitRepoInterfaceMockRecorder is the mock recorder for MockRoomUnitRepoInterface.
+type MockRoomUnitRepoInterfaceMockRecorder struct {
+	mock *MockRoomUnitRepoInterface
+}
+
+// NewMockRoomUnitRepoInterface creates a new mock instance.
+func NewMockRoomUnitRepoInterface(ctrl *gomock.Controller) *MockRoomUnitRepoInterface {
+	mock := &MockRoomUnitRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockRoomUnitRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomUnitRepoInterface) EXPECT() *MockRoomUnitRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateUnit mocks base method.
+func (m *MockRoomUnitRepoInterface) CreateUnit(arg0 *models.RoomUnits) (*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnit", arg0)
+	ret0, _ := ret[0].(*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnit indicates an expected call of CreateUnit.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) CreateUnit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnit", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).CreateUnit), arg0)
+}
+
+// GetFreeUnits mocks base method.
+func (m *MockRoomUnitRepoInterface) GetFreeUnits(hotelID uuid.UUID, roomType room.RoomType) ([]*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFreeUnits", hotelID, roomType)
+	ret0, _ := ret[0].([]*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFreeUnits indicates an expected call of GetFreeUnits.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) GetFreeUnits(hotelID, roomType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFreeUnits", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).GetFreeUnits), hotelID, roomType)
+}
+
+// GetUnitById mocks base method.
+func (m *MockRoomUnitRepoInterface) GetUnitById(unitId uuid.UUID) (*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitById", unitId)
+	ret0, _ := ret[0].(*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitById indicates an expected call of GetUnitById.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) GetUnitById(unitId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitById", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).GetUnitById), unitId)
+}
+
+// GetUnitsByBookingId mocks base method.
+func (m *MockRoomUnitRepoInterface) GetUnitsByBookingId(bookingId uuid.UUID) ([]*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitsByBookingId", bookingId)
+	ret0, _ := ret[0].([]*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitsByBookingId indicates an expected call of GetUnitsByBookingId.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) GetUnitsByBookingId(bookingId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitsByBookingId", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).GetUnitsByBookingId), bookingId)
+}
+
+// GetUnitsByHotelID mocks base method.
+func (m *MockRoomUnitRepoInterface) GetUnitsByHotelID(hotelID uuid.UUID) ([]*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitsByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitsByHotelID indicates an expected call of GetUnitsByHotelID.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) GetUnitsByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitsByHotelID", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).GetUnitsByHotelID), hotelID)
+}
+
+// GetUnreadyUnitCount mocks base method.
+func (m *MockRoomUnitRepoInterface) GetUnreadyUnitCount(hotelID uuid.UUID, roomType room.RoomType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadyUnitCount", hotelID, roomType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadyUnitCount indicates an expected call of GetUnreadyUnitCount.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) GetUnreadyUnitCount(hotelID, roomType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadyUnitCount", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).GetUnreadyUnitCount), hotelID, roomType)
+}
+
+// UpdateCleanliness mocks base method.
+func (m *MockRoomUnitRepoInterface) UpdateCleanliness(unitId uuid.UUID, cleanliness room.Cleanliness) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCleanliness", unitId, cleanliness)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCleanliness indicates an expected call of UpdateCleanliness.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) UpdateCleanliness(unitId, cleanliness interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCleanliness", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).UpdateCleanliness), unitId, cleanliness)
+}
+
+// UpdateUnitStatus mocks base method.
+func (m *MockRoomUnitRepoInterface) UpdateUnitStatus(unitId uuid.UUID, status room.UnitStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnitStatus", unitId, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUnitStatus indicates an expected call of UpdateUnitStatus.
+func (mr *MockRoomUnitRepoInterfaceMockRecorder) UpdateUnitStatus(unitId, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnitStatus", reflect.TypeOf((*MockRoomUnitRepoInterface)(nil).UpdateUnitStatus), unitId, status)
+}
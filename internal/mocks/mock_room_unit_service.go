@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: room_unit_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockRoomUnitServiceInterface is a mock of RoomUnitServiceInterface interface.
+type MockRoomUnitServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoomUnitServiceInterfaceMockRecorder
+}
+
+// MockRoomUnitServiceInterfaceMockRecorder is the mock recorder for MockRoomUnitServiceInterface.
+type MockRoomUnitServiceInterfaceMockRecorder struct {
+	mock *MockRoomUnitServiceInterface
+}
+
+// NewMockRoomUnitServiceInterface creates a new mock instance.
+func NewMockRoomUnitServiceInterface(ctrl *gomock.Controller) *MockRoomUnitServiceInterface {
+	mock := &MockRoomUnitServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockRoomUnitServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoomUnitServiceInterface) EXPECT() *MockRoomUnitServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateUnit mocks base method.
+func (m *MockRoomUnitServiceInterface) CreateUnit(arg0 *models.UserContext, arg1 *payloads.CreateRoomUnitPayload) (*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnit", arg0, arg1)
+	ret0, _ := ret[0].(*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnit indicates an expected call of CreateUnit.
+func (mr *MockRoomUnitServiceInterfaceMockRecorder) CreateUnit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnit", reflect.TypeOf((*MockRoomUnitServiceInterface)(nil).CreateUnit), arg0, arg1)
+}
+
+// GetUnitsByHotelID mocks base method.
+func (m *MockRoomUnitServiceInterface) GetUnitsByHotelID(hotelID uuid.UUID) ([]*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitsByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitsByHotelID indicates an expected call of GetUnitsByHotelID.
+func (mr *MockRoomUnitServiceInterfaceMockRecorder) GetUnitsByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitsByHotelID", reflect.TypeOf((*MockRoomUnitServiceInterface)(nil).GetUnitsByHotelID), hotelID)
+}
+
+// PickUnits mocks base method.
+func (m *MockRoomUnitServiceInterface) PickUnits(hotelID uuid.UUID, bookedRooms []*models.BookedRooms, requestedUnitIds []uuid.UUID) ([]*models.BookedRoomUnits, []*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickUnits", hotelID, bookedRooms, requestedUnitIds)
+	ret0, _ := ret[0].([]*models.BookedRoomUnits)
+	ret1, _ := ret[1].([]*models.RoomUnits)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PickUnits indicates an expected call of PickUnits.
+func (mr *MockRoomUnitServiceInterfaceMockRecorder) PickUnits(hotelID, bookedRooms, requestedUnitIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickUnits", reflect.TypeOf((*MockRoomUnitServiceInterface)(nil).PickUnits), hotelID, bookedRooms, requestedUnitIds)
+}
+
+// UpdateUnitStatus mocks base method.
+func (m *MockRoomUnitServiceInterface) UpdateUnitStatus(userCtx *models.UserContext, unitId uuid.UUID, status room.UnitStatus) (*models.RoomUnits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnitStatus", userCtx, unitId, status)
+	ret0, _ := ret[0].(*models.RoomUnits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUnitStatus indicates an expected call of UpdateUnitStatus.
+func (mr *MockRoomUnitServiceInterfaceMockRecorder) UpdateUnitStatus(userCtx, unitId, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnitStatus", reflect.TypeOf((*MockRoomUnitServiceInterface)(nil).UpdateUnitStatus), userCtx, unitId, status)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stay_restriction_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockStayRestrictionRepoInterface is a mock of StayRestrictionRepoInterface interface.
+type MockStayRestrictionRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStayRestrictionRepoInterfaceMockRecorder
+}
+
+// MockStayRestrictionRepoInterfaceMockRecorder is the mock recorder for MockStayRestrictionRepoInterface.
+type MockStayRestrictionRepoInterfaceMockRecorder struct {
+	mock *MockStayRestrictionRepoInterface
+}
+
+// NewMockStayRestrictionRepoInterface creates a new mock instance.
+func NewMockStayRestrictionRepoInterface(ctrl *gomock.Controller) *MockStayRestrictionRepoInterface {
+	mock := &MockStayRestrictionRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockStayRestrictionRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStayRestrictionRepoInterface) EXPECT() *MockStayRestrictionRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateRestriction mocks base method.
+func (m *MockStayRestrictionRepoInterface) CreateRestriction(arg0 *models.StayRestrictions) (*models.StayRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestriction", arg0)
+	ret0, _ := ret[0].(*models.StayRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRestriction indicates an expected call of CreateRestriction.
+func (mr *MockStayRestrictionRepoInterfaceMockRecorder) CreateRestriction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestriction", reflect.TypeOf((*MockStayRestrictionRepoInterface)(nil).CreateRestriction), arg0)
+}
+
+// DeleteRestriction mocks base method.
+func (m *MockStayRestrictionRepoInterface) DeleteRestriction(restrictionId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRestriction", restrictionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRestriction indicates an expected call of DeleteRestriction.
+func (mr *MockStayRestrictionRepoInterfaceMockRecorder) DeleteRestriction(restrictionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRestriction", reflect.TypeOf((*MockStayRestrictionRepoInterface)(nil).DeleteRestriction), restrictionId)
+}
+
+// GetRestrictionById mocks base method.
+func (m *MockStayRestrictionRepoInterface) GetRestrictionById(restrictionId uuid.UUID) (*models.StayRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRestrictionById", restrictionId)
+	ret0, _ := ret[0].(*models.StayRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRestrictionById indicates an expected call of GetRestrictionById.
+func (mr *MockStayRestrictionRepoInterfaceMockRecorder) GetRestrictionById(restrictionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestrictionById", reflect.TypeOf((*MockStayRestrictionRepoInterface)(nil).GetRestrictionById), restrictionId)
+}
+
+// GetRestrictionsByHotelID mocks base method.
+func (m *MockStayRestrictionRepoInterface) GetRestrictionsByHotelID(hotelID uuid.UUID) ([]*models.StayRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRestrictionsByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.StayRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRestrictionsByHotelID indicates an expected call of GetRestrictionsByHotelID.
+func (mr *MockStayRestrictionRepoInterfaceMockRecorder) GetRestrictionsByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestrictionsByHotelID", reflect.TypeOf((*MockStayRestrictionRepoInterface)(nil).GetRestrictionsByHotelID), hotelID)
+}
+
+// GetRestrictionsForStay mocks base method.
+func (m *MockStayRestrictionRepoInterface) GetRestrictionsForStay(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) ([]*models.StayRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRestrictionsForStay", hotelID, roomType, from, to)
+	ret0, _ := ret[0].([]*models.StayRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRestrictionsForStay indicates an expected call of GetRestrictionsForStay.
+func (mr *MockStayRestrictionRepoInterfaceMockRecorder) GetRestrictionsForStay(hotelID, roomType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestrictionsForStay", reflect.TypeOf((*MockStayRestrictionRepoInterface)(nil).GetRestrictionsForStay), hotelID, roomType, from, to)
+}
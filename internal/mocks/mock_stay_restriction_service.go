@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stay_restriction_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockStayRestrictionServiceInterface is a mock of StayRestrictionServiceInterface interface.
+type MockStayRestrictionServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockStayRestrictionServiceInterfaceMockRecorder
+}
+
+// MockStayRestrictionServiceInterfaceMockRecorder is the mock recorder for MockStayRestrictionServiceInterface.
+type MockStayRestrictionServiceInterfaceMockRecorder struct {
+	mock *MockStayRestrictionServiceInterface
+}
+
+// NewMockStayRestrictionServiceInterface creates a new mock instance.
+func NewMockStayRestrictionServiceInterface(ctrl *gomock.Controller) *MockStayRestrictionServiceInterface {
+	mock := &MockStayRestrictionServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockStayRestrictionServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStayRestrictionServiceInterface) EXPECT() *MockStayRestrictionServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckStay mocks base method.
+func (m *MockStayRestrictionServiceInterface) CheckStay(hotelID uuid.UUID, roomType room.RoomType, checkIn, checkOut time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStay", hotelID, roomType, checkIn, checkOut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckStay indicates an expected call of CheckStay.
+func (mr *MockStayRestrictionServiceInterfaceMockRecorder) CheckStay(hotelID, roomType, checkIn, checkOut interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStay", reflect.TypeOf((*MockStayRestrictionServiceInterface)(nil).CheckStay), hotelID, roomType, checkIn, checkOut)
+}
+
+// CreateRestriction mocks base method.
+func (m *MockStayRestrictionServiceInterface) CreateRestriction(arg0 *models.UserContext, arg1 *payloads.StayRestrictionPayload) (*models.StayRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRestriction", arg0, arg1)
+	ret0, _ := ret[0].(*models.StayRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRestriction indicates an expected call of CreateRestriction.
+func (mr *MockStayRestrictionServiceInterfaceMockRecorder) CreateRestriction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRestriction", reflect.TypeOf((*MockStayRestrictionServiceInterface)(nil).CreateRestriction), arg0, arg1)
+}
+
+// DeleteRestriction mocks base method.
+func (m *MockStayRestrictionServiceInterface) DeleteRestriction(userCtx *models.UserContext, restrictionId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRestriction", userCtx, restrictionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRestriction indicates an expected call of DeleteRestriction.
+func (mr *MockStayRestrictionServiceInterfaceMockRecorder) DeleteRestriction(userCtx, restrictionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRestriction", reflect.TypeOf((*MockStayRestrictionServiceInterface)(nil).DeleteRestriction), userCtx, restrictionId)
+}
+
+// GetRestrictionsByHotelID mocks base method.
+func (m *MockStayRestrictionServiceInterface) GetRestrictionsByHotelID(hotelID uuid.UUID) ([]*models.StayRestrictions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRestrictionsByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.StayRestrictions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRestrictionsByHotelID indicates an expected call of GetRestrictionsByHotelID.
+func (mr *MockStayRestrictionServiceInterfaceMockRecorder) GetRestrictionsByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRestrictionsByHotelID", reflect.TypeOf((*MockStayRestrictionServiceInterface)(nil).GetRestrictionsByHotelID), hotelID)
+}
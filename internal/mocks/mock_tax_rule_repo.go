@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tax_rule_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockTaxRuleRepoInterface is a mock of TaxRuleRepoInterface interface.
+type MockTaxRuleRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRuleRepoInterfaceMockRecorder
+}
+
+// MockTaxRuleRepoInterfaceMockRecorder is the mock recorder for MockTaxRuleRepoInterface.
+type MockTaxRuleRepoInterfaceMockRecorder struct {
+	mock *MockTaxRuleRepoInterface
+}
+
+// NewMockTaxRuleRepoInterface creates a new mock instance.
+func NewMockTaxRuleRepoInterface(ctrl *gomock.Controller) *MockTaxRuleRepoInterface {
+	mock := &MockTaxRuleRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockTaxRuleRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxRuleRepoInterface) EXPECT() *MockTaxRuleRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateTaxRule mocks base method.
+func (m *MockTaxRuleRepoInterface) CreateTaxRule(arg0 *models.TaxRules) (*models.TaxRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaxRule", arg0)
+	ret0, _ := ret[0].(*models.TaxRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaxRule indicates an expected call of CreateTaxRule.
+func (mr *MockTaxRuleRepoInterfaceMockRecorder) CreateTaxRule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaxRule", reflect.TypeOf((*MockTaxRuleRepoInterface)(nil).CreateTaxRule), arg0)
+}
+
+// DeleteTaxRule mocks base method.
+func (m *MockTaxRuleRepoInterface) DeleteTaxRule(taxRuleId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaxRule", taxRuleId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaxRule indicates an expected call of DeleteTaxRule.
+func (mr *MockTaxRuleRepoInterfaceMockRecorder) DeleteTaxRule(taxRuleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaxRule", reflect.TypeOf((*MockTaxRuleRepoInterface)(nil).DeleteTaxRule), taxRuleId)
+}
+
+// GetTaxRuleById mocks base method.
+func (m *MockTaxRuleRepoInterface) GetTaxRuleById(taxRuleId uuid.UUID) (*models.TaxRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaxRuleById", taxRuleId)
+	ret0, _ := ret[0].(*models.TaxRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaxRuleById indicates an expected call of GetTaxRuleById.
+func (mr *MockTaxRuleRepoInterfaceMockRecorder) GetTaxRuleById(taxRuleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaxRuleById", reflect.TypeOf((*MockTaxRuleRepoInterface)(nil).GetTaxRuleById), taxRuleId)
+}
+
+// GetTaxRulesByHotelID mocks base method.
+func (m *MockTaxRuleRepoInterface) GetTaxRulesByHotelID(hotelID uuid.UUID) ([]*models.TaxRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaxRulesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.TaxRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaxRulesByHotelID indicates an expected call of GetTaxRulesByHotelID.
+func (mr *MockTaxRuleRepoInterfaceMockRecorder) GetTaxRulesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaxRulesByHotelID", reflect.TypeOf((*MockTaxRuleRepoInterface)(nil).GetTaxRulesByHotelID), hotelID)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tax_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockTaxServiceInterface is a mock of TaxServiceInterface interface.
+type MockTaxServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxServiceInterfaceMockRecorder
+}
+
+// MockTaxServiceInterfaceMockRecorder is the mock recorder for MockTaxServiceInterface.
+type MockTaxServiceInterfaceMockRecorder struct {
+	mock *MockTaxServiceInterface
+}
+
+// NewMockTaxServiceInterface creates a new mock instance.
+func NewMockTaxServiceInterface(ctrl *gomock.Controller) *MockTaxServiceInterface {
+	mock := &MockTaxServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTaxServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxServiceInterface) EXPECT() *MockTaxServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CalculateTaxes mocks base method.
+func (m *MockTaxServiceInterface) CalculateTaxes(hotelId, bookingId uuid.UUID, bookedRooms []*models.BookedRooms, nights int, roomCharge int64) ([]*models.BookingTaxes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CalculateTaxes", hotelId, bookingId, bookedRooms, nights, roomCharge)
+	ret0, _ := ret[0].([]*models.BookingTaxes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CalculateTaxes indicates an expected call of CalculateTaxes.
+func (mr *MockTaxServiceInterfaceMockRecorder) CalculateTaxes(hotelId, bookingId, bookedRooms, nights, roomCharge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalculateTaxes", reflect.TypeOf((*MockTaxServiceInterface)(nil).CalculateTaxes), hotelId, bookingId, bookedRooms, nights, roomCharge)
+}
+
+// CreateTaxRule mocks base method.
+func (m *MockTaxServiceInterface) CreateTaxRule(arg0 *models.UserContext, arg1 *payloads.CreateTaxRulePayload) (*models.TaxRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTaxRule", arg0, arg1)
+	ret0, _ := ret[0].(*models.TaxRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTaxRule indicates an expected call of CreateTaxRule.
+func (mr *MockTaxServiceInterfaceMockRecorder) CreateTaxRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTaxRule", reflect.TypeOf((*MockTaxServiceInterface)(nil).CreateTaxRule), arg0, arg1)
+}
+
+// DeleteTaxRule mocks base method.
+func (m *MockTaxServiceInterface) DeleteTaxRule(userCtx *models.UserContext, taxRuleId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaxRule", userCtx, taxRuleId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTaxRule indicates an expected call of DeleteTaxRule.
+func (mr *MockTaxServiceInterfaceMockRecorder) DeleteTaxRule(userCtx, taxRuleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaxRule", reflect.TypeOf((*MockTaxServiceInterface)(nil).DeleteTaxRule), userCtx, taxRuleId)
+}
+
+// GetTaxRulesByHotelID mocks base method.
+func (m *MockTaxServiceInterface) GetTaxRulesByHotelID(hotelID uuid.UUID) ([]*models.TaxRules, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaxRulesByHotelID", hotelID)
+	ret0, _ := ret[0].([]*models.TaxRules)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaxRulesByHotelID indicates an expected call of GetTaxRulesByHotelID.
+func (mr *MockTaxServiceInterfaceMockRecorder) GetTaxRulesByHotelID(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaxRulesByHotelID", reflect.TypeOf((*MockTaxServiceInterface)(nil).GetTaxRulesByHotelID), hotelID)
+}
@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: waitlist_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+	models "github.com/tktanisha/booking_system/internal/models"
+)
+
+// MockWaitlistRepoInterface is a mock of WaitlistRepoInterface interface.
+type MockWaitlistRepoInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistRepoInterfaceMockRecorder
+}
+
+// MockWaitlistRepoInterfaceMockRecorder is the mock recorder for MockWaitlistRepoInterface.
+type MockWaitlistRepoInterfaceMockRecorder struct {
+	mock *MockWaitlistRepoInterface
+}
+
+// NewMockWaitlistRepoInterface creates a new mock instance.
+func NewMockWaitlistRepoInterface(ctrl *gomock.Controller) *MockWaitlistRepoInterface {
+	mock := &MockWaitlistRepoInterface{ctrl: ctrl}
+	mock.recorder = &MockWaitlistRepoInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistRepoInterface) EXPECT() *MockWaitlistRepoInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockWaitlistRepoInterface) CreateEntry(arg0 *models.WaitlistEntries) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) CreateEntry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).CreateEntry), arg0)
+}
+
+// ExpireEntries mocks base method.
+func (m *MockWaitlistRepoInterface) ExpireEntries(now time.Time) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireEntries", now)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireEntries indicates an expected call of ExpireEntries.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) ExpireEntries(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireEntries", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).ExpireEntries), now)
+}
+
+// GetEntriesByHotelId mocks base method.
+func (m *MockWaitlistRepoInterface) GetEntriesByHotelId(hotelId uuid.UUID) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntriesByHotelId", hotelId)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntriesByHotelId indicates an expected call of GetEntriesByHotelId.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) GetEntriesByHotelId(hotelId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntriesByHotelId", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).GetEntriesByHotelId), hotelId)
+}
+
+// GetEntriesByUserId mocks base method.
+func (m *MockWaitlistRepoInterface) GetEntriesByUserId(userId uuid.UUID) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntriesByUserId", userId)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntriesByUserId indicates an expected call of GetEntriesByUserId.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) GetEntriesByUserId(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntriesByUserId", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).GetEntriesByUserId), userId)
+}
+
+// GetEntryById mocks base method.
+func (m *MockWaitlistRepoInterface) GetEntryById(entryId uuid.UUID) (*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntryById", entryId)
+	ret0, _ := ret[0].(*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntryById indicates an expected call of GetEntryById.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) GetEntryById(entryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntryById", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).GetEntryById), entryId)
+}
+
+// GetHeldQuantity mocks base method.
+func (m *MockWaitlistRepoInterface) GetHeldQuantity(hotelId uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldQuantity", hotelId, roomType, from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldQuantity indicates an expected call of GetHeldQuantity.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) GetHeldQuantity(hotelId, roomType, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldQuantity", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).GetHeldQuantity), hotelId, roomType, from, to)
+}
+
+// GetWaitingEntries mocks base method.
+func (m *MockWaitlistRepoInterface) GetWaitingEntries(hotelId uuid.UUID, roomType room.RoomType, now time.Time) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitingEntries", hotelId, roomType, now)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitingEntries indicates an expected call of GetWaitingEntries.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) GetWaitingEntries(hotelId, roomType, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitingEntries", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).GetWaitingEntries), hotelId, roomType, now)
+}
+
+// GetWaitingQueues mocks base method.
+func (m *MockWaitlistRepoInterface) GetWaitingQueues(now time.Time) ([]*models.WaitlistQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitingQueues", now)
+	ret0, _ := ret[0].([]*models.WaitlistQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitingQueues indicates an expected call of GetWaitingQueues.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) GetWaitingQueues(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitingQueues", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).GetWaitingQueues), now)
+}
+
+// OfferEntry mocks base method.
+func (m *MockWaitlistRepoInterface) OfferEntry(entryId uuid.UUID, offeredAt, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferEntry", entryId, offeredAt, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferEntry indicates an expected call of OfferEntry.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) OfferEntry(entryId, offeredAt, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferEntry", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).OfferEntry), entryId, offeredAt, expiresAt)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockWaitlistRepoInterface) UpdateStatus(entryId uuid.UUID, from, to waitlist_status.WaitlistStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", entryId, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockWaitlistRepoInterfaceMockRecorder) UpdateStatus(entryId, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockWaitlistRepoInterface)(nil).UpdateStatus), entryId, from, to)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: waitlist_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	room "github.com/tktanisha/booking_system/internal/enums/room"
+	models "github.com/tktanisha/booking_system/internal/models"
+	payloads "github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// MockWaitlistServiceInterface is a mock of WaitlistServiceInterface interface.
+type MockWaitlistServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaitlistServiceInterfaceMockRecorder
+}
+
+// MockWaitlistServiceInterfaceMockRecorder is the mock recorder for MockWaitlistServiceInterface.
+type MockWaitlistServiceInterfaceMockRecorder struct {
+	mock *MockWaitlistServiceInterface
+}
+
+// NewMockWaitlistServiceInterface creates a new mock instance.
+func NewMockWaitlistServiceInterface(ctrl *gomock.Controller) *MockWaitlistServiceInterface {
+	mock := &MockWaitlistServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockWaitlistServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaitlistServiceInterface) EXPECT() *MockWaitlistServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ClaimOffer mocks base method.
+func (m *MockWaitlistServiceInterface) ClaimOffer(userCtx *models.UserContext, entryId uuid.UUID, payload *payloads.BookingPayload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOffer", userCtx, entryId, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimOffer indicates an expected call of ClaimOffer.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) ClaimOffer(userCtx, entryId, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOffer", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).ClaimOffer), userCtx, entryId, payload)
+}
+
+// GetHotelWaitlist mocks base method.
+func (m *MockWaitlistServiceInterface) GetHotelWaitlist(hotelID uuid.UUID) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHotelWaitlist", hotelID)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHotelWaitlist indicates an expected call of GetHotelWaitlist.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) GetHotelWaitlist(hotelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHotelWaitlist", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).GetHotelWaitlist), hotelID)
+}
+
+// GetMyWaitlist mocks base method.
+func (m *MockWaitlistServiceInterface) GetMyWaitlist(userCtx *models.UserContext) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMyWaitlist", userCtx)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMyWaitlist indicates an expected call of GetMyWaitlist.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) GetMyWaitlist(userCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMyWaitlist", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).GetMyWaitlist), userCtx)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockWaitlistServiceInterface) JoinWaitlist(userCtx *models.UserContext, payload *payloads.WaitlistPayload) (*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", userCtx, payload)
+	ret0, _ := ret[0].(*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) JoinWaitlist(userCtx, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).JoinWaitlist), userCtx, payload)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockWaitlistServiceInterface) LeaveWaitlist(userCtx *models.UserContext, entryId uuid.UUID) (*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", userCtx, entryId)
+	ret0, _ := ret[0].(*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) LeaveWaitlist(userCtx, entryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).LeaveWaitlist), userCtx, entryId)
+}
+
+// OfferFreedRooms mocks base method.
+func (m *MockWaitlistServiceInterface) OfferFreedRooms(hotelID uuid.UUID, roomType room.RoomType) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferFreedRooms", hotelID, roomType)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferFreedRooms indicates an expected call of OfferFreedRooms.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) OfferFreedRooms(hotelID, roomType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferFreedRooms", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).OfferFreedRooms), hotelID, roomType)
+}
+
+// ProcessWaitlist mocks base method.
+func (m *MockWaitlistServiceInterface) ProcessWaitlist(now time.Time) ([]*models.WaitlistEntries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessWaitlist", now)
+	ret0, _ := ret[0].([]*models.WaitlistEntries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessWaitlist indicates an expected call of ProcessWaitlist.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) ProcessWaitlist(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessWaitlist", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).ProcessWaitlist), now)
+}
+
+// RestoreOffer mocks base method.
+func (m *MockWaitlistServiceInterface) RestoreOffer(entryId uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreOffer", entryId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreOffer indicates an expected call of RestoreOffer.
+func (mr *MockWaitlistServiceInterfaceMockRecorder) RestoreOffer(entryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreOffer", reflect.TypeOf((*MockWaitlistServiceInterface)(nil).RestoreOffer), entryId)
+}
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BookedRoomUnits struct {
+	Id           uuid.UUID `json:"id"`
+	BookedRoomId uuid.UUID `json:"booked_room_id"`
+	RoomUnitId   uuid.UUID `json:"room_unit_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -8,9 +8,13 @@ import (
 )
 
 type BookedRooms struct {
-	Id           uuid.UUID     `json:"id"`
-	BookingId    uuid.UUID     `json:"booking_id"`
-	RoomType     room.RoomType `json:"room_type"`
-	RoomQuantity int           `json:"room_quantity"`
-	CreatedAt    time.Time     `json:"created_at"`
+	Id               uuid.UUID     `json:"id"`
+	BookingId        uuid.UUID     `json:"booking_id"`
+	RoomType         room.RoomType `json:"room_type"`
+	RoomQuantity     int           `json:"room_quantity"`
+	PricePerNight    int64         `json:"price_per_night"`    // snapshot of the nightly rate per room at booking time, extra guests included
+	Adults           int           `json:"adults"`             // guests per room
+	Children         int           `json:"children"`           // guests per room
+	ExtraGuestCharge int64         `json:"extra_guest_charge"` // part of PricePerNight charged for guests above the base occupancy
+	CreatedAt        time.Time     `json:"created_at"`
 }
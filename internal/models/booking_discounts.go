@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BookingDiscounts are the price reductions applied to a booking, in minor units.
+type BookingDiscounts struct {
+	Id          uuid.UUID  `json:"id"`
+	BookingId   uuid.UUID  `json:"booking_id"`
+	PromoCodeId *uuid.UUID `json:"promo_code_id,omitempty"`
+	Description string     `json:"description"`
+	Amount      int64      `json:"amount"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
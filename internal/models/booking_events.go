@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+)
+
+// BookingEvents records a single status change of a booking.
+// ActorId is nil when the change was made by the system, e.g. the no-show job.
+type BookingEvents struct {
+	Id         uuid.UUID                    `json:"id"`
+	BookingId  uuid.UUID                    `json:"booking_id"`
+	FromStatus booking_status.BookingStatus `json:"from_status"`
+	ToStatus   booking_status.BookingStatus `json:"to_status"`
+	ActorId    *uuid.UUID                   `json:"actor_id"`
+	Reason     string                       `json:"reason"`
+	CreatedAt  time.Time                    `json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BookingGuests struct {
+	Id        uuid.UUID `json:"id"`
+	BookingId uuid.UUID `json:"booking_id"`
+	FullName  string    `json:"full_name"`
+	Email     string    `json:"email,omitempty"`
+	Phone     string    `json:"phone,omitempty"`
+	IsLead    bool      `json:"is_lead"`
+	CreatedAt time.Time `json:"created_at"`
+}
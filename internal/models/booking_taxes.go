@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+)
+
+// BookingTaxes are the tax and fee lines charged on a booking, in minor units.
+type BookingTaxes struct {
+	Id         uuid.UUID      `json:"id"`
+	BookingId  uuid.UUID      `json:"booking_id"`
+	TaxRuleId  *uuid.UUID     `json:"tax_rule_id,omitempty"`
+	Name       string         `json:"name"`
+	ChargeType tax.ChargeType `json:"charge_type"`
+	Inclusive  bool           `json:"inclusive"`
+	Amount     int64          `json:"amount"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
@@ -8,11 +8,22 @@ import (
 )
 
 type Bookings struct {
-	Id        uuid.UUID                    `json:"id"`
-	UserId    uuid.UUID                    `json:"user_id"`
-	HotelId   uuid.UUID                    `json:"hotel_id"`
-	CheckIn   time.Time                    `json:"checkin"`
-	CheckOut  time.Time                    `json:"checkout"`
-	Status    booking_status.BookingStatus `json:"status"`
-	CreatedAt time.Time                    `json:"created_at"`
+	Id               uuid.UUID                    `json:"id"`
+	UserId           uuid.UUID                    `json:"user_id"`
+	HotelId          uuid.UUID                    `json:"hotel_id"`
+	CheckIn          time.Time                    `json:"checkin"`
+	CheckOut         time.Time                    `json:"checkout"`
+	Status           booking_status.BookingStatus `json:"status"`
+	TotalAmount      int64                        `json:"total_amount"`
+	PenaltyAmount    int64                        `json:"penalty_amount"`
+	RefundAmount     int64                        `json:"refund_amount"`
+	Currency         string                       `json:"currency"`                    // hotel currency at booking time; every amount is settled in it
+	EstimatedArrival string                       `json:"estimated_arrival,omitempty"` // "15:04" local hotel time
+	Notes            string                       `json:"notes,omitempty"`
+	GroupCode        string                       `json:"group_code,omitempty"` // group block the rooms were picked up from
+	CreatedAt        time.Time                    `json:"created_at"`
+	Guests           []*BookingGuests             `json:"guests,omitempty"`
+	Discounts        []*BookingDiscounts          `json:"discounts,omitempty"`
+	Taxes            []*BookingTaxes              `json:"taxes,omitempty"`
+	PriceBreakdown   *PriceBreakdown              `json:"price_breakdown,omitempty"`
 }
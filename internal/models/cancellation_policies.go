@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// CancellationPolicies describes what a guest is charged when cancelling.
+// An empty RoomCategory makes the policy the hotel-wide default.
+type CancellationPolicies struct {
+	Id              uuid.UUID     `json:"id"`
+	HotelId         uuid.UUID     `json:"hotel_id"`
+	RoomCategory    room.RoomType `json:"room_category,omitempty"`
+	FreeCancelHours int           `json:"free_cancel_hours"`
+	PenaltyNights   int           `json:"penalty_nights"`
+	NonRefundable   bool          `json:"non_refundable"`
+	CreatedAt       time.Time     `json:"created_at"`
+}
@@ -0,0 +1,9 @@
+package models
+
+// DisplayPrice is an amount converted into a currency the client asked for.
+// It is informational only; bookings are always settled in the hotel currency.
+type DisplayPrice struct {
+	Currency string  `json:"currency"`
+	Amount   int64   `json:"amount"` // minor units of Currency
+	Rate     float64 `json:"rate"`   // units of Currency per unit of the hotel currency
+}
@@ -0,0 +1,9 @@
+package models
+
+// ExchangeRates is the exchange-rate table: how many units of each currency
+// one unit of Base buys.
+type ExchangeRates struct {
+	Base  string             `json:"base"`
+	AsOf  string             `json:"as_of"`
+	Rates map[string]float64 `json:"rates"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "github.com/google/uuid"
+
+// Folio is the guest account of a booking. RoomCharges is the booking total;
+// the balance is what the guest still owes once payments are taken off.
+// Every amount is in the booking's currency.
+type Folio struct {
+	BookingId   uuid.UUID     `json:"booking_id"`
+	Currency    string        `json:"currency"`
+	Lines       []*FolioLines `json:"lines"`
+	RoomCharges int64         `json:"room_charges"`
+	Charges     int64         `json:"charges"`
+	Adjustments int64         `json:"adjustments"`
+	Payments    int64         `json:"payments"`
+	Balance     int64         `json:"balance"`
+}
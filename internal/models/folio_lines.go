@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+)
+
+// FolioLines are the charges, payments and adjustments posted to a booking, in
+// minor units. Voided lines stay on the folio but no longer count towards the balance.
+type FolioLines struct {
+	Id          uuid.UUID      `json:"id"`
+	BookingId   uuid.UUID      `json:"booking_id"`
+	LineType    folio.LineType `json:"line_type"`
+	Category    folio.Category `json:"category,omitempty"`
+	Description string         `json:"description"`
+	Amount      int64          `json:"amount"`
+	PostedBy    uuid.UUID      `json:"posted_by"`
+	PostedAt    time.Time      `json:"posted_at"`
+	VoidedBy    *uuid.UUID     `json:"voided_by,omitempty"`
+	VoidedAt    *time.Time     `json:"voided_at,omitempty"`
+	VoidReason  string         `json:"void_reason,omitempty"`
+}
+
+func (l *FolioLines) IsVoided() bool {
+	return l.VoidedAt != nil
+}
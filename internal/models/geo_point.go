@@ -0,0 +1,7 @@
+package models
+
+// GeoPoint is a position in decimal degrees.
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
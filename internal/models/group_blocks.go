@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	group_block_status "github.com/tktanisha/booking_system/internal/enums/group_block"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// GroupBlocks holds Quantity rooms of RoomType for a group such as a wedding
+// or conference. Attendees book against the block with its Code until CutoffAt,
+// when the rooms nobody picked up return to general inventory.
+type GroupBlocks struct {
+	Id        uuid.UUID                           `json:"id"`
+	HotelId   uuid.UUID                           `json:"hotel_id"`
+	Code      string                              `json:"code"`
+	Name      string                              `json:"name"`
+	RoomType  room.RoomType                       `json:"room_type"`
+	Quantity  int                                 `json:"quantity"`
+	PickedUp  int                                 `json:"picked_up"`
+	CheckIn   time.Time                           `json:"checkin"`
+	CheckOut  time.Time                           `json:"checkout"`
+	CutoffAt  time.Time                           `json:"cutoff_at"` // start of the cutoff date in hotel time
+	Status    group_block_status.GroupBlockStatus `json:"status"`
+	CreatedBy uuid.UUID                           `json:"created_by"`
+	CreatedAt time.Time                           `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+// HotelPolicies are the house rules shown to guests before they book.
+type HotelPolicies struct {
+	PetsAllowed    bool `json:"pets_allowed"`
+	SmokingAllowed bool `json:"smoking_allowed"`
+	ChildAgeLimit  int  `json:"child_age_limit"`  // guests younger than this count as children
+	MinCheckInAge  int  `json:"min_check_in_age"` // youngest age allowed to check in as lead guest
+}
+
+type HotelContact struct {
+	Phone   string `json:"phone,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Website string `json:"website,omitempty"`
+}
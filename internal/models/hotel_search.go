@@ -0,0 +1,13 @@
+package models
+
+import "github.com/tktanisha/booking_system/internal/enums/hotel"
+
+// HotelSearch holds the criteria of a hotel search. Hotels must offer every
+// one of Amenities to match. When Near is set only hotels within RadiusKm of it
+// match.
+type HotelSearch struct {
+	Amenities []hotel.Amenity
+	Near      *GeoPoint
+	RadiusKm  float64
+	Sort      hotel.SortOrder
+}
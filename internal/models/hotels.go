@@ -4,12 +4,70 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
 )
 
 type Hotels struct {
-	Id        uuid.UUID `json:"id"`
-	ManagerId uuid.UUID `json:"manager_id"`
-	Name      string    `json:"name"`
-	Address   string    `json:"address"`
-	CreatedAt time.Time `json:"created_at"`
+	Id                 uuid.UUID       `json:"id"`
+	ManagerId          uuid.UUID       `json:"manager_id"`
+	Name               string          `json:"name"`
+	Address            string          `json:"address"`
+	NoShowCutoffHours  int             `json:"no_show_cutoff_hours"`  // hours after check-in before an unarrived booking is a no-show
+	NoShowChargeNights int             `json:"no_show_charge_nights"` // nights charged for a no-show
+	HoldUntilInspected bool            `json:"hold_until_inspected"`  // keep vacated units off same-day sale until inspected
+	Currency           string          `json:"currency"`              // ISO 4217 code all of the hotel's prices are in
+	TimeZone           string          `json:"time_zone"`             // IANA name, such as "Asia/Tokyo"
+	CheckInTime        string          `json:"check_in_time"`         // "15:04" local time rooms are ready
+	CheckOutTime       string          `json:"check_out_time"`        // "15:04" local time rooms must be vacated
+	StarRating         int             `json:"star_rating"`           // official 1 to 5 star category, 0 when unclassified
+	Description        string          `json:"description,omitempty"`
+	Amenities          []hotel.Amenity `json:"amenities"`
+	Policies           HotelPolicies   `json:"policies"`
+	Contact            HotelContact    `json:"contact"`
+	Coordinates        *GeoPoint       `json:"coordinates,omitempty"` // nil until the hotel has been placed on the map
+	CreatedAt          time.Time       `json:"created_at"`
+	Rating             *RatingSummary  `json:"rating,omitempty"`
+	DistanceKm         *float64        `json:"distance_km,omitempty"` // from the searched point, set by proximity searches only
+	Media              []*Media        `json:"media,omitempty"`       // photos of the hotel itself, in display order
+}
+
+// Location returns the hotel's time zone, falling back to UTC when it is
+// missing or unknown.
+func (h *Hotels) Location() *time.Location {
+	loc, err := time.LoadLocation(h.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// CheckInAt returns the instant the stay starting on date begins in hotel time.
+// Only the calendar date of date is used.
+func (h *Hotels) CheckInAt(date time.Time) time.Time {
+	return h.atLocalTime(date, h.CheckInTime)
+}
+
+// CheckOutAt returns the instant the stay ending on date ends in hotel time.
+func (h *Hotels) CheckOutAt(date time.Time) time.Time {
+	return h.atLocalTime(date, h.CheckOutTime)
+}
+
+// StartOfDay returns midnight at the start of date in hotel time. Only the
+// calendar date of date is used.
+func (h *Hotels) StartOfDay(date time.Time) time.Time {
+	return h.atLocalTime(date, "00:00")
+}
+
+// Today returns the hotel's current calendar date.
+func (h *Hotels) Today(now time.Time) time.Time {
+	local := now.In(h.Location())
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func (h *Hotels) atLocalTime(date time.Time, clock string) time.Time {
+	var hour, minute int
+	if t, err := time.Parse("15:04", clock); err == nil {
+		hour, minute = t.Hour(), t.Minute()
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), hour, minute, 0, 0, h.Location())
 }
@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	housekeeping_status "github.com/tktanisha/booking_system/internal/enums/housekeeping"
+)
+
+type HousekeepingTasks struct {
+	Id          uuid.UUID                      `json:"id"`
+	HotelId     uuid.UUID                      `json:"hotel_id"`
+	RoomUnitId  uuid.UUID                      `json:"room_unit_id"`
+	RoomNumber  string                         `json:"room_number"`
+	BookingId   *uuid.UUID                     `json:"booking_id,omitempty"` // checkout that generated the task
+	Status      housekeeping_status.TaskStatus `json:"status"`
+	AssignedTo  *uuid.UUID                     `json:"assigned_to,omitempty"`
+	CreatedAt   time.Time                      `json:"created_at"`
+	ClaimedAt   *time.Time                     `json:"claimed_at,omitempty"`
+	CompletedAt *time.Time                     `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invoices are issued once per booking and stored as issued, so later changes
+// to the booking or the hotel never alter an invoice. Amounts are in minor units
+// of Currency, the currency the booking is settled in.
+type Invoices struct {
+	Id            uuid.UUID       `json:"id"`
+	HotelId       uuid.UUID       `json:"hotel_id"`
+	BookingId     uuid.UUID       `json:"booking_id"`
+	Number        int64           `json:"number"` // sequential per hotel
+	InvoiceNumber string          `json:"invoice_number"`
+	IssuedAt      time.Time       `json:"issued_at"`
+	HotelName     string          `json:"hotel_name"`
+	HotelAddress  string          `json:"hotel_address"`
+	GuestName     string          `json:"guest_name,omitempty"`
+	CheckIn       time.Time       `json:"checkin"`
+	CheckOut      time.Time       `json:"checkout"`
+	Nights        int             `json:"nights"`
+	Currency      string          `json:"currency"`
+	Lines         []*InvoiceLines `json:"lines"`
+	TaxLines      []*InvoiceLines `json:"tax_lines,omitempty"`
+	Subtotal      int64           `json:"subtotal"`
+	Taxes         int64           `json:"taxes"`
+	IncludedTaxes int64           `json:"included_taxes"`
+	Total         int64           `json:"total"`
+	Payments      int64           `json:"payments"`
+	BalanceDue    int64           `json:"balance_due"`
+}
+
+type InvoiceLines struct {
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	UnitAmount  int64  `json:"unit_amount"`
+	Amount      int64  `json:"amount"`
+	Inclusive   bool   `json:"inclusive,omitempty"` // tax already part of the room rate
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Media is a photo of a hotel, or of one of its room types when RoomTypeId is
+// set. Photos are shown in Position order within their hotel or room type.
+type Media struct {
+	Id           uuid.UUID  `json:"id"`
+	HotelId      uuid.UUID  `json:"hotel_id"`
+	RoomTypeId   *uuid.UUID `json:"room_type_id,omitempty"`
+	ContentType  string     `json:"content_type"`
+	SizeBytes    int64      `json:"size_bytes"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	Caption      string     `json:"caption,omitempty"`
+	Position     int        `json:"position"`
+	StorageKey   string     `json:"-"` // blob holding the uploaded image
+	ThumbnailKey string     `json:"-"` // blob holding its JPEG thumbnail
+	UploadedBy   uuid.UUID  `json:"uploaded_by"`
+	CreatedAt    time.Time  `json:"created_at"`
+
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// SetURLs fills in where the image and its thumbnail are served from.
+func (m *Media) SetURLs() {
+	m.URL = "/media/" + m.Id.String()
+	m.ThumbnailURL = m.URL + "?size=thumbnail"
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// OverbookingLimits lets a hotel sell LimitPercent percent more rooms of a
+// type than it physically has, to make up for guests who do not show.
+type OverbookingLimits struct {
+	Id           uuid.UUID     `json:"id"`
+	HotelId      uuid.UUID     `json:"hotel_id"`
+	RoomType     room.RoomType `json:"room_type"`
+	LimitPercent int           `json:"limit_percent"`
+	UpdatedBy    uuid.UUID     `json:"updated_by"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+// OverbookingAudits records a booking that took OversoldQuantity of its rooms
+// of a type out of the overbooking allowance rather than physical inventory.
+type OverbookingAudits struct {
+	Id               uuid.UUID     `json:"id"`
+	BookingId        uuid.UUID     `json:"booking_id"`
+	HotelId          uuid.UUID     `json:"hotel_id"`
+	RoomType         room.RoomType `json:"room_type"`
+	RoomQuantity     int           `json:"room_quantity"`
+	OversoldQuantity int           `json:"oversold_quantity"`
+	PhysicalQuantity int           `json:"physical_quantity"` // rooms of the type the hotel had when the booking was made
+	LimitPercent     int           `json:"limit_percent"`     // overbooking limit in force when the booking was made
+	CreatedAt        time.Time     `json:"created_at"`
+}
+
+// BookedStays is one room type of a confirmed or checked-in booking.
+type BookedStays struct {
+	BookingId uuid.UUID     `json:"booking_id"`
+	UserId    uuid.UUID     `json:"user_id"`
+	RoomType  room.RoomType `json:"room_type"`
+	Quantity  int           `json:"quantity"`
+	CheckIn   time.Time     `json:"checkin"`
+	CheckOut  time.Time     `json:"checkout"`
+	CheckedIn bool          `json:"checked_in"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// WalkListNights is a night on which a room type has more rooms booked than
+// the hotel physically has. Bookings lists the stays that night, most recently
+// booked first, which is the order the front desk walks guests in.
+type WalkListNights struct {
+	Date             time.Time      `json:"date"` // the night's calendar date in hotel time, at midnight UTC
+	RoomType         room.RoomType  `json:"room_type"`
+	PhysicalQuantity int            `json:"physical_quantity"`
+	BookedQuantity   int            `json:"booked_quantity"`
+	OversoldQuantity int            `json:"oversold_quantity"`
+	Bookings         []*BookedStays `json:"bookings"`
+}
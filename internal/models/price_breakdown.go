@@ -0,0 +1,12 @@
+package models
+
+// PriceBreakdown sums up how a booking total is made: the room subtotal, less
+// discounts, plus exclusive taxes and fees. IncludedTaxes are already part of
+// the subtotal and only shown for information.
+type PriceBreakdown struct {
+	Subtotal      int64 `json:"subtotal"`
+	Discounts     int64 `json:"discounts"`
+	Taxes         int64 `json:"taxes"`
+	IncludedTaxes int64 `json:"included_taxes"`
+	Total         int64 `json:"total"`
+}
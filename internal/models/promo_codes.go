@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+)
+
+// PromoCodes can be redeemed from ValidFrom to ValidTo. A nil HotelId makes the
+// code valid at every hotel; zero MaxUses or PerUserLimit means no limit.
+type PromoCodes struct {
+	Id           uuid.UUID          `json:"id"`
+	Code         string             `json:"code"`
+	HotelId      *uuid.UUID         `json:"hotel_id,omitempty"`
+	DiscountType promo.DiscountType `json:"discount_type"`
+	Value        int64              `json:"value"`
+	ValidFrom    time.Time          `json:"valid_from"`
+	ValidTo      time.Time          `json:"valid_to"`
+	MaxUses      int                `json:"max_uses"`
+	PerUserLimit int                `json:"per_user_limit"`
+	UsedCount    int                `json:"used_count"`
+	CreatedBy    uuid.UUID          `json:"created_by"`
+	CreatedAt    time.Time          `json:"created_at"`
+}
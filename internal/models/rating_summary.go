@@ -0,0 +1,9 @@
+package models
+
+// RatingSummary aggregates the reviews of a hotel. Distribution counts the
+// reviews per star, keyed 1 to 5, and is only filled in for a single hotel.
+type RatingSummary struct {
+	Average      float64     `json:"average"`
+	Count        int         `json:"count"`
+	Distribution map[int]int `json:"distribution,omitempty"`
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// ReportNights adds up the booked room nights of one room type in one report
+// period, as read from the bookings. Period is the first day of the period.
+type ReportNights struct {
+	Period              time.Time
+	RoomType            room.RoomType
+	RoomNightsSold      int
+	RoomRevenue         int64
+	CancelledRoomNights int
+	PickupRoomNights    int
+}
+
+// ReportMetrics are the figures of a report period, for one room type or for
+// all of them when RoomType is empty. Amounts are in minor units of the
+// report's currency. ADR is room revenue per room night sold and RevPAR room
+// revenue per room night available.
+type ReportMetrics struct {
+	RoomType            room.RoomType `json:"room_type,omitempty"`
+	RoomNightsAvailable int           `json:"room_nights_available"`
+	RoomNightsSold      int           `json:"room_nights_sold"`
+	OccupancyPercent    float64       `json:"occupancy_percent"`
+	RoomRevenue         int64         `json:"room_revenue"`
+	ADR                 int64         `json:"adr"`
+	RevPAR              int64         `json:"revpar"`
+	CancelledRoomNights int           `json:"cancelled_room_nights"`
+	PickupRoomNights    int           `json:"pickup_room_nights"`
+}
+
+// ReportPeriods is a day, week or month of a report. Nights counts only the
+// nights of the period inside the report's range.
+type ReportPeriods struct {
+	Start     time.Time        `json:"start"`
+	Nights    int              `json:"nights"`
+	Totals    *ReportMetrics   `json:"totals"`
+	RoomTypes []*ReportMetrics `json:"room_types"`
+}
+
+// Reports holds a hotel's occupancy and revenue figures for the nights from
+// From up to, but not including, To.
+type Reports struct {
+	HotelId  uuid.UUID        `json:"hotel_id"`
+	From     time.Time        `json:"from"`
+	To       time.Time        `json:"to"`
+	GroupBy  report.Grouping  `json:"group_by"`
+	Currency string           `json:"currency"`
+	Totals   *ReportMetrics   `json:"totals"`
+	Periods  []*ReportPeriods `json:"periods"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Reviews are left by the guest of a checked-out booking, one per booking. A
+// manager may answer with a reply, which replaces any earlier one.
+type Reviews struct {
+	Id        uuid.UUID  `json:"id"`
+	HotelId   uuid.UUID  `json:"hotel_id"`
+	BookingId uuid.UUID  `json:"booking_id"`
+	UserId    uuid.UUID  `json:"user_id"`
+	Rating    int        `json:"rating"` // 1 to 5 stars
+	Title     string     `json:"title,omitempty"`
+	Body      string     `json:"body"`
+	Reply     string     `json:"reply,omitempty"`
+	RepliedBy *uuid.UUID `json:"replied_by,omitempty"`
+	RepliedAt *time.Time `json:"replied_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (r *Reviews) HasReply() bool {
+	return r.RepliedAt != nil
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// RoomBlocks takes inventory out of service over [StartDate, EndDate), either
+// a quantity of a room category, a single unit when RoomUnitId is set, or the
+// whole hotel when WholeHotel is set.
+type RoomBlocks struct {
+	Id           uuid.UUID     `json:"id"`
+	HotelId      uuid.UUID     `json:"hotel_id"`
+	WholeHotel   bool          `json:"whole_hotel"`
+	RoomCategory room.RoomType `json:"room_category"`
+	RoomUnitId   *uuid.UUID    `json:"room_unit_id,omitempty"`
+	Quantity     int           `json:"quantity"`
+	StartDate    time.Time     `json:"start_date"`
+	EndDate      time.Time     `json:"end_date"`
+	Reason       string        `json:"reason"`
+	Forced       bool          `json:"forced"` // created despite overlapping confirmed bookings
+	CreatedBy    uuid.UUID     `json:"created_by"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+type RoomTypes struct {
+	Id               uuid.UUID     `json:"id"`
+	HotelId          uuid.UUID     `json:"hotel_id"`
+	Code             room.RoomType `json:"code"`
+	Name             string        `json:"name"`
+	MaxOccupancy     int           `json:"max_occupancy"`
+	BaseOccupancy    int           `json:"base_occupancy"`  // guests covered by the room price
+	ExtraGuestFee    int64         `json:"extra_guest_fee"` // per guest above BaseOccupancy per night, in minor units
+	BedConfiguration string        `json:"bed_configuration"`
+	SizeSqm          int           `json:"size_sqm"`
+	Amenities        []string      `json:"amenities"`
+	CreatedAt        time.Time     `json:"created_at"`
+	Media            []*Media      `json:"media,omitempty"` // photos in display order
+}
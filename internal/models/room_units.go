@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// RoomUnits is a physical room, such as "412", belonging to a room category.
+type RoomUnits struct {
+	Id           uuid.UUID        `json:"id"`
+	RoomId       uuid.UUID        `json:"room_id"`
+	HotelId      uuid.UUID        `json:"hotel_id"`
+	RoomCategory room.RoomType    `json:"room_category"`
+	Number       string           `json:"number"`
+	Floor        int              `json:"floor"`
+	Status       room.UnitStatus  `json:"status"`
+	Cleanliness  room.Cleanliness `json:"cleanliness"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
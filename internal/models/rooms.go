@@ -12,5 +12,10 @@ type Rooms struct {
 	HotelId           uuid.UUID     `json:"hotel_id"`
 	AvailableQuantity int           `json:"available_quantity"`
 	RoomCategory      room.RoomType `json:"room_category"`
+	Price             int64         `json:"price"`    // nightly rate in minor units
+	Currency          string        `json:"currency"` // the hotel's currency, which Price is in
 	CreatedAt         time.Time     `json:"created_at"`
+
+	DisplayPrice *DisplayPrice `json:"display_price,omitempty"` // Price converted into a requested currency
+	Media        []*Media      `json:"media,omitempty"`         // photos of the room's type, in display order
 }
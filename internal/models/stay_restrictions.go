@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// StayRestrictions apply on every date from StartDate to EndDate inclusive,
+// optionally narrowed to DaysOfWeek (0 = Sunday).
+type StayRestrictions struct {
+	Id                uuid.UUID     `json:"id"`
+	HotelId           uuid.UUID     `json:"hotel_id"`
+	RoomCategory      room.RoomType `json:"room_category,omitempty"` // empty applies to every room type
+	StartDate         time.Time     `json:"start_date"`
+	EndDate           time.Time     `json:"end_date"`
+	DaysOfWeek        []int         `json:"days_of_week,omitempty"`
+	MinLengthOfStay   int           `json:"min_los,omitempty"`
+	MaxLengthOfStay   int           `json:"max_los,omitempty"`
+	ClosedToArrival   bool          `json:"closed_to_arrival"`
+	ClosedToDeparture bool          `json:"closed_to_departure"`
+	StopSell          bool          `json:"stop_sell"`
+	CreatedBy         uuid.UUID     `json:"created_by"`
+	CreatedAt         time.Time     `json:"created_at"`
+}
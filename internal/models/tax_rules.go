@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+)
+
+// TaxRules are the taxes and fees a hotel charges on every stay. Inclusive rules
+// are already part of the room price and are only itemized; exclusive rules are
+// added on top of it.
+type TaxRules struct {
+	Id         uuid.UUID      `json:"id"`
+	HotelId    uuid.UUID      `json:"hotel_id"`
+	Name       string         `json:"name"`
+	ChargeType tax.ChargeType `json:"charge_type"`
+	Value      int64          `json:"value"`
+	Inclusive  bool           `json:"inclusive"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+)
+
+// WaitlistEntries queue a guest for rooms of a type that were sold out for a
+// stay. Guests are offered freed rooms first come, first served; an offer holds
+// the rooms for the guest until OfferExpiresAt.
+type WaitlistEntries struct {
+	Id             uuid.UUID                      `json:"id"`
+	HotelId        uuid.UUID                      `json:"hotel_id"`
+	UserId         uuid.UUID                      `json:"user_id"`
+	RoomType       room.RoomType                  `json:"room_type"`
+	Quantity       int                            `json:"quantity"`
+	CheckIn        time.Time                      `json:"checkin"`
+	CheckOut       time.Time                      `json:"checkout"`
+	Status         waitlist_status.WaitlistStatus `json:"status"`
+	OfferedAt      *time.Time                     `json:"offered_at,omitempty"`
+	OfferExpiresAt *time.Time                     `json:"offer_expires_at,omitempty"`
+	CreatedAt      time.Time                      `json:"created_at"`
+}
+
+// WaitlistQueue identifies the guests waiting for one room type of a hotel.
+type WaitlistQueue struct {
+	HotelId  uuid.UUID
+	RoomType room.RoomType
+}
@@ -0,0 +1,11 @@
+package blob_repo
+
+//go:generate mockgen -source=blob_interface.go -destination=../../mocks/mock_blob_repo.go -package=mocks
+
+// BlobStoreInterface stores opaque files under slash-separated keys such as
+// "hotels/<id>/<file>". It is the hook for moving uploads to object storage.
+type BlobStoreInterface interface {
+	Put(key string, data []byte) error
+	Get(key string) ([]byte, error)
+	Delete(key string) error
+}
@@ -0,0 +1,49 @@
+package blob_repo_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/repository/blob_repo"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	store := blob_repo.NewLocalBlobStore(t.TempDir())
+	key := "hotels/6f1c/photo.jpg"
+
+	if err := store.Put(key, []byte("first")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := store.Put(key, []byte("second")); err != nil {
+		t.Fatalf("unexpected error overwriting: %v", err)
+	}
+
+	data, err := store.Get(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(data, []byte("second")) {
+		t.Errorf("expected the overwritten blob, got %q", data)
+	}
+
+	if err := store.Delete(key); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := store.Get(key); !errors.Is(err, blob_repo.ErrBlobNotFound) {
+		t.Errorf("expected ErrBlobNotFound after delete, got %v", err)
+	}
+	if err := store.Delete(key); err != nil {
+		t.Errorf("expected deleting a missing blob to succeed, got %v", err)
+	}
+}
+
+func TestLocalBlobStore_RejectsKeysOutsideRoot(t *testing.T) {
+	store := blob_repo.NewLocalBlobStore(t.TempDir())
+
+	for _, key := range []string{"", "../escape.jpg", "/etc/passwd", "hotels/../../escape.jpg"} {
+		if err := store.Put(key, []byte("x")); err == nil {
+			t.Errorf("expected key %q to be rejected", key)
+		}
+	}
+}
@@ -3,9 +3,12 @@ package booking_repo
 import (
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/tktanisha/booking_system/internal/db"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
 	"github.com/tktanisha/booking_system/internal/models"
 )
 
@@ -17,13 +20,22 @@ func NewBookingRepo(database db.DB) *BookingRepo {
 	return &BookingRepo{db: database}
 }
 
-func (r *BookingRepo) CreateBookingWithRooms(booking *models.Bookings, bookedRooms []*models.BookedRooms) (*models.Bookings, error) {
+// CreateBookingWithRooms saves a booking with its rooms, guests and price lines. The
+// event recording its creation is inserted by the same statement as the booking, so a
+// saved booking always has one.
+func (r *BookingRepo) CreateBookingWithRooms(booking *models.Bookings, bookedRooms []*models.BookedRooms, event *models.BookingEvents) (*models.Bookings, error) {
 
 	// Insert Booking
 	bookingQuery := `
-        INSERT INTO bookings (id, user_id, hotel_id, checkin, checkout, status, created_at)
-        VALUES ($1, $2, $3, $4, $5, $6, $7)
-        RETURNING id;
+        WITH created AS (
+            INSERT INTO bookings (id, user_id, hotel_id, checkin, checkout, status, total_amount, currency, estimated_arrival, notes, group_code, created_at)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+            RETURNING id
+        ), event AS (
+            INSERT INTO booking_events (id, booking_id, from_status, to_status, actor_id, reason, created_at)
+            SELECT $13, id, $14, $6, $15, $16, $17 FROM created
+        )
+        SELECT id FROM created;
     `
 	row := r.db.QueryRow(bookingQuery,
 		booking.Id,
@@ -32,7 +44,17 @@ func (r *BookingRepo) CreateBookingWithRooms(booking *models.Bookings, bookedRoo
 		booking.CheckIn,
 		booking.CheckOut,
 		booking.Status,
+		booking.TotalAmount,
+		booking.Currency,
+		booking.EstimatedArrival,
+		booking.Notes,
+		booking.GroupCode,
 		booking.CreatedAt,
+		event.Id,
+		event.FromStatus,
+		event.ActorId,
+		event.Reason,
+		event.CreatedAt,
 	)
 	if err := row.Scan(&booking.Id); err != nil {
 		return nil, err
@@ -40,8 +62,8 @@ func (r *BookingRepo) CreateBookingWithRooms(booking *models.Bookings, bookedRoo
 
 	//  Insert Booked Rooms
 	bookedRoomsQuery := `
-        INSERT INTO booked_rooms (id, booking_id, room_type, room_quantity, created_at)
-        VALUES ($1, $2, $3, $4, $5)
+        INSERT INTO booked_rooms (id, booking_id, room_type, room_quantity, price_per_night, adults, children, extra_guest_charge, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
     `
 	for _, room := range bookedRooms {
 		_, err := r.db.Exec(bookedRoomsQuery,
@@ -49,6 +71,10 @@ func (r *BookingRepo) CreateBookingWithRooms(booking *models.Bookings, bookedRoo
 			booking.Id,
 			room.RoomType,
 			room.RoomQuantity,
+			room.PricePerNight,
+			room.Adults,
+			room.Children,
+			room.ExtraGuestCharge,
 			room.CreatedAt,
 		)
 		if err != nil {
@@ -56,15 +82,72 @@ func (r *BookingRepo) CreateBookingWithRooms(booking *models.Bookings, bookedRoo
 		}
 	}
 
+	guestsQuery := `
+        INSERT INTO booking_guests (id, booking_id, full_name, email, phone, is_lead, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+    `
+	for _, guest := range booking.Guests {
+		_, err := r.db.Exec(guestsQuery,
+			guest.Id,
+			booking.Id,
+			guest.FullName,
+			guest.Email,
+			guest.Phone,
+			guest.IsLead,
+			guest.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	discountsQuery := `
+        INSERT INTO booking_discounts (id, booking_id, promo_code_id, description, amount, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+    `
+	for _, discount := range booking.Discounts {
+		_, err := r.db.Exec(discountsQuery,
+			discount.Id,
+			booking.Id,
+			discount.PromoCodeId,
+			discount.Description,
+			discount.Amount,
+			discount.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	taxesQuery := `
+        INSERT INTO booking_taxes (id, booking_id, tax_rule_id, name, charge_type, inclusive, amount, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+	for _, tax := range booking.Taxes {
+		_, err := r.db.Exec(taxesQuery,
+			tax.Id,
+			booking.Id,
+			tax.TaxRuleId,
+			tax.Name,
+			tax.ChargeType,
+			tax.Inclusive,
+			tax.Amount,
+			tax.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return booking, nil
 }
 
 func (r *BookingRepo) GetBookingById(bookingId uuid.UUID) (*models.Bookings, error) {
-	query := `SELECT id, user_id, hotel_id, checkin, checkout, status, created_at FROM bookings WHERE id = $1`
+	query := `SELECT id, user_id, hotel_id, checkin, checkout, status, total_amount, penalty_amount, refund_amount, currency, estimated_arrival, notes, group_code, created_at FROM bookings WHERE id = $1`
 	row := r.db.QueryRow(query, bookingId)
 
 	var booking models.Bookings
-	if err := row.Scan(&booking.Id, &booking.UserId, &booking.HotelId, &booking.CheckIn, &booking.CheckOut, &booking.Status, &booking.CreatedAt); err != nil {
+	if err := row.Scan(&booking.Id, &booking.UserId, &booking.HotelId, &booking.CheckIn, &booking.CheckOut, &booking.Status, &booking.TotalAmount, &booking.PenaltyAmount, &booking.RefundAmount, &booking.Currency, &booking.EstimatedArrival, &booking.Notes, &booking.GroupCode, &booking.CreatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.New("booking not found")
 		}
@@ -74,7 +157,7 @@ func (r *BookingRepo) GetBookingById(bookingId uuid.UUID) (*models.Bookings, err
 }
 
 func (r *BookingRepo) GetBookedRoomsByBookingId(bookingId uuid.UUID) ([]*models.BookedRooms, error) {
-	query := `SELECT id, booking_id, room_type, room_quantity, created_at FROM booked_rooms WHERE booking_id = $1`
+	query := `SELECT id, booking_id, room_type, room_quantity, price_per_night, adults, children, extra_guest_charge, created_at FROM booked_rooms WHERE booking_id = $1`
 	rows, err := r.db.Query(query, bookingId)
 	if err != nil {
 		return nil, err
@@ -83,7 +166,7 @@ func (r *BookingRepo) GetBookedRoomsByBookingId(bookingId uuid.UUID) ([]*models.
 	var bookedRooms []*models.BookedRooms
 	for rows.Next() {
 		var room models.BookedRooms
-		if err := rows.Scan(&room.Id, &room.BookingId, &room.RoomType, &room.RoomQuantity, &room.CreatedAt); err != nil {
+		if err := rows.Scan(&room.Id, &room.BookingId, &room.RoomType, &room.RoomQuantity, &room.PricePerNight, &room.Adults, &room.Children, &room.ExtraGuestCharge, &room.CreatedAt); err != nil {
 			return nil, err
 		}
 		bookedRooms = append(bookedRooms, &room)
@@ -91,20 +174,208 @@ func (r *BookingRepo) GetBookedRoomsByBookingId(bookingId uuid.UUID) ([]*models.
 	return bookedRooms, nil
 }
 
+// GetBookingGuestsByBookingId lists the guests of a booking, lead guest first.
+func (r *BookingRepo) GetBookingGuestsByBookingId(bookingId uuid.UUID) ([]*models.BookingGuests, error) {
+	query := `
+		SELECT id, booking_id, full_name, email, phone, is_lead, created_at
+		FROM booking_guests
+		WHERE booking_id = $1
+		ORDER BY is_lead DESC, created_at
+	`
+	rows, err := r.db.Query(query, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var guests []*models.BookingGuests
+	for rows.Next() {
+		var guest models.BookingGuests
+		if err := rows.Scan(&guest.Id, &guest.BookingId, &guest.FullName, &guest.Email, &guest.Phone, &guest.IsLead, &guest.CreatedAt); err != nil {
+			return nil, err
+		}
+		guests = append(guests, &guest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return guests, nil
+}
+
+func (r *BookingRepo) GetBookingDiscountsByBookingId(bookingId uuid.UUID) ([]*models.BookingDiscounts, error) {
+	query := `
+		SELECT id, booking_id, promo_code_id, description, amount, created_at
+		FROM booking_discounts
+		WHERE booking_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var discounts []*models.BookingDiscounts
+	for rows.Next() {
+		var discount models.BookingDiscounts
+		if err := rows.Scan(&discount.Id, &discount.BookingId, &discount.PromoCodeId, &discount.Description, &discount.Amount, &discount.CreatedAt); err != nil {
+			return nil, err
+		}
+		discounts = append(discounts, &discount)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return discounts, nil
+}
+
+func (r *BookingRepo) GetBookingTaxesByBookingId(bookingId uuid.UUID) ([]*models.BookingTaxes, error) {
+	query := `
+		SELECT id, booking_id, tax_rule_id, name, charge_type, inclusive, amount, created_at
+		FROM booking_taxes
+		WHERE booking_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var taxes []*models.BookingTaxes
+	for rows.Next() {
+		var tax models.BookingTaxes
+		if err := rows.Scan(&tax.Id, &tax.BookingId, &tax.TaxRuleId, &tax.Name, &tax.ChargeType, &tax.Inclusive, &tax.Amount, &tax.CreatedAt); err != nil {
+			return nil, err
+		}
+		taxes = append(taxes, &tax)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return taxes, nil
+}
+
+// GetNoShowCandidates returns confirmed bookings whose hotel no-show cutoff has passed at now.
+func (r *BookingRepo) GetNoShowCandidates(now time.Time) ([]*models.Bookings, error) {
+	query := `
+		SELECT b.id, b.user_id, b.hotel_id, b.checkin, b.checkout, b.status, b.total_amount, b.penalty_amount, b.refund_amount, b.currency, b.estimated_arrival, b.notes, b.group_code, b.created_at
+		FROM bookings b
+		JOIN hotels h ON h.id = b.hotel_id
+		WHERE b.status = $1
+		AND b.checkin + h.no_show_cutoff_hours * INTERVAL '1 hour' < $2
+	`
+	rows, err := r.db.Query(query, booking_status.StatusConfirmed, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-func (r *BookingRepo) Save(booking *models.Bookings) error {
+	var bookings []*models.Bookings
+	for rows.Next() {
+		var booking models.Bookings
+		if err := rows.Scan(&booking.Id, &booking.UserId, &booking.HotelId, &booking.CheckIn, &booking.CheckOut, &booking.Status, &booking.TotalAmount, &booking.PenaltyAmount, &booking.RefundAmount, &booking.Currency, &booking.EstimatedArrival, &booking.Notes, &booking.GroupCode, &booking.CreatedAt); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, &booking)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// SaveTransition persists a status change together with its audit event. The update only
+// applies while the stored status still equals event.FromStatus, so concurrent transitions
+// cannot overwrite each other.
+func (r *BookingRepo) SaveTransition(booking *models.Bookings, event *models.BookingEvents) error {
 	query := `
-		UPDATE bookings
-		SET user_id=$2, hotel_id=$3, checkin=$4, checkout=$5, status=$6, created_at=$7
-		WHERE id=$1
+		WITH updated AS (
+			UPDATE bookings
+			SET status=$2, penalty_amount=$3, refund_amount=$4
+			WHERE id=$1 AND status=$5
+			RETURNING id
+		)
+		INSERT INTO booking_events (id, booking_id, from_status, to_status, actor_id, reason, created_at)
+		SELECT $6, id, $5, $2, $7, $8, $9 FROM updated
 	`
-	result, err := r.db.Exec(query, booking.Id, booking.UserId, booking.HotelId, booking.CheckIn, booking.CheckOut, booking.Status, booking.CreatedAt)
+	result, err := r.db.Exec(query, booking.Id, event.ToStatus, booking.PenaltyAmount, booking.RefundAmount, event.FromStatus,
+		event.Id, event.ActorId, event.Reason, event.CreatedAt)
 	if err != nil {
 		return err
 	}
 	rowsAffected, _ := result.RowsAffected()
 	if rowsAffected == 0 {
-		return errors.New("booking not found")
+		return errors.New("booking not found or its status has changed")
 	}
 	return nil
 }
+
+// SaveCheckIn is SaveTransition for a check-in that also assigns room units. The
+// assignments are inserted by the same statement, only when the status update
+// applies, so a check-in that loses a race for the booking assigns nothing.
+func (r *BookingRepo) SaveCheckIn(booking *models.Bookings, event *models.BookingEvents, assignments []*models.BookedRoomUnits) error {
+	query := `
+		WITH updated AS (
+			UPDATE bookings
+			SET status=$2
+			WHERE id=$1 AND status=$3
+			RETURNING id
+		), event AS (
+			INSERT INTO booking_events (id, booking_id, from_status, to_status, actor_id, reason, created_at)
+			SELECT $4, id, $3, $2, $5, $6, $7 FROM updated
+		), assigned AS (
+			INSERT INTO booked_room_units (id, booked_room_id, room_unit_id, created_at)
+			SELECT a.id, a.booked_room_id, a.room_unit_id, $7
+			FROM updated, unnest($8::uuid[], $9::uuid[], $10::uuid[]) AS a(id, booked_room_id, room_unit_id)
+		)
+		SELECT COUNT(*) FROM updated
+	`
+
+	ids := make([]string, len(assignments))
+	bookedRoomIds := make([]string, len(assignments))
+	unitIds := make([]string, len(assignments))
+	for i, assignment := range assignments {
+		ids[i] = assignment.Id.String()
+		bookedRoomIds[i] = assignment.BookedRoomId.String()
+		unitIds[i] = assignment.RoomUnitId.String()
+	}
+
+	var updated int
+	err := r.db.QueryRow(query, booking.Id, event.ToStatus, event.FromStatus, event.Id, event.ActorId, event.Reason, event.CreatedAt,
+		pq.Array(ids), pq.Array(bookedRoomIds), pq.Array(unitIds)).Scan(&updated)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return errors.New("booking not found or its status has changed")
+	}
+	return nil
+}
+
+func (r *BookingRepo) GetBookingEventsByBookingId(bookingId uuid.UUID) ([]*models.BookingEvents, error) {
+	query := `
+		SELECT id, booking_id, from_status, to_status, actor_id, reason, created_at
+		FROM booking_events
+		WHERE booking_id = $1
+		ORDER BY created_at
+	`
+	rows, err := r.db.Query(query, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*models.BookingEvents
+	for rows.Next() {
+		var event models.BookingEvents
+		if err := rows.Scan(&event.Id, &event.BookingId, &event.FromStatus, &event.ToStatus, &event.ActorId, &event.Reason, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
@@ -1,6 +1,8 @@
 package booking_repo
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/models"
 )
@@ -8,8 +10,14 @@ import (
 //go:generate mockgen -source=booking_repo_interface.go -destination=../../mocks/mock_booking_repo.go -package=mocks
 
 type BookingRepoInterface interface {
-	CreateBookingWithRooms(*models.Bookings, []*models.BookedRooms) (*models.Bookings, error)
+	CreateBookingWithRooms(*models.Bookings, []*models.BookedRooms, *models.BookingEvents) (*models.Bookings, error)
 	GetBookingById(uuid.UUID) (*models.Bookings, error)
 	GetBookedRoomsByBookingId(uuid.UUID) ([]*models.BookedRooms, error)
-	Save(*models.Bookings) error
+	GetBookingGuestsByBookingId(uuid.UUID) ([]*models.BookingGuests, error)
+	GetBookingDiscountsByBookingId(uuid.UUID) ([]*models.BookingDiscounts, error)
+	GetBookingTaxesByBookingId(uuid.UUID) ([]*models.BookingTaxes, error)
+	GetNoShowCandidates(now time.Time) ([]*models.Bookings, error)
+	SaveTransition(*models.Bookings, *models.BookingEvents) error
+	SaveCheckIn(*models.Bookings, *models.BookingEvents, []*models.BookedRoomUnits) error
+	GetBookingEventsByBookingId(uuid.UUID) ([]*models.BookingEvents, error)
 }
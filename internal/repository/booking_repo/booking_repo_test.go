@@ -9,6 +9,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 
+	"github.com/tktanisha/booking_system/internal/enums/tax"
 	"github.com/tktanisha/booking_system/internal/models"
 	"github.com/tktanisha/booking_system/internal/repository/booking_repo"
 )
@@ -25,21 +26,75 @@ func TestBookingRepo_CreateBookingWithRooms(t *testing.T) {
 		{
 			name: "success",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectQuery(`INSERT INTO bookings`).
-					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectQuery(`WITH created AS \(\s*INSERT INTO bookings`).
+					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "", sqlmock.AnyArg(), "booking created", sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(bookingID))
 
 				mock.ExpectExec(`INSERT INTO booked_rooms`).
-					WithArgs(sqlmock.AnyArg(), bookingID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sqlmock.AnyArg(), bookingID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(`INSERT INTO booking_guests`).
+					WithArgs(sqlmock.AnyArg(), bookingID, "Asha Rao", "asha@example.com", "", true, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(`INSERT INTO booking_discounts`).
+					WithArgs(sqlmock.AnyArg(), bookingID, sqlmock.AnyArg(), "Promo SUMMER10", int64(2000), sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+
+				mock.ExpectExec(`INSERT INTO booking_taxes`).
+					WithArgs(sqlmock.AnyArg(), bookingID, sqlmock.AnyArg(), "City tax", tax.PerPerson, false, int64(500), sqlmock.AnyArg()).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
 		},
+		{
+			name: "discount insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`WITH created AS \(\s*INSERT INTO bookings`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(bookingID))
+				mock.ExpectExec(`INSERT INTO booked_rooms`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO booking_guests`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO booking_discounts`).
+					WillReturnError(errors.New("discount insert failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "tax insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`WITH created AS \(\s*INSERT INTO bookings`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(bookingID))
+				mock.ExpectExec(`INSERT INTO booked_rooms`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO booking_guests`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO booking_discounts`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO booking_taxes`).
+					WillReturnError(errors.New("tax insert failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "guest insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`WITH created AS \(\s*INSERT INTO bookings`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(bookingID))
+				mock.ExpectExec(`INSERT INTO booked_rooms`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO booking_guests`).
+					WillReturnError(errors.New("guest insert failed"))
+			},
+			wantErr: true,
+		},
 		{
 			name: "booking insert fails",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectQuery(`INSERT INTO bookings`).
-					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectQuery(`WITH created AS \(\s*INSERT INTO bookings`).
+					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(errors.New("insert failed"))
 			},
 			wantErr: true,
@@ -47,12 +102,12 @@ func TestBookingRepo_CreateBookingWithRooms(t *testing.T) {
 		{
 			name: "booked room insert fails",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectQuery(`INSERT INTO bookings`).
-					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+				mock.ExpectQuery(`WITH created AS \(\s*INSERT INTO bookings`).
+					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(bookingID))
 
 				mock.ExpectExec(`INSERT INTO booked_rooms`).
-					WithArgs(sqlmock.AnyArg(), bookingID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(sqlmock.AnyArg(), bookingID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(errors.New("room insert failed"))
 			},
 			wantErr: true,
@@ -73,18 +128,144 @@ func TestBookingRepo_CreateBookingWithRooms(t *testing.T) {
 			booking := &models.Bookings{
 				Id:        bookingID,
 				CreatedAt: time.Now(),
+				Guests: []*models.BookingGuests{
+					{Id: uuid.New(), FullName: "Asha Rao", Email: "asha@example.com", IsLead: true, CreatedAt: time.Now()},
+				},
+				Discounts: []*models.BookingDiscounts{
+					{Id: uuid.New(), Description: "Promo SUMMER10", Amount: 2000, CreatedAt: time.Now()},
+				},
+				Taxes: []*models.BookingTaxes{
+					{Id: uuid.New(), Name: "City tax", ChargeType: tax.PerPerson, Amount: 500, CreatedAt: time.Now()},
+				},
 			}
 			bookedRooms := []*models.BookedRooms{
 				{Id: uuid.New(), CreatedAt: time.Now()},
 			}
 
+			event := &models.BookingEvents{
+				Id:        uuid.New(),
+				BookingId: bookingID,
+				ToStatus:  "confirmed",
+				Reason:    "booking created",
+				CreatedAt: time.Now(),
+			}
+
+			tt.setupMocks(mock, bookingID)
+			_, err = repo.CreateBookingWithRooms(booking, bookedRooms, event)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestBookingRepo_GetBookingGuestsByBookingId(t *testing.T) {
+	columns := []string{"id", "booking_id", "full_name", "email", "phone", "is_lead", "created_at"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, bookingID uuid.UUID)
+		wantGuests int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), bookingID, "Asha Rao", "asha@example.com", "+91 98765 43210", true, time.Now()).
+					AddRow(uuid.New(), bookingID, "Vikram Rao", "", "", false, time.Now())
+				mock.ExpectQuery(`FROM booking_guests`).WithArgs(bookingID).WillReturnRows(rows)
+			},
+			wantGuests: 2,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`FROM booking_guests`).WithArgs(bookingID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := booking_repo.NewBookingRepo(db)
+			bookingID := uuid.New()
+
 			tt.setupMocks(mock, bookingID)
-			_, err = repo.CreateBookingWithRooms(booking, bookedRooms)
+			guests, err := repo.GetBookingGuestsByBookingId(bookingID)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
 			}
+			if len(guests) != tt.wantGuests {
+				t.Errorf("expected %d guests, got %d", tt.wantGuests, len(guests))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestBookingRepo_GetBookingDiscountsByBookingId(t *testing.T) {
+	columns := []string{"id", "booking_id", "promo_code_id", "description", "amount", "created_at"}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(mock sqlmock.Sqlmock, bookingID uuid.UUID)
+		wantDiscounts int
+		wantErr       bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), bookingID, uuid.New(), "Promo SUMMER10", 2000, time.Now())
+				mock.ExpectQuery(`FROM booking_discounts`).WithArgs(bookingID).WillReturnRows(rows)
+			},
+			wantDiscounts: 1,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`FROM booking_discounts`).WithArgs(bookingID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := booking_repo.NewBookingRepo(db)
+			bookingID := uuid.New()
 
+			tt.setupMocks(mock, bookingID)
+			discounts, err := repo.GetBookingDiscountsByBookingId(bookingID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if len(discounts) != tt.wantDiscounts {
+				t.Errorf("expected %d discounts, got %d", tt.wantDiscounts, len(discounts))
+			}
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Errorf("unmet sqlmock expectations: %v", err)
 			}
@@ -92,6 +273,33 @@ func TestBookingRepo_CreateBookingWithRooms(t *testing.T) {
 	}
 }
 
+func TestBookingRepo_GetBookingTaxesByBookingId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := booking_repo.NewBookingRepo(db)
+	bookingID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"id", "booking_id", "tax_rule_id", "name", "charge_type", "inclusive", "amount", "created_at"}).
+		AddRow(uuid.New(), bookingID, uuid.New(), "VAT", "percentage", true, 2143, time.Now()).
+		AddRow(uuid.New(), bookingID, nil, "City tax", "per_person", false, 500, time.Now())
+	mock.ExpectQuery(`FROM booking_taxes`).WithArgs(bookingID).WillReturnRows(rows)
+
+	taxes, err := repo.GetBookingTaxesByBookingId(bookingID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(taxes) != 2 || !taxes[0].Inclusive || taxes[1].TaxRuleId != nil {
+		t.Errorf("unexpected taxes: %+v", taxes)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
 func TestBookingRepo_GetBookingById(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -101,9 +309,9 @@ func TestBookingRepo_GetBookingById(t *testing.T) {
 		{
 			name: "success",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				rows := sqlmock.NewRows([]string{"id", "user_id", "hotel_id", "checkin", "checkout", "status", "created_at"}).
-					AddRow(bookingID, uuid.Nil, uuid.Nil, time.Now(), time.Now(), "confirmed", time.Now())
-				mock.ExpectQuery(`SELECT id, user_id, hotel_id, checkin, checkout, status, created_at FROM bookings`).
+				rows := sqlmock.NewRows([]string{"id", "user_id", "hotel_id", "checkin", "checkout", "status", "total_amount", "penalty_amount", "refund_amount", "currency", "estimated_arrival", "notes", "group_code", "created_at"}).
+					AddRow(bookingID, uuid.Nil, uuid.Nil, time.Now(), time.Now(), "confirmed", 900000, 0, 0, "USD", "18:30", "late arrival", "SMITH-WEDDING", time.Now())
+				mock.ExpectQuery(`SELECT id, user_id, hotel_id, checkin, checkout, status, total_amount, penalty_amount, refund_amount, currency, estimated_arrival, notes, group_code, created_at FROM bookings`).
 					WithArgs(bookingID).WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -111,7 +319,7 @@ func TestBookingRepo_GetBookingById(t *testing.T) {
 		{
 			name: "no rows found",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectQuery(`SELECT id, user_id, hotel_id, checkin, checkout, status, created_at FROM bookings`).
+				mock.ExpectQuery(`SELECT id, user_id, hotel_id, checkin, checkout, status, total_amount, penalty_amount, refund_amount, currency, estimated_arrival, notes, group_code, created_at FROM bookings`).
 					WithArgs(bookingID).WillReturnError(sql.ErrNoRows)
 			},
 			wantErr: true,
@@ -119,7 +327,7 @@ func TestBookingRepo_GetBookingById(t *testing.T) {
 		{
 			name: "query error",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectQuery(`SELECT id, user_id, hotel_id, checkin, checkout, status, created_at FROM bookings`).
+				mock.ExpectQuery(`SELECT id, user_id, hotel_id, checkin, checkout, status, total_amount, penalty_amount, refund_amount, currency, estimated_arrival, notes, group_code, created_at FROM bookings`).
 					WithArgs(bookingID).WillReturnError(errors.New("query failed"))
 			},
 			wantErr: true,
@@ -160,9 +368,9 @@ func TestBookingRepo_GetBookedRoomsByBookingId(t *testing.T) {
 		{
 			name: "success",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				rows := sqlmock.NewRows([]string{"id", "booking_id", "room_type", "room_quantity", "created_at"}).
-					AddRow(uuid.New(), bookingID, "single", 2, time.Now())
-				mock.ExpectQuery(`SELECT id, booking_id, room_type, room_quantity, created_at FROM booked_rooms`).
+				rows := sqlmock.NewRows([]string{"id", "booking_id", "room_type", "room_quantity", "price_per_night", "adults", "children", "extra_guest_charge", "created_at"}).
+					AddRow(uuid.New(), bookingID, "single", 2, 450000, 1, 0, 0, time.Now())
+				mock.ExpectQuery(`SELECT id, booking_id, room_type, room_quantity, price_per_night, adults, children, extra_guest_charge, created_at FROM booked_rooms`).
 					WithArgs(bookingID).WillReturnRows(rows)
 			},
 			wantErr: false,
@@ -170,7 +378,7 @@ func TestBookingRepo_GetBookedRoomsByBookingId(t *testing.T) {
 		{
 			name: "query error",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectQuery(`SELECT id, booking_id, room_type, room_quantity, created_at FROM booked_rooms`).
+				mock.ExpectQuery(`SELECT id, booking_id, room_type, room_quantity, price_per_night, adults, children, extra_guest_charge, created_at FROM booked_rooms`).
 					WithArgs(bookingID).WillReturnError(errors.New("query failed"))
 			},
 			wantErr: true,
@@ -178,9 +386,9 @@ func TestBookingRepo_GetBookedRoomsByBookingId(t *testing.T) {
 		{
 			name: "row scan error",
 			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				rows := sqlmock.NewRows([]string{"id", "booking_id", "room_type", "room_quantity", "created_at"}).
-					AddRow("invalid-uuid", bookingID, "single", 2, time.Now())
-				mock.ExpectQuery(`SELECT id, booking_id, room_type, room_quantity, created_at FROM booked_rooms`).
+				rows := sqlmock.NewRows([]string{"id", "booking_id", "room_type", "room_quantity", "price_per_night", "adults", "children", "extra_guest_charge", "created_at"}).
+					AddRow("invalid-uuid", bookingID, "single", 2, 450000, 1, 0, 0, time.Now())
+				mock.ExpectQuery(`SELECT id, booking_id, room_type, room_quantity, price_per_night, adults, children, extra_guest_charge, created_at FROM booked_rooms`).
 					WithArgs(bookingID).WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -212,36 +420,172 @@ func TestBookingRepo_GetBookedRoomsByBookingId(t *testing.T) {
 	}
 }
 
-func TestBookingRepo_Save(t *testing.T) {
+func TestBookingRepo_GetNoShowCandidates(t *testing.T) {
+	columns := []string{"id", "user_id", "hotel_id", "checkin", "checkout", "status", "total_amount", "penalty_amount", "refund_amount", "currency", "estimated_arrival", "notes", "group_code", "created_at"}
+
 	tests := []struct {
 		name       string
-		setupMocks func(mock sqlmock.Sqlmock, bookingID uuid.UUID)
+		setupMocks func(mock sqlmock.Sqlmock, now time.Time)
+		wantCount  int
 		wantErr    bool
 	}{
 		{
 			name: "success",
-			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectExec(`UPDATE bookings`).
-					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			setupMocks: func(mock sqlmock.Sqlmock, now time.Time) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), uuid.New(), uuid.New(), now.Add(-48*time.Hour), now, "confirmed", 900000, 0, 0, "USD", "", "", "", now)
+				mock.ExpectQuery(`FROM bookings b\s+JOIN hotels h`).
+					WithArgs("confirmed", now).WillReturnRows(rows)
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, now time.Time) {
+				mock.ExpectQuery(`FROM bookings b\s+JOIN hotels h`).
+					WithArgs("confirmed", now).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "row scan error",
+			setupMocks: func(mock sqlmock.Sqlmock, now time.Time) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("invalid-uuid", uuid.New(), uuid.New(), now, now, "confirmed", 0, 0, 0, "USD", "", "", "", now)
+				mock.ExpectQuery(`FROM bookings b\s+JOIN hotels h`).
+					WithArgs("confirmed", now).WillReturnRows(rows)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := booking_repo.NewBookingRepo(db)
+			now := time.Now()
+
+			tt.setupMocks(mock, now)
+			bookings, err := repo.GetNoShowCandidates(now)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(bookings) != tt.wantCount {
+				t.Errorf("expected %d bookings, got %d", tt.wantCount, len(bookings))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestBookingRepo_SaveTransition(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents) {
+				mock.ExpectExec(`WITH updated AS \(\s+UPDATE bookings`).
+					WithArgs(booking.Id, "cancelled", booking.PenaltyAmount, booking.RefundAmount, "confirmed",
+						event.Id, event.ActorId, event.Reason, event.CreatedAt).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
 		},
 		{
-			name: "update error",
-			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectExec(`UPDATE bookings`).
-					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			name: "status changed concurrently",
+			setupMocks: func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents) {
+				mock.ExpectExec(`WITH updated AS \(\s+UPDATE bookings`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+		{
+			name: "exec error",
+			setupMocks: func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents) {
+				mock.ExpectExec(`WITH updated AS \(\s+UPDATE bookings`).
 					WillReturnError(errors.New("update failed"))
 			},
 			wantErr: true,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := booking_repo.NewBookingRepo(db)
+			actorID := uuid.New()
+			booking := &models.Bookings{Id: uuid.New(), Status: "cancelled", PenaltyAmount: 100, RefundAmount: 900}
+			event := &models.BookingEvents{
+				Id:         uuid.New(),
+				BookingId:  booking.Id,
+				FromStatus: "confirmed",
+				ToStatus:   "cancelled",
+				ActorId:    &actorID,
+				Reason:     "cancelled by guest",
+				CreatedAt:  time.Now(),
+			}
+
+			tt.setupMocks(mock, booking, event)
+			err = repo.SaveTransition(booking, event)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestBookingRepo_SaveCheckIn(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents)
+		wantErr    bool
+	}{
 		{
-			name: "no rows affected",
-			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
-				mock.ExpectExec(`UPDATE bookings`).
-					WithArgs(bookingID, uuid.Nil, uuid.Nil, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
-					WillReturnResult(sqlmock.NewResult(0, 0))
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents) {
+				mock.ExpectQuery(`WITH updated AS \(\s+UPDATE bookings[\s\S]+INSERT INTO booked_room_units`).
+					WithArgs(booking.Id, "checked_in", "confirmed", event.Id, event.ActorId, event.Reason, event.CreatedAt,
+						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "status changed concurrently",
+			setupMocks: func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents) {
+				mock.ExpectQuery(`WITH updated AS \(\s+UPDATE bookings`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			wantErr: true,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, booking *models.Bookings, event *models.BookingEvents) {
+				mock.ExpectQuery(`WITH updated AS \(\s+UPDATE bookings`).
+					WillReturnError(errors.New("update failed"))
 			},
 			wantErr: true,
 		},
@@ -256,13 +600,21 @@ func TestBookingRepo_Save(t *testing.T) {
 			defer db.Close()
 
 			repo := booking_repo.NewBookingRepo(db)
-			booking := &models.Bookings{
-				Id:        uuid.New(),
-				CreatedAt: time.Now(),
+			actorID := uuid.New()
+			booking := &models.Bookings{Id: uuid.New(), Status: "confirmed"}
+			event := &models.BookingEvents{
+				Id:         uuid.New(),
+				BookingId:  booking.Id,
+				FromStatus: "confirmed",
+				ToStatus:   "checked_in",
+				ActorId:    &actorID,
+				Reason:     "guest checked in",
+				CreatedAt:  time.Now(),
 			}
+			assignments := []*models.BookedRoomUnits{{Id: uuid.New(), BookedRoomId: uuid.New(), RoomUnitId: uuid.New()}}
 
-			tt.setupMocks(mock, booking.Id)
-			err = repo.Save(booking)
+			tt.setupMocks(mock, booking, event)
+			err = repo.SaveCheckIn(booking, event, assignments)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
@@ -274,3 +626,72 @@ func TestBookingRepo_Save(t *testing.T) {
 		})
 	}
 }
+
+func TestBookingRepo_GetBookingEventsByBookingId(t *testing.T) {
+	columns := []string{"id", "booking_id", "from_status", "to_status", "actor_id", "reason", "created_at"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, bookingID uuid.UUID)
+		wantCount  int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), bookingID, "", "confirmed", uuid.New().String(), "booking created", time.Now()).
+					AddRow(uuid.New(), bookingID, "confirmed", "no_show", nil, "no-show cutoff passed", time.Now())
+				mock.ExpectQuery(`SELECT id, booking_id, from_status, to_status, actor_id, reason, created_at`).
+					WithArgs(bookingID).WillReturnRows(rows)
+			},
+			wantCount: 2,
+			wantErr:   false,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`SELECT id, booking_id, from_status, to_status, actor_id, reason, created_at`).
+					WithArgs(bookingID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "row scan error",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("invalid-uuid", bookingID, "", "confirmed", nil, "", time.Now())
+				mock.ExpectQuery(`SELECT id, booking_id, from_status, to_status, actor_id, reason, created_at`).
+					WithArgs(bookingID).WillReturnRows(rows)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := booking_repo.NewBookingRepo(db)
+			bookingID := uuid.New()
+
+			tt.setupMocks(mock, bookingID)
+			events, err := repo.GetBookingEventsByBookingId(bookingID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(events) != tt.wantCount {
+				t.Errorf("expected %d events, got %d", tt.wantCount, len(events))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package cancellation_policy_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type CancellationPolicyRepo struct {
+	db db.DB
+}
+
+func NewCancellationPolicyRepo(database db.DB) *CancellationPolicyRepo {
+	return &CancellationPolicyRepo{db: database}
+}
+
+func (r *CancellationPolicyRepo) CreatePolicy(policy *models.CancellationPolicies) (*models.CancellationPolicies, error) {
+	query := `
+		INSERT INTO cancellation_policies (id, hotel_id, room_category, free_cancel_hours, penalty_nights, non_refundable, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (hotel_id, room_category)
+		DO UPDATE SET free_cancel_hours = EXCLUDED.free_cancel_hours, penalty_nights = EXCLUDED.penalty_nights, non_refundable = EXCLUDED.non_refundable
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, policy.Id, policy.HotelId, policy.RoomCategory, policy.FreeCancelHours, policy.PenaltyNights, policy.NonRefundable, policy.CreatedAt)
+	if err := row.Scan(&policy.Id); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (r *CancellationPolicyRepo) GetPoliciesByHotelID(hotelID uuid.UUID) ([]*models.CancellationPolicies, error) {
+	query := `
+		SELECT id, hotel_id, room_category, free_cancel_hours, penalty_nights, non_refundable, created_at
+		FROM cancellation_policies
+		WHERE hotel_id = $1
+	`
+
+	rows, err := r.db.Query(query, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*models.CancellationPolicies
+	for rows.Next() {
+		policy := &models.CancellationPolicies{}
+		if err := rows.Scan(&policy.Id, &policy.HotelId, &policy.RoomCategory, &policy.FreeCancelHours, &policy.PenaltyNights, &policy.NonRefundable, &policy.CreatedAt); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
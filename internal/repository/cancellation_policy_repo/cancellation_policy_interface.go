@@ -0,0 +1,13 @@
+package cancellation_policy_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=cancellation_policy_interface.go -destination=../../mocks/mock_cancellation_policy_repo.go -package=mocks
+
+type CancellationPolicyRepoInterface interface {
+	CreatePolicy(*models.CancellationPolicies) (*models.CancellationPolicies, error)
+	GetPoliciesByHotelID(hotelID uuid.UUID) ([]*models.CancellationPolicies, error)
+}
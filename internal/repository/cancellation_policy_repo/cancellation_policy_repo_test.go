@@ -0,0 +1,137 @@
+package cancellation_policy_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/cancellation_policy_repo"
+)
+
+func TestCancellationPolicyRepo_CreatePolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, policy *models.CancellationPolicies)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, policy *models.CancellationPolicies) {
+				mock.ExpectQuery(`INSERT INTO cancellation_policies`).
+					WithArgs(policy.Id, policy.HotelId, policy.RoomCategory, policy.FreeCancelHours, policy.PenaltyNights, policy.NonRefundable, policy.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(policy.Id))
+			},
+			wantErr: false,
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, policy *models.CancellationPolicies) {
+				mock.ExpectQuery(`INSERT INTO cancellation_policies`).
+					WillReturnError(errors.New("insert failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := cancellation_policy_repo.NewCancellationPolicyRepo(db)
+			policy := &models.CancellationPolicies{
+				Id:              uuid.New(),
+				HotelId:         uuid.New(),
+				FreeCancelHours: 48,
+				PenaltyNights:   1,
+				CreatedAt:       time.Now(),
+			}
+
+			tt.setupMocks(mock, policy)
+			_, err = repo.CreatePolicy(policy)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestCancellationPolicyRepo_GetPoliciesByHotelID(t *testing.T) {
+	columns := []string{"id", "hotel_id", "room_category", "free_cancel_hours", "penalty_nights", "non_refundable", "created_at"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		wantCount  int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), hotelID, "", 48, 1, false, time.Now()).
+					AddRow(uuid.New(), hotelID, "suite", 0, 0, true, time.Now())
+				mock.ExpectQuery(`SELECT id, hotel_id, room_category, free_cancel_hours, penalty_nights, non_refundable, created_at`).
+					WithArgs(hotelID).WillReturnRows(rows)
+			},
+			wantCount: 2,
+			wantErr:   false,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`SELECT id, hotel_id, room_category, free_cancel_hours, penalty_nights, non_refundable, created_at`).
+					WithArgs(hotelID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "row scan error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow("invalid-uuid", hotelID, "", 48, 1, false, time.Now())
+				mock.ExpectQuery(`SELECT id, hotel_id, room_category, free_cancel_hours, penalty_nights, non_refundable, created_at`).
+					WithArgs(hotelID).WillReturnRows(rows)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := cancellation_policy_repo.NewCancellationPolicyRepo(db)
+			hotelID := uuid.New()
+
+			tt.setupMocks(mock, hotelID)
+			policies, err := repo.GetPoliciesByHotelID(hotelID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(policies) != tt.wantCount {
+				t.Errorf("expected %d policies, got %d", tt.wantCount, len(policies))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package exchange_rate_repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+)
+
+// ExchangeRateRepo reads the exchange-rate table from a local JSON file. The
+// parsed table is kept until the file changes on disk.
+type ExchangeRateRepo struct {
+	path string
+
+	mu      sync.Mutex
+	rates   *models.ExchangeRates
+	modTime time.Time
+}
+
+func NewExchangeRateRepo(path string) *ExchangeRateRepo {
+	return &ExchangeRateRepo{path: path}
+}
+
+func (r *ExchangeRateRepo) GetExchangeRates() (*models.ExchangeRates, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("exchange rates: %w", err)
+	}
+	if r.rates != nil && info.ModTime().Equal(r.modTime) {
+		return r.rates, nil
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("exchange rates: %w", err)
+	}
+	var rates models.ExchangeRates
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("exchange rates: %w", err)
+	}
+	if err := validateRates(&rates); err != nil {
+		return nil, fmt.Errorf("exchange rates: %w", err)
+	}
+
+	r.rates = &rates
+	r.modTime = info.ModTime()
+	return r.rates, nil
+}
+
+func validateRates(rates *models.ExchangeRates) error {
+	if !currency.IsValid(rates.Base) {
+		return fmt.Errorf("unsupported base currency %q", rates.Base)
+	}
+	for code, rate := range rates.Rates {
+		if !currency.IsValid(code) {
+			return fmt.Errorf("unsupported currency %q", code)
+		}
+		if rate <= 0 {
+			return fmt.Errorf("rate for %s must be positive", code)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package exchange_rate_repo
+
+import "github.com/tktanisha/booking_system/internal/models"
+
+//go:generate mockgen -source=exchange_rate_interface.go -destination=../../mocks/mock_exchange_rate_repo.go -package=mocks
+
+type ExchangeRateRepoInterface interface {
+	GetExchangeRates() (*models.ExchangeRates, error)
+}
@@ -0,0 +1,95 @@
+package exchange_rate_repo_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/repository/exchange_rate_repo"
+)
+
+func writeRates(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write rates file: %v", err)
+	}
+}
+
+func TestExchangeRateRepo_GetExchangeRates(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			content: `{"base": "USD", "as_of": "2026-10-01", "rates": {"EUR": 0.92, "JPY": 149.5}}`,
+		},
+		{
+			name:    "malformed json",
+			content: `{"base": "USD",`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported base",
+			content: `{"base": "XYZ", "rates": {"EUR": 0.92}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported currency",
+			content: `{"base": "USD", "rates": {"ABC": 1.5}}`,
+			wantErr: true,
+		},
+		{
+			name:    "non-positive rate",
+			content: `{"base": "USD", "rates": {"EUR": 0}}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rates.json")
+			writeRates(t, path, tt.content)
+
+			rates, err := exchange_rate_repo.NewExchangeRateRepo(path).GetExchangeRates()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && rates.Rates["EUR"] != 0.92 {
+				t.Errorf("expected EUR rate 0.92, got %v", rates.Rates["EUR"])
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		repo := exchange_rate_repo.NewExchangeRateRepo(filepath.Join(t.TempDir(), "missing.json"))
+		if _, err := repo.GetExchangeRates(); err == nil {
+			t.Errorf("expected error for a missing file")
+		}
+	})
+
+	t.Run("reloads when the file changes", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rates.json")
+		writeRates(t, path, `{"base": "USD", "rates": {"EUR": 0.92}}`)
+		repo := exchange_rate_repo.NewExchangeRateRepo(path)
+		if _, err := repo.GetExchangeRates(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		writeRates(t, path, `{"base": "USD", "rates": {"EUR": 0.95}}`)
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatalf("failed to touch rates file: %v", err)
+		}
+
+		rates, err := repo.GetExchangeRates()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rates.Rates["EUR"] != 0.95 {
+			t.Errorf("expected reloaded EUR rate 0.95, got %v", rates.Rates["EUR"])
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package export_repo
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type ExportRepo struct {
+	db db.DB
+}
+
+func NewExportRepo(database db.DB) *ExportRepo {
+	return &ExportRepo{db: database}
+}
+
+// StreamBookings streams the hotel's bookings checking in between from and to.
+func (r *ExportRepo) StreamBookings(hotelId uuid.UUID, from, to time.Time, visit func(*models.Bookings) error) error {
+	args := []any{hotelId}
+	query := `
+		SELECT b.id, b.user_id, b.hotel_id, b.checkin, b.checkout, b.status, b.total_amount, b.penalty_amount, b.refund_amount,
+			b.currency, b.estimated_arrival, b.notes, b.group_code, b.created_at
+		FROM bookings b
+		WHERE b.hotel_id = $1` + dateRange("b.checkin", from, to, &args) + `
+		ORDER BY b.checkin, b.id
+	`
+
+	return r.stream(query, args, func(rows *sql.Rows) error {
+		b := &models.Bookings{}
+		if err := rows.Scan(&b.Id, &b.UserId, &b.HotelId, &b.CheckIn, &b.CheckOut, &b.Status, &b.TotalAmount, &b.PenaltyAmount,
+			&b.RefundAmount, &b.Currency, &b.EstimatedArrival, &b.Notes, &b.GroupCode, &b.CreatedAt); err != nil {
+			return err
+		}
+		return visit(b)
+	})
+}
+
+// StreamBookedRooms streams the rooms of the hotel's bookings checking in between from and to.
+func (r *ExportRepo) StreamBookedRooms(hotelId uuid.UUID, from, to time.Time, visit func(*models.BookedRooms) error) error {
+	args := []any{hotelId}
+	query := `
+		SELECT br.id, br.booking_id, br.room_type, br.room_quantity, br.price_per_night, br.adults, br.children,
+			br.extra_guest_charge, br.created_at
+		FROM booked_rooms br
+		JOIN bookings b ON b.id = br.booking_id
+		WHERE b.hotel_id = $1` + dateRange("b.checkin", from, to, &args) + `
+		ORDER BY b.checkin, br.booking_id, br.id
+	`
+
+	return r.stream(query, args, func(rows *sql.Rows) error {
+		br := &models.BookedRooms{}
+		if err := rows.Scan(&br.Id, &br.BookingId, &br.RoomType, &br.RoomQuantity, &br.PricePerNight, &br.Adults, &br.Children,
+			&br.ExtraGuestCharge, &br.CreatedAt); err != nil {
+			return err
+		}
+		return visit(br)
+	})
+}
+
+// StreamGuests streams the named guests of the hotel's bookings checking in
+// between from and to, each booking's lead guest first.
+func (r *ExportRepo) StreamGuests(hotelId uuid.UUID, from, to time.Time, visit func(*models.BookingGuests) error) error {
+	args := []any{hotelId}
+	query := `
+		SELECT g.id, g.booking_id, g.full_name, g.email, g.phone, g.is_lead, g.created_at
+		FROM booking_guests g
+		JOIN bookings b ON b.id = g.booking_id
+		WHERE b.hotel_id = $1` + dateRange("b.checkin", from, to, &args) + `
+		ORDER BY b.checkin, g.booking_id, g.is_lead DESC, g.created_at, g.id
+	`
+
+	return r.stream(query, args, func(rows *sql.Rows) error {
+		g := &models.BookingGuests{}
+		if err := rows.Scan(&g.Id, &g.BookingId, &g.FullName, &g.Email, &g.Phone, &g.IsLead, &g.CreatedAt); err != nil {
+			return err
+		}
+		return visit(g)
+	})
+}
+
+// StreamPayments streams the payments posted to the folios of the hotel's
+// bookings between from and to, voided ones included.
+func (r *ExportRepo) StreamPayments(hotelId uuid.UUID, from, to time.Time, visit func(*models.FolioLines) error) error {
+	args := []any{hotelId, folio.Payment}
+	query := `
+		SELECT f.id, f.booking_id, f.line_type, f.category, f.description, f.amount, f.posted_by, f.posted_at,
+			f.voided_by, f.voided_at, f.void_reason
+		FROM folio_lines f
+		JOIN bookings b ON b.id = f.booking_id
+		WHERE b.hotel_id = $1 AND f.line_type = $2` + dateRange("f.posted_at", from, to, &args) + `
+		ORDER BY f.posted_at, f.id
+	`
+
+	return r.stream(query, args, func(rows *sql.Rows) error {
+		f := &models.FolioLines{}
+		if err := rows.Scan(&f.Id, &f.BookingId, &f.LineType, &f.Category, &f.Description, &f.Amount, &f.PostedBy, &f.PostedAt,
+			&f.VoidedBy, &f.VoidedAt, &f.VoidReason); err != nil {
+			return err
+		}
+		return visit(f)
+	})
+}
+
+// stream runs query and hands each row to scan as it is read, so no more
+// than one row is held at a time.
+func (r *ExportRepo) stream(query string, args []any, scan func(*sql.Rows) error) error {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// dateRange returns the conditions keeping column from from up to, but not
+// including, to, adding the bounds to args. A zero bound adds no condition.
+func dateRange(column string, from, to time.Time, args *[]any) string {
+	conditions := ""
+	if !from.IsZero() {
+		*args = append(*args, from)
+		conditions += " AND " + column + " >= $" + strconv.Itoa(len(*args))
+	}
+	if !to.IsZero() {
+		*args = append(*args, to)
+		conditions += " AND " + column + " < $" + strconv.Itoa(len(*args))
+	}
+	return conditions
+}
@@ -0,0 +1,20 @@
+package export_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=export_interface.go -destination=../../mocks/mock_export_repo.go -package=mocks
+
+// ExportRepoInterface streams a hotel's rows to visit one at a time, in a
+// stable order, while the query's rows are read. A zero from or to leaves
+// that end of the date range open. An error from visit stops the export.
+type ExportRepoInterface interface {
+	StreamBookings(hotelId uuid.UUID, from, to time.Time, visit func(*models.Bookings) error) error
+	StreamBookedRooms(hotelId uuid.UUID, from, to time.Time, visit func(*models.BookedRooms) error) error
+	StreamGuests(hotelId uuid.UUID, from, to time.Time, visit func(*models.BookingGuests) error) error
+	StreamPayments(hotelId uuid.UUID, from, to time.Time, visit func(*models.FolioLines) error) error
+}
@@ -0,0 +1,115 @@
+package export_repo_test
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/export_repo"
+)
+
+var bookingColumns = []string{"id", "user_id", "hotel_id", "checkin", "checkout", "status", "total_amount", "penalty_amount",
+	"refund_amount", "currency", "estimated_arrival", "notes", "group_code", "created_at"}
+
+func TestExportRepo_StreamBookings(t *testing.T) {
+	hotelID, now := uuid.New(), time.Now()
+	from, to := now, now.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name      string
+		from, to  time.Time
+		query     string
+		args      []driver.Value
+		visitErr  error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "whole history", query: `WHERE b.hotel_id = \$1\s+ORDER BY b.checkin, b.id`, args: []driver.Value{hotelID}, wantCalls: 2},
+		{name: "date range", from: from, to: to, query: `WHERE b.hotel_id = \$1 AND b.checkin >= \$2 AND b.checkin < \$3\s+ORDER BY`, args: []driver.Value{hotelID, from, to}, wantCalls: 2},
+		{name: "open start", to: to, query: `WHERE b.hotel_id = \$1 AND b.checkin < \$2\s+ORDER BY`, args: []driver.Value{hotelID, to}, wantCalls: 2},
+		{name: "visit error stops the export", query: `FROM bookings b`, args: []driver.Value{hotelID}, visitErr: errors.New("client gone"), wantErr: errors.New("client gone"), wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectQuery(tt.query).WithArgs(tt.args...).WillReturnRows(sqlmock.NewRows(bookingColumns).
+				AddRow(uuid.New(), uuid.New(), hotelID, now, now.AddDate(0, 0, 2), "confirmed", int64(20000), int64(0), int64(0), "EUR", "", "", "", now).
+				AddRow(uuid.New(), uuid.New(), hotelID, now, now.AddDate(0, 0, 1), "cancelled", int64(10000), int64(2500), int64(7500), "EUR", "21:30", "late", "", now))
+
+			calls := 0
+			err = export_repo.NewExportRepo(db).StreamBookings(hotelID, tt.from, tt.to, func(b *models.Bookings) error {
+				calls++
+				if b.Currency != "EUR" {
+					t.Errorf("unexpected booking %+v", b)
+				}
+				return tt.visitErr
+			})
+			if (err == nil) != (tt.wantErr == nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("expected %d rows visited, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestExportRepo_StreamPayments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID, now := uuid.New(), time.Now()
+	voidedBy := uuid.New()
+	mock.ExpectQuery(`WHERE b.hotel_id = \$1 AND f.line_type = \$2 AND f.posted_at >= \$3\s+ORDER BY f.posted_at, f.id`).
+		WithArgs(hotelID, folio.Payment, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "booking_id", "line_type", "category", "description", "amount", "posted_by", "posted_at", "voided_by", "voided_at", "void_reason"}).
+			AddRow(uuid.New(), uuid.New(), "payment", "", "card", int64(20000), uuid.New(), now, nil, nil, "").
+			AddRow(uuid.New(), uuid.New(), "payment", "", "cash", int64(5000), uuid.New(), now, voidedBy, now, "entered twice"))
+
+	var payments []*models.FolioLines
+	err = export_repo.NewExportRepo(db).StreamPayments(hotelID, now, time.Time{}, func(f *models.FolioLines) error {
+		payments = append(payments, f)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(payments) != 2 || payments[0].IsVoided() || !payments[1].IsVoided() || *payments[1].VoidedBy != voidedBy {
+		t.Errorf("unexpected payments %+v", payments)
+	}
+}
+
+func TestExportRepo_StreamGuests(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID := uuid.New()
+	mock.ExpectQuery(`FROM booking_guests g\s+JOIN bookings b ON b.id = g.booking_id\s+WHERE b.hotel_id = \$1`).
+		WithArgs(hotelID).
+		WillReturnError(errors.New("db error"))
+
+	err = export_repo.NewExportRepo(db).StreamGuests(hotelID, time.Time{}, time.Time{}, func(*models.BookingGuests) error {
+		t.Errorf("no guest should be visited")
+		return nil
+	})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
@@ -0,0 +1,85 @@
+package folio_repo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrFolioLineNotFound = errors.New("folio line not found or already voided")
+
+type FolioRepo struct {
+	db db.DB
+}
+
+func NewFolioRepo(database db.DB) *FolioRepo {
+	return &FolioRepo{db: database}
+}
+
+func (r *FolioRepo) CreateFolioLine(line *models.FolioLines) (*models.FolioLines, error) {
+	query := `
+		INSERT INTO folio_lines (id, booking_id, line_type, category, description, amount, posted_by, posted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, line.Id, line.BookingId, line.LineType, line.Category, line.Description, line.Amount, line.PostedBy, line.PostedAt)
+	if err := row.Scan(&line.Id); err != nil {
+		return nil, err
+	}
+	return line, nil
+}
+
+func (r *FolioRepo) GetFolioLinesByBookingId(bookingId uuid.UUID) ([]*models.FolioLines, error) {
+	query := `
+		SELECT id, booking_id, line_type, category, description, amount, posted_by, posted_at, voided_by, voided_at, void_reason
+		FROM folio_lines
+		WHERE booking_id = $1
+		ORDER BY posted_at
+	`
+
+	rows, err := r.db.Query(query, bookingId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []*models.FolioLines
+	for rows.Next() {
+		line := &models.FolioLines{}
+		if err := rows.Scan(&line.Id, &line.BookingId, &line.LineType, &line.Category, &line.Description, &line.Amount,
+			&line.PostedBy, &line.PostedAt, &line.VoidedBy, &line.VoidedAt, &line.VoidReason); err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// VoidFolioLine marks a line of the booking's folio as voided. Lines are never
+// deleted so the folio keeps a record of every correction.
+func (r *FolioRepo) VoidFolioLine(lineId, bookingId, voidedBy uuid.UUID, reason string, voidedAt time.Time) error {
+	query := `
+		UPDATE folio_lines
+		SET voided_by = $3, voided_at = $4, void_reason = $5
+		WHERE id = $1 AND booking_id = $2 AND voided_at IS NULL
+	`
+
+	result, err := r.db.Exec(query, lineId, bookingId, voidedBy, voidedAt, reason)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrFolioLineNotFound
+	}
+	return nil
+}
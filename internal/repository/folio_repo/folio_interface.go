@@ -0,0 +1,16 @@
+package folio_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=folio_interface.go -destination=../../mocks/mock_folio_repo.go -package=mocks
+
+type FolioRepoInterface interface {
+	CreateFolioLine(*models.FolioLines) (*models.FolioLines, error)
+	GetFolioLinesByBookingId(bookingId uuid.UUID) ([]*models.FolioLines, error)
+	VoidFolioLine(lineId, bookingId, voidedBy uuid.UUID, reason string, voidedAt time.Time) error
+}
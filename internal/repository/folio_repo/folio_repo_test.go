@@ -0,0 +1,199 @@
+package folio_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/folio_repo"
+)
+
+func TestFolioRepo_CreateFolioLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, line *models.FolioLines)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, line *models.FolioLines) {
+				mock.ExpectQuery(`INSERT INTO folio_lines`).
+					WithArgs(line.Id, line.BookingId, line.LineType, line.Category, line.Description, line.Amount, line.PostedBy, line.PostedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(line.Id))
+			},
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, line *models.FolioLines) {
+				mock.ExpectQuery(`INSERT INTO folio_lines`).WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := folio_repo.NewFolioRepo(db)
+			line := &models.FolioLines{
+				Id:          uuid.New(),
+				BookingId:   uuid.New(),
+				LineType:    folio.Charge,
+				Category:    folio.Minibar,
+				Description: "Two sodas",
+				Amount:      800,
+				PostedBy:    uuid.New(),
+				PostedAt:    time.Now(),
+			}
+
+			tt.setupMocks(mock, line)
+			_, err = repo.CreateFolioLine(line)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestFolioRepo_GetFolioLinesByBookingId(t *testing.T) {
+	columns := []string{"id", "booking_id", "line_type", "category", "description", "amount", "posted_by", "posted_at", "voided_by", "voided_at", "void_reason"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, bookingID uuid.UUID)
+		wantCount  int
+		wantVoided int
+		wantErr    bool
+	}{
+		{
+			name: "success with a voided line",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				now := time.Now()
+				mock.ExpectQuery(`SELECT (.+) FROM folio_lines WHERE booking_id = \$1`).
+					WithArgs(bookingID).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(uuid.New(), bookingID, folio.Charge, folio.Parking, "Parking", 1500, uuid.New(), now, nil, nil, "").
+						AddRow(uuid.New(), bookingID, folio.Charge, folio.Minibar, "Water", 300, uuid.New(), now, uuid.New(), now, "posted twice"))
+			},
+			wantCount:  2,
+			wantVoided: 1,
+		},
+		{
+			name: "query fails",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`SELECT (.+) FROM folio_lines`).WillReturnError(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "scan fails",
+			setupMocks: func(mock sqlmock.Sqlmock, bookingID uuid.UUID) {
+				mock.ExpectQuery(`SELECT (.+) FROM folio_lines`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := folio_repo.NewFolioRepo(db)
+			bookingID := uuid.New()
+
+			tt.setupMocks(mock, bookingID)
+			lines, err := repo.GetFolioLinesByBookingId(bookingID)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr {
+				voided := 0
+				for _, line := range lines {
+					if line.IsVoided() {
+						voided++
+					}
+				}
+				if len(lines) != tt.wantCount || voided != tt.wantVoided {
+					t.Errorf("expected %d lines with %d voided, got %d with %d", tt.wantCount, tt.wantVoided, len(lines), voided)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestFolioRepo_VoidFolioLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock)
+		wantErr    error
+		expectErr  bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE folio_lines`).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "missing or already voided",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE folio_lines`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr:   folio_repo.ErrFolioLineNotFound,
+			expectErr: true,
+		},
+		{
+			name: "exec fails",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE folio_lines`).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := folio_repo.NewFolioRepo(db)
+			tt.setupMocks(mock)
+			err = repo.VoidFolioLine(uuid.New(), uuid.New(), uuid.New(), "posted twice", time.Now())
+
+			if (err != nil) != tt.expectErr {
+				t.Errorf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package geocode_repo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrAddressNotFound = errors.New("address not found")
+
+// GeocodeRepo looks addresses up in a local JSON gazetteer mapping addresses to
+// coordinates, so hotels can be placed on the map without calling an online
+// service. The parsed table is kept until the file changes on disk.
+type GeocodeRepo struct {
+	path string
+
+	mu      sync.Mutex
+	places  map[string]models.GeoPoint
+	modTime time.Time
+}
+
+type gazetteer struct {
+	Places map[string]models.GeoPoint `json:"places"`
+}
+
+func NewGeocodeRepo(path string) *GeocodeRepo {
+	return &GeocodeRepo{path: path}
+}
+
+func (r *GeocodeRepo) Geocode(address string) (*models.GeoPoint, error) {
+	places, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	point, ok := places[normalizeAddress(address)]
+	if !ok {
+		return nil, ErrAddressNotFound
+	}
+	return &point, nil
+}
+
+func (r *GeocodeRepo) load() (map[string]models.GeoPoint, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("gazetteer: %w", err)
+	}
+	if r.places != nil && info.ModTime().Equal(r.modTime) {
+		return r.places, nil
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("gazetteer: %w", err)
+	}
+	var table gazetteer
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("gazetteer: %w", err)
+	}
+
+	places := make(map[string]models.GeoPoint, len(table.Places))
+	for address, point := range table.Places {
+		if point.Latitude < -90 || point.Latitude > 90 || point.Longitude < -180 || point.Longitude > 180 {
+			return nil, fmt.Errorf("gazetteer: coordinates for %q are out of range", address)
+		}
+		places[normalizeAddress(address)] = point
+	}
+
+	r.places = places
+	r.modTime = info.ModTime()
+	return r.places, nil
+}
+
+// normalizeAddress makes lookups ignore case, spacing and comma placement.
+func normalizeAddress(address string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(address, ",", " "))), " ")
+}
@@ -0,0 +1,12 @@
+package geocode_repo
+
+import "github.com/tktanisha/booking_system/internal/models"
+
+//go:generate mockgen -source=geocode_interface.go -destination=../../mocks/mock_geocode_repo.go -package=mocks
+
+// GeocoderInterface resolves a free-text address to coordinates. It is the hook
+// for plugging in another geocoder; it returns ErrAddressNotFound when the
+// address is unknown.
+type GeocoderInterface interface {
+	Geocode(address string) (*models.GeoPoint, error)
+}
@@ -0,0 +1,69 @@
+package geocode_repo_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/repository/geocode_repo"
+)
+
+func TestGeocodeRepo_Geocode(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		address string
+		wantLat float64
+		wantErr error
+	}{
+		{
+			name:    "found ignoring case and spacing",
+			content: `{"places": {"Terminal 2, Sahar Road, Mumbai": {"latitude": 19.0896, "longitude": 72.8656}}}`,
+			address: "terminal 2 ,  SAHAR road, mumbai",
+			wantLat: 19.0896,
+		},
+		{
+			name:    "unknown address",
+			content: `{"places": {"Terminal 2, Sahar Road, Mumbai": {"latitude": 19.0896, "longitude": 72.8656}}}`,
+			address: "1 Unknown Street",
+			wantErr: geocode_repo.ErrAddressNotFound,
+		},
+		{
+			name:    "coordinates out of range",
+			content: `{"places": {"Nowhere": {"latitude": 95, "longitude": 0}}}`,
+			address: "Nowhere",
+			wantErr: errors.New("gazetteer: coordinates for \"Nowhere\" are out of range"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "gazetteer.json")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to write gazetteer: %v", err)
+			}
+
+			point, err := geocode_repo.NewGeocodeRepo(path).Geocode(tt.address)
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if point.Latitude != tt.wantLat {
+				t.Errorf("expected latitude %v, got %v", tt.wantLat, point.Latitude)
+			}
+		})
+	}
+}
+
+func TestGeocodeRepo_MissingFile(t *testing.T) {
+	_, err := geocode_repo.NewGeocodeRepo(filepath.Join(t.TempDir(), "missing.json")).Geocode("anywhere")
+	if err == nil {
+		t.Fatal("expected an error for a missing gazetteer")
+	}
+}
@@ -0,0 +1,195 @@
+package group_block_repo
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	group_block_status "github.com/tktanisha/booking_system/internal/enums/group_block"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var (
+	ErrGroupBlockNotFound = errors.New("group block not found")
+	ErrGroupBlockExists   = errors.New("group code already holds this room type at the hotel")
+	ErrBlockUnavailable   = errors.New("group block is released or has no rooms left")
+)
+
+const blockColumns = `id, hotel_id, code, name, room_type, quantity, picked_up, check_in, check_out, cutoff_at, status, created_by, created_at`
+
+type GroupBlockRepo struct {
+	db db.DB
+}
+
+func NewGroupBlockRepo(database db.DB) *GroupBlockRepo {
+	return &GroupBlockRepo{db: database}
+}
+
+func (r *GroupBlockRepo) CreateBlock(block *models.GroupBlocks) error {
+	query := `
+		INSERT INTO group_blocks (id, hotel_id, code, name, room_type, quantity, picked_up, check_in, check_out, cutoff_at, status, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	_, err := r.db.Exec(query, block.Id, block.HotelId, block.Code, block.Name, block.RoomType, block.Quantity, block.PickedUp,
+		block.CheckIn, block.CheckOut, block.CutoffAt, block.Status, block.CreatedBy, block.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "uq_group_block_code" {
+			return ErrGroupBlockExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *GroupBlockRepo) GetBlockById(blockId uuid.UUID) (*models.GroupBlocks, error) {
+	query := `SELECT ` + blockColumns + ` FROM group_blocks WHERE id = $1`
+
+	block, err := scanBlock(r.db.QueryRow(query, blockId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrGroupBlockNotFound
+		}
+		return nil, err
+	}
+	return block, nil
+}
+
+// GetBlocksByHotelId returns a hotel's group blocks by arrival date.
+func (r *GroupBlockRepo) GetBlocksByHotelId(hotelId uuid.UUID) ([]*models.GroupBlocks, error) {
+	query := `SELECT ` + blockColumns + ` FROM group_blocks WHERE hotel_id = $1 ORDER BY check_in, code, room_type`
+	return r.queryBlocks(query, hotelId)
+}
+
+// GetBlocksByCode returns the allotments a group code holds at a hotel, one per
+// room type.
+func (r *GroupBlockRepo) GetBlocksByCode(hotelId uuid.UUID, code string) ([]*models.GroupBlocks, error) {
+	query := `SELECT ` + blockColumns + ` FROM group_blocks WHERE hotel_id = $1 AND code = $2 ORDER BY room_type`
+	return r.queryBlocks(query, hotelId, code)
+}
+
+// PickUpRooms books quantity rooms out of an active block before its cutoff.
+// It fails with ErrBlockUnavailable when the block is released or too few
+// rooms are left, so two attendees cannot take the same room.
+func (r *GroupBlockRepo) PickUpRooms(blockId uuid.UUID, quantity int, now time.Time) error {
+	query := `
+		UPDATE group_blocks
+		SET picked_up = picked_up + $2
+		WHERE id = $1 AND status = $3 AND cutoff_at > $4 AND picked_up + $2 <= quantity
+	`
+
+	result, err := r.db.Exec(query, blockId, quantity, group_block_status.StatusActive, now)
+	if err != nil {
+		return err
+	}
+	return requireOneRow(result)
+}
+
+// ReturnRooms puts rooms picked up from a block back into it. Once the block
+// is released the rooms belong to general inventory and it fails with
+// ErrBlockUnavailable.
+func (r *GroupBlockRepo) ReturnRooms(blockId uuid.UUID, quantity int, now time.Time) error {
+	query := `
+		UPDATE group_blocks
+		SET picked_up = picked_up - $2
+		WHERE id = $1 AND status = $3 AND cutoff_at > $4 AND picked_up >= $2
+	`
+
+	result, err := r.db.Exec(query, blockId, quantity, group_block_status.StatusActive, now)
+	if err != nil {
+		return err
+	}
+	return requireOneRow(result)
+}
+
+// ReleaseBlock returns an active block's unpicked rooms to general inventory.
+func (r *GroupBlockRepo) ReleaseBlock(blockId uuid.UUID) error {
+	query := `UPDATE group_blocks SET status = $2 WHERE id = $1 AND status = $3`
+
+	result, err := r.db.Exec(query, blockId, group_block_status.StatusReleased, group_block_status.StatusActive)
+	if err != nil {
+		return err
+	}
+	return requireOneRow(result)
+}
+
+// ReleaseDueBlocks releases the active blocks whose cutoff has passed and
+// returns them.
+func (r *GroupBlockRepo) ReleaseDueBlocks(now time.Time) ([]*models.GroupBlocks, error) {
+	query := `
+		UPDATE group_blocks
+		SET status = $1
+		WHERE status = $2 AND cutoff_at <= $3
+		RETURNING ` + blockColumns
+
+	return r.queryBlocks(query, group_block_status.StatusReleased, group_block_status.StatusActive, now)
+}
+
+// GetHeldQuantity sums the unpicked rooms of a type that active blocks hold
+// for stays overlapping [from, to). Blocks stop holding rooms at their cutoff
+// even before ReleaseDueBlocks marks them released.
+func (r *GroupBlockRepo) GetHeldQuantity(hotelId uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(quantity - picked_up), 0)
+		FROM group_blocks
+		WHERE hotel_id = $1 AND room_type = $2 AND status = $5 AND cutoff_at > NOW()
+			AND check_in < $4 AND check_out > $3
+	`
+
+	var held int
+	if err := r.db.QueryRow(query, hotelId, roomType, from, to, group_block_status.StatusActive).Scan(&held); err != nil {
+		return 0, err
+	}
+	return held, nil
+}
+
+func (r *GroupBlockRepo) queryBlocks(query string, args ...any) ([]*models.GroupBlocks, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	blocks := make([]*models.GroupBlocks, 0)
+	for rows.Next() {
+		block, err := scanBlock(rows)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBlock(row rowScanner) (*models.GroupBlocks, error) {
+	block := &models.GroupBlocks{}
+	if err := row.Scan(&block.Id, &block.HotelId, &block.Code, &block.Name, &block.RoomType, &block.Quantity, &block.PickedUp,
+		&block.CheckIn, &block.CheckOut, &block.CutoffAt, &block.Status, &block.CreatedBy, &block.CreatedAt); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func requireOneRow(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrBlockUnavailable
+	}
+	return nil
+}
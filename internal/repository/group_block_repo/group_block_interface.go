@@ -0,0 +1,23 @@
+package group_block_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=group_block_interface.go -destination=../../mocks/mock_group_block_repo.go -package=mocks
+
+type GroupBlockRepoInterface interface {
+	CreateBlock(*models.GroupBlocks) error
+	GetBlockById(blockId uuid.UUID) (*models.GroupBlocks, error)
+	GetBlocksByHotelId(hotelId uuid.UUID) ([]*models.GroupBlocks, error)
+	GetBlocksByCode(hotelId uuid.UUID, code string) ([]*models.GroupBlocks, error)
+	PickUpRooms(blockId uuid.UUID, quantity int, now time.Time) error
+	ReturnRooms(blockId uuid.UUID, quantity int, now time.Time) error
+	ReleaseBlock(blockId uuid.UUID) error
+	ReleaseDueBlocks(now time.Time) ([]*models.GroupBlocks, error)
+	GetHeldQuantity(hotelId uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error)
+}
@@ -0,0 +1,187 @@
+package group_block_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	group_block_status "github.com/tktanisha/booking_system/internal/enums/group_block"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+)
+
+var blockColumns = []string{"id", "hotel_id", "code", "name", "room_type", "quantity", "picked_up", "check_in", "check_out", "cutoff_at", "status", "created_by", "created_at"}
+
+func TestGroupBlockRepo_CreateBlock(t *testing.T) {
+	now := time.Now()
+	block := &models.GroupBlocks{Id: uuid.New(), HotelId: uuid.New(), Code: "SMITH-WEDDING", Name: "Smith wedding", RoomType: room.Double,
+		Quantity: 30, CheckIn: now.AddDate(0, 1, 0), CheckOut: now.AddDate(0, 1, 2), CutoffAt: now.AddDate(0, 0, 14),
+		Status: group_block_status.StatusActive, CreatedBy: uuid.New(), CreatedAt: now}
+
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr error
+	}{
+		{name: "created"},
+		{name: "code already holds the room type", execErr: &pq.Error{Code: "23505", Constraint: "uq_group_block_code"}, wantErr: group_block_repo.ErrGroupBlockExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			exec := mock.ExpectExec(`INSERT INTO group_blocks`).
+				WithArgs(block.Id, block.HotelId, block.Code, block.Name, block.RoomType, block.Quantity, 0,
+					block.CheckIn, block.CheckOut, block.CutoffAt, block.Status, block.CreatedBy, block.CreatedAt)
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			err = group_block_repo.NewGroupBlockRepo(db).CreateBlock(block)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGroupBlockRepo_GetBlockById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	blockID := uuid.New()
+	mock.ExpectQuery(`FROM group_blocks WHERE id = \$1`).WithArgs(blockID).WillReturnRows(sqlmock.NewRows(blockColumns))
+
+	if _, err := group_block_repo.NewGroupBlockRepo(db).GetBlockById(blockID); !errors.Is(err, group_block_repo.ErrGroupBlockNotFound) {
+		t.Errorf("expected ErrGroupBlockNotFound, got %v", err)
+	}
+}
+
+func TestGroupBlockRepo_GetBlocksByCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID, now := uuid.New(), time.Now()
+	mock.ExpectQuery(`WHERE hotel_id = \$1 AND code = \$2 ORDER BY room_type`).WithArgs(hotelID, "SMITH-WEDDING").
+		WillReturnRows(sqlmock.NewRows(blockColumns).
+			AddRow(uuid.New(), hotelID, "SMITH-WEDDING", "Smith wedding", "double", 30, 4, now.AddDate(0, 1, 0), now.AddDate(0, 1, 2), now.AddDate(0, 0, 14), "active", uuid.New(), now).
+			AddRow(uuid.New(), hotelID, "SMITH-WEDDING", "Smith wedding", "suite", 2, 0, now.AddDate(0, 1, 0), now.AddDate(0, 1, 2), now.AddDate(0, 0, 14), "active", uuid.New(), now))
+
+	blocks, err := group_block_repo.NewGroupBlockRepo(db).GetBlocksByCode(hotelID, "SMITH-WEDDING")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(blocks) != 2 || blocks[0].PickedUp != 4 || blocks[1].RoomType != room.Suite {
+		t.Errorf("unexpected blocks %+v", blocks)
+	}
+}
+
+func TestGroupBlockRepo_PickUpRooms(t *testing.T) {
+	blockID, now := uuid.New(), time.Now()
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      error
+	}{
+		{name: "rooms left", rowsAffected: 1},
+		{name: "released or picked up in full", rowsAffected: 0, wantErr: group_block_repo.ErrBlockUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec(`SET picked_up = picked_up \+ \$2\s+WHERE id = \$1 AND status = \$3 AND cutoff_at > \$4 AND picked_up \+ \$2 <= quantity`).
+				WithArgs(blockID, 3, group_block_status.StatusActive, now).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = group_block_repo.NewGroupBlockRepo(db).PickUpRooms(blockID, 3, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGroupBlockRepo_ReturnRooms(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	blockID, now := uuid.New(), time.Now()
+	mock.ExpectExec(`SET picked_up = picked_up - \$2`).
+		WithArgs(blockID, 2, group_block_status.StatusActive, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := group_block_repo.NewGroupBlockRepo(db).ReturnRooms(blockID, 2, now); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGroupBlockRepo_ReleaseDueBlocks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery(`UPDATE group_blocks\s+SET status = \$1\s+WHERE status = \$2 AND cutoff_at <= \$3\s+RETURNING`).
+		WithArgs(group_block_status.StatusReleased, group_block_status.StatusActive, now).
+		WillReturnRows(sqlmock.NewRows(blockColumns).
+			AddRow(uuid.New(), uuid.New(), "SMITH-WEDDING", "Smith wedding", "double", 30, 12, now.AddDate(0, 0, 3), now.AddDate(0, 0, 5), now.Add(-time.Minute), "released", uuid.New(), now))
+
+	released, err := group_block_repo.NewGroupBlockRepo(db).ReleaseDueBlocks(now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(released) != 1 || released[0].Status != group_block_status.StatusReleased {
+		t.Errorf("unexpected blocks %+v", released)
+	}
+}
+
+func TestGroupBlockRepo_GetHeldQuantity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID := uuid.New()
+	from, to := time.Now().AddDate(0, 1, 0), time.Now().AddDate(0, 1, 2)
+	mock.ExpectQuery(`SUM\(quantity - picked_up\)`).
+		WithArgs(hotelID, room.Double, from, to, group_block_status.StatusActive).
+		WillReturnRows(sqlmock.NewRows([]string{"held"}).AddRow(26))
+
+	held, err := group_block_repo.NewGroupBlockRepo(db).GetHeldQuantity(hotelID, room.Double, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held != 26 {
+		t.Errorf("expected 26 held rooms, got %d", held)
+	}
+}
@@ -3,13 +3,37 @@ package hotel_repo
 import (
 	"database/sql"
 	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
 	"github.com/tktanisha/booking_system/internal/models"
 )
 
+// searchOrder maps each sort order to its ORDER BY clause. Ties fall back to the
+// name so pages are stable.
+var searchOrder = map[hotel.SortOrder]string{
+	hotel.SortByName:     "h.name, h.id",
+	hotel.SortByRating:   "r.average DESC NULLS LAST, r.review_count DESC NULLS LAST, h.name, h.id",
+	hotel.SortByNewest:   "h.created_at DESC, h.name, h.id",
+	hotel.SortByDistance: "distance_km, h.name, h.id",
+}
+
+const earthRadiusKm = 6371.0
+
+// haversine is the great-circle distance in kilometres between a hotel and the
+// point given by two query parameters. LEAST guards ASIN against rounding just
+// past 1 for antipodal points.
+const haversine = `%[1]g * 2 * ASIN(LEAST(1, SQRT(
+	POWER(SIN(RADIANS(h.latitude - $%[2]d) / 2), 2) +
+	COS(RADIANS($%[2]d)) * COS(RADIANS(h.latitude)) * POWER(SIN(RADIANS(h.longitude - $%[3]d) / 2), 2))))`
+
 type HotelRepository struct {
 	db db.DB
 }
@@ -20,26 +44,34 @@ func NewHotelRepo(database db.DB) *HotelRepository {
 
 func (hr *HotelRepository) GetHotelByID(hotelID uuid.UUID) (*models.Hotels, error) {
 	query := `
-		SELECT id, manager_id, name, address, created_at
+		SELECT id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+			star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at
 		FROM hotels
 		WHERE id = $1
 	`
 
 	var hotel models.Hotels
+	var amenities pq.StringArray
+	var latitude, longitude *float64
 	row := hr.db.QueryRow(query, hotelID)
-	if err := row.Scan(&hotel.Id, &hotel.ManagerId, &hotel.Name, &hotel.Address, &hotel.CreatedAt); err != nil {
+	if err := row.Scan(&hotel.Id, &hotel.ManagerId, &hotel.Name, &hotel.Address, &hotel.NoShowCutoffHours, &hotel.NoShowChargeNights, &hotel.HoldUntilInspected, &hotel.Currency, &hotel.TimeZone, &hotel.CheckInTime, &hotel.CheckOutTime,
+		&hotel.StarRating, &hotel.Description, &amenities, &hotel.Policies.PetsAllowed, &hotel.Policies.SmokingAllowed, &hotel.Policies.ChildAgeLimit, &hotel.Policies.MinCheckInAge,
+		&hotel.Contact.Phone, &hotel.Contact.Email, &hotel.Contact.Website, &latitude, &longitude, &hotel.CreatedAt); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errors.New("hotel not found")
 		}
 		return nil, err
 	}
+	hotel.Amenities = toAmenities(amenities)
+	hotel.Coordinates = toGeoPoint(latitude, longitude)
 	return &hotel, nil
 }
 
 func (hr *HotelRepository) CreateHotel(hotel *models.Hotels) (*models.Hotels, error) {
 	query := `
-		INSERT INTO hotels (id, manager_id, name, address, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO hotels (id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+			star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 		RETURNING id;
 	`
 
@@ -49,10 +81,181 @@ func (hr *HotelRepository) CreateHotel(hotel *models.Hotels) (*models.Hotels, er
 	if hotel.CreatedAt.IsZero() {
 		hotel.CreatedAt = time.Now()
 	}
+	latitude, longitude := coordinateArgs(hotel.Coordinates)
 
-	row := hr.db.QueryRow(query, hotel.Id, hotel.ManagerId, hotel.Name, hotel.Address, hotel.CreatedAt)
+	row := hr.db.QueryRow(query, hotel.Id, hotel.ManagerId, hotel.Name, hotel.Address, hotel.NoShowCutoffHours, hotel.NoShowChargeNights, hotel.HoldUntilInspected, hotel.Currency, hotel.TimeZone, hotel.CheckInTime, hotel.CheckOutTime,
+		hotel.StarRating, hotel.Description, pq.Array(amenityStrings(hotel.Amenities)), hotel.Policies.PetsAllowed, hotel.Policies.SmokingAllowed, hotel.Policies.ChildAgeLimit, hotel.Policies.MinCheckInAge,
+		hotel.Contact.Phone, hotel.Contact.Email, hotel.Contact.Website, latitude, longitude, hotel.CreatedAt)
 	if err := row.Scan(&hotel.Id); err != nil {
 		return nil, err
 	}
 	return hotel, nil
 }
+
+// UpdateHotel saves the editable details of hotel: its name, address, content
+// and coordinates. Operational settings such as the currency and time zone are
+// left as they are.
+func (hr *HotelRepository) UpdateHotel(hotel *models.Hotels) error {
+	query := `
+		UPDATE hotels
+		SET name = $2, address = $3, star_rating = $4, description = $5, amenities = $6, pets_allowed = $7, smoking_allowed = $8,
+			child_age_limit = $9, min_check_in_age = $10, phone = $11, email = $12, website = $13, latitude = $14, longitude = $15
+		WHERE id = $1
+	`
+
+	latitude, longitude := coordinateArgs(hotel.Coordinates)
+	result, err := hr.db.Exec(query, hotel.Id, hotel.Name, hotel.Address, hotel.StarRating, hotel.Description, pq.Array(amenityStrings(hotel.Amenities)),
+		hotel.Policies.PetsAllowed, hotel.Policies.SmokingAllowed, hotel.Policies.ChildAgeLimit, hotel.Policies.MinCheckInAge,
+		hotel.Contact.Phone, hotel.Contact.Email, hotel.Contact.Website, latitude, longitude)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("hotel not found")
+	}
+	return nil
+}
+
+// SearchHotels returns the hotels matching search with their average rating and
+// review count, in the requested order. Only hotels offering every requested
+// amenity are returned. A proximity search also returns each hotel's distance
+// from the searched point and leaves out hotels that have no coordinates.
+func (hr *HotelRepository) SearchHotels(search *models.HotelSearch) ([]*models.Hotels, error) {
+	order, ok := searchOrder[search.Sort]
+	if !ok || (search.Sort == hotel.SortByDistance && search.Near == nil) {
+		order = searchOrder[hotel.SortByName]
+	}
+
+	var conditions []string
+	var args []any
+	param := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+	if len(search.Amenities) > 0 {
+		conditions = append(conditions, "h.amenities @> "+param(pq.Array(amenityStrings(search.Amenities))))
+	}
+
+	distance := "NULL::float8"
+	if search.Near != nil {
+		// The bounding box lets the index discard far-away hotels; the
+		// distance check then trims the box's corners.
+		box := boundingBox(*search.Near, search.RadiusKm)
+		conditions = append(conditions, "h.latitude BETWEEN "+param(box.minLat)+" AND "+param(box.maxLat))
+		if !box.allLongitudes {
+			conditions = append(conditions, "h.longitude BETWEEN "+param(box.minLng)+" AND "+param(box.maxLng))
+		}
+		args = append(args, search.Near.Latitude, search.Near.Longitude)
+		distance = fmt.Sprintf(haversine, earthRadiusKm, len(args)-1, len(args))
+		conditions = append(conditions, distance+" <= "+param(search.RadiusKm))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := `
+		SELECT h.id, h.manager_id, h.name, h.address, h.no_show_cutoff_hours, h.no_show_charge_nights, h.hold_until_inspected,
+			h.currency, h.time_zone, h.check_in_time, h.check_out_time, h.star_rating, h.description, h.amenities,
+			h.pets_allowed, h.smoking_allowed, h.child_age_limit, h.min_check_in_age, h.phone, h.email, h.website, h.latitude, h.longitude, h.created_at,
+			COALESCE(r.average, 0), COALESCE(r.review_count, 0), ` + distance + ` AS distance_km
+		FROM hotels h
+		LEFT JOIN (
+			SELECT hotel_id, ROUND(AVG(rating), 1)::float8 AS average, COUNT(*) AS review_count
+			FROM reviews
+			GROUP BY hotel_id
+		) r ON r.hotel_id = h.id
+		` + where + `
+		ORDER BY ` + order
+
+	rows, err := hr.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hotels := make([]*models.Hotels, 0)
+	for rows.Next() {
+		h := &models.Hotels{Rating: &models.RatingSummary{}}
+		var amenities pq.StringArray
+		var latitude, longitude *float64
+		if err := rows.Scan(&h.Id, &h.ManagerId, &h.Name, &h.Address, &h.NoShowCutoffHours, &h.NoShowChargeNights,
+			&h.HoldUntilInspected, &h.Currency, &h.TimeZone, &h.CheckInTime, &h.CheckOutTime, &h.StarRating, &h.Description, &amenities,
+			&h.Policies.PetsAllowed, &h.Policies.SmokingAllowed, &h.Policies.ChildAgeLimit, &h.Policies.MinCheckInAge,
+			&h.Contact.Phone, &h.Contact.Email, &h.Contact.Website, &latitude, &longitude, &h.CreatedAt,
+			&h.Rating.Average, &h.Rating.Count, &h.DistanceKm); err != nil {
+			return nil, err
+		}
+		h.Amenities = toAmenities(amenities)
+		h.Coordinates = toGeoPoint(latitude, longitude)
+		hotels = append(hotels, h)
+	}
+	return hotels, rows.Err()
+}
+
+// amenityStrings and toAmenities convert between amenities and the TEXT[] column
+// they are stored in; pq cannot scan into a named string type directly.
+func amenityStrings(amenities []hotel.Amenity) []string {
+	values := make([]string, len(amenities))
+	for i, a := range amenities {
+		values[i] = string(a)
+	}
+	return values
+}
+
+func toAmenities(values []string) []hotel.Amenity {
+	amenities := make([]hotel.Amenity, len(values))
+	for i, v := range values {
+		amenities[i] = hotel.Amenity(v)
+	}
+	return amenities
+}
+
+// coordinateArgs and toGeoPoint convert between a hotel's coordinates and its
+// nullable latitude and longitude columns.
+func coordinateArgs(point *models.GeoPoint) (*float64, *float64) {
+	if point == nil {
+		return nil, nil
+	}
+	return &point.Latitude, &point.Longitude
+}
+
+func toGeoPoint(latitude, longitude *float64) *models.GeoPoint {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+	return &models.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+}
+
+type box struct {
+	minLat, maxLat float64
+	minLng, maxLng float64
+	allLongitudes  bool // the box takes in a pole or crosses the antimeridian
+}
+
+// boundingBox returns the smallest latitude/longitude box holding every point
+// within radiusKm of center.
+func boundingBox(center models.GeoPoint, radiusKm float64) box {
+	angular := radiusKm / earthRadiusKm
+	deltaLat := angular * 180 / math.Pi
+	b := box{
+		minLat: center.Latitude - deltaLat,
+		maxLat: center.Latitude + deltaLat,
+	}
+	if b.minLat <= -90 || b.maxLat >= 90 {
+		b.minLat, b.maxLat = math.Max(b.minLat, -90), math.Min(b.maxLat, 90)
+		b.allLongitudes = true
+		return b
+	}
+
+	deltaLng := math.Asin(math.Sin(angular)/math.Cos(center.Latitude*math.Pi/180)) * 180 / math.Pi
+	b.minLng, b.maxLng = center.Longitude-deltaLng, center.Longitude+deltaLng
+	if b.minLng < -180 || b.maxLng > 180 {
+		b.allLongitudes = true
+	}
+	return b
+}
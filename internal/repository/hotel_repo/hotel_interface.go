@@ -10,4 +10,6 @@ import (
 type HotelRepositoryInterface interface {
 	GetHotelByID(uuid.UUID) (*models.Hotels, error)
 	CreateHotel(*models.Hotels) (*models.Hotels, error)
+	UpdateHotel(*models.Hotels) error
+	SearchHotels(*models.HotelSearch) ([]*models.Hotels, error)
 }
@@ -3,12 +3,14 @@ package hotel_repo
 import (
 	"database/sql"
 	"errors"
+	"math"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
 	"github.com/tktanisha/booking_system/internal/models"
 )
 
@@ -25,10 +27,13 @@ func TestHotelRepository_GetHotelByID(t *testing.T) {
 			hotelID: uuid.New(),
 			mockBehavior: func(mock sqlmock.Sqlmock, id uuid.UUID) {
 				rows := sqlmock.NewRows([]string{
-					"id", "manager_id", "name", "address", "created_at",
-				}).AddRow(id, uuid.New(), "Hotel ABC", "123 Street", time.Now())
+					"id", "manager_id", "name", "address", "no_show_cutoff_hours", "no_show_charge_nights", "hold_until_inspected", "currency", "time_zone", "check_in_time", "check_out_time",
+					"star_rating", "description", "amenities", "pets_allowed", "smoking_allowed", "child_age_limit", "min_check_in_age", "phone", "email", "website", "latitude", "longitude", "created_at",
+				}).AddRow(id, uuid.New(), "Hotel ABC", "123 Street", 24, 1, false, "USD", "Asia/Tokyo", "15:00", "11:00",
+					4, "Harbour views", "{wifi,pool}", true, false, 12, 18, "+81 3 1234 5678", "stay@abc.example", "", 35.6762, 139.6503, time.Now())
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					SELECT id, manager_id, name, address, created_at
+					SELECT id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+						star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at
 					FROM hotels
 					WHERE id = $1
 				`)).WithArgs(id).WillReturnRows(rows)
@@ -40,7 +45,8 @@ func TestHotelRepository_GetHotelByID(t *testing.T) {
 			hotelID: uuid.New(),
 			mockBehavior: func(mock sqlmock.Sqlmock, id uuid.UUID) {
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					SELECT id, manager_id, name, address, created_at
+					SELECT id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+						star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at
 					FROM hotels
 					WHERE id = $1
 				`)).WithArgs(id).WillReturnError(sql.ErrNoRows)
@@ -52,7 +58,8 @@ func TestHotelRepository_GetHotelByID(t *testing.T) {
 			hotelID: uuid.New(),
 			mockBehavior: func(mock sqlmock.Sqlmock, id uuid.UUID) {
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					SELECT id, manager_id, name, address, created_at
+					SELECT id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+						star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at
 					FROM hotels
 					WHERE id = $1
 				`)).WithArgs(id).WillReturnError(errors.New("query failed"))
@@ -117,11 +124,14 @@ func TestHotelRepository_CreateHotel(t *testing.T) {
 			mockBehavior: func(mock sqlmock.Sqlmock, hotel *models.Hotels) {
 				rows := sqlmock.NewRows([]string{"id"}).AddRow(hotel.Id)
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					INSERT INTO hotels (id, manager_id, name, address, created_at)
-					VALUES ($1, $2, $3, $4, $5)
+					INSERT INTO hotels (id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+						star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 					RETURNING id;
 				`)).
-					WithArgs(hotel.Id, hotel.ManagerId, hotel.Name, hotel.Address, hotel.CreatedAt).
+					WithArgs(hotel.Id, hotel.ManagerId, hotel.Name, hotel.Address, hotel.NoShowCutoffHours, hotel.NoShowChargeNights, hotel.HoldUntilInspected, hotel.Currency, hotel.TimeZone, hotel.CheckInTime, hotel.CheckOutTime,
+						hotel.StarRating, hotel.Description, sqlmock.AnyArg(), hotel.Policies.PetsAllowed, hotel.Policies.SmokingAllowed, hotel.Policies.ChildAgeLimit, hotel.Policies.MinCheckInAge,
+						hotel.Contact.Phone, hotel.Contact.Email, hotel.Contact.Website, nil, nil, hotel.CreatedAt).
 					WillReturnRows(rows)
 			},
 			expectedError: nil,
@@ -137,11 +147,14 @@ func TestHotelRepository_CreateHotel(t *testing.T) {
 			mockBehavior: func(mock sqlmock.Sqlmock, hotel *models.Hotels) {
 				// We expect the ID to be generated by the repo (uuid.New())
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					INSERT INTO hotels (id, manager_id, name, address, created_at)
-					VALUES ($1, $2, $3, $4, $5)
+					INSERT INTO hotels (id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+						star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 					RETURNING id;
 				`)).
-					WithArgs(sqlmock.AnyArg(), hotel.ManagerId, hotel.Name, hotel.Address, sqlmock.AnyArg()).
+					WithArgs(sqlmock.AnyArg(), hotel.ManagerId, hotel.Name, hotel.Address, hotel.NoShowCutoffHours, hotel.NoShowChargeNights, hotel.HoldUntilInspected, hotel.Currency, hotel.TimeZone, hotel.CheckInTime, hotel.CheckOutTime,
+						hotel.StarRating, hotel.Description, sqlmock.AnyArg(), hotel.Policies.PetsAllowed, hotel.Policies.SmokingAllowed, hotel.Policies.ChildAgeLimit, hotel.Policies.MinCheckInAge,
+						hotel.Contact.Phone, hotel.Contact.Email, hotel.Contact.Website, nil, nil, sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New()))
 			},
 			expectedError: nil,
@@ -157,11 +170,14 @@ func TestHotelRepository_CreateHotel(t *testing.T) {
 			},
 			mockBehavior: func(mock sqlmock.Sqlmock, hotel *models.Hotels) {
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					INSERT INTO hotels (id, manager_id, name, address, created_at)
-					VALUES ($1, $2, $3, $4, $5)
+					INSERT INTO hotels (id, manager_id, name, address, no_show_cutoff_hours, no_show_charge_nights, hold_until_inspected, currency, time_zone, check_in_time, check_out_time,
+						star_rating, description, amenities, pets_allowed, smoking_allowed, child_age_limit, min_check_in_age, phone, email, website, latitude, longitude, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 					RETURNING id;
 				`)).
-					WithArgs(hotel.Id, hotel.ManagerId, hotel.Name, hotel.Address, hotel.CreatedAt).
+					WithArgs(hotel.Id, hotel.ManagerId, hotel.Name, hotel.Address, hotel.NoShowCutoffHours, hotel.NoShowChargeNights, hotel.HoldUntilInspected, hotel.Currency, hotel.TimeZone, hotel.CheckInTime, hotel.CheckOutTime,
+						hotel.StarRating, hotel.Description, sqlmock.AnyArg(), hotel.Policies.PetsAllowed, hotel.Policies.SmokingAllowed, hotel.Policies.ChildAgeLimit, hotel.Policies.MinCheckInAge,
+						hotel.Contact.Phone, hotel.Contact.Email, hotel.Contact.Website, nil, nil, hotel.CreatedAt).
 					WillReturnError(errors.New("insert failed"))
 			},
 			expectedError: errors.New("insert failed"),
@@ -203,3 +219,208 @@ func TestHotelRepository_CreateHotel(t *testing.T) {
 		})
 	}
 }
+
+func TestHotelRepository_SearchHotels(t *testing.T) {
+	columns := []string{
+		"id", "manager_id", "name", "address", "no_show_cutoff_hours", "no_show_charge_nights", "hold_until_inspected", "currency",
+		"time_zone", "check_in_time", "check_out_time", "star_rating", "description", "amenities", "pets_allowed", "smoking_allowed",
+		"child_age_limit", "min_check_in_age", "phone", "email", "website", "latitude", "longitude", "created_at", "average", "review_count", "distance_km",
+	}
+
+	tests := []struct {
+		name         string
+		sort         hotel.SortOrder
+		amenities    []hotel.Amenity
+		near         *models.GeoPoint
+		mockBehavior func(mock sqlmock.Sqlmock)
+		wantCount    int
+		wantErr      bool
+	}{
+		{
+			name: "sorted by rating with unrated hotels last",
+			sort: hotel.SortByRating,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), uuid.New(), "Top Rated", "1 Street", 24, 1, false, "USD", "UTC", "15:00", "11:00", 5, "", "{wifi,pool}", false, false, 12, 18, "", "", "", 19.0896, 72.8656, time.Now(), 4.8, 12, nil).
+					AddRow(uuid.New(), uuid.New(), "Unrated", "2 Street", 24, 1, false, "USD", "UTC", "15:00", "11:00", 0, "", "{}", false, false, 12, 18, "", "", "", nil, nil, time.Now(), 0, 0, nil)
+				mock.ExpectQuery(`LEFT JOIN \(.+FROM reviews.+\) r ON r.hotel_id = h.id\s+ORDER BY r.average DESC NULLS LAST`).WillReturnRows(rows)
+			},
+			wantCount: 2,
+		},
+		{
+			name:      "filtered on amenities",
+			sort:      hotel.SortByName,
+			amenities: []hotel.Amenity{hotel.Wifi, hotel.Pool},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE h.amenities @> \$1\s+ORDER BY h.name, h.id`).
+					WithArgs("{\"wifi\",\"pool\"}").
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "nearest first within the radius",
+			sort: hotel.SortByDistance,
+			near: &models.GeoPoint{Latitude: 19.0896, Longitude: 72.8656},
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`AS distance_km.+WHERE h.latitude BETWEEN \$1 AND \$2 AND h.longitude BETWEEN \$3 AND \$4 AND .+ASIN.+ <= \$7\s+ORDER BY distance_km, h.name`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 19.0896, 72.8656, 5.0).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "distance sort without a point falls back to name",
+			sort: hotel.SortByDistance,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`NULL::float8 AS distance_km.+ORDER BY h.name, h.id`).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "unknown sort falls back to name",
+			sort: "",
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`ORDER BY h.name, h.id`).WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "query error",
+			sort: hotel.SortByNewest,
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`ORDER BY h.created_at DESC`).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("error opening mock db: %s", err)
+			}
+			defer db.Close()
+
+			tt.mockBehavior(mock)
+
+			hotels, err := NewHotelRepo(db).SearchHotels(&models.HotelSearch{Sort: tt.sort, Amenities: tt.amenities, Near: tt.near, RadiusKm: 5})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr {
+				if len(hotels) != tt.wantCount {
+					t.Fatalf("expected %d hotels, got %d", tt.wantCount, len(hotels))
+				}
+				if tt.wantCount > 0 && (hotels[0].Rating.Average != 4.8 || hotels[0].Rating.Count != 12) {
+					t.Errorf("expected 4.8 from 12 reviews, got %+v", hotels[0].Rating)
+				}
+				if tt.wantCount > 0 && len(hotels[0].Amenities) != 2 {
+					t.Errorf("expected 2 amenities, got %v", hotels[0].Amenities)
+				}
+				if tt.wantCount > 0 && (hotels[0].Coordinates == nil || hotels[1].Coordinates != nil) {
+					t.Errorf("expected coordinates only for the first hotel, got %v and %v", hotels[0].Coordinates, hotels[1].Coordinates)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestHotelRepository_UpdateHotel(t *testing.T) {
+	h := &models.Hotels{
+		Id:         uuid.New(),
+		Name:       "Grand Plaza",
+		Address:    "456 Avenue",
+		StarRating: 4,
+		Amenities:  []hotel.Amenity{hotel.Wifi, hotel.Parking},
+		Policies:   models.HotelPolicies{PetsAllowed: true, ChildAgeLimit: 12, MinCheckInAge: 18},
+		Contact:    models.HotelContact{Email: "stay@plaza.example"},
+	}
+
+	tests := []struct {
+		name          string
+		mockBehavior  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "updated",
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE hotels\s+SET name = \$2`).
+					WithArgs(h.Id, h.Name, h.Address, h.StarRating, h.Description, "{\"wifi\",\"parking\"}", true, false, 12, 18, "", "stay@plaza.example", "", nil, nil).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "hotel not found",
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE hotels`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedError: errors.New("hotel not found"),
+		},
+		{
+			name: "exec error",
+			mockBehavior: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE hotels`).WillReturnError(errors.New("update failed"))
+			},
+			expectedError: errors.New("update failed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("error opening mock db: %s", err)
+			}
+			defer db.Close()
+
+			tt.mockBehavior(mock)
+
+			err = NewHotelRepo(db).UpdateHotel(h)
+			if tt.expectedError != nil {
+				if err == nil || err.Error() != tt.expectedError.Error() {
+					t.Errorf("expected error: %v, got: %v", tt.expectedError, err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	tests := []struct {
+		name          string
+		center        models.GeoPoint
+		radiusKm      float64
+		allLongitudes bool
+	}{
+		{"equator", models.GeoPoint{Latitude: 0, Longitude: 0}, 111.19, false},
+		{"mumbai airport", models.GeoPoint{Latitude: 19.0896, Longitude: 72.8656}, 10, false},
+		{"across the antimeridian", models.GeoPoint{Latitude: -17.7, Longitude: 179.9}, 50, true},
+		{"takes in the north pole", models.GeoPoint{Latitude: 89.9, Longitude: 10}, 50, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := boundingBox(tt.center, tt.radiusKm)
+			if b.allLongitudes != tt.allLongitudes {
+				t.Fatalf("expected allLongitudes %v, got %+v", tt.allLongitudes, b)
+			}
+			if b.minLat > tt.center.Latitude || b.maxLat < tt.center.Latitude || b.minLat < -90 || b.maxLat > 90 {
+				t.Errorf("latitude range %v..%v does not hold %v", b.minLat, b.maxLat, tt.center.Latitude)
+			}
+			if !b.allLongitudes && (b.minLng > tt.center.Longitude || b.maxLng < tt.center.Longitude) {
+				t.Errorf("longitude range %v..%v does not hold %v", b.minLng, b.maxLng, tt.center.Longitude)
+			}
+		})
+	}
+
+	// One degree of latitude is about 111.19 km everywhere.
+	if b := boundingBox(models.GeoPoint{}, 111.19); math.Abs(b.maxLat-1) > 0.001 || math.Abs(b.maxLng-1) > 0.001 {
+		t.Errorf("expected a one degree box at the equator, got %+v", b)
+	}
+}
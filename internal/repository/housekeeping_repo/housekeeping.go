@@ -0,0 +1,140 @@
+package housekeeping_repo
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	housekeeping_status "github.com/tktanisha/booking_system/internal/enums/housekeeping"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type HousekeepingRepo struct {
+	db db.DB
+}
+
+func NewHousekeepingRepo(database db.DB) *HousekeepingRepo {
+	return &HousekeepingRepo{db: database}
+}
+
+// CreateTask records a cleaning task and marks its unit dirty in the same statement.
+func (r *HousekeepingRepo) CreateTask(task *models.HousekeepingTasks) error {
+	query := `
+		WITH dirty AS (
+			UPDATE room_units SET cleanliness = $7 WHERE id = $3 RETURNING id
+		)
+		INSERT INTO housekeeping_tasks (id, hotel_id, room_unit_id, booking_id, status, created_at)
+		SELECT $1, $2, id, $4, $5, $6 FROM dirty
+	`
+
+	result, err := r.db.Exec(query, task.Id, task.HotelId, task.RoomUnitId, task.BookingId, task.Status, task.CreatedAt, room.CleanlinessDirty)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.New("room unit not found")
+	}
+	return nil
+}
+
+func (r *HousekeepingRepo) GetTaskById(taskId uuid.UUID) (*models.HousekeepingTasks, error) {
+	query := `
+		SELECT t.id, t.hotel_id, t.room_unit_id, u.number, t.booking_id, t.status, t.assigned_to, t.created_at, t.claimed_at, t.completed_at
+		FROM housekeeping_tasks t
+		JOIN room_units u ON u.id = t.room_unit_id
+		WHERE t.id = $1
+	`
+
+	var task models.HousekeepingTasks
+	row := r.db.QueryRow(query, taskId)
+	if err := row.Scan(&task.Id, &task.HotelId, &task.RoomUnitId, &task.RoomNumber, &task.BookingId, &task.Status,
+		&task.AssignedTo, &task.CreatedAt, &task.ClaimedAt, &task.CompletedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("housekeeping task not found")
+		}
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (r *HousekeepingRepo) GetOpenTasksByHotelID(hotelID uuid.UUID) ([]*models.HousekeepingTasks, error) {
+	query := `
+		SELECT t.id, t.hotel_id, t.room_unit_id, u.number, t.booking_id, t.status, t.assigned_to, t.created_at, t.claimed_at, t.completed_at
+		FROM housekeeping_tasks t
+		JOIN room_units u ON u.id = t.room_unit_id
+		WHERE t.hotel_id = $1 AND t.status <> $2
+		ORDER BY t.created_at
+	`
+
+	rows, err := r.db.Query(query, hotelID, housekeeping_status.TaskDone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []*models.HousekeepingTasks
+	for rows.Next() {
+		task := &models.HousekeepingTasks{}
+		if err := rows.Scan(&task.Id, &task.HotelId, &task.RoomUnitId, &task.RoomNumber, &task.BookingId, &task.Status,
+			&task.AssignedTo, &task.CreatedAt, &task.ClaimedAt, &task.CompletedAt); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// ClaimTask assigns a pending task to a staff member and moves its unit to cleaning.
+func (r *HousekeepingRepo) ClaimTask(taskId, staffId uuid.UUID, claimedAt time.Time) error {
+	query := `
+		WITH claimed AS (
+			UPDATE housekeeping_tasks
+			SET status = $4, assigned_to = $2, claimed_at = $3
+			WHERE id = $1 AND status = $5
+			RETURNING room_unit_id
+		)
+		UPDATE room_units SET cleanliness = $6 WHERE id IN (SELECT room_unit_id FROM claimed)
+	`
+
+	result, err := r.db.Exec(query, taskId, staffId, claimedAt, housekeeping_status.TaskInProgress, housekeeping_status.TaskPending, room.CleanlinessCleaning)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.New("task not found or already claimed")
+	}
+	return nil
+}
+
+// CompleteTask closes an in-progress task and marks its unit clean.
+func (r *HousekeepingRepo) CompleteTask(taskId uuid.UUID, completedAt time.Time) error {
+	query := `
+		WITH completed AS (
+			UPDATE housekeeping_tasks
+			SET status = $3, completed_at = $2
+			WHERE id = $1 AND status = $4
+			RETURNING room_unit_id
+		)
+		UPDATE room_units SET cleanliness = $5 WHERE id IN (SELECT room_unit_id FROM completed)
+	`
+
+	result, err := r.db.Exec(query, taskId, completedAt, housekeeping_status.TaskDone, housekeeping_status.TaskInProgress, room.CleanlinessClean)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.New("task not found or not in progress")
+	}
+	return nil
+}
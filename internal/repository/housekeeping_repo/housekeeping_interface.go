@@ -0,0 +1,18 @@
+package housekeeping_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=housekeeping_interface.go -destination=../../mocks/mock_housekeeping_repo.go -package=mocks
+
+type HousekeepingRepoInterface interface {
+	CreateTask(*models.HousekeepingTasks) error
+	GetTaskById(taskId uuid.UUID) (*models.HousekeepingTasks, error)
+	GetOpenTasksByHotelID(hotelID uuid.UUID) ([]*models.HousekeepingTasks, error)
+	ClaimTask(taskId, staffId uuid.UUID, claimedAt time.Time) error
+	CompleteTask(taskId uuid.UUID, completedAt time.Time) error
+}
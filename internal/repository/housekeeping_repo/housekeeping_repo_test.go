@@ -0,0 +1,230 @@
+package housekeeping_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	housekeeping_status "github.com/tktanisha/booking_system/internal/enums/housekeeping"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/housekeeping_repo"
+)
+
+var taskColumns = []string{"id", "hotel_id", "room_unit_id", "number", "booking_id", "status", "assigned_to", "created_at", "claimed_at", "completed_at"}
+
+func TestHousekeepingRepo_CreateTask(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, task *models.HousekeepingTasks)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, task *models.HousekeepingTasks) {
+				mock.ExpectExec(`INSERT INTO housekeeping_tasks`).
+					WithArgs(task.Id, task.HotelId, task.RoomUnitId, task.BookingId, task.Status, task.CreatedAt, room.CleanlinessDirty).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "unit not found",
+			setupMocks: func(mock sqlmock.Sqlmock, task *models.HousekeepingTasks) {
+				mock.ExpectExec(`INSERT INTO housekeeping_tasks`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+		{
+			name: "exec error",
+			setupMocks: func(mock sqlmock.Sqlmock, task *models.HousekeepingTasks) {
+				mock.ExpectExec(`INSERT INTO housekeeping_tasks`).WillReturnError(errors.New("insert failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := housekeeping_repo.NewHousekeepingRepo(db)
+			bookingID := uuid.New()
+			task := &models.HousekeepingTasks{
+				Id:         uuid.New(),
+				HotelId:    uuid.New(),
+				RoomUnitId: uuid.New(),
+				BookingId:  &bookingID,
+				Status:     housekeeping_status.TaskPending,
+				CreatedAt:  time.Now(),
+			}
+
+			tt.setupMocks(mock, task)
+			err = repo.CreateTask(task)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestHousekeepingRepo_GetTaskById(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, taskID uuid.UUID)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, taskID uuid.UUID) {
+				rows := sqlmock.NewRows(taskColumns).
+					AddRow(taskID, uuid.New(), uuid.New(), "101", nil, "pending", nil, time.Now(), nil, nil)
+				mock.ExpectQuery(`FROM housekeeping_tasks t`).WithArgs(taskID).WillReturnRows(rows)
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock, taskID uuid.UUID) {
+				mock.ExpectQuery(`FROM housekeeping_tasks t`).WithArgs(taskID).WillReturnRows(sqlmock.NewRows(taskColumns))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := housekeeping_repo.NewHousekeepingRepo(db)
+			taskID := uuid.New()
+
+			tt.setupMocks(mock, taskID)
+			_, err = repo.GetTaskById(taskID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestHousekeepingRepo_GetOpenTasksByHotelID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := housekeeping_repo.NewHousekeepingRepo(db)
+	hotelID := uuid.New()
+	staffID := uuid.New()
+
+	rows := sqlmock.NewRows(taskColumns).
+		AddRow(uuid.New(), hotelID, uuid.New(), "101", uuid.New(), "pending", nil, time.Now(), nil, nil).
+		AddRow(uuid.New(), hotelID, uuid.New(), "102", uuid.New(), "in_progress", staffID, time.Now(), time.Now(), nil)
+	mock.ExpectQuery(`FROM housekeeping_tasks t`).
+		WithArgs(hotelID, housekeeping_status.TaskDone).
+		WillReturnRows(rows)
+
+	tasks, err := repo.GetOpenTasksByHotelID(hotelID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+	if tasks[1].AssignedTo == nil || *tasks[1].AssignedTo != staffID {
+		t.Errorf("expected second task assigned to %v, got %v", staffID, tasks[1].AssignedTo)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestHousekeepingRepo_ClaimTask(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"claimed", 1, false},
+		{"already claimed", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := housekeeping_repo.NewHousekeepingRepo(db)
+			taskID, staffID, now := uuid.New(), uuid.New(), time.Now()
+
+			mock.ExpectExec(`WITH claimed AS`).
+				WithArgs(taskID, staffID, now, housekeeping_status.TaskInProgress, housekeeping_status.TaskPending, room.CleanlinessCleaning).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.ClaimTask(taskID, staffID, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestHousekeepingRepo_CompleteTask(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"completed", 1, false},
+		{"not in progress", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := housekeeping_repo.NewHousekeepingRepo(db)
+			taskID, now := uuid.New(), time.Now()
+
+			mock.ExpectExec(`WITH completed AS`).
+				WithArgs(taskID, now, housekeeping_status.TaskDone, housekeeping_status.TaskInProgress, room.CleanlinessClean).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.CompleteTask(taskID, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package invoice_repo
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var (
+	ErrInvoiceNotFound = errors.New("invoice not found")
+	ErrInvoiceExists   = errors.New("booking already has an invoice")
+)
+
+type InvoiceRepo struct {
+	db db.DB
+}
+
+func NewInvoiceRepo(database db.DB) *InvoiceRepo {
+	return &InvoiceRepo{db: database}
+}
+
+// CreateInvoice takes the next number in the hotel's sequence and stores the
+// invoice in one statement. A failed insert rolls the sequence back with it, so
+// numbers have no gaps.
+func (r *InvoiceRepo) CreateInvoice(invoice *models.Invoices) (*models.Invoices, error) {
+	document, err := json.Marshal(invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		WITH next AS (
+			INSERT INTO invoice_sequences (hotel_id, last_number)
+			VALUES ($2, 1)
+			ON CONFLICT (hotel_id) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+			RETURNING last_number, 'INV-' || LPAD(last_number::text, 6, '0') AS invoice_number
+		)
+		INSERT INTO invoices (id, hotel_id, booking_id, number, invoice_number, issued_at, document)
+		SELECT $1, $2, $3, last_number, invoice_number, $4,
+			$5::jsonb || jsonb_build_object('number', last_number, 'invoice_number', invoice_number)
+		FROM next
+		RETURNING number, invoice_number;
+	`
+
+	row := r.db.QueryRow(query, invoice.Id, invoice.HotelId, invoice.BookingId, invoice.IssuedAt, string(document))
+	if err := row.Scan(&invoice.Number, &invoice.InvoiceNumber); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "invoices_booking_id_key" {
+			return nil, ErrInvoiceExists
+		}
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (r *InvoiceRepo) GetInvoiceByBookingId(bookingId uuid.UUID) (*models.Invoices, error) {
+	query := `SELECT document FROM invoices WHERE booking_id = $1`
+
+	var document []byte
+	if err := r.db.QueryRow(query, bookingId).Scan(&document); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, err
+	}
+
+	invoice := &models.Invoices{}
+	if err := json.Unmarshal(document, invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
@@ -0,0 +1,13 @@
+package invoice_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=invoice_interface.go -destination=../../mocks/mock_invoice_repo.go -package=mocks
+
+type InvoiceRepoInterface interface {
+	CreateInvoice(*models.Invoices) (*models.Invoices, error)
+	GetInvoiceByBookingId(bookingId uuid.UUID) (*models.Invoices, error)
+}
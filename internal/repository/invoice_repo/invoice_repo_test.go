@@ -0,0 +1,144 @@
+package invoice_repo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/invoice_repo"
+)
+
+func TestInvoiceRepo_CreateInvoice(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, invoice *models.Invoices)
+		wantNumber string
+		wantErr    error
+		expectErr  bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, invoice *models.Invoices) {
+				mock.ExpectQuery(`INSERT INTO invoice_sequences (.+) INSERT INTO invoices`).
+					WithArgs(invoice.Id, invoice.HotelId, invoice.BookingId, invoice.IssuedAt, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"number", "invoice_number"}).AddRow(42, "INV-000042"))
+			},
+			wantNumber: "INV-000042",
+		},
+		{
+			name: "booking already invoiced",
+			setupMocks: func(mock sqlmock.Sqlmock, invoice *models.Invoices) {
+				mock.ExpectQuery(`INSERT INTO invoice_sequences`).
+					WillReturnError(&pq.Error{Code: "23505", Constraint: "invoices_booking_id_key"})
+			},
+			wantErr:   invoice_repo.ErrInvoiceExists,
+			expectErr: true,
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, invoice *models.Invoices) {
+				mock.ExpectQuery(`INSERT INTO invoice_sequences`).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := invoice_repo.NewInvoiceRepo(db)
+			invoice := &models.Invoices{Id: uuid.New(), HotelId: uuid.New(), BookingId: uuid.New(), IssuedAt: time.Now(), Total: 20000}
+
+			tt.setupMocks(mock, invoice)
+			got, err := repo.CreateInvoice(invoice)
+
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if !tt.expectErr && (got.InvoiceNumber != tt.wantNumber || got.Number != 42) {
+				t.Errorf("expected number %s, got %d %s", tt.wantNumber, got.Number, got.InvoiceNumber)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestInvoiceRepo_GetInvoiceByBookingId(t *testing.T) {
+	bookingID := uuid.New()
+	document, _ := json.Marshal(&models.Invoices{BookingId: bookingID, InvoiceNumber: "INV-000007", Total: 12500})
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock)
+		wantErr    error
+		expectErr  bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT document FROM invoices WHERE booking_id = \$1`).
+					WithArgs(bookingID).
+					WillReturnRows(sqlmock.NewRows([]string{"document"}).AddRow(document))
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT document FROM invoices`).
+					WillReturnRows(sqlmock.NewRows([]string{"document"}))
+			},
+			wantErr:   invoice_repo.ErrInvoiceNotFound,
+			expectErr: true,
+		},
+		{
+			name: "corrupt document",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT document FROM invoices`).
+					WillReturnRows(sqlmock.NewRows([]string{"document"}).AddRow([]byte("{")))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := invoice_repo.NewInvoiceRepo(db)
+			tt.setupMocks(mock)
+			invoice, err := repo.GetInvoiceByBookingId(bookingID)
+
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if !tt.expectErr && (invoice.InvoiceNumber != "INV-000007" || invoice.Total != 12500) {
+				t.Errorf("invoice not read from document: %+v", invoice)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package media_repo
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrMediaNotFound = errors.New("media not found")
+
+type MediaRepo struct {
+	db db.DB
+}
+
+func NewMediaRepo(database db.DB) *MediaRepo {
+	return &MediaRepo{db: database}
+}
+
+// CreateMedia saves media at the end of its hotel's or room type's photos and
+// sets the position it was given.
+func (r *MediaRepo) CreateMedia(media *models.Media) error {
+	query := `
+		INSERT INTO media (id, hotel_id, room_type_id, content_type, size_bytes, width, height, caption, position, storage_key, thumbnail_key, uploaded_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+			COALESCE((SELECT MAX(position) FROM media WHERE hotel_id = $2 AND room_type_id IS NOT DISTINCT FROM $3), 0) + 1,
+			$9, $10, $11, $12)
+		RETURNING position
+	`
+
+	row := r.db.QueryRow(query, media.Id, media.HotelId, media.RoomTypeId, media.ContentType, media.SizeBytes, media.Width, media.Height,
+		media.Caption, media.StorageKey, media.ThumbnailKey, media.UploadedBy, media.CreatedAt)
+	return row.Scan(&media.Position)
+}
+
+func (r *MediaRepo) GetMediaById(mediaId uuid.UUID) (*models.Media, error) {
+	query := `
+		SELECT id, hotel_id, room_type_id, content_type, size_bytes, width, height, caption, position, storage_key, thumbnail_key, uploaded_by, created_at
+		FROM media
+		WHERE id = $1
+	`
+
+	media, err := scanMedia(r.db.QueryRow(query, mediaId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMediaNotFound
+		}
+		return nil, err
+	}
+	return media, nil
+}
+
+// GetMediaByHotelId returns the photos of a hotel and of its room types, the
+// hotel's own first, each group in display order.
+func (r *MediaRepo) GetMediaByHotelId(hotelId uuid.UUID) ([]*models.Media, error) {
+	query := `
+		SELECT id, hotel_id, room_type_id, content_type, size_bytes, width, height, caption, position, storage_key, thumbnail_key, uploaded_by, created_at
+		FROM media
+		WHERE hotel_id = $1
+		ORDER BY room_type_id NULLS FIRST, position, created_at
+	`
+
+	rows, err := r.db.Query(query, hotelId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	media := make([]*models.Media, 0)
+	for rows.Next() {
+		m, err := scanMedia(rows)
+		if err != nil {
+			return nil, err
+		}
+		media = append(media, m)
+	}
+	return media, rows.Err()
+}
+
+// SetPositions numbers the given media 1, 2, 3... in the order listed. It is a
+// single statement, so either every position changes or none does; it fails
+// with ErrMediaNotFound when any of them is not a photo of the hotel.
+func (r *MediaRepo) SetPositions(hotelId uuid.UUID, mediaIds []uuid.UUID) error {
+	query := `
+		UPDATE media m
+		SET position = o.position
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE m.id = o.id AND m.hotel_id = $1
+		  AND (SELECT COUNT(*) FROM media WHERE id = ANY($2::uuid[]) AND hotel_id = $1) = $3
+	`
+
+	ids := make([]string, len(mediaIds))
+	for i, id := range mediaIds {
+		ids[i] = id.String()
+	}
+
+	result, err := r.db.Exec(query, hotelId, pq.Array(ids), len(mediaIds))
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected != int64(len(mediaIds)) {
+		return ErrMediaNotFound
+	}
+	return nil
+}
+
+func (r *MediaRepo) DeleteMedia(mediaId uuid.UUID) error {
+	result, err := r.db.Exec(`DELETE FROM media WHERE id = $1`, mediaId)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrMediaNotFound
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMedia(row rowScanner) (*models.Media, error) {
+	media := &models.Media{}
+	if err := row.Scan(&media.Id, &media.HotelId, &media.RoomTypeId, &media.ContentType, &media.SizeBytes, &media.Width, &media.Height,
+		&media.Caption, &media.Position, &media.StorageKey, &media.ThumbnailKey, &media.UploadedBy, &media.CreatedAt); err != nil {
+		return nil, err
+	}
+	media.SetURLs()
+	return media, nil
+}
@@ -0,0 +1,16 @@
+package media_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=media_interface.go -destination=../../mocks/mock_media_repo.go -package=mocks
+
+type MediaRepoInterface interface {
+	CreateMedia(*models.Media) error
+	GetMediaById(mediaId uuid.UUID) (*models.Media, error)
+	GetMediaByHotelId(hotelId uuid.UUID) ([]*models.Media, error)
+	SetPositions(hotelId uuid.UUID, mediaIds []uuid.UUID) error
+	DeleteMedia(mediaId uuid.UUID) error
+}
@@ -0,0 +1,165 @@
+package media_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+)
+
+var mediaColumns = []string{"id", "hotel_id", "room_type_id", "content_type", "size_bytes", "width", "height", "caption", "position", "storage_key", "thumbnail_key", "uploaded_by", "created_at"}
+
+func TestMediaRepo_CreateMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	roomTypeID := uuid.New()
+	media := &models.Media{Id: uuid.New(), HotelId: uuid.New(), RoomTypeId: &roomTypeID, ContentType: "image/jpeg", SizeBytes: 2048,
+		Width: 1200, Height: 800, StorageKey: "hotels/a/b.jpg", ThumbnailKey: "hotels/a/b_thumb.jpg", UploadedBy: uuid.New(), CreatedAt: time.Now()}
+
+	mock.ExpectQuery(`INSERT INTO media .+SELECT MAX\(position\) FROM media WHERE hotel_id = \$2 AND room_type_id IS NOT DISTINCT FROM \$3`).
+		WithArgs(media.Id, media.HotelId, media.RoomTypeId, media.ContentType, media.SizeBytes, media.Width, media.Height,
+			media.Caption, media.StorageKey, media.ThumbnailKey, media.UploadedBy, media.CreatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(3))
+
+	if err := media_repo.NewMediaRepo(db).CreateMedia(media); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if media.Position != 3 {
+		t.Errorf("expected position 3, got %d", media.Position)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestMediaRepo_GetMediaById(t *testing.T) {
+	mediaID := uuid.New()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM media\s+WHERE id = \$1`).WithArgs(mediaID).
+					WillReturnRows(sqlmock.NewRows(mediaColumns).AddRow(mediaID, uuid.New(), nil, "image/png", 4096, 640, 480, "Lobby", 1, "k", "t", uuid.New(), time.Now()))
+			},
+		},
+		{
+			name: "not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM media`).WillReturnRows(sqlmock.NewRows(mediaColumns))
+			},
+			wantErr: media_repo.ErrMediaNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setup(mock)
+
+			media, err := media_repo.NewMediaRepo(db).GetMediaById(mediaID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && (media.RoomTypeId != nil || media.URL != "/media/"+mediaID.String()) {
+				t.Errorf("expected hotel media served from its URL, got %+v", media)
+			}
+		})
+	}
+}
+
+func TestMediaRepo_GetMediaByHotelId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID, roomTypeID := uuid.New(), uuid.New()
+	mock.ExpectQuery(`WHERE hotel_id = \$1\s+ORDER BY room_type_id NULLS FIRST, position`).WithArgs(hotelID).
+		WillReturnRows(sqlmock.NewRows(mediaColumns).
+			AddRow(uuid.New(), hotelID, nil, "image/jpeg", 2048, 1200, 800, "", 1, "k1", "t1", uuid.New(), time.Now()).
+			AddRow(uuid.New(), hotelID, roomTypeID, "image/jpeg", 2048, 1200, 800, "", 1, "k2", "t2", uuid.New(), time.Now()))
+
+	media, err := media_repo.NewMediaRepo(db).GetMediaByHotelId(hotelID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(media) != 2 || media[1].RoomTypeId == nil || *media[1].RoomTypeId != roomTypeID {
+		t.Errorf("expected hotel then room type media, got %+v", media)
+	}
+}
+
+func TestMediaRepo_SetPositions(t *testing.T) {
+	hotelID := uuid.New()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	tests := []struct {
+		name     string
+		affected int64
+		execErr  error
+		wantErr  error
+	}{
+		{name: "all reordered", affected: 2},
+		{name: "media from another hotel", affected: 0, wantErr: media_repo.ErrMediaNotFound},
+		{name: "exec error", execErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			exec := mock.ExpectExec(`UPDATE media m\s+SET position = o.position\s+FROM unnest\(\$2::uuid\[\]\) WITH ORDINALITY`).
+				WithArgs(hotelID, "{\""+ids[0].String()+"\",\""+ids[1].String()+"\"}", 2)
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			}
+
+			err = media_repo.NewMediaRepo(db).SetPositions(hotelID, ids)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && (err == nil || err.Error() != tt.wantErr.Error()) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMediaRepo_DeleteMedia(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	mediaID := uuid.New()
+	mock.ExpectExec(`DELETE FROM media WHERE id = \$1`).WithArgs(mediaID).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := media_repo.NewMediaRepo(db).DeleteMedia(mediaID); !errors.Is(err, media_repo.ErrMediaNotFound) {
+		t.Errorf("expected ErrMediaNotFound, got %v", err)
+	}
+}
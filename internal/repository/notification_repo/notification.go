@@ -0,0 +1,55 @@
+package notification_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type NotificationRepo struct {
+	db db.DB
+}
+
+func NewNotificationRepo(database db.DB) *NotificationRepo {
+	return &NotificationRepo{db: database}
+}
+
+func (r *NotificationRepo) CreateNotification(notification *models.Notifications) error {
+	query := `
+		INSERT INTO notifications (id, user_id, subject, body, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.Exec(query, notification.Id, notification.UserId, notification.Subject, notification.Body, notification.CreatedAt)
+	return err
+}
+
+// GetNotificationsByUserId returns a user's notifications, newest first.
+func (r *NotificationRepo) GetNotificationsByUserId(userId uuid.UUID) ([]*models.Notifications, error) {
+	query := `
+		SELECT id, user_id, subject, body, created_at
+		FROM notifications
+		WHERE user_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(query, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := make([]*models.Notifications, 0)
+	for rows.Next() {
+		notification := &models.Notifications{}
+		if err := rows.Scan(&notification.Id, &notification.UserId, &notification.Subject, &notification.Body, &notification.CreatedAt); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
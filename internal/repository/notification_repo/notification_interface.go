@@ -0,0 +1,13 @@
+package notification_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=notification_interface.go -destination=../../mocks/mock_notification_repo.go -package=mocks
+
+type NotificationRepoInterface interface {
+	CreateNotification(*models.Notifications) error
+	GetNotificationsByUserId(userId uuid.UUID) ([]*models.Notifications, error)
+}
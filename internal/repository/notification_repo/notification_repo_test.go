@@ -0,0 +1,52 @@
+package notification_repo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/notification_repo"
+)
+
+func TestNotificationRepo_CreateNotification(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	notification := &models.Notifications{Id: uuid.New(), UserId: uuid.New(), Subject: "Rooms available", Body: "Book now", CreatedAt: time.Now()}
+	mock.ExpectExec(`INSERT INTO notifications`).
+		WithArgs(notification.Id, notification.UserId, notification.Subject, notification.Body, notification.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := notification_repo.NewNotificationRepo(db).CreateNotification(notification); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestNotificationRepo_GetNotificationsByUserId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	userID := uuid.New()
+	mock.ExpectQuery(`FROM notifications\s+WHERE user_id = \$1\s+ORDER BY created_at DESC`).WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "subject", "body", "created_at"}).
+			AddRow(uuid.New(), userID, "Rooms available", "Book now", time.Now()))
+
+	notifications, err := notification_repo.NewNotificationRepo(db).GetNotificationsByUserId(userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifications) != 1 || notifications[0].Subject != "Rooms available" {
+		t.Errorf("expected the user's notification, got %+v", notifications)
+	}
+}
@@ -0,0 +1,158 @@
+package overbooking_repo
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type OverbookingRepo struct {
+	db db.DB
+}
+
+func NewOverbookingRepo(database db.DB) *OverbookingRepo {
+	return &OverbookingRepo{db: database}
+}
+
+// UpsertLimit sets a room type's overbooking limit, replacing any earlier one.
+func (r *OverbookingRepo) UpsertLimit(limit *models.OverbookingLimits) (*models.OverbookingLimits, error) {
+	query := `
+		INSERT INTO overbooking_limits (id, hotel_id, room_type, limit_percent, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (hotel_id, room_type)
+		DO UPDATE SET limit_percent = EXCLUDED.limit_percent, updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, limit.Id, limit.HotelId, limit.RoomType, limit.LimitPercent, limit.UpdatedBy, limit.UpdatedAt)
+	if err := row.Scan(&limit.Id); err != nil {
+		return nil, err
+	}
+	return limit, nil
+}
+
+// GetLimit returns a room type's overbooking limit in percent, or 0 when the
+// hotel has not set one.
+func (r *OverbookingRepo) GetLimit(hotelId uuid.UUID, roomType room.RoomType) (int, error) {
+	query := `SELECT limit_percent FROM overbooking_limits WHERE hotel_id = $1 AND room_type = $2`
+
+	var limitPercent int
+	if err := r.db.QueryRow(query, hotelId, roomType).Scan(&limitPercent); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return limitPercent, nil
+}
+
+func (r *OverbookingRepo) GetLimitsByHotelId(hotelId uuid.UUID) ([]*models.OverbookingLimits, error) {
+	query := `
+		SELECT id, hotel_id, room_type, limit_percent, updated_by, updated_at
+		FROM overbooking_limits
+		WHERE hotel_id = $1
+		ORDER BY room_type
+	`
+
+	rows, err := r.db.Query(query, hotelId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	limits := make([]*models.OverbookingLimits, 0)
+	for rows.Next() {
+		limit := &models.OverbookingLimits{}
+		if err := rows.Scan(&limit.Id, &limit.HotelId, &limit.RoomType, &limit.LimitPercent, &limit.UpdatedBy, &limit.UpdatedAt); err != nil {
+			return nil, err
+		}
+		limits = append(limits, limit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+func (r *OverbookingRepo) CreateAudit(audit *models.OverbookingAudits) error {
+	query := `
+		INSERT INTO overbooking_audits (id, booking_id, hotel_id, room_type, room_quantity, oversold_quantity, physical_quantity, limit_percent, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Exec(query, audit.Id, audit.BookingId, audit.HotelId, audit.RoomType, audit.RoomQuantity,
+		audit.OversoldQuantity, audit.PhysicalQuantity, audit.LimitPercent, audit.CreatedAt)
+	return err
+}
+
+// GetAuditsByHotelId returns a hotel's oversold bookings, newest first.
+func (r *OverbookingRepo) GetAuditsByHotelId(hotelId uuid.UUID) ([]*models.OverbookingAudits, error) {
+	query := `
+		SELECT id, booking_id, hotel_id, room_type, room_quantity, oversold_quantity, physical_quantity, limit_percent, created_at
+		FROM overbooking_audits
+		WHERE hotel_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(query, hotelId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	audits := make([]*models.OverbookingAudits, 0)
+	for rows.Next() {
+		audit := &models.OverbookingAudits{}
+		if err := rows.Scan(&audit.Id, &audit.BookingId, &audit.HotelId, &audit.RoomType, &audit.RoomQuantity,
+			&audit.OversoldQuantity, &audit.PhysicalQuantity, &audit.LimitPercent, &audit.CreatedAt); err != nil {
+			return nil, err
+		}
+		audits = append(audits, audit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return audits, nil
+}
+
+// GetBookedStays returns the rooms of confirmed or checked-in bookings whose
+// stay overlaps [from, to), most recently booked first.
+func (r *OverbookingRepo) GetBookedStays(hotelId uuid.UUID, from, to time.Time) ([]*models.BookedStays, error) {
+	query := `
+		SELECT b.id, b.user_id, br.room_type, br.room_quantity, b.checkin, b.checkout, b.status = $3, b.created_at
+		FROM booked_rooms br
+		JOIN bookings b ON b.id = br.booking_id
+		WHERE b.hotel_id = $1 AND b.status IN ($2, $3)
+		AND b.checkin < $5 AND b.checkout > $4
+		ORDER BY b.created_at DESC, br.room_type
+	`
+
+	rows, err := r.db.Query(query, hotelId, booking_status.StatusConfirmed, booking_status.StatusCheckedIn, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stays := make([]*models.BookedStays, 0)
+	for rows.Next() {
+		stay := &models.BookedStays{}
+		if err := rows.Scan(&stay.BookingId, &stay.UserId, &stay.RoomType, &stay.Quantity, &stay.CheckIn, &stay.CheckOut,
+			&stay.CheckedIn, &stay.CreatedAt); err != nil {
+			return nil, err
+		}
+		stays = append(stays, stay)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return stays, nil
+}
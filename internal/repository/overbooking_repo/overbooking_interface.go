@@ -0,0 +1,20 @@
+package overbooking_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=overbooking_interface.go -destination=../../mocks/mock_overbooking_repo.go -package=mocks
+
+type OverbookingRepoInterface interface {
+	UpsertLimit(*models.OverbookingLimits) (*models.OverbookingLimits, error)
+	GetLimit(hotelId uuid.UUID, roomType room.RoomType) (int, error)
+	GetLimitsByHotelId(hotelId uuid.UUID) ([]*models.OverbookingLimits, error)
+	CreateAudit(*models.OverbookingAudits) error
+	GetAuditsByHotelId(hotelId uuid.UUID) ([]*models.OverbookingAudits, error)
+	GetBookedStays(hotelId uuid.UUID, from, to time.Time) ([]*models.BookedStays, error)
+}
@@ -0,0 +1,118 @@
+package overbooking_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/overbooking_repo"
+)
+
+func TestOverbookingRepo_UpsertLimit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	limit := &models.OverbookingLimits{Id: uuid.New(), HotelId: uuid.New(), RoomType: room.Double, LimitPercent: 10, UpdatedBy: uuid.New(), UpdatedAt: time.Now()}
+	existingID := uuid.New()
+	mock.ExpectQuery(`INSERT INTO overbooking_limits .* ON CONFLICT \(hotel_id, room_type\)`).
+		WithArgs(limit.Id, limit.HotelId, limit.RoomType, limit.LimitPercent, limit.UpdatedBy, limit.UpdatedAt).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(existingID))
+
+	saved, err := overbooking_repo.NewOverbookingRepo(db).UpsertLimit(limit)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// an update keeps the id of the limit it replaces
+	if saved.Id != existingID {
+		t.Errorf("expected id %v, got %v", existingID, saved.Id)
+	}
+}
+
+func TestOverbookingRepo_GetLimit(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name  string
+		rows  *sqlmock.Rows
+		err   error
+		want  int
+		isErr bool
+	}{
+		{name: "limit set", rows: sqlmock.NewRows([]string{"limit_percent"}).AddRow(15), want: 15},
+		{name: "no limit set", rows: sqlmock.NewRows([]string{"limit_percent"}), want: 0},
+		{name: "db error", err: errors.New("db error"), isErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			query := mock.ExpectQuery(`SELECT limit_percent FROM overbooking_limits WHERE hotel_id = \$1 AND room_type = \$2`).WithArgs(hotelID, room.Double)
+			if tt.err != nil {
+				query.WillReturnError(tt.err)
+			} else {
+				query.WillReturnRows(tt.rows)
+			}
+
+			got, err := overbooking_repo.NewOverbookingRepo(db).GetLimit(hotelID, room.Double)
+			if (err != nil) != tt.isErr || got != tt.want {
+				t.Errorf("GetLimit() = %d, %v; want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverbookingRepo_CreateAudit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	audit := &models.OverbookingAudits{Id: uuid.New(), BookingId: uuid.New(), HotelId: uuid.New(), RoomType: room.Double,
+		RoomQuantity: 3, OversoldQuantity: 2, PhysicalQuantity: 20, LimitPercent: 10, CreatedAt: time.Now()}
+	mock.ExpectExec(`INSERT INTO overbooking_audits`).
+		WithArgs(audit.Id, audit.BookingId, audit.HotelId, audit.RoomType, audit.RoomQuantity, audit.OversoldQuantity,
+			audit.PhysicalQuantity, audit.LimitPercent, audit.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := overbooking_repo.NewOverbookingRepo(db).CreateAudit(audit); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestOverbookingRepo_GetBookedStays(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID, now := uuid.New(), time.Now()
+	from, to := now, now.AddDate(0, 0, 7)
+	mock.ExpectQuery(`WHERE b.hotel_id = \$1 AND b.status IN \(\$2, \$3\)\s+AND b.checkin < \$5 AND b.checkout > \$4`).
+		WithArgs(hotelID, booking_status.StatusConfirmed, booking_status.StatusCheckedIn, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "room_type", "room_quantity", "checkin", "checkout", "checked_in", "created_at"}).
+			AddRow(uuid.New(), uuid.New(), "double", 2, now.AddDate(0, 0, 1), now.AddDate(0, 0, 3), false, now).
+			AddRow(uuid.New(), uuid.New(), "suite", 1, now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), true, now.AddDate(0, 0, -5)))
+
+	stays, err := overbooking_repo.NewOverbookingRepo(db).GetBookedStays(hotelID, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stays) != 2 || stays[0].Quantity != 2 || stays[0].CheckedIn || !stays[1].CheckedIn {
+		t.Errorf("unexpected stays %+v", stays)
+	}
+}
@@ -0,0 +1,162 @@
+package promo_code_repo
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var (
+	ErrPromoCodeNotFound  = errors.New("promo code not found")
+	ErrPromoCodeExhausted = errors.New("promo code usage limit reached")
+)
+
+type PromoCodeRepo struct {
+	db db.DB
+}
+
+func NewPromoCodeRepo(database db.DB) *PromoCodeRepo {
+	return &PromoCodeRepo{db: database}
+}
+
+func (r *PromoCodeRepo) CreatePromoCode(promoCode *models.PromoCodes) (*models.PromoCodes, error) {
+	query := `
+		INSERT INTO promo_codes (id, code, hotel_id, discount_type, value, valid_from, valid_to, max_uses, per_user_limit, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, promoCode.Id, promoCode.Code, promoCode.HotelId, promoCode.DiscountType, promoCode.Value,
+		promoCode.ValidFrom, promoCode.ValidTo, promoCode.MaxUses, promoCode.PerUserLimit, promoCode.CreatedBy, promoCode.CreatedAt)
+	if err := row.Scan(&promoCode.Id); err != nil {
+		return nil, err
+	}
+	return promoCode, nil
+}
+
+func (r *PromoCodeRepo) GetPromoCodeByCode(code string) (*models.PromoCodes, error) {
+	query := `
+		SELECT id, code, hotel_id, discount_type, value, valid_from, valid_to, max_uses, per_user_limit, used_count, created_by, created_at
+		FROM promo_codes
+		WHERE code = $1
+	`
+
+	promoCode, err := scanPromoCode(r.db.QueryRow(query, code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPromoCodeNotFound
+		}
+		return nil, err
+	}
+	return promoCode, nil
+}
+
+// GetPromoCodesByHotelID lists the codes redeemable at the hotel, including those valid at every hotel.
+func (r *PromoCodeRepo) GetPromoCodesByHotelID(hotelID uuid.UUID) ([]*models.PromoCodes, error) {
+	query := `
+		SELECT id, code, hotel_id, discount_type, value, valid_from, valid_to, max_uses, per_user_limit, used_count, created_by, created_at
+		FROM promo_codes
+		WHERE hotel_id = $1 OR hotel_id IS NULL
+		ORDER BY valid_from DESC
+	`
+
+	rows, err := r.db.Query(query, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var promoCodes []*models.PromoCodes
+	for rows.Next() {
+		promoCode, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, err
+		}
+		promoCodes = append(promoCodes, promoCode)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return promoCodes, nil
+}
+
+// RedeemPromoCode counts one use of the code against its caps and records the
+// redemption in a single statement. The update holds the promo code row lock, so
+// concurrent redemptions re-check max_uses against the committed count and can
+// never push it past the cap. The user's own uses are counted in their
+// promo_user_uses row, whose check constraint aborts the whole statement, the
+// used_count increment included, once the per-user limit would be passed.
+func (r *PromoCodeRepo) RedeemPromoCode(promoCodeId, userId, bookingId uuid.UUID) error {
+	query := `
+		WITH claimed AS (
+			UPDATE promo_codes
+			SET used_count = used_count + 1
+			WHERE id = $1
+			AND (max_uses = 0 OR used_count < max_uses)
+			RETURNING id, per_user_limit
+		), user_uses AS (
+			INSERT INTO promo_user_uses (promo_code_id, user_id, use_count, use_limit)
+			SELECT id, $2, 1, per_user_limit FROM claimed
+			ON CONFLICT (promo_code_id, user_id)
+			DO UPDATE SET use_count = promo_user_uses.use_count + 1
+		)
+		INSERT INTO promo_redemptions (id, promo_code_id, user_id, booking_id, created_at)
+		SELECT $3, id, $2, $4, $5 FROM claimed
+	`
+
+	result, err := r.db.Exec(query, promoCodeId, userId, uuid.New(), bookingId, time.Now())
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23514" && pqErr.Constraint == "chk_promo_user_limit" {
+			return ErrPromoCodeExhausted
+		}
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrPromoCodeExhausted
+	}
+	return nil
+}
+
+// ReleaseRedemption gives back the use counted for a booking that could not be created.
+func (r *PromoCodeRepo) ReleaseRedemption(bookingId uuid.UUID) error {
+	query := `
+		WITH released AS (
+			DELETE FROM promo_redemptions WHERE booking_id = $1 RETURNING promo_code_id, user_id
+		), user_released AS (
+			UPDATE promo_user_uses
+			SET use_count = GREATEST(promo_user_uses.use_count - 1, 0)
+			FROM released
+			WHERE promo_user_uses.promo_code_id = released.promo_code_id
+			AND promo_user_uses.user_id = released.user_id
+		)
+		UPDATE promo_codes
+		SET used_count = used_count - 1
+		WHERE id IN (SELECT promo_code_id FROM released)
+	`
+
+	_, err := r.db.Exec(query, bookingId)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPromoCode(row rowScanner) (*models.PromoCodes, error) {
+	promoCode := &models.PromoCodes{}
+	if err := row.Scan(&promoCode.Id, &promoCode.Code, &promoCode.HotelId, &promoCode.DiscountType, &promoCode.Value,
+		&promoCode.ValidFrom, &promoCode.ValidTo, &promoCode.MaxUses, &promoCode.PerUserLimit, &promoCode.UsedCount,
+		&promoCode.CreatedBy, &promoCode.CreatedAt); err != nil {
+		return nil, err
+	}
+	return promoCode, nil
+}
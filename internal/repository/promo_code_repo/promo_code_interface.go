@@ -0,0 +1,16 @@
+package promo_code_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=promo_code_interface.go -destination=../../mocks/mock_promo_code_repo.go -package=mocks
+
+type PromoCodeRepoInterface interface {
+	CreatePromoCode(*models.PromoCodes) (*models.PromoCodes, error)
+	GetPromoCodeByCode(code string) (*models.PromoCodes, error)
+	GetPromoCodesByHotelID(hotelID uuid.UUID) ([]*models.PromoCodes, error)
+	RedeemPromoCode(promoCodeId, userId, bookingId uuid.UUID) error
+	ReleaseRedemption(bookingId uuid.UUID) error
+}
@@ -0,0 +1,232 @@
+package promo_code_repo_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/promo_code_repo"
+)
+
+var promoColumns = []string{"id", "code", "hotel_id", "discount_type", "value", "valid_from", "valid_to", "max_uses", "per_user_limit", "used_count", "created_by", "created_at"}
+
+func TestPromoCodeRepo_CreatePromoCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, promoCode *models.PromoCodes)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, promoCode *models.PromoCodes) {
+				mock.ExpectQuery(`INSERT INTO promo_codes`).
+					WithArgs(promoCode.Id, promoCode.Code, promoCode.HotelId, promoCode.DiscountType, promoCode.Value,
+						promoCode.ValidFrom, promoCode.ValidTo, promoCode.MaxUses, promoCode.PerUserLimit, promoCode.CreatedBy, promoCode.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(promoCode.Id))
+			},
+		},
+		{
+			name: "duplicate code",
+			setupMocks: func(mock sqlmock.Sqlmock, promoCode *models.PromoCodes) {
+				mock.ExpectQuery(`INSERT INTO promo_codes`).WillReturnError(errors.New("duplicate key value"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := promo_code_repo.NewPromoCodeRepo(db)
+			promoCode := &models.PromoCodes{
+				Id:           uuid.New(),
+				Code:         "SUMMER10",
+				DiscountType: promo.Percentage,
+				Value:        10,
+				ValidFrom:    time.Now(),
+				ValidTo:      time.Now().Add(30 * 24 * time.Hour),
+				MaxUses:      100,
+				PerUserLimit: 1,
+				CreatedBy:    uuid.New(),
+				CreatedAt:    time.Now(),
+			}
+
+			tt.setupMocks(mock, promoCode)
+			_, err = repo.CreatePromoCode(promoCode)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPromoCodeRepo_GetPromoCodeByCode(t *testing.T) {
+	hotelID := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock)
+		wantHotel  bool
+		wantErr    error
+	}{
+		{
+			name: "hotel scoped code",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(promoColumns).
+					AddRow(uuid.New(), "SUMMER10", hotelID, "percentage", 10, now, now.Add(time.Hour), 100, 1, 3, uuid.New(), now)
+				mock.ExpectQuery(`FROM promo_codes`).WithArgs("SUMMER10").WillReturnRows(rows)
+			},
+			wantHotel: true,
+		},
+		{
+			name: "code valid at every hotel",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(promoColumns).
+					AddRow(uuid.New(), "SUMMER10", nil, "fixed", 5000, now, now.Add(time.Hour), 0, 0, 0, uuid.New(), now)
+				mock.ExpectQuery(`FROM promo_codes`).WithArgs("SUMMER10").WillReturnRows(rows)
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM promo_codes`).WithArgs("SUMMER10").WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: promo_code_repo.ErrPromoCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := promo_code_repo.NewPromoCodeRepo(db)
+			tt.setupMocks(mock)
+
+			promoCode, err := repo.GetPromoCodeByCode("SUMMER10")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && (promoCode.HotelId != nil) != tt.wantHotel {
+				t.Errorf("expected hotel scope %v, got %v", tt.wantHotel, promoCode.HotelId)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPromoCodeRepo_GetPromoCodesByHotelID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := promo_code_repo.NewPromoCodeRepo(db)
+	hotelID := uuid.New()
+	now := time.Now()
+
+	rows := sqlmock.NewRows(promoColumns).
+		AddRow(uuid.New(), "SUMMER10", hotelID, "percentage", 10, now, now.Add(time.Hour), 100, 1, 3, uuid.New(), now).
+		AddRow(uuid.New(), "WELCOME", nil, "fixed", 5000, now, now.Add(time.Hour), 0, 1, 0, uuid.New(), now)
+	mock.ExpectQuery(`WHERE hotel_id = \$1 OR hotel_id IS NULL`).WithArgs(hotelID).WillReturnRows(rows)
+
+	promoCodes, err := repo.GetPromoCodesByHotelID(hotelID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(promoCodes) != 2 {
+		t.Errorf("expected 2 promo codes, got %d", len(promoCodes))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestPromoCodeRepo_RedeemPromoCode(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		execErr      error
+		wantErr      error
+	}{
+		{name: "redeemed", rowsAffected: 1},
+		{name: "cap reached", rowsAffected: 0, wantErr: promo_code_repo.ErrPromoCodeExhausted},
+		{name: "per-user limit reached", execErr: &pq.Error{Code: "23514", Constraint: "chk_promo_user_limit"}, wantErr: promo_code_repo.ErrPromoCodeExhausted},
+		{name: "db error", execErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := promo_code_repo.NewPromoCodeRepo(db)
+			promoID, userID, bookingID := uuid.New(), uuid.New(), uuid.New()
+
+			expect := mock.ExpectExec(`UPDATE promo_codes\s+SET used_count = used_count \+ 1(.|\n)*INSERT INTO promo_user_uses`).
+				WithArgs(promoID, userID, sqlmock.AnyArg(), bookingID, sqlmock.AnyArg())
+			if tt.execErr != nil {
+				expect.WillReturnError(tt.execErr)
+			} else {
+				expect.WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			}
+
+			err = repo.RedeemPromoCode(promoID, userID, bookingID)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if errors.Is(tt.wantErr, promo_code_repo.ErrPromoCodeExhausted) && !errors.Is(err, promo_code_repo.ErrPromoCodeExhausted) {
+				t.Errorf("expected ErrPromoCodeExhausted, got %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPromoCodeRepo_ReleaseRedemption(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := promo_code_repo.NewPromoCodeRepo(db)
+	bookingID := uuid.New()
+
+	mock.ExpectExec(`DELETE FROM promo_redemptions WHERE booking_id = \$1(.|\n)*UPDATE promo_user_uses`).WithArgs(bookingID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := repo.ReleaseRedemption(bookingID); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package report_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type ReportRepo struct {
+	db db.DB
+}
+
+func NewReportRepo(database db.DB) *ReportRepo {
+	return &ReportRepo{db: database}
+}
+
+// GetStayNights adds up, per period and room type, the room nights booked for
+// the nights between from and to, which are midnights in the hotel's time
+// zone. Each stay is spread over its nights in hotel time, so a stay crossing
+// a period boundary counts in both periods. Confirmed, checked-in and
+// checked-out bookings count as sold at their nightly rate; cancelled
+// bookings count as cancelled room nights. No-shows count as neither.
+func (r *ReportRepo) GetStayNights(hotelId uuid.UUID, timeZone string, from, to time.Time, groupBy report.Grouping) ([]*models.ReportNights, error) {
+	query := `
+		SELECT date_trunc($5, night)::date AS period, br.room_type,
+			COALESCE(SUM(br.room_quantity) FILTER (WHERE b.status IN ($6, $7, $8)), 0) AS room_nights_sold,
+			COALESCE(SUM(br.room_quantity * br.price_per_night) FILTER (WHERE b.status IN ($6, $7, $8)), 0) AS room_revenue,
+			COALESCE(SUM(br.room_quantity) FILTER (WHERE b.status = $9), 0) AS cancelled_room_nights
+		FROM bookings b
+		JOIN booked_rooms br ON br.booking_id = b.id
+		CROSS JOIN LATERAL generate_series(
+			(b.checkin AT TIME ZONE $2)::date,
+			(b.checkout AT TIME ZONE $2)::date - 1,
+			INTERVAL '1 day'
+		) AS night
+		WHERE b.hotel_id = $1 AND b.checkin < $4 AND b.checkout > $3
+		AND night >= ($3 AT TIME ZONE $2) AND night < ($4 AT TIME ZONE $2)
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`
+
+	rows, err := r.db.Query(query, hotelId, timeZone, from, to, string(groupBy),
+		booking_status.StatusConfirmed, booking_status.StatusCheckedIn, booking_status.StatusCheckedOut, booking_status.StatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nights := make([]*models.ReportNights, 0)
+	for rows.Next() {
+		n := &models.ReportNights{}
+		if err := rows.Scan(&n.Period, &n.RoomType, &n.RoomNightsSold, &n.RoomRevenue, &n.CancelledRoomNights); err != nil {
+			return nil, err
+		}
+		nights = append(nights, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return nights, nil
+}
+
+// GetPickup adds up, per period and room type, the room nights of bookings
+// made between from and to, whatever nights they are for. Bookings cancelled
+// since still count, as pickup is measured when the booking is made.
+func (r *ReportRepo) GetPickup(hotelId uuid.UUID, timeZone string, from, to time.Time, groupBy report.Grouping) ([]*models.ReportNights, error) {
+	query := `
+		SELECT date_trunc($5, b.created_at AT TIME ZONE $2)::date AS period, br.room_type,
+			SUM(br.room_quantity * ((b.checkout AT TIME ZONE $2)::date - (b.checkin AT TIME ZONE $2)::date)) AS pickup_room_nights
+		FROM bookings b
+		JOIN booked_rooms br ON br.booking_id = b.id
+		WHERE b.hotel_id = $1 AND b.created_at >= $3 AND b.created_at < $4
+		GROUP BY 1, 2
+		ORDER BY 1, 2
+	`
+
+	rows, err := r.db.Query(query, hotelId, timeZone, from, to, string(groupBy))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nights := make([]*models.ReportNights, 0)
+	for rows.Next() {
+		n := &models.ReportNights{}
+		if err := rows.Scan(&n.Period, &n.RoomType, &n.PickupRoomNights); err != nil {
+			return nil, err
+		}
+		nights = append(nights, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return nights, nil
+}
@@ -0,0 +1,16 @@
+package report_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=report_interface.go -destination=../../mocks/mock_report_repo.go -package=mocks
+
+type ReportRepoInterface interface {
+	GetStayNights(hotelId uuid.UUID, timeZone string, from, to time.Time, groupBy report.Grouping) ([]*models.ReportNights, error)
+	GetPickup(hotelId uuid.UUID, timeZone string, from, to time.Time, groupBy report.Grouping) ([]*models.ReportNights, error)
+}
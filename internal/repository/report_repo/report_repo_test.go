@@ -0,0 +1,87 @@
+package report_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/repository/report_repo"
+)
+
+func TestReportRepo_GetStayNights(t *testing.T) {
+	hotelID := uuid.New()
+	from, to := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		err     error
+		wantLen int
+	}{
+		{
+			name: "nights grouped by period and room type",
+			rows: sqlmock.NewRows([]string{"period", "room_type", "room_nights_sold", "room_revenue", "cancelled_room_nights"}).
+				AddRow(from, "double", 42, int64(420000), 3).
+				AddRow(from, "suite", 5, int64(150000), 0),
+			wantLen: 2,
+		},
+		{name: "db error", err: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			query := mock.ExpectQuery(`generate_series\(\s+\(b.checkin AT TIME ZONE \$2\)::date,\s+\(b.checkout AT TIME ZONE \$2\)::date - 1`).
+				WithArgs(hotelID, "Asia/Kolkata", from, to, "month", booking_status.StatusConfirmed, booking_status.StatusCheckedIn,
+					booking_status.StatusCheckedOut, booking_status.StatusCancelled)
+			if tt.err != nil {
+				query.WillReturnError(tt.err)
+			} else {
+				query.WillReturnRows(tt.rows)
+			}
+
+			nights, err := report_repo.NewReportRepo(db).GetStayNights(hotelID, "Asia/Kolkata", from, to, report.GroupByMonth)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if len(nights) != tt.wantLen {
+				t.Fatalf("expected %d rows, got %d", tt.wantLen, len(nights))
+			}
+			if tt.wantLen > 0 && (nights[0].RoomType != room.Double || nights[0].RoomNightsSold != 42 || nights[0].RoomRevenue != 420000 || nights[0].CancelledRoomNights != 3) {
+				t.Errorf("unexpected row %+v", nights[0])
+			}
+		})
+	}
+}
+
+func TestReportRepo_GetPickup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID := uuid.New()
+	from, to := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 5, 8, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`WHERE b.hotel_id = \$1 AND b.created_at >= \$3 AND b.created_at < \$4`).
+		WithArgs(hotelID, "UTC", from, to, "day").
+		WillReturnRows(sqlmock.NewRows([]string{"period", "room_type", "pickup_room_nights"}).AddRow(from, "double", 6))
+
+	pickup, err := report_repo.NewReportRepo(db).GetPickup(hotelID, "UTC", from, to, report.GroupByDay)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(pickup) != 1 || pickup[0].PickupRoomNights != 6 || pickup[0].RoomNightsSold != 0 {
+		t.Errorf("unexpected pickup %+v", pickup)
+	}
+}
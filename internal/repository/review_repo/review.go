@@ -0,0 +1,148 @@
+package review_repo
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var (
+	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewExists   = errors.New("booking has already been reviewed")
+)
+
+type ReviewRepo struct {
+	db db.DB
+}
+
+func NewReviewRepo(database db.DB) *ReviewRepo {
+	return &ReviewRepo{db: database}
+}
+
+func (r *ReviewRepo) CreateReview(review *models.Reviews) error {
+	query := `
+		INSERT INTO reviews (id, hotel_id, booking_id, user_id, rating, title, body, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.Exec(query, review.Id, review.HotelId, review.BookingId, review.UserId, review.Rating, review.Title, review.Body, review.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "reviews_booking_id_key" {
+			return ErrReviewExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *ReviewRepo) GetReviewById(reviewId uuid.UUID) (*models.Reviews, error) {
+	query := `
+		SELECT id, hotel_id, booking_id, user_id, rating, title, body, reply, replied_by, replied_at, created_at
+		FROM reviews
+		WHERE id = $1
+	`
+
+	review, err := scanReview(r.db.QueryRow(query, reviewId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReviewNotFound
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+// GetReviewsByHotelId returns the reviews of a hotel, newest first.
+func (r *ReviewRepo) GetReviewsByHotelId(hotelId uuid.UUID) ([]*models.Reviews, error) {
+	query := `
+		SELECT id, hotel_id, booking_id, user_id, rating, title, body, reply, replied_by, replied_at, created_at
+		FROM reviews
+		WHERE hotel_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.Query(query, hotelId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := make([]*models.Reviews, 0)
+	for rows.Next() {
+		review, err := scanReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, rows.Err()
+}
+
+func (r *ReviewRepo) SaveReply(review *models.Reviews) error {
+	query := `UPDATE reviews SET reply = $1, replied_by = $2, replied_at = $3 WHERE id = $4`
+
+	result, err := r.db.Exec(query, review.Reply, review.RepliedBy, review.RepliedAt, review.Id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrReviewNotFound
+	}
+	return nil
+}
+
+// GetRatingSummary counts a hotel's reviews per star and works out the average.
+// A hotel without reviews has a zero summary.
+func (r *ReviewRepo) GetRatingSummary(hotelId uuid.UUID) (*models.RatingSummary, error) {
+	query := `SELECT rating, COUNT(*) FROM reviews WHERE hotel_id = $1 GROUP BY rating`
+
+	rows, err := r.db.Query(query, hotelId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	summary := &models.RatingSummary{Distribution: map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0}}
+	total := 0
+	for rows.Next() {
+		var rating, count int
+		if err := rows.Scan(&rating, &count); err != nil {
+			return nil, err
+		}
+		summary.Distribution[rating] = count
+		summary.Count += count
+		total += rating * count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if summary.Count > 0 {
+		// one decimal, as ratings are shown
+		summary.Average = math.Round(float64(total)/float64(summary.Count)*10) / 10
+	}
+	return summary, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanReview(row rowScanner) (*models.Reviews, error) {
+	review := &models.Reviews{}
+	if err := row.Scan(&review.Id, &review.HotelId, &review.BookingId, &review.UserId, &review.Rating, &review.Title,
+		&review.Body, &review.Reply, &review.RepliedBy, &review.RepliedAt, &review.CreatedAt); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
@@ -0,0 +1,16 @@
+package review_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=review_interface.go -destination=../../mocks/mock_review_repo.go -package=mocks
+
+type ReviewRepoInterface interface {
+	CreateReview(review *models.Reviews) error
+	GetReviewById(reviewId uuid.UUID) (*models.Reviews, error)
+	GetReviewsByHotelId(hotelId uuid.UUID) ([]*models.Reviews, error)
+	SaveReply(review *models.Reviews) error
+	GetRatingSummary(hotelId uuid.UUID) (*models.RatingSummary, error)
+}
@@ -0,0 +1,272 @@
+package review_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+)
+
+var reviewColumns = []string{"id", "hotel_id", "booking_id", "user_id", "rating", "title", "body", "reply", "replied_by", "replied_at", "created_at"}
+
+func TestReviewRepo_CreateReview(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, review *models.Reviews)
+		wantErr    error
+		expectErr  bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, review *models.Reviews) {
+				mock.ExpectExec(`INSERT INTO reviews`).
+					WithArgs(review.Id, review.HotelId, review.BookingId, review.UserId, review.Rating, review.Title, review.Body, review.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "booking already reviewed",
+			setupMocks: func(mock sqlmock.Sqlmock, review *models.Reviews) {
+				mock.ExpectExec(`INSERT INTO reviews`).
+					WillReturnError(&pq.Error{Code: "23505", Constraint: "reviews_booking_id_key"})
+			},
+			wantErr:   review_repo.ErrReviewExists,
+			expectErr: true,
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, review *models.Reviews) {
+				mock.ExpectExec(`INSERT INTO reviews`).WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := review_repo.NewReviewRepo(db)
+			review := &models.Reviews{Id: uuid.New(), HotelId: uuid.New(), BookingId: uuid.New(), UserId: uuid.New(), Rating: 5, Body: "Lovely stay", CreatedAt: time.Now()}
+
+			tt.setupMocks(mock, review)
+			err = repo.CreateReview(review)
+
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestReviewRepo_GetReviewById(t *testing.T) {
+	reviewID := uuid.New()
+	managerID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock)
+		wantReply  bool
+		wantErr    error
+		expectErr  bool
+	}{
+		{
+			name: "review without reply",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM reviews WHERE id = \$1`).WithArgs(reviewID).
+					WillReturnRows(sqlmock.NewRows(reviewColumns).
+						AddRow(reviewID, uuid.New(), uuid.New(), uuid.New(), 4, "", "Good", "", nil, nil, time.Now()))
+			},
+		},
+		{
+			name: "review with reply",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM reviews WHERE id = \$1`).WithArgs(reviewID).
+					WillReturnRows(sqlmock.NewRows(reviewColumns).
+						AddRow(reviewID, uuid.New(), uuid.New(), uuid.New(), 2, "", "Noisy", "Sorry, we have fixed the windows", managerID, time.Now(), time.Now()))
+			},
+			wantReply: true,
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT (.+) FROM reviews`).WithArgs(reviewID).WillReturnRows(sqlmock.NewRows(reviewColumns))
+			},
+			wantErr:   review_repo.ErrReviewNotFound,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setupMocks(mock)
+			review, err := review_repo.NewReviewRepo(db).GetReviewById(reviewID)
+
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if !tt.expectErr && review.HasReply() != tt.wantReply {
+				t.Errorf("expected reply: %v, got %+v", tt.wantReply, review)
+			}
+			if tt.wantReply && *review.RepliedBy != managerID {
+				t.Errorf("expected reply by %v, got %v", managerID, *review.RepliedBy)
+			}
+		})
+	}
+}
+
+func TestReviewRepo_GetReviewsByHotelId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID := uuid.New()
+	mock.ExpectQuery(`SELECT (.+) FROM reviews WHERE hotel_id = \$1 ORDER BY created_at DESC`).WithArgs(hotelID).
+		WillReturnRows(sqlmock.NewRows(reviewColumns).
+			AddRow(uuid.New(), hotelID, uuid.New(), uuid.New(), 5, "Perfect", "Great breakfast", "", nil, nil, time.Now()).
+			AddRow(uuid.New(), hotelID, uuid.New(), uuid.New(), 3, "", "Fine", "", nil, nil, time.Now()))
+
+	reviews, err := review_repo.NewReviewRepo(db).GetReviewsByHotelId(hotelID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reviews) != 2 || reviews[0].Title != "Perfect" {
+		t.Errorf("expected two reviews in order, got %+v", reviews)
+	}
+}
+
+type driverResult struct {
+	rows int64
+	err  error
+}
+
+func TestReviewRepo_SaveReply(t *testing.T) {
+	now := time.Now()
+	managerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		result    driverResult
+		wantErr   error
+		expectErr bool
+	}{
+		{"reply saved", driverResult{rows: 1}, nil, false},
+		{"review missing", driverResult{rows: 0}, review_repo.ErrReviewNotFound, true},
+		{"update fails", driverResult{err: errors.New("db error")}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			review := &models.Reviews{Id: uuid.New(), Reply: "Thank you!", RepliedBy: &managerID, RepliedAt: &now}
+			expect := mock.ExpectExec(`UPDATE reviews SET reply = \$1, replied_by = \$2, replied_at = \$3 WHERE id = \$4`).
+				WithArgs(review.Reply, review.RepliedBy, review.RepliedAt, review.Id)
+			if tt.result.err != nil {
+				expect.WillReturnError(tt.result.err)
+			} else {
+				expect.WillReturnResult(sqlmock.NewResult(0, tt.result.rows))
+			}
+
+			err = review_repo.NewReviewRepo(db).SaveReply(review)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReviewRepo_GetRatingSummary(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name        string
+		rows        *sqlmock.Rows
+		queryErr    error
+		wantAverage float64
+		wantCount   int
+		expectErr   bool
+	}{
+		{
+			name:        "averages and counts per star",
+			rows:        sqlmock.NewRows([]string{"rating", "count"}).AddRow(5, 2).AddRow(4, 1).AddRow(1, 1),
+			wantAverage: 3.8,
+			wantCount:   4,
+		},
+		{
+			name:        "no reviews",
+			rows:        sqlmock.NewRows([]string{"rating", "count"}),
+			wantAverage: 0,
+			wantCount:   0,
+		},
+		{
+			name:      "query fails",
+			queryErr:  errors.New("db error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			expect := mock.ExpectQuery(`SELECT rating, COUNT\(\*\) FROM reviews WHERE hotel_id = \$1 GROUP BY rating`).WithArgs(hotelID)
+			if tt.queryErr != nil {
+				expect.WillReturnError(tt.queryErr)
+			} else {
+				expect.WillReturnRows(tt.rows)
+			}
+
+			summary, err := review_repo.NewReviewRepo(db).GetRatingSummary(hotelID)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.expectErr {
+				return
+			}
+			if summary.Average != tt.wantAverage || summary.Count != tt.wantCount {
+				t.Errorf("expected %v from %d reviews, got %v from %d", tt.wantAverage, tt.wantCount, summary.Average, summary.Count)
+			}
+			if len(summary.Distribution) != 5 {
+				t.Errorf("expected a count for every star, got %v", summary.Distribution)
+			}
+		})
+	}
+}
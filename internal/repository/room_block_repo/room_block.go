@@ -0,0 +1,134 @@
+package room_block_repo
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrRoomBlockNotFound = errors.New("room block not found")
+
+type RoomBlockRepo struct {
+	db db.DB
+}
+
+func NewRoomBlockRepo(database db.DB) *RoomBlockRepo {
+	return &RoomBlockRepo{db: database}
+}
+
+func (r *RoomBlockRepo) CreateBlock(block *models.RoomBlocks) (*models.RoomBlocks, error) {
+	query := `
+		INSERT INTO room_blocks (id, hotel_id, whole_hotel, room_category, room_unit_id, quantity, start_date, end_date, reason, forced, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, block.Id, block.HotelId, block.WholeHotel, block.RoomCategory, block.RoomUnitId, block.Quantity,
+		block.StartDate, block.EndDate, block.Reason, block.Forced, block.CreatedBy, block.CreatedAt)
+	if err := row.Scan(&block.Id); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *RoomBlockRepo) GetBlocksByHotelID(hotelID uuid.UUID) ([]*models.RoomBlocks, error) {
+	query := `
+		SELECT id, hotel_id, whole_hotel, room_category, room_unit_id, quantity, start_date, end_date, reason, forced, created_by, created_at
+		FROM room_blocks
+		WHERE hotel_id = $1
+		ORDER BY start_date
+	`
+
+	rows, err := r.db.Query(query, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []*models.RoomBlocks
+	for rows.Next() {
+		block := &models.RoomBlocks{}
+		if err := rows.Scan(&block.Id, &block.HotelId, &block.WholeHotel, &block.RoomCategory, &block.RoomUnitId, &block.Quantity,
+			&block.StartDate, &block.EndDate, &block.Reason, &block.Forced, &block.CreatedBy, &block.CreatedAt); err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return blocks, nil
+}
+
+func (r *RoomBlockRepo) GetBlockById(blockId uuid.UUID) (*models.RoomBlocks, error) {
+	query := `
+		SELECT id, hotel_id, whole_hotel, room_category, room_unit_id, quantity, start_date, end_date, reason, forced, created_by, created_at
+		FROM room_blocks
+		WHERE id = $1
+	`
+
+	block := &models.RoomBlocks{}
+	err := r.db.QueryRow(query, blockId).Scan(&block.Id, &block.HotelId, &block.WholeHotel, &block.RoomCategory, &block.RoomUnitId, &block.Quantity,
+		&block.StartDate, &block.EndDate, &block.Reason, &block.Forced, &block.CreatedBy, &block.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoomBlockNotFound
+		}
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *RoomBlockRepo) DeleteBlock(blockId uuid.UUID) error {
+	query := `DELETE FROM room_blocks WHERE id = $1`
+
+	result, err := r.db.Exec(query, blockId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrRoomBlockNotFound
+	}
+	return nil
+}
+
+// GetBlockedQuantity sums the blocks of a category that overlap [from, to).
+// Whole-hotel blocks are not counted; see IsHotelClosed.
+func (r *RoomBlockRepo) GetBlockedQuantity(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(quantity), 0)
+		FROM room_blocks
+		WHERE hotel_id = $1 AND room_category = $2 AND start_date < $4 AND end_date > $3
+	`
+
+	var blocked int
+	if err := r.db.QueryRow(query, hotelID, roomType, from, to).Scan(&blocked); err != nil {
+		return 0, err
+	}
+	return blocked, nil
+}
+
+// IsHotelClosed reports whether a whole-hotel block overlaps [from, to).
+func (r *RoomBlockRepo) IsHotelClosed(hotelID uuid.UUID, from, to time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM room_blocks
+			WHERE hotel_id = $1 AND whole_hotel AND start_date < $3 AND end_date > $2
+		)
+	`
+
+	var closed bool
+	if err := r.db.QueryRow(query, hotelID, from, to).Scan(&closed); err != nil {
+		return false, err
+	}
+	return closed, nil
+}
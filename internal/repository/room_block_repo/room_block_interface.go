@@ -0,0 +1,20 @@
+package room_block_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=room_block_interface.go -destination=../../mocks/mock_room_block_repo.go -package=mocks
+
+type RoomBlockRepoInterface interface {
+	CreateBlock(*models.RoomBlocks) (*models.RoomBlocks, error)
+	GetBlocksByHotelID(hotelID uuid.UUID) ([]*models.RoomBlocks, error)
+	GetBlockById(blockId uuid.UUID) (*models.RoomBlocks, error)
+	DeleteBlock(blockId uuid.UUID) error
+	GetBlockedQuantity(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error)
+	IsHotelClosed(hotelID uuid.UUID, from, to time.Time) (bool, error)
+}
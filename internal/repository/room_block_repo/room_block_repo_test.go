@@ -0,0 +1,276 @@
+package room_block_repo_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_block_repo"
+)
+
+func TestRoomBlockRepo_CreateBlock(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, block *models.RoomBlocks)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, block *models.RoomBlocks) {
+				mock.ExpectQuery(`INSERT INTO room_blocks`).
+					WithArgs(block.Id, block.HotelId, block.WholeHotel, block.RoomCategory, block.RoomUnitId, block.Quantity,
+						block.StartDate, block.EndDate, block.Reason, block.Forced, block.CreatedBy, block.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(block.Id))
+			},
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, block *models.RoomBlocks) {
+				mock.ExpectQuery(`INSERT INTO room_blocks`).WillReturnError(errors.New("insert failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_block_repo.NewRoomBlockRepo(db)
+			start := time.Now()
+			block := &models.RoomBlocks{
+				Id:           uuid.New(),
+				HotelId:      uuid.New(),
+				RoomCategory: room.Double,
+				Quantity:     2,
+				StartDate:    start,
+				EndDate:      start.Add(48 * time.Hour),
+				Reason:       "renovation",
+				CreatedBy:    uuid.New(),
+				CreatedAt:    time.Now(),
+			}
+
+			tt.setupMocks(mock, block)
+			_, err = repo.CreateBlock(block)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomBlockRepo_GetBlocksByHotelID(t *testing.T) {
+	columns := []string{"id", "hotel_id", "whole_hotel", "room_category", "room_unit_id", "quantity", "start_date", "end_date", "reason", "forced", "created_by", "created_at"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		wantCount  int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				now := time.Now()
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), hotelID, false, "double", nil, 2, now, now.Add(time.Hour), "renovation", false, uuid.New(), now).
+					AddRow(uuid.New(), hotelID, false, "suite", uuid.New(), 1, now, now.Add(time.Hour), "broken AC", true, uuid.New(), now).
+					AddRow(uuid.New(), hotelID, true, "", nil, 0, now, now.Add(time.Hour), "closed for the season", false, uuid.New(), now)
+				mock.ExpectQuery(`FROM room_blocks`).WithArgs(hotelID).WillReturnRows(rows)
+			},
+			wantCount: 3,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`FROM room_blocks`).WithArgs(hotelID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_block_repo.NewRoomBlockRepo(db)
+			hotelID := uuid.New()
+
+			tt.setupMocks(mock, hotelID)
+			blocks, err := repo.GetBlocksByHotelID(hotelID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(blocks) != tt.wantCount {
+				t.Errorf("expected %d blocks, got %d", tt.wantCount, len(blocks))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomBlockRepo_GetBlockById(t *testing.T) {
+	columns := []string{"id", "hotel_id", "whole_hotel", "room_category", "room_unit_id", "quantity", "start_date", "end_date", "reason", "forced", "created_by", "created_at"}
+	blockID := uuid.New()
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				now := time.Now()
+				mock.ExpectQuery(`FROM room_blocks`).WithArgs(blockID).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(blockID, hotelID, false, "double", nil, 2, now, now.Add(time.Hour), "renovation", false, uuid.New(), now))
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM room_blocks`).WithArgs(blockID).WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: room_block_repo.ErrRoomBlockNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_block_repo.NewRoomBlockRepo(db)
+			tt.setupMocks(mock)
+
+			block, err := repo.GetBlockById(blockID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && block.HotelId != hotelID {
+				t.Errorf("expected hotel %s, got %s", hotelID, block.HotelId)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomBlockRepo_DeleteBlock(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"success", 1, false},
+		{"not found", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_block_repo.NewRoomBlockRepo(db)
+			blockID := uuid.New()
+
+			mock.ExpectExec(`DELETE FROM room_blocks`).WithArgs(blockID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.DeleteBlock(blockID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if tt.wantErr && !errors.Is(err, room_block_repo.ErrRoomBlockNotFound) {
+				t.Errorf("expected ErrRoomBlockNotFound, got %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomBlockRepo_GetBlockedQuantity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := room_block_repo.NewRoomBlockRepo(db)
+	hotelID := uuid.New()
+	from := time.Now()
+	to := from.Add(72 * time.Hour)
+
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(quantity\), 0\)`).
+		WithArgs(hotelID, room.Single, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(3))
+
+	blocked, err := repo.GetBlockedQuantity(hotelID, room.Single, from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if blocked != 3 {
+		t.Errorf("expected 3 blocked rooms, got %d", blocked)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestRoomBlockRepo_IsHotelClosed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := room_block_repo.NewRoomBlockRepo(db)
+	hotelID := uuid.New()
+	from := time.Now()
+	to := from.Add(72 * time.Hour)
+
+	mock.ExpectQuery(`SELECT EXISTS`).
+		WithArgs(hotelID, from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	closed, err := repo.IsHotelClosed(hotelID, from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !closed {
+		t.Errorf("expected the hotel to be closed")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
@@ -1,11 +1,15 @@
 package room_repo
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/db"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/models"
 )
 
@@ -19,12 +23,12 @@ func NewRoomRepo(database db.DB) *RoomRepository {
 
 func (rr *RoomRepository) CreateRoom(room *models.Rooms) (*models.Rooms, error) {
 	query := `
-		INSERT INTO rooms (id, hotel_id, available_quantity, room_category, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO rooms (id, hotel_id, available_quantity, room_category, price, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id;
 	`
 
-	row := rr.db.QueryRow(query, room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt)
+	row := rr.db.QueryRow(query, room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt)
 	if err := row.Scan(&room.Id); err != nil {
 		return nil, err
 	}
@@ -33,9 +37,10 @@ func (rr *RoomRepository) CreateRoom(room *models.Rooms) (*models.Rooms, error)
 
 func (rr *RoomRepository) GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Rooms, error) {
 	query := `
-		SELECT id, hotel_id, available_quantity, room_category, created_at
-		FROM rooms
-		WHERE hotel_id = $1
+		SELECT r.id, r.hotel_id, r.available_quantity, r.room_category, r.price, h.currency, r.created_at
+		FROM rooms r
+		JOIN hotels h ON h.id = r.hotel_id
+		WHERE r.hotel_id = $1
 	`
 
 	rows, err := rr.db.Query(query, hotelID)
@@ -47,7 +52,7 @@ func (rr *RoomRepository) GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Room
 	var rooms []*models.Rooms
 	for rows.Next() {
 		room := &models.Rooms{}
-		if err := rows.Scan(&room.Id, &room.HotelId, &room.AvailableQuantity, &room.RoomCategory, &room.CreatedAt); err != nil {
+		if err := rows.Scan(&room.Id, &room.HotelId, &room.AvailableQuantity, &room.RoomCategory, &room.Price, &room.Currency, &room.CreatedAt); err != nil {
 			return nil, err
 		}
 		rooms = append(rooms, room)
@@ -64,11 +69,11 @@ func (rr *RoomRepository) GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Room
 func (rr *RoomRepository) UpdateRoom(room *models.Rooms) (*models.Rooms, error) {
 	query := `
 		UPDATE rooms
-		SET hotel_id=$2, available_quantity=$3, room_category=$4, created_at=$5
+		SET hotel_id=$2, available_quantity=$3, room_category=$4, price=$5, created_at=$6
 		WHERE id=$1
 	`
 
-	result, err := rr.db.Exec(query, room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt)
+	result, err := rr.db.Exec(query, room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -80,3 +85,64 @@ func (rr *RoomRepository) UpdateRoom(room *models.Rooms) (*models.Rooms, error)
 
 	return room, nil
 }
+
+// SyncAvailabilityFromUnits recomputes a category's available quantity as its active units
+// minus the rooms held by confirmed or checked-in bookings. The result is negative while the
+// category is oversold. Categories without units are left untouched.
+func (rr *RoomRepository) SyncAvailabilityFromUnits(roomID uuid.UUID) error {
+	query := `
+		UPDATE rooms r
+		SET available_quantity =
+			(SELECT COUNT(*) FROM room_units u WHERE u.room_id = r.id AND u.status = $2)
+			- (SELECT COALESCE(SUM(br.room_quantity), 0)
+				FROM booked_rooms br
+				JOIN bookings b ON b.id = br.booking_id
+				WHERE b.hotel_id = r.hotel_id AND br.room_type = r.room_category AND b.status IN ($3, $4))
+		WHERE r.id = $1 AND EXISTS (SELECT 1 FROM room_units u WHERE u.room_id = r.id)
+	`
+
+	_, err := rr.db.Exec(query, roomID, room.UnitActive, booking_status.StatusConfirmed, booking_status.StatusCheckedIn)
+	return err
+}
+
+// GetTotalQuantity returns the size of a category: the rooms still available plus the
+// rooms held by confirmed or checked-in bookings.
+func (rr *RoomRepository) GetTotalQuantity(hotelID uuid.UUID, roomType room.RoomType) (int, error) {
+	query := `
+		SELECT r.available_quantity + COALESCE((
+			SELECT SUM(br.room_quantity)
+			FROM booked_rooms br
+			JOIN bookings b ON b.id = br.booking_id
+			WHERE b.hotel_id = r.hotel_id AND br.room_type = r.room_category AND b.status IN ($3, $4)), 0)
+		FROM rooms r
+		WHERE r.hotel_id = $1 AND r.room_category = $2
+	`
+
+	var total int
+	err := rr.db.QueryRow(query, hotelID, roomType, booking_status.StatusConfirmed, booking_status.StatusCheckedIn).Scan(&total)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errors.New("room type not found")
+		}
+		return 0, err
+	}
+	return total, nil
+}
+
+// GetBookedQuantity sums the rooms of a category held by confirmed or checked-in bookings overlapping [from, to).
+func (rr *RoomRepository) GetBookedQuantity(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(br.room_quantity), 0)
+		FROM booked_rooms br
+		JOIN bookings b ON b.id = br.booking_id
+		WHERE b.hotel_id = $1 AND br.room_type = $2 AND b.status IN ($3, $4)
+		AND b.checkin < $6 AND b.checkout > $5
+	`
+
+	var booked int
+	err := rr.db.QueryRow(query, hotelID, roomType, booking_status.StatusConfirmed, booking_status.StatusCheckedIn, from, to).Scan(&booked)
+	if err != nil {
+		return 0, err
+	}
+	return booked, nil
+}
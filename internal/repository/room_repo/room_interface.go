@@ -1,7 +1,10 @@
 package room_repo
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/models"
 )
 
@@ -11,4 +14,7 @@ type RoomRepoInterface interface {
 	CreateRoom(*models.Rooms) (*models.Rooms, error)
 	GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Rooms, error)
 	UpdateRoom(*models.Rooms) (*models.Rooms, error)
+	SyncAvailabilityFromUnits(roomID uuid.UUID) error
+	GetTotalQuantity(hotelID uuid.UUID, roomType room.RoomType) (int, error)
+	GetBookedQuantity(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error)
 }
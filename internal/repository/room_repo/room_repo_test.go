@@ -30,11 +30,11 @@ func TestRoomRepository_CreateRoom(t *testing.T) {
 			mockBehavior: func(mock sqlmock.Sqlmock, room *models.Rooms) {
 				rows := sqlmock.NewRows([]string{"id"}).AddRow(room.Id)
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					INSERT INTO rooms (id, hotel_id, available_quantity, room_category, created_at)
-					VALUES ($1, $2, $3, $4, $5)
+					INSERT INTO rooms (id, hotel_id, available_quantity, room_category, price, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6)
 					RETURNING id;
 				`)).
-					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt).
+					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt).
 					WillReturnRows(rows)
 			},
 			expectedError: false,
@@ -50,11 +50,11 @@ func TestRoomRepository_CreateRoom(t *testing.T) {
 			},
 			mockBehavior: func(mock sqlmock.Sqlmock, room *models.Rooms) {
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					INSERT INTO rooms (id, hotel_id, available_quantity, room_category, created_at)
-					VALUES ($1, $2, $3, $4, $5)
+					INSERT INTO rooms (id, hotel_id, available_quantity, room_category, price, created_at)
+					VALUES ($1, $2, $3, $4, $5, $6)
 					RETURNING id;
 				`)).
-					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt).
+					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt).
 					WillReturnError(errors.New("insert failed"))
 			},
 			expectedError: true,
@@ -94,14 +94,15 @@ func TestRoomRepository_GetAllRoomByHotelID(t *testing.T) {
 			name: "Success - Rooms Found",
 			mockBehavior: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
 				rows := sqlmock.NewRows([]string{
-					"id", "hotel_id", "available_quantity", "room_category", "created_at",
+					"id", "hotel_id", "available_quantity", "room_category", "price", "currency", "created_at",
 				}).
-					AddRow(uuid.New(), hotelID, 10, "Single", time.Now()).
-					AddRow(uuid.New(), hotelID, 3, "Double", time.Now())
+					AddRow(uuid.New(), hotelID, 10, "Single", 450000, "USD", time.Now()).
+					AddRow(uuid.New(), hotelID, 3, "Double", 650000, "USD", time.Now())
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					SELECT id, hotel_id, available_quantity, room_category, created_at
-					FROM rooms
-					WHERE hotel_id = $1
+					SELECT r.id, r.hotel_id, r.available_quantity, r.room_category, r.price, h.currency, r.created_at
+					FROM rooms r
+					JOIN hotels h ON h.id = r.hotel_id
+					WHERE r.hotel_id = $1
 				`)).WithArgs(hotelID).WillReturnRows(rows)
 			},
 			expectedError: false,
@@ -110,9 +111,10 @@ func TestRoomRepository_GetAllRoomByHotelID(t *testing.T) {
 			name: "Failure - Query Error",
 			mockBehavior: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					SELECT id, hotel_id, available_quantity, room_category, created_at
-					FROM rooms
-					WHERE hotel_id = $1
+					SELECT r.id, r.hotel_id, r.available_quantity, r.room_category, r.price, h.currency, r.created_at
+					FROM rooms r
+					JOIN hotels h ON h.id = r.hotel_id
+					WHERE r.hotel_id = $1
 				`)).WithArgs(hotelID).WillReturnError(errors.New("query failed"))
 			},
 			expectedError: true,
@@ -121,13 +123,14 @@ func TestRoomRepository_GetAllRoomByHotelID(t *testing.T) {
 			name: "Failure - Scan Error",
 			mockBehavior: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
 				rows := sqlmock.NewRows([]string{
-					"id", "hotel_id", "available_quantity", "room_category", "created_at",
+					"id", "hotel_id", "available_quantity", "room_category", "price", "currency", "created_at",
 				}).
-					AddRow("invalid-uuid", hotelID, 5, "single", time.Now())
+					AddRow("invalid-uuid", hotelID, 5, "single", 450000, "USD", time.Now())
 				mock.ExpectQuery(regexp.QuoteMeta(`
-					SELECT id, hotel_id, available_quantity, room_category, created_at
-					FROM rooms
-					WHERE hotel_id = $1
+					SELECT r.id, r.hotel_id, r.available_quantity, r.room_category, r.price, h.currency, r.created_at
+					FROM rooms r
+					JOIN hotels h ON h.id = r.hotel_id
+					WHERE r.hotel_id = $1
 				`)).WithArgs(hotelID).WillReturnRows(rows)
 			},
 			expectedError: true,
@@ -173,10 +176,10 @@ func TestRoomRepository_UpdateRoom(t *testing.T) {
 			mockBehavior: func(mock sqlmock.Sqlmock, room *models.Rooms) {
 				mock.ExpectExec(regexp.QuoteMeta(`
 					UPDATE rooms
-					SET hotel_id=$2, available_quantity=$3, room_category=$4, created_at=$5
+					SET hotel_id=$2, available_quantity=$3, room_category=$4, price=$5, created_at=$6
 					WHERE id=$1
 				`)).
-					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt).
+					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt).
 					WillReturnResult(sqlmock.NewResult(0, 1)) // 1 row affected
 			},
 			expectedError: nil,
@@ -193,10 +196,10 @@ func TestRoomRepository_UpdateRoom(t *testing.T) {
 			mockBehavior: func(mock sqlmock.Sqlmock, room *models.Rooms) {
 				mock.ExpectExec(regexp.QuoteMeta(`
 					UPDATE rooms
-					SET hotel_id=$2, available_quantity=$3, room_category=$4, created_at=$5
+					SET hotel_id=$2, available_quantity=$3, room_category=$4, price=$5, created_at=$6
 					WHERE id=$1
 				`)).
-					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt).
+					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			expectedError: errors.New("room not found"),
@@ -213,10 +216,10 @@ func TestRoomRepository_UpdateRoom(t *testing.T) {
 			mockBehavior: func(mock sqlmock.Sqlmock, room *models.Rooms) {
 				mock.ExpectExec(regexp.QuoteMeta(`
 					UPDATE rooms
-					SET hotel_id=$2, available_quantity=$3, room_category=$4, created_at=$5
+					SET hotel_id=$2, available_quantity=$3, room_category=$4, price=$5, created_at=$6
 					WHERE id=$1
 				`)).
-					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.CreatedAt).
+					WithArgs(room.Id, room.HotelId, room.AvailableQuantity, room.RoomCategory, room.Price, room.CreatedAt).
 					WillReturnError(errors.New("update failed"))
 			},
 			expectedError: errors.New("update failed"),
@@ -262,3 +265,133 @@ func TestRoomRepository_UpdateRoom(t *testing.T) {
 func containsError(got, want string) bool {
 	return regexp.MustCompile(regexp.QuoteMeta(want)).MatchString(got)
 }
+
+func TestRoomRepository_SyncAvailabilityFromUnits(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockBehavior func(mock sqlmock.Sqlmock, roomID uuid.UUID)
+		wantErr      bool
+	}{
+		{
+			name: "Success - Availability Synced",
+			mockBehavior: func(mock sqlmock.Sqlmock, roomID uuid.UUID) {
+				mock.ExpectExec(`UPDATE rooms r\s+SET available_quantity`).
+					WithArgs(roomID, "active", "confirmed", "checked_in").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "Failure - Update Error",
+			mockBehavior: func(mock sqlmock.Sqlmock, roomID uuid.UUID) {
+				mock.ExpectExec(`UPDATE rooms r\s+SET available_quantity`).
+					WillReturnError(errors.New("update failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("error opening mock db: %s", err)
+			}
+			defer db.Close()
+
+			roomID := uuid.New()
+			tt.mockBehavior(mock, roomID)
+
+			repo := NewRoomRepo(db)
+			err = repo.SyncAvailabilityFromUnits(roomID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRoomRepository_GetTotalQuantity(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockBehavior func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		want         int
+		wantErr      bool
+	}{
+		{
+			name: "Success - Available Plus Held",
+			mockBehavior: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`SELECT r.available_quantity \+ COALESCE`).
+					WithArgs(hotelID, "single", "confirmed", "checked_in").
+					WillReturnRows(sqlmock.NewRows([]string{"total"}).AddRow(7))
+			},
+			want: 7,
+		},
+		{
+			name: "Failure - Room Type Not Found",
+			mockBehavior: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`SELECT r.available_quantity \+ COALESCE`).
+					WillReturnRows(sqlmock.NewRows([]string{"total"}))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("error opening mock db: %s", err)
+			}
+			defer db.Close()
+
+			hotelID := uuid.New()
+			tt.mockBehavior(mock, hotelID)
+
+			repo := NewRoomRepo(db)
+			got, err := repo.GetTotalQuantity(hotelID, "single")
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestRoomRepository_GetBookedQuantity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("error opening mock db: %s", err)
+	}
+	defer db.Close()
+
+	hotelID := uuid.New()
+	from := time.Now()
+	to := from.Add(48 * time.Hour)
+
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(br.room_quantity\), 0\)`).
+		WithArgs(hotelID, "double", "confirmed", "checked_in", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(4))
+
+	repo := NewRoomRepo(db)
+	booked, err := repo.GetBookedQuantity(hotelID, "double", from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if booked != 4 {
+		t.Errorf("expected 4 booked rooms, got %d", booked)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %s", err)
+	}
+}
@@ -0,0 +1,133 @@
+package room_type_repo
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrRoomTypeNotFound = errors.New("room type not found")
+
+type RoomTypeRepo struct {
+	db db.DB
+}
+
+func NewRoomTypeRepo(database db.DB) *RoomTypeRepo {
+	return &RoomTypeRepo{db: database}
+}
+
+func (r *RoomTypeRepo) CreateRoomType(roomType *models.RoomTypes) (*models.RoomTypes, error) {
+	query := `
+		INSERT INTO room_types (id, hotel_id, code, name, max_occupancy, base_occupancy, extra_guest_fee, bed_configuration, size_sqm, amenities, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, roomType.Id, roomType.HotelId, roomType.Code, roomType.Name, roomType.MaxOccupancy,
+		roomType.BaseOccupancy, roomType.ExtraGuestFee, roomType.BedConfiguration, roomType.SizeSqm, pq.Array(roomType.Amenities), roomType.CreatedAt)
+	if err := row.Scan(&roomType.Id); err != nil {
+		return nil, err
+	}
+	return roomType, nil
+}
+
+func (r *RoomTypeRepo) GetRoomTypeById(typeId uuid.UUID) (*models.RoomTypes, error) {
+	query := `
+		SELECT id, hotel_id, code, name, max_occupancy, base_occupancy, extra_guest_fee, bed_configuration, size_sqm, amenities, created_at
+		FROM room_types
+		WHERE id = $1
+	`
+	return r.queryRoomType(query, typeId)
+}
+
+func (r *RoomTypeRepo) GetRoomTypeByCode(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error) {
+	query := `
+		SELECT id, hotel_id, code, name, max_occupancy, base_occupancy, extra_guest_fee, bed_configuration, size_sqm, amenities, created_at
+		FROM room_types
+		WHERE hotel_id = $1 AND code = $2
+	`
+	return r.queryRoomType(query, hotelID, code)
+}
+
+func (r *RoomTypeRepo) GetRoomTypesByHotelID(hotelID uuid.UUID) ([]*models.RoomTypes, error) {
+	query := `
+		SELECT id, hotel_id, code, name, max_occupancy, base_occupancy, extra_guest_fee, bed_configuration, size_sqm, amenities, created_at
+		FROM room_types
+		WHERE hotel_id = $1
+		ORDER BY code
+	`
+
+	rows, err := r.db.Query(query, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roomTypes []*models.RoomTypes
+	for rows.Next() {
+		roomType := &models.RoomTypes{}
+		if err := rows.Scan(&roomType.Id, &roomType.HotelId, &roomType.Code, &roomType.Name, &roomType.MaxOccupancy,
+			&roomType.BaseOccupancy, &roomType.ExtraGuestFee, &roomType.BedConfiguration, &roomType.SizeSqm, pq.Array(&roomType.Amenities), &roomType.CreatedAt); err != nil {
+			return nil, err
+		}
+		roomTypes = append(roomTypes, roomType)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return roomTypes, nil
+}
+
+// UpdateRoomType rewrites the descriptive attributes of a type; its hotel and code never change.
+func (r *RoomTypeRepo) UpdateRoomType(roomType *models.RoomTypes) (*models.RoomTypes, error) {
+	query := `
+		UPDATE room_types
+		SET name = $2, max_occupancy = $3, base_occupancy = $4, extra_guest_fee = $5, bed_configuration = $6, size_sqm = $7, amenities = $8
+		WHERE id = $1
+	`
+
+	result, err := r.db.Exec(query, roomType.Id, roomType.Name, roomType.MaxOccupancy, roomType.BaseOccupancy, roomType.ExtraGuestFee,
+		roomType.BedConfiguration, roomType.SizeSqm, pq.Array(roomType.Amenities))
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return nil, ErrRoomTypeNotFound
+	}
+	return roomType, nil
+}
+
+func (r *RoomTypeRepo) DeleteRoomType(typeId uuid.UUID) error {
+	query := `DELETE FROM room_types WHERE id = $1`
+
+	result, err := r.db.Exec(query, typeId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrRoomTypeNotFound
+	}
+	return nil
+}
+
+func (r *RoomTypeRepo) queryRoomType(query string, args ...interface{}) (*models.RoomTypes, error) {
+	roomType := &models.RoomTypes{}
+	err := r.db.QueryRow(query, args...).Scan(&roomType.Id, &roomType.HotelId, &roomType.Code, &roomType.Name,
+		&roomType.MaxOccupancy, &roomType.BaseOccupancy, &roomType.ExtraGuestFee, &roomType.BedConfiguration, &roomType.SizeSqm, pq.Array(&roomType.Amenities), &roomType.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRoomTypeNotFound
+		}
+		return nil, err
+	}
+	return roomType, nil
+}
@@ -0,0 +1,18 @@
+package room_type_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=room_type_interface.go -destination=../../mocks/mock_room_type_repo.go -package=mocks
+
+type RoomTypeRepoInterface interface {
+	CreateRoomType(*models.RoomTypes) (*models.RoomTypes, error)
+	GetRoomTypeById(typeId uuid.UUID) (*models.RoomTypes, error)
+	GetRoomTypeByCode(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error)
+	GetRoomTypesByHotelID(hotelID uuid.UUID) ([]*models.RoomTypes, error)
+	UpdateRoomType(*models.RoomTypes) (*models.RoomTypes, error)
+	DeleteRoomType(typeId uuid.UUID) error
+}
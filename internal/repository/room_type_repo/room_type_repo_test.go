@@ -0,0 +1,224 @@
+package room_type_repo_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+)
+
+var roomTypeColumns = []string{"id", "hotel_id", "code", "name", "max_occupancy", "base_occupancy", "extra_guest_fee", "bed_configuration", "size_sqm", "amenities", "created_at"}
+
+func TestRoomTypeRepo_CreateRoomType(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, roomType *models.RoomTypes)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, roomType *models.RoomTypes) {
+				mock.ExpectQuery(`INSERT INTO room_types`).
+					WithArgs(roomType.Id, roomType.HotelId, roomType.Code, roomType.Name, roomType.MaxOccupancy,
+						roomType.BaseOccupancy, roomType.ExtraGuestFee, roomType.BedConfiguration, roomType.SizeSqm, pq.Array(roomType.Amenities), roomType.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(roomType.Id))
+			},
+		},
+		{
+			name: "duplicate code",
+			setupMocks: func(mock sqlmock.Sqlmock, roomType *models.RoomTypes) {
+				mock.ExpectQuery(`INSERT INTO room_types`).WillReturnError(errors.New("duplicate key value"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_type_repo.NewRoomTypeRepo(db)
+			roomType := &models.RoomTypes{
+				Id:               uuid.New(),
+				HotelId:          uuid.New(),
+				Code:             "villa",
+				Name:             "Garden Villa",
+				MaxOccupancy:     6,
+				BaseOccupancy:    4,
+				ExtraGuestFee:    5000,
+				BedConfiguration: "2 king beds",
+				SizeSqm:          120,
+				Amenities:        []string{"private pool", "kitchen"},
+				CreatedAt:        time.Now(),
+			}
+
+			tt.setupMocks(mock, roomType)
+			_, err = repo.CreateRoomType(roomType)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomTypeRepo_GetRoomTypeByCode(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock)
+		wantErr    error
+	}{
+		{
+			name: "found",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(roomTypeColumns).
+					AddRow(uuid.New(), hotelID, "dorm_bed", "Dorm Bed", 1, 1, 0, "1 bunk bed", 8, "{lockers,\"shared bathroom\"}", time.Now())
+				mock.ExpectQuery(`FROM room_types`).WithArgs(hotelID, room.RoomType("dorm_bed")).WillReturnRows(rows)
+			},
+		},
+		{
+			name: "not registered",
+			setupMocks: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM room_types`).WithArgs(hotelID, room.RoomType("dorm_bed")).WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: room_type_repo.ErrRoomTypeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_type_repo.NewRoomTypeRepo(db)
+			tt.setupMocks(mock)
+
+			roomType, err := repo.GetRoomTypeByCode(hotelID, "dorm_bed")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && len(roomType.Amenities) != 2 {
+				t.Errorf("expected 2 amenities, got %v", roomType.Amenities)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomTypeRepo_GetRoomTypesByHotelID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := room_type_repo.NewRoomTypeRepo(db)
+	hotelID := uuid.New()
+
+	rows := sqlmock.NewRows(roomTypeColumns).
+		AddRow(uuid.New(), hotelID, "family", "Family Room", 4, 2, 1500, "1 double, 2 singles", 35, "{}", time.Now()).
+		AddRow(uuid.New(), hotelID, "villa", "Garden Villa", 6, 4, 5000, "2 king beds", 120, "{\"private pool\"}", time.Now())
+	mock.ExpectQuery(`FROM room_types`).WithArgs(hotelID).WillReturnRows(rows)
+
+	roomTypes, err := repo.GetRoomTypesByHotelID(hotelID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(roomTypes) != 2 || roomTypes[1].Code != "villa" {
+		t.Errorf("unexpected room types: %+v", roomTypes)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestRoomTypeRepo_UpdateRoomType(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"updated", 1, false},
+		{"not found", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_type_repo.NewRoomTypeRepo(db)
+			roomType := &models.RoomTypes{Id: uuid.New(), Name: "Family Room", MaxOccupancy: 5, BaseOccupancy: 2, ExtraGuestFee: 1500, Amenities: []string{"crib"}}
+
+			mock.ExpectExec(`UPDATE room_types`).
+				WithArgs(roomType.Id, roomType.Name, roomType.MaxOccupancy, roomType.BaseOccupancy, roomType.ExtraGuestFee, roomType.BedConfiguration, roomType.SizeSqm, pq.Array(roomType.Amenities)).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			_, err = repo.UpdateRoomType(roomType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomTypeRepo_DeleteRoomType(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"deleted", 1, false},
+		{"not found", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_type_repo.NewRoomTypeRepo(db)
+			typeID := uuid.New()
+
+			mock.ExpectExec(`DELETE FROM room_types`).WithArgs(typeID).WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.DeleteRoomType(typeID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
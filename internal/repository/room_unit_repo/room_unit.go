@@ -0,0 +1,179 @@
+package room_unit_repo
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+type RoomUnitRepo struct {
+	db db.DB
+}
+
+func NewRoomUnitRepo(database db.DB) *RoomUnitRepo {
+	return &RoomUnitRepo{db: database}
+}
+
+func (r *RoomUnitRepo) CreateUnit(unit *models.RoomUnits) (*models.RoomUnits, error) {
+	query := `
+		INSERT INTO room_units (id, room_id, hotel_id, number, floor, status, cleanliness, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, unit.Id, unit.RoomId, unit.HotelId, unit.Number, unit.Floor, unit.Status, unit.Cleanliness, unit.CreatedAt)
+	if err := row.Scan(&unit.Id); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (r *RoomUnitRepo) GetUnitById(unitId uuid.UUID) (*models.RoomUnits, error) {
+	query := `
+		SELECT u.id, u.room_id, u.hotel_id, r.room_category, u.number, u.floor, u.status, u.cleanliness, u.created_at
+		FROM room_units u
+		JOIN rooms r ON r.id = u.room_id
+		WHERE u.id = $1
+	`
+
+	var unit models.RoomUnits
+	row := r.db.QueryRow(query, unitId)
+	if err := row.Scan(&unit.Id, &unit.RoomId, &unit.HotelId, &unit.RoomCategory, &unit.Number, &unit.Floor, &unit.Status, &unit.Cleanliness, &unit.CreatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("room unit not found")
+		}
+		return nil, err
+	}
+	return &unit, nil
+}
+
+func (r *RoomUnitRepo) GetUnitsByHotelID(hotelID uuid.UUID) ([]*models.RoomUnits, error) {
+	query := `
+		SELECT u.id, u.room_id, u.hotel_id, r.room_category, u.number, u.floor, u.status, u.cleanliness, u.created_at
+		FROM room_units u
+		JOIN rooms r ON r.id = u.room_id
+		WHERE u.hotel_id = $1
+		ORDER BY u.floor, u.number
+	`
+	return r.queryUnits(query, hotelID)
+}
+
+// GetFreeUnits returns the active, ready units of a category that are neither occupied by a
+// checked-in booking nor under a block covering the current time. A unit is ready once
+// clean, or once inspected when the hotel holds units until inspection.
+func (r *RoomUnitRepo) GetFreeUnits(hotelID uuid.UUID, roomType room.RoomType) ([]*models.RoomUnits, error) {
+	query := `
+		SELECT u.id, u.room_id, u.hotel_id, r.room_category, u.number, u.floor, u.status, u.cleanliness, u.created_at
+		FROM room_units u
+		JOIN rooms r ON r.id = u.room_id
+		JOIN hotels h ON h.id = u.hotel_id
+		WHERE u.hotel_id = $1 AND r.room_category = $2 AND u.status = $3
+		AND (u.cleanliness = $6 OR (u.cleanliness = $5 AND NOT h.hold_until_inspected))
+		AND NOT EXISTS (
+			SELECT 1
+			FROM booked_room_units bru
+			JOIN booked_rooms br ON br.id = bru.booked_room_id
+			JOIN bookings b ON b.id = br.booking_id
+			WHERE bru.room_unit_id = u.id AND b.status = $4
+		)
+		AND NOT EXISTS (
+			SELECT 1
+			FROM room_blocks rb
+			WHERE (rb.room_unit_id = u.id OR (rb.hotel_id = u.hotel_id AND rb.whole_hotel))
+			AND rb.start_date <= NOW() AND rb.end_date > NOW()
+		)
+		ORDER BY u.floor, u.number
+	`
+	return r.queryUnits(query, hotelID, roomType, room.UnitActive, booking_status.StatusCheckedIn, room.CleanlinessClean, room.CleanlinessInspected)
+}
+
+// GetUnitsByBookingId returns the units assigned to a booking at check-in.
+func (r *RoomUnitRepo) GetUnitsByBookingId(bookingId uuid.UUID) ([]*models.RoomUnits, error) {
+	query := `
+		SELECT u.id, u.room_id, u.hotel_id, r.room_category, u.number, u.floor, u.status, u.cleanliness, u.created_at
+		FROM room_units u
+		JOIN rooms r ON r.id = u.room_id
+		JOIN booked_room_units bru ON bru.room_unit_id = u.id
+		JOIN booked_rooms br ON br.id = bru.booked_room_id
+		WHERE br.booking_id = $1
+		ORDER BY u.floor, u.number
+	`
+	return r.queryUnits(query, bookingId)
+}
+
+// GetUnreadyUnitCount counts the active units of a category that cannot be sold for
+// today yet: units still dirty or being cleaned, plus clean but uninspected units when
+// the hotel holds units until inspection.
+func (r *RoomUnitRepo) GetUnreadyUnitCount(hotelID uuid.UUID, roomType room.RoomType) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM room_units u
+		JOIN rooms r ON r.id = u.room_id
+		JOIN hotels h ON h.id = u.hotel_id
+		WHERE u.hotel_id = $1 AND r.room_category = $2 AND u.status = $3
+		AND (u.cleanliness IN ($4, $5) OR (u.cleanliness = $6 AND h.hold_until_inspected))
+	`
+
+	var unready int
+	err := r.db.QueryRow(query, hotelID, roomType, room.UnitActive, room.CleanlinessDirty, room.CleanlinessCleaning, room.CleanlinessClean).Scan(&unready)
+	if err != nil {
+		return 0, err
+	}
+	return unready, nil
+}
+
+func (r *RoomUnitRepo) UpdateUnitStatus(unitId uuid.UUID, status room.UnitStatus) error {
+	query := `UPDATE room_units SET status = $2 WHERE id = $1`
+
+	result, err := r.db.Exec(query, unitId, status)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.New("room unit not found")
+	}
+	return nil
+}
+
+func (r *RoomUnitRepo) UpdateCleanliness(unitId uuid.UUID, cleanliness room.Cleanliness) error {
+	query := `UPDATE room_units SET cleanliness = $2 WHERE id = $1`
+
+	result, err := r.db.Exec(query, unitId, cleanliness)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.New("room unit not found")
+	}
+	return nil
+}
+
+func (r *RoomUnitRepo) queryUnits(query string, args ...interface{}) ([]*models.RoomUnits, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var units []*models.RoomUnits
+	for rows.Next() {
+		unit := &models.RoomUnits{}
+		if err := rows.Scan(&unit.Id, &unit.RoomId, &unit.HotelId, &unit.RoomCategory, &unit.Number, &unit.Floor, &unit.Status, &unit.Cleanliness, &unit.CreatedAt); err != nil {
+			return nil, err
+		}
+		units = append(units, unit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
@@ -0,0 +1,20 @@
+package room_unit_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=room_unit_interface.go -destination=../../mocks/mock_room_unit_repo.go -package=mocks
+
+type RoomUnitRepoInterface interface {
+	CreateUnit(*models.RoomUnits) (*models.RoomUnits, error)
+	GetUnitById(unitId uuid.UUID) (*models.RoomUnits, error)
+	GetUnitsByHotelID(hotelID uuid.UUID) ([]*models.RoomUnits, error)
+	GetFreeUnits(hotelID uuid.UUID, roomType room.RoomType) ([]*models.RoomUnits, error)
+	GetUnitsByBookingId(bookingId uuid.UUID) ([]*models.RoomUnits, error)
+	GetUnreadyUnitCount(hotelID uuid.UUID, roomType room.RoomType) (int, error)
+	UpdateUnitStatus(unitId uuid.UUID, status room.UnitStatus) error
+	UpdateCleanliness(unitId uuid.UUID, cleanliness room.Cleanliness) error
+}
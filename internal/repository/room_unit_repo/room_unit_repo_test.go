@@ -0,0 +1,333 @@
+package room_unit_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_unit_repo"
+)
+
+var unitColumns = []string{"id", "room_id", "hotel_id", "room_category", "number", "floor", "status", "cleanliness", "created_at"}
+
+func TestRoomUnitRepo_CreateUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, unit *models.RoomUnits)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, unit *models.RoomUnits) {
+				mock.ExpectQuery(`INSERT INTO room_units`).
+					WithArgs(unit.Id, unit.RoomId, unit.HotelId, unit.Number, unit.Floor, unit.Status, unit.Cleanliness, unit.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(unit.Id))
+			},
+		},
+		{
+			name: "duplicate number",
+			setupMocks: func(mock sqlmock.Sqlmock, unit *models.RoomUnits) {
+				mock.ExpectQuery(`INSERT INTO room_units`).
+					WillReturnError(errors.New("duplicate key value violates unique constraint"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_unit_repo.NewRoomUnitRepo(db)
+			unit := &models.RoomUnits{
+				Id:        uuid.New(),
+				RoomId:    uuid.New(),
+				HotelId:   uuid.New(),
+				Number:    "412",
+				Floor:     4,
+				Status:    room.UnitActive,
+				CreatedAt: time.Now(),
+			}
+
+			tt.setupMocks(mock, unit)
+			_, err = repo.CreateUnit(unit)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomUnitRepo_GetUnitById(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, unitID uuid.UUID)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, unitID uuid.UUID) {
+				rows := sqlmock.NewRows(unitColumns).
+					AddRow(unitID, uuid.New(), uuid.New(), "single", "101", 1, "active", "inspected", time.Now())
+				mock.ExpectQuery(`FROM room_units u`).WithArgs(unitID).WillReturnRows(rows)
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock, unitID uuid.UUID) {
+				mock.ExpectQuery(`FROM room_units u`).WithArgs(unitID).WillReturnRows(sqlmock.NewRows(unitColumns))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_unit_repo.NewRoomUnitRepo(db)
+			unitID := uuid.New()
+
+			tt.setupMocks(mock, unitID)
+			unit, err := repo.GetUnitById(unitID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && unit.RoomCategory != room.Single {
+				t.Errorf("expected category single, got %q", unit.RoomCategory)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomUnitRepo_GetFreeUnits(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		wantCount  int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				rows := sqlmock.NewRows(unitColumns).
+					AddRow(uuid.New(), uuid.New(), hotelID, "double", "201", 2, "active", "inspected", time.Now()).
+					AddRow(uuid.New(), uuid.New(), hotelID, "double", "202", 2, "active", "inspected", time.Now())
+				mock.ExpectQuery(`NOT EXISTS`).
+					WithArgs(hotelID, room.Double, room.UnitActive, "checked_in", room.CleanlinessClean, room.CleanlinessInspected).
+					WillReturnRows(rows)
+			},
+			wantCount: 2,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`NOT EXISTS`).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "row scan error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				rows := sqlmock.NewRows(unitColumns).
+					AddRow("invalid-uuid", uuid.New(), hotelID, "double", "201", 2, "active", "inspected", time.Now())
+				mock.ExpectQuery(`NOT EXISTS`).WillReturnRows(rows)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_unit_repo.NewRoomUnitRepo(db)
+			hotelID := uuid.New()
+
+			tt.setupMocks(mock, hotelID)
+			units, err := repo.GetFreeUnits(hotelID, room.Double)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(units) != tt.wantCount {
+				t.Errorf("expected %d units, got %d", tt.wantCount, len(units))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomUnitRepo_UpdateUnitStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, unitID uuid.UUID)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, unitID uuid.UUID) {
+				mock.ExpectExec(`UPDATE room_units SET status`).
+					WithArgs(unitID, room.UnitOutOfOrder).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name: "not found",
+			setupMocks: func(mock sqlmock.Sqlmock, unitID uuid.UUID) {
+				mock.ExpectExec(`UPDATE room_units SET status`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_unit_repo.NewRoomUnitRepo(db)
+			unitID := uuid.New()
+
+			tt.setupMocks(mock, unitID)
+			err = repo.UpdateUnitStatus(unitID, room.UnitOutOfOrder)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomUnitRepo_GetUnitsByBookingId(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := room_unit_repo.NewRoomUnitRepo(db)
+	bookingID := uuid.New()
+
+	rows := sqlmock.NewRows(unitColumns).
+		AddRow(uuid.New(), uuid.New(), uuid.New(), "suite", "501", 5, "active", "inspected", time.Now())
+	mock.ExpectQuery(`JOIN booked_room_units bru`).WithArgs(bookingID).WillReturnRows(rows)
+
+	units, err := repo.GetUnitsByBookingId(bookingID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(units) != 1 || units[0].Number != "501" {
+		t.Errorf("unexpected units %+v", units)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestRoomUnitRepo_GetUnreadyUnitCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		want       int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)`).
+					WithArgs(hotelID, room.Single, room.UnitActive, room.CleanlinessDirty, room.CleanlinessCleaning, room.CleanlinessClean).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+			want: 2,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\)`).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := room_unit_repo.NewRoomUnitRepo(db)
+			hotelID := uuid.New()
+
+			tt.setupMocks(mock, hotelID)
+			got, err := repo.GetUnreadyUnitCount(hotelID, room.Single)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomUnitRepo_UpdateCleanliness(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := room_unit_repo.NewRoomUnitRepo(db)
+	unitID := uuid.New()
+
+	mock.ExpectExec(`UPDATE room_units SET cleanliness`).
+		WithArgs(unitID, room.CleanlinessInspected).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := repo.UpdateCleanliness(unitID, room.CleanlinessInspected); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package stay_restriction_repo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrRestrictionNotFound = errors.New("stay restriction not found")
+
+type StayRestrictionRepo struct {
+	db db.DB
+}
+
+func NewStayRestrictionRepo(database db.DB) *StayRestrictionRepo {
+	return &StayRestrictionRepo{db: database}
+}
+
+func (r *StayRestrictionRepo) CreateRestriction(restriction *models.StayRestrictions) (*models.StayRestrictions, error) {
+	query := `
+		INSERT INTO stay_restrictions (id, hotel_id, room_category, start_date, end_date, days_of_week, min_los, max_los,
+			closed_to_arrival, closed_to_departure, stop_sell, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, restriction.Id, restriction.HotelId, restriction.RoomCategory, restriction.StartDate,
+		restriction.EndDate, pq.Array(restriction.DaysOfWeek), restriction.MinLengthOfStay, restriction.MaxLengthOfStay,
+		restriction.ClosedToArrival, restriction.ClosedToDeparture, restriction.StopSell, restriction.CreatedBy, restriction.CreatedAt)
+	if err := row.Scan(&restriction.Id); err != nil {
+		return nil, err
+	}
+	return restriction, nil
+}
+
+func (r *StayRestrictionRepo) GetRestrictionsByHotelID(hotelID uuid.UUID) ([]*models.StayRestrictions, error) {
+	query := `
+		SELECT id, hotel_id, room_category, start_date, end_date, days_of_week, min_los, max_los,
+			closed_to_arrival, closed_to_departure, stop_sell, created_by, created_at
+		FROM stay_restrictions
+		WHERE hotel_id = $1
+		ORDER BY start_date
+	`
+	return r.queryRestrictions(query, hotelID)
+}
+
+func (r *StayRestrictionRepo) GetRestrictionById(restrictionId uuid.UUID) (*models.StayRestrictions, error) {
+	query := `
+		SELECT id, hotel_id, room_category, start_date, end_date, days_of_week, min_los, max_los,
+			closed_to_arrival, closed_to_departure, stop_sell, created_by, created_at
+		FROM stay_restrictions
+		WHERE id = $1
+	`
+	restrictions, err := r.queryRestrictions(query, restrictionId)
+	if err != nil {
+		return nil, err
+	}
+	if len(restrictions) == 0 {
+		return nil, ErrRestrictionNotFound
+	}
+	return restrictions[0], nil
+}
+
+// GetRestrictionsForStay returns the restrictions of a room type, and the hotel-wide
+// ones, whose date range touches any day from `from` to `to` inclusive.
+func (r *StayRestrictionRepo) GetRestrictionsForStay(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) ([]*models.StayRestrictions, error) {
+	query := `
+		SELECT id, hotel_id, room_category, start_date, end_date, days_of_week, min_los, max_los,
+			closed_to_arrival, closed_to_departure, stop_sell, created_by, created_at
+		FROM stay_restrictions
+		WHERE hotel_id = $1 AND (room_category = $2 OR room_category = '')
+		AND start_date <= $4::date AND end_date >= $3::date
+		ORDER BY start_date
+	`
+	return r.queryRestrictions(query, hotelID, roomType, from, to)
+}
+
+func (r *StayRestrictionRepo) DeleteRestriction(restrictionId uuid.UUID) error {
+	query := `DELETE FROM stay_restrictions WHERE id = $1`
+
+	result, err := r.db.Exec(query, restrictionId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrRestrictionNotFound
+	}
+	return nil
+}
+
+func (r *StayRestrictionRepo) queryRestrictions(query string, args ...interface{}) ([]*models.StayRestrictions, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var restrictions []*models.StayRestrictions
+	for rows.Next() {
+		restriction := &models.StayRestrictions{}
+		var daysOfWeek pq.Int64Array
+		if err := rows.Scan(&restriction.Id, &restriction.HotelId, &restriction.RoomCategory, &restriction.StartDate,
+			&restriction.EndDate, &daysOfWeek, &restriction.MinLengthOfStay, &restriction.MaxLengthOfStay,
+			&restriction.ClosedToArrival, &restriction.ClosedToDeparture, &restriction.StopSell,
+			&restriction.CreatedBy, &restriction.CreatedAt); err != nil {
+			return nil, err
+		}
+		for _, day := range daysOfWeek {
+			restriction.DaysOfWeek = append(restriction.DaysOfWeek, int(day))
+		}
+		restrictions = append(restrictions, restriction)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return restrictions, nil
+}
@@ -0,0 +1,19 @@
+package stay_restriction_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=stay_restriction_interface.go -destination=../../mocks/mock_stay_restriction_repo.go -package=mocks
+
+type StayRestrictionRepoInterface interface {
+	CreateRestriction(*models.StayRestrictions) (*models.StayRestrictions, error)
+	GetRestrictionsByHotelID(hotelID uuid.UUID) ([]*models.StayRestrictions, error)
+	GetRestrictionById(restrictionId uuid.UUID) (*models.StayRestrictions, error)
+	GetRestrictionsForStay(hotelID uuid.UUID, roomType room.RoomType, from, to time.Time) ([]*models.StayRestrictions, error)
+	DeleteRestriction(restrictionId uuid.UUID) error
+}
@@ -0,0 +1,233 @@
+package stay_restriction_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/stay_restriction_repo"
+)
+
+var restrictionColumns = []string{"id", "hotel_id", "room_category", "start_date", "end_date", "days_of_week", "min_los", "max_los",
+	"closed_to_arrival", "closed_to_departure", "stop_sell", "created_by", "created_at"}
+
+func TestStayRestrictionRepo_CreateRestriction(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, restriction *models.StayRestrictions)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, restriction *models.StayRestrictions) {
+				mock.ExpectQuery(`INSERT INTO stay_restrictions`).
+					WithArgs(restriction.Id, restriction.HotelId, restriction.RoomCategory, restriction.StartDate,
+						restriction.EndDate, sqlmock.AnyArg(), restriction.MinLengthOfStay, restriction.MaxLengthOfStay,
+						restriction.ClosedToArrival, restriction.ClosedToDeparture, restriction.StopSell,
+						restriction.CreatedBy, restriction.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(restriction.Id))
+			},
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, restriction *models.StayRestrictions) {
+				mock.ExpectQuery(`INSERT INTO stay_restrictions`).WillReturnError(errors.New("insert failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := stay_restriction_repo.NewStayRestrictionRepo(db)
+			start := time.Now()
+			restriction := &models.StayRestrictions{
+				Id:              uuid.New(),
+				HotelId:         uuid.New(),
+				RoomCategory:    room.Suite,
+				StartDate:       start,
+				EndDate:         start.Add(30 * 24 * time.Hour),
+				DaysOfWeek:      []int{5},
+				MinLengthOfStay: 2,
+				CreatedBy:       uuid.New(),
+				CreatedAt:       time.Now(),
+			}
+
+			tt.setupMocks(mock, restriction)
+			_, err = repo.CreateRestriction(restriction)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestStayRestrictionRepo_GetRestrictionsByHotelID(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		wantCount  int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				now := time.Now()
+				rows := sqlmock.NewRows(restrictionColumns).
+					AddRow(uuid.New(), hotelID, "", now, now, "{}", 0, 0, true, false, false, uuid.New(), now).
+					AddRow(uuid.New(), hotelID, "suite", now, now.Add(time.Hour), "{5,6}", 2, 0, false, false, false, uuid.New(), now)
+				mock.ExpectQuery(`FROM stay_restrictions`).WithArgs(hotelID).WillReturnRows(rows)
+			},
+			wantCount: 2,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`FROM stay_restrictions`).WithArgs(hotelID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := stay_restriction_repo.NewStayRestrictionRepo(db)
+			hotelID := uuid.New()
+
+			tt.setupMocks(mock, hotelID)
+			restrictions, err := repo.GetRestrictionsByHotelID(hotelID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(restrictions) != tt.wantCount {
+				t.Errorf("expected %d restrictions, got %d", tt.wantCount, len(restrictions))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestStayRestrictionRepo_GetRestrictionsForStay(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := stay_restriction_repo.NewStayRestrictionRepo(db)
+	hotelID := uuid.New()
+	from := time.Now()
+	to := from.Add(72 * time.Hour)
+
+	rows := sqlmock.NewRows(restrictionColumns).
+		AddRow(uuid.New(), hotelID, "double", from, to, "{5,6}", 2, 0, false, false, false, uuid.New(), from)
+	mock.ExpectQuery(`FROM stay_restrictions`).
+		WithArgs(hotelID, room.Double, from, to).
+		WillReturnRows(rows)
+
+	restrictions, err := repo.GetRestrictionsForStay(hotelID, room.Double, from, to)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(restrictions) != 1 {
+		t.Fatalf("expected 1 restriction, got %d", len(restrictions))
+	}
+	if got := restrictions[0].DaysOfWeek; len(got) != 2 || got[0] != 5 || got[1] != 6 {
+		t.Errorf("expected days of week [5 6], got %v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestStayRestrictionRepo_GetRestrictionById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := stay_restriction_repo.NewStayRestrictionRepo(db)
+	restrictionID := uuid.New()
+	hotelID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM stay_restrictions`).WithArgs(restrictionID).
+		WillReturnRows(sqlmock.NewRows(restrictionColumns).
+			AddRow(restrictionID, hotelID, "", now, now, "{}", 0, 0, true, false, false, uuid.New(), now))
+	restriction, err := repo.GetRestrictionById(restrictionID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if restriction.HotelId != hotelID {
+		t.Errorf("expected hotel %v, got %v", hotelID, restriction.HotelId)
+	}
+
+	mock.ExpectQuery(`FROM stay_restrictions`).WithArgs(restrictionID).
+		WillReturnRows(sqlmock.NewRows(restrictionColumns))
+	if _, err := repo.GetRestrictionById(restrictionID); !errors.Is(err, stay_restriction_repo.ErrRestrictionNotFound) {
+		t.Errorf("expected ErrRestrictionNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestStayRestrictionRepo_DeleteRestriction(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"success", 1, false},
+		{"not found", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := stay_restriction_repo.NewStayRestrictionRepo(db)
+			restrictionID := uuid.New()
+
+			mock.ExpectExec(`DELETE FROM stay_restrictions`).WithArgs(restrictionID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.DeleteRestriction(restrictionID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tax_rule_repo
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var ErrTaxRuleNotFound = errors.New("tax rule not found")
+
+type TaxRuleRepo struct {
+	db db.DB
+}
+
+func NewTaxRuleRepo(database db.DB) *TaxRuleRepo {
+	return &TaxRuleRepo{db: database}
+}
+
+func (r *TaxRuleRepo) CreateTaxRule(rule *models.TaxRules) (*models.TaxRules, error) {
+	query := `
+		INSERT INTO tax_rules (id, hotel_id, name, charge_type, value, inclusive, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id;
+	`
+
+	row := r.db.QueryRow(query, rule.Id, rule.HotelId, rule.Name, rule.ChargeType, rule.Value, rule.Inclusive, rule.CreatedAt)
+	if err := row.Scan(&rule.Id); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *TaxRuleRepo) GetTaxRulesByHotelID(hotelID uuid.UUID) ([]*models.TaxRules, error) {
+	query := `
+		SELECT id, hotel_id, name, charge_type, value, inclusive, created_at
+		FROM tax_rules
+		WHERE hotel_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.Query(query, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*models.TaxRules
+	for rows.Next() {
+		rule := &models.TaxRules{}
+		if err := rows.Scan(&rule.Id, &rule.HotelId, &rule.Name, &rule.ChargeType, &rule.Value, &rule.Inclusive, &rule.CreatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *TaxRuleRepo) GetTaxRuleById(taxRuleId uuid.UUID) (*models.TaxRules, error) {
+	query := `
+		SELECT id, hotel_id, name, charge_type, value, inclusive, created_at
+		FROM tax_rules
+		WHERE id = $1
+	`
+
+	rule := &models.TaxRules{}
+	err := r.db.QueryRow(query, taxRuleId).Scan(&rule.Id, &rule.HotelId, &rule.Name, &rule.ChargeType, &rule.Value, &rule.Inclusive, &rule.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaxRuleNotFound
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *TaxRuleRepo) DeleteTaxRule(taxRuleId uuid.UUID) error {
+	query := `DELETE FROM tax_rules WHERE id = $1`
+
+	result, err := r.db.Exec(query, taxRuleId)
+	if err != nil {
+		return err
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return ErrTaxRuleNotFound
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package tax_rule_repo
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=tax_rule_interface.go -destination=../../mocks/mock_tax_rule_repo.go -package=mocks
+
+type TaxRuleRepoInterface interface {
+	CreateTaxRule(*models.TaxRules) (*models.TaxRules, error)
+	GetTaxRulesByHotelID(hotelID uuid.UUID) ([]*models.TaxRules, error)
+	GetTaxRuleById(taxRuleId uuid.UUID) (*models.TaxRules, error)
+	DeleteTaxRule(taxRuleId uuid.UUID) error
+}
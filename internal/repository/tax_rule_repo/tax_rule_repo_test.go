@@ -0,0 +1,190 @@
+package tax_rule_repo_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/tax_rule_repo"
+)
+
+func TestTaxRuleRepo_CreateTaxRule(t *testing.T) {
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, rule *models.TaxRules)
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, rule *models.TaxRules) {
+				mock.ExpectQuery(`INSERT INTO tax_rules`).
+					WithArgs(rule.Id, rule.HotelId, rule.Name, rule.ChargeType, rule.Value, rule.Inclusive, rule.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(rule.Id))
+			},
+		},
+		{
+			name: "insert fails",
+			setupMocks: func(mock sqlmock.Sqlmock, rule *models.TaxRules) {
+				mock.ExpectQuery(`INSERT INTO tax_rules`).WillReturnError(errors.New("duplicate key value"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := tax_rule_repo.NewTaxRuleRepo(db)
+			rule := &models.TaxRules{
+				Id:         uuid.New(),
+				HotelId:    uuid.New(),
+				Name:       "VAT",
+				ChargeType: tax.Percentage,
+				Value:      1200,
+				CreatedAt:  time.Now(),
+			}
+
+			tt.setupMocks(mock, rule)
+			_, err = repo.CreateTaxRule(rule)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestTaxRuleRepo_GetTaxRulesByHotelID(t *testing.T) {
+	columns := []string{"id", "hotel_id", "name", "charge_type", "value", "inclusive", "created_at"}
+
+	tests := []struct {
+		name       string
+		setupMocks func(mock sqlmock.Sqlmock, hotelID uuid.UUID)
+		wantCount  int
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(uuid.New(), hotelID, "VAT", "percentage", 1200, true, time.Now()).
+					AddRow(uuid.New(), hotelID, "City tax", "per_person", 250, false, time.Now())
+				mock.ExpectQuery(`FROM tax_rules`).WithArgs(hotelID).WillReturnRows(rows)
+			},
+			wantCount: 2,
+		},
+		{
+			name: "query error",
+			setupMocks: func(mock sqlmock.Sqlmock, hotelID uuid.UUID) {
+				mock.ExpectQuery(`FROM tax_rules`).WithArgs(hotelID).WillReturnError(errors.New("query failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := tax_rule_repo.NewTaxRuleRepo(db)
+			hotelID := uuid.New()
+
+			tt.setupMocks(mock, hotelID)
+			rules, err := repo.GetTaxRulesByHotelID(hotelID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(rules) != tt.wantCount {
+				t.Errorf("expected %d rules, got %d", tt.wantCount, len(rules))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestTaxRuleRepo_GetTaxRuleById(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	repo := tax_rule_repo.NewTaxRuleRepo(db)
+	ruleID := uuid.New()
+	hotelID := uuid.New()
+	columns := []string{"id", "hotel_id", "name", "charge_type", "value", "inclusive", "created_at"}
+
+	mock.ExpectQuery(`FROM tax_rules`).WithArgs(ruleID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(ruleID, hotelID, "VAT", "percentage", 1250, false, time.Now()))
+	rule, err := repo.GetTaxRuleById(ruleID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rule.HotelId != hotelID {
+		t.Errorf("expected hotel %v, got %v", hotelID, rule.HotelId)
+	}
+
+	mock.ExpectQuery(`FROM tax_rules`).WithArgs(ruleID).WillReturnError(sql.ErrNoRows)
+	if _, err := repo.GetTaxRuleById(ruleID); !errors.Is(err, tax_rule_repo.ErrTaxRuleNotFound) {
+		t.Errorf("expected ErrTaxRuleNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestTaxRuleRepo_DeleteTaxRule(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantErr      bool
+	}{
+		{"success", 1, false},
+		{"not found", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			repo := tax_rule_repo.NewTaxRuleRepo(db)
+			ruleID := uuid.New()
+
+			mock.ExpectExec(`DELETE FROM tax_rules`).WithArgs(ruleID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			err = repo.DeleteTaxRule(ruleID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet sqlmock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package waitlist_repo
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/db"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+var (
+	ErrEntryNotFound      = errors.New("waitlist entry not found")
+	ErrEntryStatusChanged = errors.New("waitlist entry is no longer in the expected status")
+)
+
+const entryColumns = `id, hotel_id, user_id, room_type, quantity, check_in, check_out, status, offered_at, offer_expires_at, created_at`
+
+type WaitlistRepo struct {
+	db db.DB
+}
+
+func NewWaitlistRepo(database db.DB) *WaitlistRepo {
+	return &WaitlistRepo{db: database}
+}
+
+func (r *WaitlistRepo) CreateEntry(entry *models.WaitlistEntries) error {
+	query := `
+		INSERT INTO waitlist_entries (id, hotel_id, user_id, room_type, quantity, check_in, check_out, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.Exec(query, entry.Id, entry.HotelId, entry.UserId, entry.RoomType, entry.Quantity,
+		entry.CheckIn, entry.CheckOut, entry.Status, entry.CreatedAt)
+	return err
+}
+
+func (r *WaitlistRepo) GetEntryById(entryId uuid.UUID) (*models.WaitlistEntries, error) {
+	query := `SELECT ` + entryColumns + ` FROM waitlist_entries WHERE id = $1`
+
+	entry, err := scanEntry(r.db.QueryRow(query, entryId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrEntryNotFound
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetEntriesByUserId returns a guest's waitlist entries, newest first.
+func (r *WaitlistRepo) GetEntriesByUserId(userId uuid.UUID) ([]*models.WaitlistEntries, error) {
+	query := `SELECT ` + entryColumns + ` FROM waitlist_entries WHERE user_id = $1 ORDER BY created_at DESC`
+	return r.queryEntries(query, userId)
+}
+
+// GetEntriesByHotelId returns a hotel's waitlist in queue order.
+func (r *WaitlistRepo) GetEntriesByHotelId(hotelId uuid.UUID) ([]*models.WaitlistEntries, error) {
+	query := `SELECT ` + entryColumns + ` FROM waitlist_entries WHERE hotel_id = $1 ORDER BY created_at`
+	return r.queryEntries(query, hotelId)
+}
+
+// GetWaitingEntries returns the guests still queued for a room type whose
+// stay has not begun, first come first.
+func (r *WaitlistRepo) GetWaitingEntries(hotelId uuid.UUID, roomType room.RoomType, now time.Time) ([]*models.WaitlistEntries, error) {
+	query := `
+		SELECT ` + entryColumns + `
+		FROM waitlist_entries
+		WHERE hotel_id = $1 AND room_type = $2 AND status = $3 AND check_in > $4
+		ORDER BY created_at
+	`
+	return r.queryEntries(query, hotelId, roomType, waitlist_status.StatusWaiting, now)
+}
+
+// GetWaitingQueues lists the hotel room types that have guests waiting for a
+// stay that has not begun.
+func (r *WaitlistRepo) GetWaitingQueues(now time.Time) ([]*models.WaitlistQueue, error) {
+	query := `
+		SELECT DISTINCT hotel_id, room_type
+		FROM waitlist_entries
+		WHERE status = $1 AND check_in > $2
+	`
+
+	rows, err := r.db.Query(query, waitlist_status.StatusWaiting, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	queues := make([]*models.WaitlistQueue, 0)
+	for rows.Next() {
+		queue := &models.WaitlistQueue{}
+		if err := rows.Scan(&queue.HotelId, &queue.RoomType); err != nil {
+			return nil, err
+		}
+		queues = append(queues, queue)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return queues, nil
+}
+
+// OfferEntry turns a waiting entry into an offer that holds its rooms until
+// expiresAt. It fails with ErrEntryStatusChanged when the entry stopped waiting.
+func (r *WaitlistRepo) OfferEntry(entryId uuid.UUID, offeredAt, expiresAt time.Time) error {
+	query := `
+		UPDATE waitlist_entries
+		SET status = $2, offered_at = $3, offer_expires_at = $4
+		WHERE id = $1 AND status = $5
+	`
+
+	result, err := r.db.Exec(query, entryId, waitlist_status.StatusOffered, offeredAt, expiresAt, waitlist_status.StatusWaiting)
+	if err != nil {
+		return err
+	}
+	return requireOneRow(result)
+}
+
+// UpdateStatus moves an entry from one status to another. It fails with
+// ErrEntryStatusChanged when the entry is not in status from, so two requests
+// cannot both act on the same offer.
+func (r *WaitlistRepo) UpdateStatus(entryId uuid.UUID, from, to waitlist_status.WaitlistStatus) error {
+	query := `UPDATE waitlist_entries SET status = $2 WHERE id = $1 AND status = $3`
+
+	result, err := r.db.Exec(query, entryId, to, from)
+	if err != nil {
+		return err
+	}
+	return requireOneRow(result)
+}
+
+// ExpireEntries closes offers that lapsed and entries whose stay has begun,
+// returning them.
+func (r *WaitlistRepo) ExpireEntries(now time.Time) ([]*models.WaitlistEntries, error) {
+	query := `
+		UPDATE waitlist_entries
+		SET status = $1
+		WHERE (status = $2 AND offer_expires_at <= $4) OR (status = $3 AND check_in <= $4)
+		RETURNING ` + entryColumns
+
+	return r.queryEntries(query, waitlist_status.StatusExpired, waitlist_status.StatusOffered, waitlist_status.StatusWaiting, now)
+}
+
+// GetHeldQuantity sums the rooms of a type held by open offers for stays that
+// overlap [from, to).
+func (r *WaitlistRepo) GetHeldQuantity(hotelId uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error) {
+	query := `
+		SELECT COALESCE(SUM(quantity), 0)
+		FROM waitlist_entries
+		WHERE hotel_id = $1 AND room_type = $2 AND status = $5 AND offer_expires_at > NOW()
+			AND check_in < $4 AND check_out > $3
+	`
+
+	var held int
+	if err := r.db.QueryRow(query, hotelId, roomType, from, to, waitlist_status.StatusOffered).Scan(&held); err != nil {
+		return 0, err
+	}
+	return held, nil
+}
+
+func (r *WaitlistRepo) queryEntries(query string, args ...any) ([]*models.WaitlistEntries, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]*models.WaitlistEntries, 0)
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEntry(row rowScanner) (*models.WaitlistEntries, error) {
+	entry := &models.WaitlistEntries{}
+	if err := row.Scan(&entry.Id, &entry.HotelId, &entry.UserId, &entry.RoomType, &entry.Quantity, &entry.CheckIn,
+		&entry.CheckOut, &entry.Status, &entry.OfferedAt, &entry.OfferExpiresAt, &entry.CreatedAt); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func requireOneRow(result sql.Result) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrEntryStatusChanged
+	}
+	return nil
+}
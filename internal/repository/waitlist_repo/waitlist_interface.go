@@ -0,0 +1,25 @@
+package waitlist_repo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=waitlist_interface.go -destination=../../mocks/mock_waitlist_repo.go -package=mocks
+
+type WaitlistRepoInterface interface {
+	CreateEntry(*models.WaitlistEntries) error
+	GetEntryById(entryId uuid.UUID) (*models.WaitlistEntries, error)
+	GetEntriesByUserId(userId uuid.UUID) ([]*models.WaitlistEntries, error)
+	GetEntriesByHotelId(hotelId uuid.UUID) ([]*models.WaitlistEntries, error)
+	GetWaitingEntries(hotelId uuid.UUID, roomType room.RoomType, now time.Time) ([]*models.WaitlistEntries, error)
+	GetWaitingQueues(now time.Time) ([]*models.WaitlistQueue, error)
+	OfferEntry(entryId uuid.UUID, offeredAt, expiresAt time.Time) error
+	UpdateStatus(entryId uuid.UUID, from, to waitlist_status.WaitlistStatus) error
+	ExpireEntries(now time.Time) ([]*models.WaitlistEntries, error)
+	GetHeldQuantity(hotelId uuid.UUID, roomType room.RoomType, from, to time.Time) (int, error)
+}
@@ -0,0 +1,205 @@
+package waitlist_repo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+)
+
+var entryColumns = []string{"id", "hotel_id", "user_id", "room_type", "quantity", "check_in", "check_out", "status", "offered_at", "offer_expires_at", "created_at"}
+
+func TestWaitlistRepo_CreateEntry(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	entry := &models.WaitlistEntries{Id: uuid.New(), HotelId: uuid.New(), UserId: uuid.New(), RoomType: room.Double, Quantity: 2,
+		CheckIn: now.AddDate(0, 0, 3), CheckOut: now.AddDate(0, 0, 5), Status: waitlist_status.StatusWaiting, CreatedAt: now}
+
+	mock.ExpectExec(`INSERT INTO waitlist_entries`).
+		WithArgs(entry.Id, entry.HotelId, entry.UserId, entry.RoomType, entry.Quantity, entry.CheckIn, entry.CheckOut, entry.Status, entry.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := waitlist_repo.NewWaitlistRepo(db).CreateEntry(entry); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestWaitlistRepo_GetEntryById(t *testing.T) {
+	entryID := uuid.New()
+
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr error
+	}{
+		{
+			name: "found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM waitlist_entries WHERE id = \$1`).WithArgs(entryID).
+					WillReturnRows(sqlmock.NewRows(entryColumns).AddRow(entryID, uuid.New(), uuid.New(), "double", 1,
+						time.Now(), time.Now().AddDate(0, 0, 1), "waiting", nil, nil, time.Now()))
+			},
+		},
+		{
+			name: "not found",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM waitlist_entries`).WillReturnRows(sqlmock.NewRows(entryColumns))
+			},
+			wantErr: waitlist_repo.ErrEntryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			tt.setup(mock)
+
+			entry, err := waitlist_repo.NewWaitlistRepo(db).GetEntryById(entryID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && (entry.Id != entryID || entry.OfferExpiresAt != nil) {
+				t.Errorf("expected a waiting entry without an offer, got %+v", entry)
+			}
+		})
+	}
+}
+
+func TestWaitlistRepo_GetWaitingEntries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID, now := uuid.New(), time.Now()
+	mock.ExpectQuery(`WHERE hotel_id = \$1 AND room_type = \$2 AND status = \$3 AND check_in > \$4\s+ORDER BY created_at`).
+		WithArgs(hotelID, room.Suite, waitlist_status.StatusWaiting, now).
+		WillReturnRows(sqlmock.NewRows(entryColumns).
+			AddRow(uuid.New(), hotelID, uuid.New(), "suite", 1, now.AddDate(0, 0, 1), now.AddDate(0, 0, 2), "waiting", nil, nil, now.Add(-time.Hour)).
+			AddRow(uuid.New(), hotelID, uuid.New(), "suite", 2, now.AddDate(0, 0, 1), now.AddDate(0, 0, 3), "waiting", nil, nil, now))
+
+	entries, err := waitlist_repo.NewWaitlistRepo(db).GetWaitingEntries(hotelID, room.Suite, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[1].Quantity != 2 {
+		t.Errorf("expected both queued entries, got %+v", entries)
+	}
+}
+
+func TestWaitlistRepo_OfferEntry(t *testing.T) {
+	entryID := uuid.New()
+	now := time.Now()
+	expiresAt := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  error
+	}{
+		{name: "offered", affected: 1},
+		{name: "no longer waiting", affected: 0, wantErr: waitlist_repo.ErrEntryStatusChanged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("failed to create sqlmock: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec(`UPDATE waitlist_entries\s+SET status = \$2, offered_at = \$3, offer_expires_at = \$4\s+WHERE id = \$1 AND status = \$5`).
+				WithArgs(entryID, waitlist_status.StatusOffered, now, expiresAt, waitlist_status.StatusWaiting).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = waitlist_repo.NewWaitlistRepo(db).OfferEntry(entryID, now, expiresAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitlistRepo_UpdateStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	entryID := uuid.New()
+	mock.ExpectExec(`UPDATE waitlist_entries SET status = \$2 WHERE id = \$1 AND status = \$3`).
+		WithArgs(entryID, waitlist_status.StatusBooked, waitlist_status.StatusOffered).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = waitlist_repo.NewWaitlistRepo(db).UpdateStatus(entryID, waitlist_status.StatusOffered, waitlist_status.StatusBooked)
+	if !errors.Is(err, waitlist_repo.ErrEntryStatusChanged) {
+		t.Errorf("expected ErrEntryStatusChanged, got %v", err)
+	}
+}
+
+func TestWaitlistRepo_ExpireEntries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	now := time.Now()
+	offeredAt, expiredAt := now.Add(-3*time.Hour), now.Add(-time.Hour)
+	mock.ExpectQuery(`UPDATE waitlist_entries\s+SET status = \$1\s+WHERE .+RETURNING`).
+		WithArgs(waitlist_status.StatusExpired, waitlist_status.StatusOffered, waitlist_status.StatusWaiting, now).
+		WillReturnRows(sqlmock.NewRows(entryColumns).
+			AddRow(uuid.New(), uuid.New(), uuid.New(), "single", 1, now.AddDate(0, 0, 1), now.AddDate(0, 0, 2), "expired", offeredAt, expiredAt, now))
+
+	expired, err := waitlist_repo.NewWaitlistRepo(db).ExpireEntries(now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(expired) != 1 || expired[0].OfferExpiresAt == nil || !expired[0].OfferExpiresAt.Equal(expiredAt) {
+		t.Errorf("expected the lapsed offer, got %+v", expired)
+	}
+}
+
+func TestWaitlistRepo_GetHeldQuantity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	hotelID := uuid.New()
+	from, to := time.Now().AddDate(0, 0, 1), time.Now().AddDate(0, 0, 3)
+	mock.ExpectQuery(`SELECT COALESCE\(SUM\(quantity\), 0\)\s+FROM waitlist_entries`).
+		WithArgs(hotelID, room.Double, from, to, waitlist_status.StatusOffered).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(3))
+
+	held, err := waitlist_repo.NewWaitlistRepo(db).GetHeldQuantity(hotelID, room.Double, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if held != 3 {
+		t.Errorf("expected 3 held rooms, got %d", held)
+	}
+}
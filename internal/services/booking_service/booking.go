@@ -2,128 +2,362 @@ package booking_service
 
 import (
 	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/google/uuid"
 
 	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/models"
 	"github.com/tktanisha/booking_system/internal/repository/booking_repo"
+	"github.com/tktanisha/booking_system/internal/services/cancellation_service"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/housekeeping_service"
+	"github.com/tktanisha/booking_system/internal/services/overbooking_service"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
 	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/services/room_unit_service"
+	"github.com/tktanisha/booking_system/internal/services/tax_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
+var (
+	ErrBookingAccessDenied = errors.New("you are not allowed to access this booking")
+	ErrOccupancyExceeded   = errors.New("too many guests for the room type")
+	ErrCheckInInPast       = errors.New("checkin date has already passed at the hotel")
+	ErrRoomsNotAvailable   = errors.New("rooms not available")
+)
+
 type BookingService struct {
-	BookingRepo booking_repo.BookingRepoInterface
-	RoomService room_service.RoomServiceInterface
+	BookingRepo         booking_repo.BookingRepoInterface
+	RoomService         room_service.RoomServiceInterface
+	CancellationService cancellation_service.CancellationServiceInterface
+	HotelService        hotel_service.HotelServiceInterface
+	RoomUnitService     room_unit_service.RoomUnitServiceInterface
+	HousekeepingService housekeeping_service.HousekeepingServiceInterface
+	RoomTypeService     room_type_service.RoomTypeServiceInterface
+	PromoService        promo_service.PromoServiceInterface
+	TaxService          tax_service.TaxServiceInterface
+	FolioService        folio_service.FolioServiceInterface
+	WaitlistService     waitlist_service.WaitlistServiceInterface
+	GroupBlockService   group_block_service.GroupBlockServiceInterface
+	OverbookingService  overbooking_service.OverbookingServiceInterface
 }
 
-func NewBookingService(bookingRepo booking_repo.BookingRepoInterface, roomService room_service.RoomServiceInterface) *BookingService {
+func NewBookingService(bookingRepo booking_repo.BookingRepoInterface, roomService room_service.RoomServiceInterface, cancellationService cancellation_service.CancellationServiceInterface, hotelService hotel_service.HotelServiceInterface, roomUnitService room_unit_service.RoomUnitServiceInterface, housekeepingService housekeeping_service.HousekeepingServiceInterface, roomTypeService room_type_service.RoomTypeServiceInterface, promoService promo_service.PromoServiceInterface, taxService tax_service.TaxServiceInterface, folioService folio_service.FolioServiceInterface, waitlistService waitlist_service.WaitlistServiceInterface, groupBlockService group_block_service.GroupBlockServiceInterface, overbookingService overbooking_service.OverbookingServiceInterface) *BookingService {
 	return &BookingService{
-		BookingRepo: bookingRepo,
-		RoomService: roomService,
+		BookingRepo:         bookingRepo,
+		RoomService:         roomService,
+		CancellationService: cancellationService,
+		HotelService:        hotelService,
+		RoomUnitService:     roomUnitService,
+		HousekeepingService: housekeepingService,
+		RoomTypeService:     roomTypeService,
+		PromoService:        promoService,
+		TaxService:          taxService,
+		FolioService:        folioService,
+		WaitlistService:     waitlistService,
+		GroupBlockService:   groupBlockService,
+		OverbookingService:  overbookingService,
 	}
 }
 
-func (b *BookingService) CancelBooking(bookingId uuid.UUID) (*models.Bookings, error) {
+// CancelBooking cancels a booking for the guest who made it or the hotel's manager.
+func (b *BookingService) CancelBooking(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Bookings, error) {
 	booking, err := b.BookingRepo.GetBookingById(bookingId)
 	if err != nil {
 		return nil, err
 	}
 
-	if booking.CheckIn.Before(time.Now()) {
-		return nil, errors.New("cannot cancel booking after check-in date")
+	hotel, err := b.HotelService.GetHotelByID(booking.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId != userCtx.Id && hotel.ManagerId != userCtx.Id {
+		return nil, ErrBookingAccessDenied
 	}
 
-	if booking.Status == booking_status.StatusCancelled {
-		return nil, errors.New("booking is already cancelled")
+	if err := booking_status.ValidateTransition(booking.Status, booking_status.StatusCancelled); err != nil {
+		return nil, err
 	}
+	localizeStay(booking, hotel)
 
-	booking.Status = booking_status.StatusCancelled
+	// check-in is the hotel's check-in time on the arrival date, so the deadline
+	// falls where the guest expects it whatever zone the server runs in
+	if booking.CheckIn.Before(time.Now()) {
+		return nil, errors.New("cannot cancel booking after check-in date")
+	}
 
 	// increase room quantity back
 	bookedRooms, err := b.BookingRepo.GetBookedRoomsByBookingId(bookingId)
 	if err != nil {
 		return nil, err
 	}
-	var roomsPayload []*payloads.RoomPayload
-	for _, bookedRoom := range bookedRooms {
-		roomPayload := &payloads.RoomPayload{
-			RoomType: bookedRoom.RoomType,
-			Quantity: bookedRoom.RoomQuantity,
-		}
-		roomsPayload = append(roomsPayload, roomPayload)
+
+	penalty, err := b.CancellationService.CalculatePenalty(booking, bookedRooms, time.Now())
+	if err != nil {
+		return nil, err
 	}
-	for _, room := range roomsPayload {
-		if _, err := b.RoomService.IncreaseRoomQuantity(room, booking.HotelId); err != nil {
-			return nil, err
-		}
+	booking.PenaltyAmount = penalty
+	booking.RefundAmount = booking.TotalAmount - penalty
+
+	if err := b.transition(booking, booking_status.StatusCancelled, &userCtx.Id, "booking cancelled"); err != nil {
+		return nil, err
 	}
 
-	//update status
-	if err := b.BookingRepo.Save(booking); err != nil {
+	if err := b.releaseRooms(booking, bookedRooms); err != nil {
 		return nil, err
 	}
 
 	return booking, nil
 }
 
+// CreateBooking books the requested rooms. A booking made with a waitlist
+// offer claims the rooms the offer holds, and the offer reopens if the booking
+// then fails. A booking made with a group code picks its rooms up from the
+// group's blocks, and they go back to the blocks if the booking fails.
 func (b *BookingService) CreateBooking(userCtx *models.UserContext, payload *payloads.BookingPayload) (*models.Bookings, error) {
+	if payload.GroupCode != "" {
+		if err := b.GroupBlockService.PickUpRooms(payload); err != nil {
+			return nil, err
+		}
+		booking, err := b.createBooking(userCtx, payload)
+		if err != nil {
+			_ = b.GroupBlockService.ReturnRooms(payload.HotelId, payload.GroupCode, payload.Rooms)
+			return nil, err
+		}
+		return booking, nil
+	}
+	if payload.WaitlistEntryId == nil {
+		return b.createBooking(userCtx, payload)
+	}
+
+	if err := b.WaitlistService.ClaimOffer(userCtx, *payload.WaitlistEntryId, payload); err != nil {
+		return nil, err
+	}
+	booking, err := b.createBooking(userCtx, payload)
+	if err != nil {
+		_ = b.WaitlistService.RestoreOffer(*payload.WaitlistEntryId)
+		return nil, err
+	}
+	return booking, nil
+}
+
+func (b *BookingService) createBooking(userCtx *models.UserContext, payload *payloads.BookingPayload) (*models.Bookings, error) {
 	rooms := payload.Rooms
 	hotelId := payload.HotelId
 
-	for _, room := range rooms {
-		if !b.RoomService.IsAvailable(room, hotelId) {
-			return nil, errors.New("rooms not available")
-		}
+	hotel, err := b.HotelService.GetHotelByID(hotelId)
+	if err != nil {
+		return nil, err
 	}
+	if payload.CheckIn.Before(hotel.Today(time.Now())) {
+		return nil, ErrCheckInInPast
+	}
+	checkIn := hotel.CheckInAt(payload.CheckIn.Time)
+	checkOut := hotel.CheckOutAt(payload.CheckOut.Time)
 
-	booking := models.Bookings{
-		Id:        uuid.New(),
-		UserId:    userCtx.Id,
-		HotelId:   hotelId,
-		CheckIn:   payload.CheckIn,
-		CheckOut:  payload.CheckOut,
-		Status:    booking_status.StatusConfirmed,
-		CreatedAt: time.Now(),
+	extraGuestCharges, err := b.extraGuestCharges(hotelId, rooms)
+	if err != nil {
+		return nil, err
 	}
 
-	// Reduce available room quantity before booking
+	// restrictions are checked on their own first so the violation reaches the caller
 	for _, room := range rooms {
-		if err := b.RoomService.ReduceRoomQuantity(room, hotelId); err != nil {
+		if err := b.RoomService.CheckRestrictions(room, hotelId, checkIn, checkOut); err != nil {
 			return nil, err
 		}
 	}
 
+	for _, room := range rooms {
+		if !b.RoomService.IsAvailable(room, hotelId, checkIn, checkOut) {
+			return nil, ErrRoomsNotAvailable
+		}
+	}
+
+	// rooms sold past physical inventory are worked out before inventory is
+	// taken and recorded once the booking exists
+	oversold, err := b.OverbookingService.OversoldRooms(hotelId, rooms, checkIn, checkOut)
+	if err != nil {
+		return nil, err
+	}
+
+	hotelRooms, err := b.RoomService.GetAllRoomByHotelID(hotelId)
+	if err != nil {
+		return nil, err
+	}
+	// a hotel prices every room in its own currency, which the booking keeps
+	priceByType := make(map[room.RoomType]int64)
+	for _, hotelRoom := range hotelRooms {
+		priceByType[hotelRoom.RoomCategory] = hotelRoom.Price
+	}
+
+	booking := models.Bookings{
+		Id:               uuid.New(),
+		UserId:           userCtx.Id,
+		HotelId:          hotelId,
+		CheckIn:          checkIn,
+		CheckOut:         checkOut,
+		Status:           booking_status.StatusConfirmed,
+		Currency:         hotel.Currency,
+		EstimatedArrival: payload.EstimatedArrival,
+		Notes:            payload.Notes,
+		GroupCode:        payload.GroupCode,
+		CreatedAt:        time.Now(),
+	}
+	booking.Guests = bookingGuests(booking.Id, payload)
+	nights := utils.CountNights(booking.CheckIn, booking.CheckOut)
+
 	// Prepare booked rooms
 	bookedRoomsData := make([]*models.BookedRooms, 0)
-	for _, room := range rooms {
+	for i, room := range rooms {
 		bookedRoom := &models.BookedRooms{
-			Id:           uuid.New(),
-			BookingId:    booking.Id,
-			RoomType:     room.RoomType,
-			RoomQuantity: room.Quantity,
-			CreatedAt:    time.Now(),
+			Id:               uuid.New(),
+			BookingId:        booking.Id,
+			RoomType:         room.RoomType,
+			RoomQuantity:     room.Quantity,
+			PricePerNight:    priceByType[room.RoomType] + extraGuestCharges[i],
+			Adults:           room.Adults,
+			Children:         room.Children,
+			ExtraGuestCharge: extraGuestCharges[i],
+			CreatedAt:        time.Now(),
 		}
 		bookedRoomsData = append(bookedRoomsData, bookedRoom)
+		booking.TotalAmount += bookedRoom.PricePerNight * int64(bookedRoom.RoomQuantity) * int64(nights)
 	}
 
-	savedBooking, err := b.BookingRepo.CreateBookingWithRooms(&booking, bookedRoomsData)
+	if payload.PromoCode != "" {
+		discount, err := b.PromoService.ApplyPromoCode(userCtx.Id, booking.Id, hotelId, payload.PromoCode, booking.TotalAmount)
+		if err != nil {
+			return nil, err
+		}
+		booking.Discounts = []*models.BookingDiscounts{discount}
+		booking.TotalAmount -= discount.Amount
+	}
+
+	booking.Taxes, err = b.TaxService.CalculateTaxes(hotelId, booking.Id, bookedRoomsData, nights, booking.TotalAmount)
 	if err != nil {
+		b.releasePromoCode(&booking)
 		return nil, err
 	}
+	for _, tax := range booking.Taxes {
+		if !tax.Inclusive {
+			booking.TotalAmount += tax.Amount
+		}
+	}
+
+	// Reduce available room quantity before booking
+	for _, room := range rooms {
+		if err := b.RoomService.ReduceRoomQuantity(room, hotelId); err != nil {
+			b.releasePromoCode(&booking)
+			return nil, err
+		}
+	}
 
+	created := &models.BookingEvents{
+		Id:         uuid.New(),
+		BookingId:  booking.Id,
+		FromStatus: "",
+		ToStatus:   booking.Status,
+		ActorId:    &userCtx.Id,
+		Reason:     "booking created",
+		CreatedAt:  time.Now(),
+	}
+	savedBooking, err := b.BookingRepo.CreateBookingWithRooms(&booking, bookedRoomsData, created)
+	if err != nil {
+		b.releasePromoCode(&booking)
+		return nil, err
+	}
+
+	// the booking is already saved; a missing audit row must not make it look failed
+	if len(oversold) > 0 {
+		if err := b.OverbookingService.RecordOversoldBooking(savedBooking.Id, oversold); err != nil {
+			log.Printf("booking %s was oversold but its overbooking audit was not saved: %v", savedBooking.Id, err)
+		}
+	}
+
+	savedBooking.PriceBreakdown = priceBreakdown(savedBooking)
 	return savedBooking, nil
 }
 
-func (b *BookingService) CheckoutBooking(bookingId uuid.UUID) (*models.Bookings, error) {
+// priceBreakdown sums the discount and tax lines of a booking. The subtotal is
+// worked back from the total so it also holds for bookings read from the store.
+func priceBreakdown(booking *models.Bookings) *models.PriceBreakdown {
+	breakdown := &models.PriceBreakdown{Total: booking.TotalAmount}
+	for _, discount := range booking.Discounts {
+		breakdown.Discounts += discount.Amount
+	}
+	for _, tax := range booking.Taxes {
+		if tax.Inclusive {
+			breakdown.IncludedTaxes += tax.Amount
+		} else {
+			breakdown.Taxes += tax.Amount
+		}
+	}
+	breakdown.Subtotal = breakdown.Total - breakdown.Taxes + breakdown.Discounts
+	return breakdown
+}
+
+// releasePromoCode gives back the promo code use of a booking that was not
+// created. A failed release is not reported; the booking error is the one the caller needs.
+func (b *BookingService) releasePromoCode(booking *models.Bookings) {
+	if len(booking.Discounts) == 0 {
+		return
+	}
+	_ = b.PromoService.ReleasePromoCode(booking.Id)
+}
+
+func (b *BookingService) CheckInBooking(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.CheckInPayload) (*models.Bookings, []*models.RoomUnits, error) {
+	booking, err := b.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := booking_status.ValidateTransition(booking.Status, booking_status.StatusCheckedIn); err != nil {
+		return nil, nil, err
+	}
+
+	bookedRooms, err := b.BookingRepo.GetBookedRoomsByBookingId(bookingId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assignments, units, err := b.RoomUnitService.PickUnits(booking.HotelId, bookedRooms, payload.UnitIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the units are saved by the statement that saves the check-in, so a
+	// request that loses a race for the same booking assigns nothing
+	event := newBookingEvent(booking, booking_status.StatusCheckedIn, &userCtx.Id, "guest checked in")
+	if err := b.BookingRepo.SaveCheckIn(booking, event, assignments); err != nil {
+		return nil, nil, err
+	}
+	booking.Status = booking_status.StatusCheckedIn
+
+	return booking, units, nil
+}
+
+func (b *BookingService) CheckoutBooking(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.CheckoutPayload) (*models.Bookings, error) {
 	booking, err := b.BookingRepo.GetBookingById(bookingId)
 	if err != nil {
 		return nil, err
 	}
 
-	if booking.Status != booking_status.StatusConfirmed {
-		return nil, errors.New("Only confirmed bookings can be checked out")
+	if err := booking_status.ValidateTransition(booking.Status, booking_status.StatusCheckedOut); err != nil {
+		return nil, err
+	}
+
+	if err := b.FolioService.CheckSettlement(booking, payload); err != nil {
+		return nil, err
 	}
 
 	// increase room quantity back
@@ -132,25 +366,228 @@ func (b *BookingService) CheckoutBooking(bookingId uuid.UUID) (*models.Bookings,
 		return nil, err
 	}
 
-	var roomsPayload []*payloads.RoomPayload
+	if err := b.transition(booking, booking_status.StatusCheckedOut, &userCtx.Id, "guest checked out"); err != nil {
+		return nil, err
+	}
+
+	if err := b.releaseRooms(booking, bookedRooms); err != nil {
+		return nil, err
+	}
+
+	if payload.WaiveBalance {
+		if err := b.FolioService.WaiveBalance(userCtx, booking, payload.WaiverReason); err != nil {
+			log.Printf("booking %s checked out but its balance was not written off: %v", booking.Id, err)
+		}
+	}
+
+	// the guest has left either way; housekeeping can still raise the tasks by hand
+	if _, err := b.HousekeepingService.CreateCheckoutTasks(booking); err != nil {
+		log.Printf("booking %s checked out but housekeeping tasks were not created: %v", booking.Id, err)
+	}
+
+	return booking, nil
+}
+
+// GetBooking returns a booking with its named guests and price lines to the guest
+// who made it or to front-desk staff.
+func (b *BookingService) GetBooking(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Bookings, error) {
+	booking, err := b.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserId != userCtx.Id && !permissions.IsFrontDesk(userCtx) {
+		return nil, ErrBookingAccessDenied
+	}
+
+	hotel, err := b.HotelService.GetHotelByID(booking.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	localizeStay(booking, hotel)
+
+	booking.Guests, err = b.BookingRepo.GetBookingGuestsByBookingId(bookingId)
+	if err != nil {
+		return nil, err
+	}
+	booking.Discounts, err = b.BookingRepo.GetBookingDiscountsByBookingId(bookingId)
+	if err != nil {
+		return nil, err
+	}
+	booking.Taxes, err = b.BookingRepo.GetBookingTaxesByBookingId(bookingId)
+	if err != nil {
+		return nil, err
+	}
+	booking.PriceBreakdown = priceBreakdown(booking)
+	return booking, nil
+}
+
+// GetBookingHistory returns the status changes of a booking, oldest first.
+// Guests can only see their own bookings.
+func (b *BookingService) GetBookingHistory(userCtx *models.UserContext, bookingId uuid.UUID) ([]*models.BookingEvents, error) {
+	booking, err := b.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserId != userCtx.Id && !permissions.IsFrontDesk(userCtx) {
+		return nil, ErrBookingAccessDenied
+	}
+
+	return b.BookingRepo.GetBookingEventsByBookingId(bookingId)
+}
+
+// MarkNoShows moves confirmed bookings past their hotel's no-show cutoff to
+// StatusNoShow, charges the hotel's no-show nights and returns the rooms to inventory.
+func (b *BookingService) MarkNoShows(now time.Time) ([]*models.Bookings, error) {
+	candidates, err := b.BookingRepo.GetNoShowCandidates(now)
+	if err != nil {
+		return nil, err
+	}
+
+	marked := make([]*models.Bookings, 0, len(candidates))
+	for _, booking := range candidates {
+		hotel, err := b.HotelService.GetHotelByID(booking.HotelId)
+		if err != nil {
+			return marked, err
+		}
+		localizeStay(booking, hotel)
+
+		bookedRooms, err := b.BookingRepo.GetBookedRoomsByBookingId(booking.Id)
+		if err != nil {
+			return marked, err
+		}
+
+		chargeNights := min(hotel.NoShowChargeNights, utils.CountNights(booking.CheckIn, booking.CheckOut))
+		var penalty int64
+		for _, bookedRoom := range bookedRooms {
+			penalty += bookedRoom.PricePerNight * int64(bookedRoom.RoomQuantity) * int64(chargeNights)
+		}
+		booking.PenaltyAmount = min(penalty, booking.TotalAmount)
+		booking.RefundAmount = booking.TotalAmount - booking.PenaltyAmount
+
+		if err := b.transition(booking, booking_status.StatusNoShow, nil, "no-show cutoff passed"); err != nil {
+			return marked, err
+		}
+
+		if err := b.releaseRooms(booking, bookedRooms); err != nil {
+			return marked, err
+		}
+		marked = append(marked, booking)
+	}
+
+	return marked, nil
+}
+
+// transition moves the booking to next through the booking state machine and records
+// who made the change. A nil actorId marks a system change.
+func (b *BookingService) transition(booking *models.Bookings, next booking_status.BookingStatus, actorId *uuid.UUID, reason string) error {
+	if err := booking_status.ValidateTransition(booking.Status, next); err != nil {
+		return err
+	}
+
+	if err := b.BookingRepo.SaveTransition(booking, newBookingEvent(booking, next, actorId, reason)); err != nil {
+		return err
+	}
+
+	booking.Status = next
+	return nil
+}
+
+func newBookingEvent(booking *models.Bookings, next booking_status.BookingStatus, actorId *uuid.UUID, reason string) *models.BookingEvents {
+	return &models.BookingEvents{
+		Id:         uuid.New(),
+		BookingId:  booking.Id,
+		FromStatus: booking.Status,
+		ToStatus:   next,
+		ActorId:    actorId,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// localizeStay moves the stay of a booking read from the store into hotel time,
+// so its calendar dates are the ones the guest booked.
+func localizeStay(booking *models.Bookings, hotel *models.Hotels) {
+	loc := hotel.Location()
+	booking.CheckIn = booking.CheckIn.In(loc)
+	booking.CheckOut = booking.CheckOut.In(loc)
+}
+
+// releaseRooms returns the booked rooms to the hotel's available inventory and
+// offers them to guests on the waitlist. Rooms picked up from a group block go
+// back to the block while it is still held. A failed offer does not fail the
+// release; the waitlist job offers the rooms on its next run.
+//
+// Callers release only after the booking's transition has been saved, so a
+// request that loses a race for the same booking returns nothing twice.
+func (b *BookingService) releaseRooms(booking *models.Bookings, bookedRooms []*models.BookedRooms) error {
+	hotelId := booking.HotelId
+	roomPayloads := make([]*payloads.RoomPayload, 0, len(bookedRooms))
 	for _, bookedRoom := range bookedRooms {
 		roomPayload := &payloads.RoomPayload{
 			RoomType: bookedRoom.RoomType,
 			Quantity: bookedRoom.RoomQuantity,
 		}
-		roomsPayload = append(roomsPayload, roomPayload)
+		if _, err := b.RoomService.IncreaseRoomQuantity(roomPayload, hotelId); err != nil {
+			return err
+		}
+		roomPayloads = append(roomPayloads, roomPayload)
+	}
+
+	if booking.GroupCode != "" {
+		if err := b.GroupBlockService.ReturnRooms(hotelId, booking.GroupCode, roomPayloads); err != nil {
+			return err
+		}
+	}
+
+	for _, bookedRoom := range bookedRooms {
+		_, _ = b.WaitlistService.OfferFreedRooms(hotelId, bookedRoom.RoomType)
 	}
+	return nil
+}
 
-	for _, room := range roomsPayload {
-		if _, err := b.RoomService.IncreaseRoomQuantity(room, booking.HotelId); err != nil {
+// extraGuestCharges checks every requested room against its type's maximum occupancy
+// and returns, per requested room, the nightly charge for guests above the base occupancy.
+func (b *BookingService) extraGuestCharges(hotelId uuid.UUID, rooms []*payloads.RoomPayload) ([]int64, error) {
+	charges := make([]int64, len(rooms))
+	for i, room := range rooms {
+		roomType, err := b.RoomTypeService.GetRoomType(hotelId, room.RoomType)
+		if err != nil {
 			return nil, err
 		}
+
+		guests := room.Adults + room.Children
+		if guests > roomType.MaxOccupancy {
+			return nil, fmt.Errorf("%w: %s rooms sleep at most %d guests, got %d", ErrOccupancyExceeded, room.RoomType, roomType.MaxOccupancy, guests)
+		}
+		if extra := guests - roomType.BaseOccupancy; extra > 0 {
+			charges[i] = int64(extra) * roomType.ExtraGuestFee
+		}
 	}
+	return charges, nil
+}
 
-	booking.Status = booking_status.StatusCheckedOut
-	if err := b.BookingRepo.Save(booking); err != nil {
-		return nil, err
+func bookingGuests(bookingId uuid.UUID, payload *payloads.BookingPayload) []*models.BookingGuests {
+	if payload.LeadGuest == nil {
+		return nil
 	}
 
-	return booking, nil
+	guests := []*models.BookingGuests{newBookingGuest(bookingId, payload.LeadGuest, true)}
+	for _, guest := range payload.Guests {
+		guests = append(guests, newBookingGuest(bookingId, guest, false))
+	}
+	return guests
+}
+
+func newBookingGuest(bookingId uuid.UUID, guest *payloads.GuestPayload, isLead bool) *models.BookingGuests {
+	return &models.BookingGuests{
+		Id:        uuid.New(),
+		BookingId: bookingId,
+		FullName:  guest.FullName,
+		Email:     guest.Email,
+		Phone:     guest.Phone,
+		IsLead:    isLead,
+		CreatedAt: time.Now(),
+	}
 }
@@ -1,6 +1,8 @@
 package booking_service
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/tktanisha/booking_system/internal/models"
@@ -11,6 +13,10 @@ import (
 
 type BookingServiceInterface interface {
 	CreateBooking(*models.UserContext, *payloads.BookingPayload) (*models.Bookings, error)
-	CancelBooking(uuid.UUID) (*models.Bookings, error)
-	CheckoutBooking(uuid.UUID) (*models.Bookings, error)
+	CancelBooking(*models.UserContext, uuid.UUID) (*models.Bookings, error)
+	CheckInBooking(*models.UserContext, uuid.UUID, *payloads.CheckInPayload) (*models.Bookings, []*models.RoomUnits, error)
+	CheckoutBooking(*models.UserContext, uuid.UUID, *payloads.CheckoutPayload) (*models.Bookings, error)
+	GetBooking(*models.UserContext, uuid.UUID) (*models.Bookings, error)
+	GetBookingHistory(*models.UserContext, uuid.UUID) ([]*models.BookingEvents, error)
+	MarkNoShows(now time.Time) ([]*models.Bookings, error)
 }
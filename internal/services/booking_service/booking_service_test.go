@@ -2,16 +2,25 @@ package booking_service_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
 	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
 	"github.com/tktanisha/booking_system/internal/mocks"
 	"github.com/tktanisha/booking_system/internal/models"
 	"github.com/tktanisha/booking_system/internal/services/booking_service"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -21,13 +30,29 @@ func TestBookingService_CancelBooking(t *testing.T) {
 
 	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
 	mockRoomService := mocks.NewMockRoomServiceInterface(ctrl)
-	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService)
+	mockCancellationService := mocks.NewMockCancellationServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	mockRoomUnitService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	mockHousekeepingService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	mockRoomTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockPromoService := mocks.NewMockPromoServiceInterface(ctrl)
+	mockTaxService := mocks.NewMockTaxServiceInterface(ctrl)
+	mockFolioService := mocks.NewMockFolioServiceInterface(ctrl)
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	mockOverbookingService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService, mockCancellationService, mockHotelService, mockRoomUnitService, mockHousekeepingService, mockRoomTypeService, mockPromoService, mockTaxService, mockFolioService, mockWaitlistService, mockGroupBlockService, mockOverbookingService)
+	mockWaitlistService.EXPECT().OfferFreedRooms(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
+	userCtx := &models.UserContext{Id: uuid.New()}
+	managerCtx := &models.UserContext{Id: uuid.New()}
 	bookingID := uuid.New()
 	hotelID := uuid.New()
+	mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id, TimeZone: "Asia/Tokyo"}, nil).AnyTimes()
 
 	tests := []struct {
 		name        string
+		caller      *models.UserContext
 		mockSetup   func()
 		expectError bool
 	}{
@@ -37,19 +62,57 @@ func TestBookingService_CancelBooking(t *testing.T) {
 				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
 					&models.Bookings{
 						Id:      bookingID,
-						UserId:  uuid.New(),
+						UserId:  userCtx.Id,
 						HotelId: hotelID,
 						CheckIn: time.Now().Add(24 * time.Hour),
 						Status:  booking_status.StatusConfirmed,
 					}, nil)
 				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(
 					[]*models.BookedRooms{{RoomType: room.Single, RoomQuantity: 2}}, nil)
+				mockCancellationService.EXPECT().CalculatePenalty(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
 				mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(
 					&models.Rooms{}, nil)
-				mockBookingRepo.EXPECT().Save(gomock.Any()).Return(nil)
+				mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectError: false,
 		},
+		{
+			name:   "hotel manager cancels a group booking, which returns its rooms to the block",
+			caller: managerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{
+						Id:        bookingID,
+						UserId:    uuid.New(),
+						HotelId:   hotelID,
+						CheckIn:   time.Now().Add(24 * time.Hour),
+						Status:    booking_status.StatusConfirmed,
+						GroupCode: "SMITH-WEDDING",
+					}, nil)
+				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(
+					[]*models.BookedRooms{{RoomType: room.Single, RoomQuantity: 2}}, nil)
+				mockCancellationService.EXPECT().CalculatePenalty(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+				mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(
+					&models.Rooms{}, nil)
+				mockGroupBlockService.EXPECT().ReturnRooms(hotelID, "SMITH-WEDDING", []*payloads.RoomPayload{{RoomType: room.Single, Quantity: 2}}).Return(nil)
+				mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "another user cannot cancel",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{
+						Id:      bookingID,
+						UserId:  uuid.New(),
+						HotelId: hotelID,
+						CheckIn: time.Now().Add(24 * time.Hour),
+						Status:  booking_status.StatusConfirmed,
+					}, nil)
+			},
+			expectError: true,
+		},
 		{
 			name: "error fetching booking",
 			mockSetup: func() {
@@ -60,7 +123,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 		{
 			name: "cannot cancel after check-in",
 			mockSetup: func() {
-				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{CheckIn: time.Now().Add(-2 * time.Hour)}, nil)
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{UserId: userCtx.Id, CheckIn: time.Now().Add(-2 * time.Hour)}, nil)
 			},
 			expectError: true,
 		},
@@ -68,7 +131,7 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			name: "booking already cancelled",
 			mockSetup: func() {
 				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
-					&models.Bookings{CheckIn: time.Now().Add(-2 * time.Hour), Status: booking_status.StatusCancelled}, nil)
+					&models.Bookings{UserId: userCtx.Id, CheckIn: time.Now().Add(-2 * time.Hour), Status: booking_status.StatusCancelled}, nil)
 			},
 			expectError: true,
 		},
@@ -76,45 +139,60 @@ func TestBookingService_CancelBooking(t *testing.T) {
 			name: "error fetching booked rooms",
 			mockSetup: func() {
 				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
-					&models.Bookings{CheckIn: time.Now().Add(24 * time.Hour), Status: booking_status.StatusConfirmed}, nil)
+					&models.Bookings{UserId: userCtx.Id, CheckIn: time.Now().Add(24 * time.Hour), Status: booking_status.StatusConfirmed}, nil)
 
 				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(
 					nil, errors.New("db error"))
 			},
 			expectError: true,
 		},
+		{
+			name: "error calculating penalty",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{UserId: userCtx.Id, CheckIn: time.Now().Add(24 * time.Hour), Status: booking_status.StatusConfirmed}, nil)
+				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(
+					[]*models.BookedRooms{{RoomType: room.Single, RoomQuantity: 2}}, nil)
+				mockCancellationService.EXPECT().CalculatePenalty(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					int64(0), errors.New("policy lookup failed"))
+			},
+			expectError: true,
+		},
 		{
 			name: "error increasing room quantity",
 			mockSetup: func() {
 				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
 					&models.Bookings{
 						Id:      bookingID,
+						UserId:  userCtx.Id,
 						HotelId: hotelID,
 						CheckIn: time.Now().Add(24 * time.Hour),
 						Status:  booking_status.StatusConfirmed,
 					}, nil)
 				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(
 					[]*models.BookedRooms{{RoomType: room.Single, RoomQuantity: 2}}, nil)
+				mockCancellationService.EXPECT().CalculatePenalty(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+				mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil)
 				mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(
 					nil, errors.New("unable to increase"))
 			},
 			expectError: true,
 		},
 		{
-			name: "error saving booking",
+			name: "status changed concurrently releases no rooms",
 			mockSetup: func() {
 				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
 					&models.Bookings{
 						Id:      bookingID,
+						UserId:  userCtx.Id,
 						HotelId: hotelID,
 						CheckIn: time.Now().Add(24 * time.Hour),
 						Status:  booking_status.StatusConfirmed,
 					}, nil)
 				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(
 					[]*models.BookedRooms{{RoomType: room.Single, RoomQuantity: 2}}, nil)
-				mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(
-					&models.Rooms{}, nil)
-				mockBookingRepo.EXPECT().Save(gomock.Any()).Return(errors.New("interanl server error"))
+				mockCancellationService.EXPECT().CalculatePenalty(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), nil)
+				mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(errors.New("interanl server error"))
 			},
 			expectError: true,
 		},
@@ -123,7 +201,11 @@ func TestBookingService_CancelBooking(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
-			_, err := service.CancelBooking(bookingID)
+			caller := tt.caller
+			if caller == nil {
+				caller = userCtx
+			}
+			_, err := service.CancelBooking(caller, bookingID)
 			if (err != nil) != tt.expectError {
 				t.Errorf("expected error=%v, got=%v", tt.expectError, err)
 			}
@@ -137,31 +219,284 @@ func TestBookingService_CreateBooking(t *testing.T) {
 
 	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
 	mockRoomService := mocks.NewMockRoomServiceInterface(ctrl)
-	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService)
+	mockCancellationService := mocks.NewMockCancellationServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	mockRoomUnitService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	mockHousekeepingService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	mockRoomTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockPromoService := mocks.NewMockPromoServiceInterface(ctrl)
+	mockTaxService := mocks.NewMockTaxServiceInterface(ctrl)
+	mockFolioService := mocks.NewMockFolioServiceInterface(ctrl)
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	mockOverbookingService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService, mockCancellationService, mockHotelService, mockRoomUnitService, mockHousekeepingService, mockRoomTypeService, mockPromoService, mockTaxService, mockFolioService, mockWaitlistService, mockGroupBlockService, mockOverbookingService)
+	mockWaitlistService.EXPECT().OfferFreedRooms(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	userCtx := &models.UserContext{Id: uuid.New()}
 	hotelID := uuid.New()
 	roomPayload := &payloads.RoomPayload{RoomType: "Deluxe", Quantity: 2}
+	hotelRooms := []*models.Rooms{{RoomCategory: "Deluxe", AvailableQuantity: 5, Price: 10000}}
+	hotel := &models.Hotels{Id: hotelID, TimeZone: "Pacific/Kiritimati", CheckInTime: "15:00", CheckOutTime: "11:00", Currency: "EUR"}
+	mockHotelService.EXPECT().GetHotelByID(hotelID).Return(hotel, nil).AnyTimes()
+	tomorrow := payloads.NewDate(hotel.Today(time.Now()).AddDate(0, 0, 1))
 	payload := &payloads.BookingPayload{
 		HotelId:  hotelID,
-		CheckIn:  time.Now().Add(24 * time.Hour),
-		CheckOut: time.Now().Add(48 * time.Hour),
+		CheckIn:  tomorrow,
+		CheckOut: payloads.NewDate(tomorrow.AddDate(0, 0, 1)),
 		Rooms:    []*payloads.RoomPayload{roomPayload},
 	}
+	deluxe := &models.RoomTypes{Code: "Deluxe", MaxOccupancy: 3, BaseOccupancy: 2, ExtraGuestFee: 2500}
+	mockRoomTypeService.EXPECT().GetRoomType(hotelID, roomPayload.RoomType).Return(deluxe, nil).AnyTimes()
+	restrictedPayload := &payloads.RoomPayload{RoomType: "Deluxe", Quantity: 1, Adults: 1}
+	mockRoomService.EXPECT().CheckRestrictions(gomock.Not(restrictedPayload), hotelID, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	taxedPayload := &payloads.RoomPayload{RoomType: "Deluxe", Quantity: 3}
+	mockTaxService.EXPECT().CalculateTaxes(hotelID, gomock.Any(), gomock.Any(), 1, gomock.Not(int64(30000))).Return(nil, nil).AnyTimes()
+	oversoldPayload := &payloads.RoomPayload{RoomType: "Deluxe", Quantity: 4}
+	mockOverbookingService.EXPECT().OversoldRooms(hotelID, gomock.Not([]*payloads.RoomPayload{oversoldPayload}), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
 	t.Run("success", func(t *testing.T) {
-		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID).Return(true)
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
 		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
-		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any()).Return(&models.Bookings{Id: uuid.New()}, nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, e *models.BookingEvents) (*models.Bookings, error) {
+				if e.BookingId != booking.Id || e.FromStatus != "" || e.ToStatus != booking_status.StatusConfirmed {
+					t.Errorf("unexpected creation event %q -> %q", e.FromStatus, e.ToStatus)
+				}
+				return booking, nil
+			})
 
-		_, err := service.CreateBooking(userCtx, payload)
+		booking, err := service.CreateBooking(userCtx, payload)
 		if err != nil {
-			t.Errorf("unexpected error: %v", err)
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// 2 rooms x 1 night x 10000
+		if booking.TotalAmount != 20000 {
+			t.Errorf("expected total amount 20000, got %d", booking.TotalAmount)
+		}
+		if booking.Currency != "EUR" {
+			t.Errorf("expected the hotel currency EUR, got %q", booking.Currency)
+		}
+		// the stay runs from the hotel's check-in time to its check-out time in hotel time
+		if !booking.CheckIn.Equal(hotel.CheckInAt(payload.CheckIn.Time)) || booking.CheckIn.Hour() != 15 {
+			t.Errorf("expected check-in at 15:00 hotel time, got %v", booking.CheckIn)
+		}
+		if !booking.CheckOut.Equal(hotel.CheckOutAt(payload.CheckOut.Time)) || booking.CheckOut.Hour() != 11 {
+			t.Errorf("expected check-out at 11:00 hotel time, got %v", booking.CheckOut)
 		}
 	})
 
-	t.Run("room not available", func(t *testing.T) {
-		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID).Return(false)
+	t.Run("check-in date already passed in hotel time", func(t *testing.T) {
+		yesterday := payloads.NewDate(hotel.Today(time.Now()).AddDate(0, 0, -1))
+
+		_, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  yesterday,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{roomPayload},
+		})
+		if !errors.Is(err, booking_service.ErrCheckInInPast) {
+			t.Errorf("expected ErrCheckInInPast, got %v", err)
+		}
+	})
+
+	t.Run("extra guests are charged per night", func(t *testing.T) {
+		familyPayload := &payloads.RoomPayload{RoomType: "Deluxe", Quantity: 2, Adults: 2, Children: 1}
+		mockRoomService.EXPECT().IsAvailable(familyPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(familyPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				if bookedRooms[0].ExtraGuestCharge != 2500 || bookedRooms[0].PricePerNight != 12500 {
+					t.Errorf("expected 2500 extra guest charge on a 12500 nightly rate, got %d and %d", bookedRooms[0].ExtraGuestCharge, bookedRooms[0].PricePerNight)
+				}
+				if bookedRooms[0].Adults != 2 || bookedRooms[0].Children != 1 {
+					t.Errorf("expected guest counts to be stored, got %+v", bookedRooms[0])
+				}
+				return booking, nil
+			})
+
+		booking, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{familyPayload},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// 2 rooms x 1 night x (10000 + 1 extra guest x 2500)
+		if booking.TotalAmount != 25000 {
+			t.Errorf("expected total amount 25000, got %d", booking.TotalAmount)
+		}
+	})
+
+	t.Run("named guests are stored with the booking", func(t *testing.T) {
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				if len(booking.Guests) != 2 || !booking.Guests[0].IsLead || booking.Guests[1].IsLead {
+					t.Errorf("expected lead guest followed by one companion, got %+v", booking.Guests)
+				}
+				if booking.Guests[1].BookingId != booking.Id {
+					t.Errorf("expected guests to reference the booking")
+				}
+				if booking.EstimatedArrival != "21:30" || booking.Notes != "cot please" {
+					t.Errorf("expected eta and notes to be stored, got %q %q", booking.EstimatedArrival, booking.Notes)
+				}
+				return booking, nil
+			})
+
+		_, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:          hotelID,
+			CheckIn:          payload.CheckIn,
+			CheckOut:         payload.CheckOut,
+			Rooms:            []*payloads.RoomPayload{roomPayload},
+			LeadGuest:        &payloads.GuestPayload{FullName: "Asha Rao", Email: "asha@example.com"},
+			Guests:           []*payloads.GuestPayload{{FullName: "Vikram Rao"}},
+			EstimatedArrival: "21:30",
+			Notes:            "cot please",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("promo code discounts the total", func(t *testing.T) {
+		promoPayload := *payload
+		promoPayload.PromoCode = "SUMMER10"
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockPromoService.EXPECT().ApplyPromoCode(userCtx.Id, gomock.Any(), hotelID, "SUMMER10", int64(20000)).
+			Return(&models.BookingDiscounts{Description: "Promo SUMMER10 (10% off)", Amount: 2000}, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				return booking, nil
+			})
+
+		booking, err := service.CreateBooking(userCtx, &promoPayload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if booking.TotalAmount != 18000 || len(booking.Discounts) != 1 {
+			t.Errorf("expected 18000 after one 2000 discount, got %d with %d discounts", booking.TotalAmount, len(booking.Discounts))
+		}
+	})
+
+	t.Run("taxes are itemized in the price breakdown", func(t *testing.T) {
+		mockRoomService.EXPECT().IsAvailable(taxedPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockTaxService.EXPECT().CalculateTaxes(hotelID, gomock.Any(), gomock.Any(), 1, int64(30000)).Return([]*models.BookingTaxes{
+			{Name: "VAT", ChargeType: tax.Percentage, Amount: 3600},
+			{Name: "Service charge", ChargeType: tax.Percentage, Inclusive: true, Amount: 1000},
+		}, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(taxedPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				if len(booking.Taxes) != 2 {
+					t.Errorf("expected tax lines to be stored, got %d", len(booking.Taxes))
+				}
+				return booking, nil
+			})
+
+		booking, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{taxedPayload},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// only the exclusive VAT is added on top of 3 rooms x 10000
+		want := models.PriceBreakdown{Subtotal: 30000, Taxes: 3600, IncludedTaxes: 1000, Total: 33600}
+		if booking.PriceBreakdown == nil || *booking.PriceBreakdown != want {
+			t.Errorf("expected breakdown %+v, got %+v", want, booking.PriceBreakdown)
+		}
+	})
+
+	t.Run("rejected promo code leaves inventory untouched", func(t *testing.T) {
+		promoPayload := *payload
+		promoPayload.PromoCode = "EXPIRED"
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockPromoService.EXPECT().ApplyPromoCode(userCtx.Id, gomock.Any(), hotelID, "EXPIRED", int64(20000)).
+			Return(nil, promo_service.ErrInvalidPromoCode)
+
+		_, err := service.CreateBooking(userCtx, &promoPayload)
+		if !errors.Is(err, promo_service.ErrInvalidPromoCode) {
+			t.Errorf("expected ErrInvalidPromoCode, got %v", err)
+		}
+	})
+
+	t.Run("promo code use is released when the booking fails", func(t *testing.T) {
+		promoPayload := *payload
+		promoPayload.PromoCode = "SUMMER10"
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockPromoService.EXPECT().ApplyPromoCode(userCtx.Id, gomock.Any(), hotelID, "SUMMER10", int64(20000)).
+			Return(&models.BookingDiscounts{Amount: 2000}, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		mockPromoService.EXPECT().ReleasePromoCode(gomock.Any()).Return(nil)
+
+		if _, err := service.CreateBooking(userCtx, &promoPayload); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("occupancy exceeded", func(t *testing.T) {
+		crowded := &payloads.RoomPayload{RoomType: "Deluxe", Quantity: 1, Adults: 5}
+
+		_, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{crowded},
+		})
+		if !errors.Is(err, booking_service.ErrOccupancyExceeded) {
+			t.Errorf("expected ErrOccupancyExceeded, got %v", err)
+		}
+	})
+
+	t.Run("unknown room type", func(t *testing.T) {
+		unknown := &payloads.RoomPayload{RoomType: "penthouse", Quantity: 1, Adults: 1}
+		mockRoomTypeService.EXPECT().GetRoomType(hotelID, unknown.RoomType).Return(nil, errors.New("room type not found"))
+
+		_, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{unknown},
+		})
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("stay restriction violated", func(t *testing.T) {
+		violation := &stay_restriction_service.ViolationError{Code: restriction.ClosedToArrival, Message: "closed to arrival"}
+		mockRoomService.EXPECT().CheckRestrictions(restrictedPayload, hotelID, gomock.Any(), gomock.Any()).Return(violation)
+
+		_, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{restrictedPayload},
+		})
+		var got *stay_restriction_service.ViolationError
+		if !errors.As(err, &got) || got.Code != restriction.ClosedToArrival {
+			t.Errorf("expected closed to arrival violation, got %v", err)
+		}
+	})
+
+	t.Run("error fetching room prices", func(t *testing.T) {
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(nil, errors.New("db error"))
 
 		_, err := service.CreateBooking(userCtx, payload)
 		if err == nil {
@@ -169,8 +504,18 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		}
 	})
 
+	t.Run("room not available", func(t *testing.T) {
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(false)
+
+		_, err := service.CreateBooking(userCtx, payload)
+		if !errors.Is(err, booking_service.ErrRoomsNotAvailable) {
+			t.Errorf("expected ErrRoomsNotAvailable, got %v", err)
+		}
+	})
+
 	t.Run("reduce room quantity failure", func(t *testing.T) {
-		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID).Return(true)
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
 		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(errors.New("reduce error"))
 
 		_, err := service.CreateBooking(userCtx, payload)
@@ -179,16 +524,258 @@ func TestBookingService_CreateBooking(t *testing.T) {
 		}
 	})
 
+	t.Run("oversold rooms are recorded with the booking", func(t *testing.T) {
+		audits := []*models.OverbookingAudits{{HotelId: hotelID, RoomType: "Deluxe", RoomQuantity: 4, OversoldQuantity: 1, PhysicalQuantity: 20, LimitPercent: 10}}
+		mockRoomService.EXPECT().IsAvailable(oversoldPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockOverbookingService.EXPECT().OversoldRooms(hotelID, []*payloads.RoomPayload{oversoldPayload}, gomock.Any(), gomock.Any()).Return(audits, nil)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(oversoldPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				return booking, nil
+			})
+		mockOverbookingService.EXPECT().RecordOversoldBooking(gomock.Any(), audits).Return(nil)
+
+		_, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{oversoldPayload},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("audit failure still returns the saved booking", func(t *testing.T) {
+		audits := []*models.OverbookingAudits{{HotelId: hotelID, RoomType: "Deluxe", RoomQuantity: 4, OversoldQuantity: 1, PhysicalQuantity: 20, LimitPercent: 10}}
+		mockRoomService.EXPECT().IsAvailable(oversoldPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockOverbookingService.EXPECT().OversoldRooms(hotelID, []*payloads.RoomPayload{oversoldPayload}, gomock.Any(), gomock.Any()).Return(audits, nil)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(oversoldPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				return booking, nil
+			})
+		mockOverbookingService.EXPECT().RecordOversoldBooking(gomock.Any(), audits).Return(errors.New("db error"))
+
+		booking, err := service.CreateBooking(userCtx, &payloads.BookingPayload{
+			HotelId:  hotelID,
+			CheckIn:  payload.CheckIn,
+			CheckOut: payload.CheckOut,
+			Rooms:    []*payloads.RoomPayload{oversoldPayload},
+		})
+		if err != nil || booking == nil {
+			t.Fatalf("expected the booking, got %v", err)
+		}
+	})
+
 	t.Run("create booking failure", func(t *testing.T) {
-		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID).Return(true)
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
 		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
-		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
 
 		_, err := service.CreateBooking(userCtx, payload)
 		if err == nil {
 			t.Errorf("expected error, got nil")
 		}
 	})
+
+	entryID := uuid.New()
+	offerPayload := &payloads.BookingPayload{
+		HotelId:         hotelID,
+		CheckIn:         payload.CheckIn,
+		CheckOut:        payload.CheckOut,
+		Rooms:           []*payloads.RoomPayload{roomPayload},
+		WaitlistEntryId: &entryID,
+	}
+
+	t.Run("waitlist offer is claimed for the booking", func(t *testing.T) {
+		gomock.InOrder(
+			mockWaitlistService.EXPECT().ClaimOffer(userCtx, entryID, offerPayload).Return(nil),
+			mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true),
+		)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				return booking, nil
+			})
+
+		if _, err := service.CreateBooking(userCtx, offerPayload); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("waitlist offer no longer open", func(t *testing.T) {
+		mockWaitlistService.EXPECT().ClaimOffer(userCtx, entryID, offerPayload).Return(waitlist_service.ErrOfferUnavailable)
+
+		if _, err := service.CreateBooking(userCtx, offerPayload); !errors.Is(err, waitlist_service.ErrOfferUnavailable) {
+			t.Errorf("expected ErrOfferUnavailable, got %v", err)
+		}
+	})
+
+	t.Run("waitlist offer reopens when the booking fails", func(t *testing.T) {
+		mockWaitlistService.EXPECT().ClaimOffer(userCtx, entryID, offerPayload).Return(nil)
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(false)
+		mockWaitlistService.EXPECT().RestoreOffer(entryID).Return(nil)
+
+		if _, err := service.CreateBooking(userCtx, offerPayload); !errors.Is(err, booking_service.ErrRoomsNotAvailable) {
+			t.Errorf("expected ErrRoomsNotAvailable, got %v", err)
+		}
+	})
+
+	groupPayload := &payloads.BookingPayload{
+		HotelId:   hotelID,
+		CheckIn:   payload.CheckIn,
+		CheckOut:  payload.CheckOut,
+		Rooms:     []*payloads.RoomPayload{roomPayload},
+		GroupCode: "SMITH-WEDDING",
+	}
+
+	t.Run("group rooms are picked up for the booking", func(t *testing.T) {
+		gomock.InOrder(
+			mockGroupBlockService.EXPECT().PickUpRooms(groupPayload).Return(nil),
+			mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(true),
+		)
+		mockRoomService.EXPECT().GetAllRoomByHotelID(hotelID).Return(hotelRooms, nil)
+		mockRoomService.EXPECT().ReduceRoomQuantity(roomPayload, hotelID).Return(nil)
+		mockBookingRepo.EXPECT().CreateBookingWithRooms(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(booking *models.Bookings, bookedRooms []*models.BookedRooms, _ *models.BookingEvents) (*models.Bookings, error) {
+				return booking, nil
+			})
+
+		booking, err := service.CreateBooking(userCtx, groupPayload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if booking.GroupCode != "SMITH-WEDDING" || booking.UserId != userCtx.Id {
+			t.Errorf("expected attendee booking under the group code, got %+v", booking)
+		}
+	})
+
+	t.Run("group block has no rooms left", func(t *testing.T) {
+		mockGroupBlockService.EXPECT().PickUpRooms(groupPayload).Return(group_block_service.ErrGroupRoomsUnavailable)
+
+		if _, err := service.CreateBooking(userCtx, groupPayload); !errors.Is(err, group_block_service.ErrGroupRoomsUnavailable) {
+			t.Errorf("expected ErrGroupRoomsUnavailable, got %v", err)
+		}
+	})
+
+	t.Run("group rooms go back to the block when the booking fails", func(t *testing.T) {
+		mockGroupBlockService.EXPECT().PickUpRooms(groupPayload).Return(nil)
+		mockRoomService.EXPECT().IsAvailable(roomPayload, hotelID, gomock.Any(), gomock.Any()).Return(false)
+		mockGroupBlockService.EXPECT().ReturnRooms(hotelID, "SMITH-WEDDING", groupPayload.Rooms).Return(nil)
+
+		if _, err := service.CreateBooking(userCtx, groupPayload); !errors.Is(err, booking_service.ErrRoomsNotAvailable) {
+			t.Errorf("expected ErrRoomsNotAvailable, got %v", err)
+		}
+	})
+}
+
+func TestBookingService_CheckInBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+	mockRoomService := mocks.NewMockRoomServiceInterface(ctrl)
+	mockCancellationService := mocks.NewMockCancellationServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	mockRoomUnitService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	mockHousekeepingService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	mockRoomTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockPromoService := mocks.NewMockPromoServiceInterface(ctrl)
+	mockTaxService := mocks.NewMockTaxServiceInterface(ctrl)
+	mockFolioService := mocks.NewMockFolioServiceInterface(ctrl)
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	mockOverbookingService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService, mockCancellationService, mockHotelService, mockRoomUnitService, mockHousekeepingService, mockRoomTypeService, mockPromoService, mockTaxService, mockFolioService, mockWaitlistService, mockGroupBlockService, mockOverbookingService)
+	mockWaitlistService.EXPECT().OfferFreedRooms(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	bookingID := uuid.New()
+	hotelID := uuid.New()
+	unitID := uuid.New()
+	bookedRooms := []*models.BookedRooms{{Id: uuid.New(), BookingId: bookingID, RoomType: room.Single, RoomQuantity: 1}}
+	payload := &payloads.CheckInPayload{UnitIds: []uuid.UUID{unitID}}
+	assignments := []*models.BookedRoomUnits{{Id: uuid.New(), BookedRoomId: bookedRooms[0].Id, RoomUnitId: unitID}}
+
+	tests := []struct {
+		name        string
+		mockSetup   func()
+		expectError bool
+	}{
+		{
+			name: "successfully checked in",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{Id: bookingID, HotelId: hotelID, Status: booking_status.StatusConfirmed}, nil)
+				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(bookedRooms, nil)
+				mockRoomUnitService.EXPECT().PickUnits(hotelID, bookedRooms, payload.UnitIds).
+					Return(assignments, []*models.RoomUnits{{Id: unitID, Number: "101"}}, nil)
+				mockBookingRepo.EXPECT().SaveCheckIn(gomock.Any(), gomock.Any(), assignments).DoAndReturn(func(b *models.Bookings, e *models.BookingEvents, a []*models.BookedRoomUnits) error {
+					if e.FromStatus != booking_status.StatusConfirmed || e.ToStatus != booking_status.StatusCheckedIn {
+						t.Errorf("unexpected transition %q -> %q", e.FromStatus, e.ToStatus)
+					}
+					if e.ActorId == nil || *e.ActorId != userCtx.Id {
+						t.Errorf("expected actor %v, got %v", userCtx.Id, e.ActorId)
+					}
+					return nil
+				})
+			},
+			expectError: false,
+		},
+		{
+			name: "error fetching booking",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(nil, errors.New("booking not found"))
+			},
+			expectError: true,
+		},
+		{
+			name: "booking not confirmed",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{Id: bookingID, Status: booking_status.StatusCancelled}, nil)
+			},
+			expectError: true,
+		},
+		{
+			name: "no free unit to assign",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{Id: bookingID, HotelId: hotelID, Status: booking_status.StatusConfirmed}, nil)
+				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(bookedRooms, nil)
+				mockRoomUnitService.EXPECT().PickUnits(hotelID, bookedRooms, payload.UnitIds).
+					Return(nil, nil, errors.New("not enough free single units to check in"))
+			},
+			expectError: true,
+		},
+		{
+			name: "status changed concurrently",
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(
+					&models.Bookings{Id: bookingID, HotelId: hotelID, Status: booking_status.StatusConfirmed}, nil)
+				mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(bookedRooms, nil)
+				mockRoomUnitService.EXPECT().PickUnits(hotelID, bookedRooms, payload.UnitIds).
+					Return(assignments, []*models.RoomUnits{{Id: unitID, Number: "101"}}, nil)
+				mockBookingRepo.EXPECT().SaveCheckIn(gomock.Any(), gomock.Any(), assignments).Return(errors.New("booking not found or its status has changed"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, _, err := service.CheckInBooking(userCtx, bookingID, payload)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error=%v, got=%v", tt.expectError, err)
+			}
+		})
+	}
 }
 
 func TestBookingService_CheckoutBooking(t *testing.T) {
@@ -197,45 +784,149 @@ func TestBookingService_CheckoutBooking(t *testing.T) {
 
 	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
 	mockRoomService := mocks.NewMockRoomServiceInterface(ctrl)
-	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService)
+	mockCancellationService := mocks.NewMockCancellationServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	mockRoomUnitService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	mockHousekeepingService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	mockRoomTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockPromoService := mocks.NewMockPromoServiceInterface(ctrl)
+	mockTaxService := mocks.NewMockTaxServiceInterface(ctrl)
+	mockFolioService := mocks.NewMockFolioServiceInterface(ctrl)
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	mockOverbookingService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService, mockCancellationService, mockHotelService, mockRoomUnitService, mockHousekeepingService, mockRoomTypeService, mockPromoService, mockTaxService, mockFolioService, mockWaitlistService, mockGroupBlockService, mockOverbookingService)
+	mockWaitlistService.EXPECT().OfferFreedRooms(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 
+	userCtx := &models.UserContext{Id: uuid.New()}
 	bookingID := uuid.New()
 	hotelID := uuid.New()
+	checkout := &payloads.CheckoutPayload{}
+	unpaidBooking := &models.Bookings{Id: uuid.New(), HotelId: hotelID, Status: booking_status.StatusCheckedIn}
+	mockFolioService.EXPECT().CheckSettlement(gomock.Not(unpaidBooking), checkout).Return(nil).AnyTimes()
 
 	t.Run("success", func(t *testing.T) {
 		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
 			Id:      bookingID,
 			HotelId: hotelID,
-			Status:  booking_status.StatusConfirmed,
+			Status:  booking_status.StatusCheckedIn,
+		}, nil)
+		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return([]*models.BookedRooms{
+			{RoomType: "Suite", RoomQuantity: 1},
+		}, nil)
+		mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(&models.Rooms{}, nil)
+		mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil)
+		mockHousekeepingService.EXPECT().CreateCheckoutTasks(gomock.Any()).Return([]*models.HousekeepingTasks{{Id: uuid.New()}}, nil)
+
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("housekeeping failure still checks the guest out", func(t *testing.T) {
+		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
+			Id:      bookingID,
+			HotelId: hotelID,
+			Status:  booking_status.StatusCheckedIn,
 		}, nil)
 		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return([]*models.BookedRooms{
 			{RoomType: "Suite", RoomQuantity: 1},
 		}, nil)
 		mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(&models.Rooms{}, nil)
-		mockBookingRepo.EXPECT().Save(gomock.Any()).Return(nil)
+		mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil)
+		mockHousekeepingService.EXPECT().CreateCheckoutTasks(gomock.Any()).Return(nil, errors.New("db error"))
 
-		_, err := service.CheckoutBooking(bookingID)
+		booking, err := service.CheckoutBooking(userCtx, bookingID, checkout)
 		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if booking.Status != booking_status.StatusCheckedOut {
+			t.Errorf("expected checked-out booking, got %s", booking.Status)
+		}
+	})
+
+	t.Run("waived balance is written off after the checkout is saved", func(t *testing.T) {
+		waiver := &payloads.CheckoutPayload{WaiveBalance: true, WaiverReason: "service recovery"}
+		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
+			Id:      bookingID,
+			HotelId: hotelID,
+			Status:  booking_status.StatusCheckedIn,
+		}, nil)
+		mockFolioService.EXPECT().CheckSettlement(gomock.Any(), waiver).Return(nil)
+		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return([]*models.BookedRooms{
+			{RoomType: "Suite", RoomQuantity: 1},
+		}, nil)
+		gomock.InOrder(
+			mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil),
+			mockFolioService.EXPECT().WaiveBalance(userCtx, gomock.Any(), "service recovery").Return(nil),
+		)
+		mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(&models.Rooms{}, nil)
+		mockHousekeepingService.EXPECT().CreateCheckoutTasks(gomock.Any()).Return(nil, nil)
+
+		if _, err := service.CheckoutBooking(userCtx, bookingID, waiver); err != nil {
 			t.Errorf("unexpected error: %v", err)
 		}
 	})
 
+	t.Run("failed checkout posts no waiver", func(t *testing.T) {
+		waiver := &payloads.CheckoutPayload{WaiveBalance: true, WaiverReason: "service recovery"}
+		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
+			Id:      bookingID,
+			HotelId: hotelID,
+			Status:  booking_status.StatusCheckedIn,
+		}, nil)
+		mockFolioService.EXPECT().CheckSettlement(gomock.Any(), waiver).Return(nil)
+		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return([]*models.BookedRooms{
+			{RoomType: "Suite", RoomQuantity: 1},
+		}, nil)
+		mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		if _, err := service.CheckoutBooking(userCtx, bookingID, waiver); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("unsettled folio blocks checkout", func(t *testing.T) {
+		clerkCtx := &models.UserContext{Id: uuid.New()}
+		mockBookingRepo.EXPECT().GetBookingById(unpaidBooking.Id).Return(unpaidBooking, nil)
+		mockFolioService.EXPECT().CheckSettlement(unpaidBooking, checkout).
+			Return(fmt.Errorf("%w: 1500 outstanding", folio_service.ErrBalanceOutstanding))
+
+		_, err := service.CheckoutBooking(clerkCtx, unpaidBooking.Id, checkout)
+		if !errors.Is(err, folio_service.ErrBalanceOutstanding) {
+			t.Errorf("expected ErrBalanceOutstanding, got %v", err)
+		}
+	})
+
 	t.Run("error fetching booking", func(t *testing.T) {
 		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(nil, errors.New("db error"))
 
-		_, err := service.CheckoutBooking(bookingID)
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
 		if err == nil {
 			t.Errorf("expected error, got nil")
 		}
 	})
 
-	t.Run("booking not confirmed", func(t *testing.T) {
+	t.Run("booking not checked in", func(t *testing.T) {
+		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
+			Id:     bookingID,
+			Status: booking_status.StatusConfirmed,
+		}, nil)
+
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
+		if err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("booking cancelled", func(t *testing.T) {
 		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
 			Id:     bookingID,
 			Status: booking_status.StatusCancelled,
 		}, nil)
 
-		_, err := service.CheckoutBooking(bookingID)
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
 		if err == nil {
 			t.Errorf("expected error, got nil")
 		}
@@ -245,11 +936,11 @@ func TestBookingService_CheckoutBooking(t *testing.T) {
 		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
 			Id:      bookingID,
 			HotelId: hotelID,
-			Status:  booking_status.StatusConfirmed,
+			Status:  booking_status.StatusCheckedIn,
 		}, nil)
 		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return(nil, errors.New("fetch error"))
 
-		_, err := service.CheckoutBooking(bookingID)
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
 		if err == nil {
 			t.Errorf("expected error, got nil")
 		}
@@ -259,34 +950,300 @@ func TestBookingService_CheckoutBooking(t *testing.T) {
 		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
 			Id:      bookingID,
 			HotelId: hotelID,
-			Status:  booking_status.StatusConfirmed,
+			Status:  booking_status.StatusCheckedIn,
 		}, nil)
 		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return([]*models.BookedRooms{
 			{RoomType: "Suite", RoomQuantity: 1},
 		}, nil)
+		mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(nil)
 		mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(nil, errors.New("increase error"))
 
-		_, err := service.CheckoutBooking(bookingID)
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
 		if err == nil {
 			t.Errorf("expected error, got nil")
 		}
 	})
 
-	t.Run("error saving booking", func(t *testing.T) {
+	t.Run("status changed concurrently releases no rooms", func(t *testing.T) {
 		mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{
 			Id:      bookingID,
 			HotelId: hotelID,
-			Status:  booking_status.StatusConfirmed,
+			Status:  booking_status.StatusCheckedIn,
 		}, nil)
 		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(bookingID).Return([]*models.BookedRooms{
 			{RoomType: "Suite", RoomQuantity: 1},
 		}, nil)
-		mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(&models.Rooms{}, nil)
-		mockBookingRepo.EXPECT().Save(gomock.Any()).Return(errors.New("save error"))
+		mockBookingRepo.EXPECT().SaveTransition(gomock.Any(), gomock.Any()).Return(errors.New("save error"))
 
-		_, err := service.CheckoutBooking(bookingID)
+		_, err := service.CheckoutBooking(userCtx, bookingID, checkout)
 		if err == nil {
 			t.Errorf("expected error, got nil")
 		}
 	})
 }
+
+func TestBookingService_MarkNoShows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+	mockRoomService := mocks.NewMockRoomServiceInterface(ctrl)
+	mockCancellationService := mocks.NewMockCancellationServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	mockRoomUnitService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	mockHousekeepingService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	mockRoomTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockPromoService := mocks.NewMockPromoServiceInterface(ctrl)
+	mockTaxService := mocks.NewMockTaxServiceInterface(ctrl)
+	mockFolioService := mocks.NewMockFolioServiceInterface(ctrl)
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	mockOverbookingService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService, mockCancellationService, mockHotelService, mockRoomUnitService, mockHousekeepingService, mockRoomTypeService, mockPromoService, mockTaxService, mockFolioService, mockWaitlistService, mockGroupBlockService, mockOverbookingService)
+	mockWaitlistService.EXPECT().OfferFreedRooms(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	now := time.Date(2025, 5, 3, 12, 0, 0, 0, time.UTC)
+	hotelID := uuid.New()
+	newCandidate := func() *models.Bookings {
+		return &models.Bookings{
+			Id:          uuid.New(),
+			HotelId:     hotelID,
+			CheckIn:     now.Add(-48 * time.Hour),
+			CheckOut:    now.Add(24 * time.Hour),
+			Status:      booking_status.StatusConfirmed,
+			TotalAmount: 30000,
+		}
+	}
+	bookedRooms := []*models.BookedRooms{{RoomType: room.Single, RoomQuantity: 1, PricePerNight: 10000}}
+
+	t.Run("marks booking and charges no-show nights", func(t *testing.T) {
+		candidate := newCandidate()
+		mockBookingRepo.EXPECT().GetNoShowCandidates(now).Return([]*models.Bookings{candidate}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, NoShowChargeNights: 1}, nil)
+		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(candidate.Id).Return(bookedRooms, nil)
+		mockRoomService.EXPECT().IncreaseRoomQuantity(gomock.Any(), hotelID).Return(&models.Rooms{}, nil)
+		mockBookingRepo.EXPECT().SaveTransition(candidate, gomock.Any()).Return(nil)
+
+		marked, err := service.MarkNoShows(now)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(marked) != 1 || marked[0].Status != booking_status.StatusNoShow {
+			t.Fatalf("expected one no-show booking, got %+v", marked)
+		}
+		if marked[0].PenaltyAmount != 10000 || marked[0].RefundAmount != 20000 {
+			t.Errorf("expected penalty 10000 and refund 20000, got %d and %d", marked[0].PenaltyAmount, marked[0].RefundAmount)
+		}
+	})
+
+	t.Run("error fetching candidates", func(t *testing.T) {
+		mockBookingRepo.EXPECT().GetNoShowCandidates(now).Return(nil, errors.New("db error"))
+
+		if _, err := service.MarkNoShows(now); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("error fetching hotel", func(t *testing.T) {
+		mockBookingRepo.EXPECT().GetNoShowCandidates(now).Return([]*models.Bookings{newCandidate()}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(nil, errors.New("hotel not found"))
+
+		if _, err := service.MarkNoShows(now); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+
+	t.Run("status changed concurrently releases no rooms", func(t *testing.T) {
+		candidate := newCandidate()
+		mockBookingRepo.EXPECT().GetNoShowCandidates(now).Return([]*models.Bookings{candidate}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, NoShowChargeNights: 1}, nil)
+		mockBookingRepo.EXPECT().GetBookedRoomsByBookingId(candidate.Id).Return(bookedRooms, nil)
+		mockBookingRepo.EXPECT().SaveTransition(candidate, gomock.Any()).Return(errors.New("save error"))
+
+		if _, err := service.MarkNoShows(now); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestBookingService_GetBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mocks.NewMockRoomServiceInterface(ctrl), mocks.NewMockCancellationServiceInterface(ctrl), mockHotelService, mocks.NewMockRoomUnitServiceInterface(ctrl), mocks.NewMockHousekeepingServiceInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockPromoServiceInterface(ctrl), mocks.NewMockTaxServiceInterface(ctrl), mocks.NewMockFolioServiceInterface(ctrl), mocks.NewMockWaitlistServiceInterface(ctrl), mocks.NewMockGroupBlockServiceInterface(ctrl), mocks.NewMockOverbookingServiceInterface(ctrl))
+	mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(&models.Hotels{TimeZone: "UTC"}, nil).AnyTimes()
+
+	bookingID := uuid.New()
+	ownerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	otherGuestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	guests := []*models.BookingGuests{{FullName: "Asha Rao", IsLead: true}, {FullName: "Vikram Rao"}}
+
+	tests := []struct {
+		name        string
+		userCtx     *models.UserContext
+		mockSetup   func()
+		expectedErr error
+		expectError bool
+	}{
+		{
+			name:    "owner sees guests",
+			userCtx: ownerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, UserId: ownerCtx.Id}, nil)
+				mockBookingRepo.EXPECT().GetBookingGuestsByBookingId(bookingID).Return(guests, nil)
+				mockBookingRepo.EXPECT().GetBookingDiscountsByBookingId(bookingID).Return(nil, nil)
+				mockBookingRepo.EXPECT().GetBookingTaxesByBookingId(bookingID).Return(nil, nil)
+			},
+		},
+		{
+			name:    "front desk sees guests",
+			userCtx: frontDeskCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, UserId: ownerCtx.Id}, nil)
+				mockBookingRepo.EXPECT().GetBookingGuestsByBookingId(bookingID).Return(guests, nil)
+				mockBookingRepo.EXPECT().GetBookingDiscountsByBookingId(bookingID).Return(nil, nil)
+				mockBookingRepo.EXPECT().GetBookingTaxesByBookingId(bookingID).Return(nil, nil)
+			},
+		},
+		{
+			name:    "other guest is denied",
+			userCtx: otherGuestCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, UserId: ownerCtx.Id}, nil)
+			},
+			expectedErr: booking_service.ErrBookingAccessDenied,
+			expectError: true,
+		},
+		{
+			name:    "error fetching guests",
+			userCtx: ownerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, UserId: ownerCtx.Id}, nil)
+				mockBookingRepo.EXPECT().GetBookingGuestsByBookingId(bookingID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			name:    "error fetching discounts",
+			userCtx: ownerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, UserId: ownerCtx.Id}, nil)
+				mockBookingRepo.EXPECT().GetBookingGuestsByBookingId(bookingID).Return(guests, nil)
+				mockBookingRepo.EXPECT().GetBookingDiscountsByBookingId(bookingID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+		{
+			name:    "error fetching taxes",
+			userCtx: ownerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, UserId: ownerCtx.Id}, nil)
+				mockBookingRepo.EXPECT().GetBookingGuestsByBookingId(bookingID).Return(guests, nil)
+				mockBookingRepo.EXPECT().GetBookingDiscountsByBookingId(bookingID).Return(nil, nil)
+				mockBookingRepo.EXPECT().GetBookingTaxesByBookingId(bookingID).Return(nil, errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			booking, err := service.GetBooking(tt.userCtx, bookingID)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error=%v, got=%v", tt.expectError, err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+			if !tt.expectError && len(booking.Guests) != len(guests) {
+				t.Errorf("expected %d guests, got %d", len(guests), len(booking.Guests))
+			}
+		})
+	}
+}
+
+func TestBookingService_GetBookingHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+	mockRoomService := mocks.NewMockRoomServiceInterface(ctrl)
+	mockCancellationService := mocks.NewMockCancellationServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	mockRoomUnitService := mocks.NewMockRoomUnitServiceInterface(ctrl)
+	mockHousekeepingService := mocks.NewMockHousekeepingServiceInterface(ctrl)
+	mockRoomTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockPromoService := mocks.NewMockPromoServiceInterface(ctrl)
+	mockTaxService := mocks.NewMockTaxServiceInterface(ctrl)
+	mockFolioService := mocks.NewMockFolioServiceInterface(ctrl)
+	mockWaitlistService := mocks.NewMockWaitlistServiceInterface(ctrl)
+	mockGroupBlockService := mocks.NewMockGroupBlockServiceInterface(ctrl)
+	mockOverbookingService := mocks.NewMockOverbookingServiceInterface(ctrl)
+	service := booking_service.NewBookingService(mockBookingRepo, mockRoomService, mockCancellationService, mockHotelService, mockRoomUnitService, mockHousekeepingService, mockRoomTypeService, mockPromoService, mockTaxService, mockFolioService, mockWaitlistService, mockGroupBlockService, mockOverbookingService)
+	mockWaitlistService.EXPECT().OfferFreedRooms(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	bookingID := uuid.New()
+	ownerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	otherGuestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	booking := &models.Bookings{Id: bookingID, UserId: ownerCtx.Id}
+
+	tests := []struct {
+		name        string
+		userCtx     *models.UserContext
+		mockSetup   func()
+		expectedErr error
+		expectError bool
+	}{
+		{
+			name:    "owner sees history",
+			userCtx: ownerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(booking, nil)
+				mockBookingRepo.EXPECT().GetBookingEventsByBookingId(bookingID).Return([]*models.BookingEvents{{BookingId: bookingID}}, nil)
+			},
+		},
+		{
+			name:    "front desk sees history",
+			userCtx: frontDeskCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(booking, nil)
+				mockBookingRepo.EXPECT().GetBookingEventsByBookingId(bookingID).Return(nil, nil)
+			},
+		},
+		{
+			name:    "other guest is denied",
+			userCtx: otherGuestCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(booking, nil)
+			},
+			expectedErr: booking_service.ErrBookingAccessDenied,
+			expectError: true,
+		},
+		{
+			name:    "error fetching booking",
+			userCtx: ownerCtx,
+			mockSetup: func() {
+				mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(nil, errors.New("booking not found"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, err := service.GetBookingHistory(tt.userCtx, bookingID)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error=%v, got=%v", tt.expectError, err)
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("expected %v, got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package cancellation_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/cancellation_policy_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var ErrPolicyAccessDenied = errors.New("you are not allowed to manage this hotel's cancellation policies")
+
+type CancellationService struct {
+	PolicyRepo      cancellation_policy_repo.CancellationPolicyRepoInterface
+	RoomTypeService room_type_service.RoomTypeServiceInterface
+	HotelService    hotel_service.HotelServiceInterface
+}
+
+func NewCancellationService(policyRepo cancellation_policy_repo.CancellationPolicyRepoInterface, roomTypeService room_type_service.RoomTypeServiceInterface, hotelService hotel_service.HotelServiceInterface) *CancellationService {
+	return &CancellationService{
+		PolicyRepo:      policyRepo,
+		RoomTypeService: roomTypeService,
+		HotelService:    hotelService,
+	}
+}
+
+func (c *CancellationService) CreatePolicy(userCtx *models.UserContext, payload *payloads.CancellationPolicyPayload) (*models.CancellationPolicies, error) {
+	hotel, err := c.HotelService.GetHotelByID(payload.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrPolicyAccessDenied
+	}
+
+	if payload.RoomCategory != "" {
+		if _, err := c.RoomTypeService.GetRoomType(payload.HotelId, payload.RoomCategory); err != nil {
+			return nil, err
+		}
+	}
+
+	policy := &models.CancellationPolicies{
+		Id:              uuid.New(),
+		HotelId:         payload.HotelId,
+		RoomCategory:    payload.RoomCategory,
+		FreeCancelHours: payload.FreeCancelHours,
+		PenaltyNights:   payload.PenaltyNights,
+		NonRefundable:   payload.NonRefundable,
+		CreatedAt:       time.Now(),
+	}
+	return c.PolicyRepo.CreatePolicy(policy)
+}
+
+func (c *CancellationService) GetPoliciesByHotelID(hotelID uuid.UUID) ([]*models.CancellationPolicies, error) {
+	return c.PolicyRepo.GetPoliciesByHotelID(hotelID)
+}
+
+// CalculatePenalty returns the amount retained when the booking is cancelled at now.
+// Each booked room uses the policy for its category, falling back to the hotel-wide
+// policy; rooms without any policy are cancelled free of charge.
+func (c *CancellationService) CalculatePenalty(booking *models.Bookings, bookedRooms []*models.BookedRooms, now time.Time) (int64, error) {
+	policies, err := c.PolicyRepo.GetPoliciesByHotelID(booking.HotelId)
+	if err != nil {
+		return 0, err
+	}
+
+	policyByCategory := make(map[room.RoomType]*models.CancellationPolicies)
+	for _, policy := range policies {
+		policyByCategory[policy.RoomCategory] = policy
+	}
+
+	nights := utils.CountNights(booking.CheckIn, booking.CheckOut)
+	hoursToCheckIn := booking.CheckIn.Sub(now).Hours()
+
+	var penalty int64
+	for _, bookedRoom := range bookedRooms {
+		policy, ok := policyByCategory[bookedRoom.RoomType]
+		if !ok {
+			policy, ok = policyByCategory[""]
+		}
+		if !ok {
+			continue
+		}
+
+		nightlyCharge := bookedRoom.PricePerNight * int64(bookedRoom.RoomQuantity)
+		switch {
+		case policy.NonRefundable:
+			penalty += nightlyCharge * int64(nights)
+		case hoursToCheckIn >= float64(policy.FreeCancelHours):
+			// still inside the free cancellation window
+		default:
+			penalty += nightlyCharge * int64(min(policy.PenaltyNights, nights))
+		}
+	}
+
+	return min(penalty, booking.TotalAmount), nil
+}
@@ -0,0 +1,17 @@
+package cancellation_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=cancellation_service_interface.go -destination=../../mocks/mock_cancellation_service.go -package=mocks
+
+type CancellationServiceInterface interface {
+	CreatePolicy(*models.UserContext, *payloads.CancellationPolicyPayload) (*models.CancellationPolicies, error)
+	GetPoliciesByHotelID(hotelID uuid.UUID) ([]*models.CancellationPolicies, error)
+	CalculatePenalty(*models.Bookings, []*models.BookedRooms, time.Time) (int64, error)
+}
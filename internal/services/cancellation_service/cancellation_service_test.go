@@ -0,0 +1,159 @@
+package cancellation_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/cancellation_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestCancellationService_CreatePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockCancellationPolicyRepoInterface(ctrl)
+	mockTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := cancellation_service.NewCancellationService(mockRepo, mockTypeService, mockHotelService)
+
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	payload := &payloads.CancellationPolicyPayload{HotelId: uuid.New(), FreeCancelHours: 48, PenaltyNights: 1}
+	mockHotelService.EXPECT().GetHotelByID(payload.HotelId).Return(&models.Hotels{Id: payload.HotelId, ManagerId: managerCtx.Id}, nil).AnyTimes()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().CreatePolicy(gomock.Any()).DoAndReturn(
+			func(p *models.CancellationPolicies) (*models.CancellationPolicies, error) {
+				return p, nil
+			})
+
+		policy, err := svc.CreatePolicy(managerCtx, payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if policy.HotelId != payload.HotelId || policy.FreeCancelHours != 48 || policy.PenaltyNights != 1 {
+			t.Errorf("policy not built from payload: %+v", policy)
+		}
+	})
+
+	t.Run("policy for a registered room type", func(t *testing.T) {
+		typed := &payloads.CancellationPolicyPayload{HotelId: payload.HotelId, RoomCategory: "villa", FreeCancelHours: 72}
+		mockTypeService.EXPECT().GetRoomType(payload.HotelId, room.RoomType("villa")).Return(&models.RoomTypes{Code: "villa"}, nil)
+		mockRepo.EXPECT().CreatePolicy(gomock.Any()).DoAndReturn(
+			func(p *models.CancellationPolicies) (*models.CancellationPolicies, error) {
+				return p, nil
+			})
+
+		if _, err := svc.CreatePolicy(managerCtx, typed); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("unknown room type", func(t *testing.T) {
+		typed := &payloads.CancellationPolicyPayload{HotelId: payload.HotelId, RoomCategory: "penthouse"}
+		mockTypeService.EXPECT().GetRoomType(payload.HotelId, room.RoomType("penthouse")).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+
+		if _, err := svc.CreatePolicy(managerCtx, typed); !errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+			t.Errorf("expected room type not found, got %v", err)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		otherManager := &models.UserContext{Id: uuid.New()}
+
+		if _, err := svc.CreatePolicy(otherManager, payload); !errors.Is(err, cancellation_service.ErrPolicyAccessDenied) {
+			t.Errorf("expected ErrPolicyAccessDenied, got %v", err)
+		}
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		mockRepo.EXPECT().CreatePolicy(gomock.Any()).Return(nil, errors.New("db error"))
+
+		if _, err := svc.CreatePolicy(managerCtx, payload); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestCancellationService_CalculatePenalty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockCancellationPolicyRepoInterface(ctrl)
+	svc := cancellation_service.NewCancellationService(mockRepo, mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl))
+
+	hotelID := uuid.New()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	booking := &models.Bookings{
+		HotelId:     hotelID,
+		CheckIn:     now.Add(24 * time.Hour),
+		CheckOut:    now.Add(72 * time.Hour),
+		TotalAmount: 40000,
+	}
+	bookedRooms := []*models.BookedRooms{
+		{RoomType: room.Single, RoomQuantity: 2, PricePerNight: 10000},
+	}
+
+	tests := []struct {
+		name        string
+		policies    []*models.CancellationPolicies
+		repoErr     error
+		wantPenalty int64
+		wantErr     bool
+	}{
+		{
+			name:        "no policy is free",
+			policies:    nil,
+			wantPenalty: 0,
+		},
+		{
+			name:        "inside free window",
+			policies:    []*models.CancellationPolicies{{FreeCancelHours: 12, PenaltyNights: 1}},
+			wantPenalty: 0,
+		},
+		{
+			name:        "hotel policy charges one night",
+			policies:    []*models.CancellationPolicies{{FreeCancelHours: 48, PenaltyNights: 1}},
+			wantPenalty: 20000,
+		},
+		{
+			name: "room category policy overrides hotel policy",
+			policies: []*models.CancellationPolicies{
+				{FreeCancelHours: 48, PenaltyNights: 1},
+				{RoomCategory: room.Single, NonRefundable: true},
+			},
+			wantPenalty: 40000,
+		},
+		{
+			name:        "penalty capped at stay length",
+			policies:    []*models.CancellationPolicies{{FreeCancelHours: 48, PenaltyNights: 5}},
+			wantPenalty: 40000,
+		},
+		{
+			name:    "repo error",
+			repoErr: errors.New("db error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo.EXPECT().GetPoliciesByHotelID(hotelID).Return(tt.policies, tt.repoErr)
+
+			penalty, err := svc.CalculatePenalty(booking, bookedRooms, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error=%v, got=%v", tt.wantErr, err)
+			}
+			if penalty != tt.wantPenalty {
+				t.Errorf("expected penalty %d, got %d", tt.wantPenalty, penalty)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package exchange_service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/exchange_rate_repo"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+)
+
+var ErrRateUnavailable = errors.New("no exchange rate for currency")
+
+// ExchangeService converts amounts for display only. Nothing it returns is
+// ever charged; bookings settle in the hotel currency.
+type ExchangeService struct {
+	ExchangeRateRepo exchange_rate_repo.ExchangeRateRepoInterface
+}
+
+func NewExchangeService(exchangeRateRepo exchange_rate_repo.ExchangeRateRepoInterface) *ExchangeService {
+	return &ExchangeService{
+		ExchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+// GetRate returns how many units of to one unit of from buys, crossing through
+// the table's base currency.
+func (e *ExchangeService) GetRate(from, to string) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	rates, err := e.ExchangeRateRepo.GetExchangeRates()
+	if err != nil {
+		return 0, err
+	}
+	fromRate, err := baseRate(rates, from)
+	if err != nil {
+		return 0, err
+	}
+	toRate, err := baseRate(rates, to)
+	if err != nil {
+		return 0, err
+	}
+	return toRate / fromRate, nil
+}
+
+func (e *ExchangeService) ToDisplayPrice(amount int64, from, to string) (*models.DisplayPrice, error) {
+	rate, err := e.GetRate(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &models.DisplayPrice{
+		Currency: to,
+		Amount:   currency.Convert(amount, from, to, rate),
+		Rate:     rate,
+	}, nil
+}
+
+func baseRate(rates *models.ExchangeRates, code string) (float64, error) {
+	if code == rates.Base {
+		return 1, nil
+	}
+	rate, ok := rates.Rates[code]
+	if !ok {
+		return 0, fmt.Errorf("%w %s", ErrRateUnavailable, code)
+	}
+	return rate, nil
+}
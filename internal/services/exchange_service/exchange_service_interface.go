@@ -0,0 +1,10 @@
+package exchange_service
+
+import "github.com/tktanisha/booking_system/internal/models"
+
+//go:generate mockgen -source=exchange_service_interface.go -destination=../../mocks/mock_exchange_service.go -package=mocks
+
+type ExchangeServiceInterface interface {
+	GetRate(from, to string) (float64, error)
+	ToDisplayPrice(amount int64, from, to string) (*models.DisplayPrice, error)
+}
@@ -0,0 +1,88 @@
+package exchange_service_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/exchange_service"
+)
+
+var rates = &models.ExchangeRates{
+	Base:  "USD",
+	Rates: map[string]float64{"EUR": 0.8, "JPY": 150, "KWD": 0.3},
+}
+
+func TestExchangeService_GetRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		from, to  string
+		loadRates bool
+		loadErr   error
+		wantRate  float64
+		wantErr   error
+	}{
+		{"same currency skips the table", "EUR", "EUR", false, nil, 1, nil},
+		{"from the base", "USD", "JPY", true, nil, 150, nil},
+		{"into the base", "EUR", "USD", true, nil, 1.25, nil},
+		{"cross rate", "EUR", "JPY", true, nil, 187.5, nil},
+		{"missing currency", "USD", "GBP", true, nil, 0, exchange_service.ErrRateUnavailable},
+		{"table cannot be read", "USD", "EUR", true, errors.New("exchange rates: no such file"), 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockExchangeRateRepoInterface(ctrl)
+			svc := exchange_service.NewExchangeService(mockRepo)
+			if tt.loadRates {
+				mockRepo.EXPECT().GetExchangeRates().Return(rates, tt.loadErr)
+			}
+
+			rate, err := svc.GetRate(tt.from, tt.to)
+			if tt.loadErr != nil {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if math.Abs(rate-tt.wantRate) > 1e-9 {
+				t.Errorf("expected rate %v, got %v", tt.wantRate, rate)
+			}
+		})
+	}
+}
+
+func TestExchangeService_ToDisplayPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockExchangeRateRepoInterface(ctrl)
+	svc := exchange_service.NewExchangeService(mockRepo)
+	mockRepo.EXPECT().GetExchangeRates().Return(rates, nil).Times(2)
+
+	t.Run("converts between exponents", func(t *testing.T) {
+		// 100.00 EUR is 125.00 USD, which is 18750 JPY
+		price, err := svc.ToDisplayPrice(10000, "EUR", "JPY")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if price.Currency != "JPY" || price.Amount != 18750 {
+			t.Errorf("expected 18750 JPY, got %d %s", price.Amount, price.Currency)
+		}
+	})
+
+	t.Run("missing currency", func(t *testing.T) {
+		if _, err := svc.ToDisplayPrice(10000, "EUR", "GBP"); !errors.Is(err, exchange_service.ErrRateUnavailable) {
+			t.Errorf("expected ErrRateUnavailable, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package export_service
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/export"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/export_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/rowwriter"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var ErrExportAccessDenied = errors.New("you are not allowed to export this hotel's data")
+
+// columns lists the columns of each dataset in the order they are exported.
+// Amounts are in minor units of the booking's currency.
+var columns = map[export.Dataset][]string{
+	export.Bookings: {"id", "user_id", "status", "checkin", "checkout", "currency", "total_amount", "penalty_amount",
+		"refund_amount", "group_code", "estimated_arrival", "notes", "created_at"},
+	export.BookedRooms: {"id", "booking_id", "room_type", "room_quantity", "price_per_night", "extra_guest_charge",
+		"adults", "children", "created_at"},
+	export.Guests:   {"id", "booking_id", "full_name", "email", "phone", "is_lead", "created_at"},
+	export.Payments: {"id", "booking_id", "description", "amount", "posted_by", "posted_at", "voided_by", "voided_at", "void_reason"},
+}
+
+type ExportService struct {
+	ExportRepo   export_repo.ExportRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewExportService(exportRepo export_repo.ExportRepoInterface, hotelService hotel_service.HotelServiceInterface) *ExportService {
+	return &ExportService{
+		ExportRepo:   exportRepo,
+		HotelService: hotelService,
+	}
+}
+
+// Export writes the hotel's rows of the payload's dataset to w as they are
+// read. Bookings, booked rooms and guests are picked by the booking's check-in
+// date and payments by the date they were posted, both in hotel time. Nothing
+// is written to w if the export fails before its first row.
+func (s *ExportService) Export(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.ExportPayload, w io.Writer) error {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrExportAccessDenied
+	}
+
+	var from, to time.Time
+	if !payload.From.IsZero() {
+		from = hotel.StartOfDay(payload.From.Time)
+	}
+	if !payload.To.IsZero() {
+		to = hotel.StartOfDay(payload.To.Time)
+	}
+
+	out := rowwriter.New(w, payload.Format, columns[payload.Dataset])
+	switch payload.Dataset {
+	case export.Bookings:
+		err = s.ExportRepo.StreamBookings(hotelID, from, to, func(b *models.Bookings) error {
+			return out.WriteRow(b.Id, b.UserId, b.Status, b.CheckIn, b.CheckOut, b.Currency, b.TotalAmount, b.PenaltyAmount,
+				b.RefundAmount, b.GroupCode, b.EstimatedArrival, b.Notes, b.CreatedAt)
+		})
+	case export.BookedRooms:
+		err = s.ExportRepo.StreamBookedRooms(hotelID, from, to, func(br *models.BookedRooms) error {
+			return out.WriteRow(br.Id, br.BookingId, br.RoomType, br.RoomQuantity, br.PricePerNight, br.ExtraGuestCharge,
+				br.Adults, br.Children, br.CreatedAt)
+		})
+	case export.Guests:
+		err = s.ExportRepo.StreamGuests(hotelID, from, to, func(g *models.BookingGuests) error {
+			return out.WriteRow(g.Id, g.BookingId, g.FullName, g.Email, g.Phone, g.IsLead, g.CreatedAt)
+		})
+	case export.Payments:
+		err = s.ExportRepo.StreamPayments(hotelID, from, to, func(f *models.FolioLines) error {
+			return out.WriteRow(f.Id, f.BookingId, f.Description, f.Amount, f.PostedBy, f.PostedAt, f.VoidedBy, f.VoidedAt, f.VoidReason)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,15 @@
+package export_service
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=export_service_interface.go -destination=../../mocks/mock_export_service.go -package=mocks
+
+type ExportServiceInterface interface {
+	Export(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.ExportPayload, w io.Writer) error
+}
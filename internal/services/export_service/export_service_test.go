@@ -0,0 +1,122 @@
+package export_service_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/export"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/export_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+type serviceMocks struct {
+	exportRepo   *mocks.MockExportRepoInterface
+	hotelService *mocks.MockHotelServiceInterface
+}
+
+func newService(ctrl *gomock.Controller) (*export_service.ExportService, serviceMocks) {
+	m := serviceMocks{
+		exportRepo:   mocks.NewMockExportRepoInterface(ctrl),
+		hotelService: mocks.NewMockHotelServiceInterface(ctrl),
+	}
+	return export_service.NewExportService(m.exportRepo, m.hotelService), m
+}
+
+func TestExportService_Export(t *testing.T) {
+	manager := &models.UserContext{Id: uuid.New()}
+	hotel := &models.Hotels{Id: uuid.New(), ManagerId: manager.Id, TimeZone: "Asia/Kolkata", CheckInTime: "14:00", CheckOutTime: "11:00"}
+	guestID, bookingID := uuid.MustParse("11111111-1111-1111-1111-111111111111"), uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	createdAt := time.Date(2030, 4, 1, 9, 0, 0, 0, time.UTC)
+	may1, june1 := payloads.NewDate(time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)), payloads.NewDate(time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name      string
+		userCtx   *models.UserContext
+		payload   *payloads.ExportPayload
+		mockSetup func(m serviceMocks)
+		wantErr   bool
+		want      string
+	}{
+		{
+			name:    "another hotel's manager",
+			userCtx: &models.UserContext{Id: uuid.New()},
+			payload: &payloads.ExportPayload{Dataset: export.Bookings, Format: export.CSV},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "guests as csv between hotel-time dates",
+			userCtx: manager,
+			payload: &payloads.ExportPayload{Dataset: export.Guests, Format: export.CSV, From: may1, To: june1},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.exportRepo.EXPECT().StreamGuests(hotel.Id, hotel.StartOfDay(may1.Time), hotel.StartOfDay(june1.Time), gomock.Any()).DoAndReturn(
+					func(_ uuid.UUID, _, _ time.Time, visit func(*models.BookingGuests) error) error {
+						return visit(&models.BookingGuests{Id: guestID, BookingId: bookingID, FullName: "Jane Smith", Email: "jane@example.com", IsLead: true, CreatedAt: createdAt})
+					})
+			},
+			want: "id,booking_id,full_name,email,phone,is_lead,created_at\n" +
+				"11111111-1111-1111-1111-111111111111,22222222-2222-2222-2222-222222222222,Jane Smith,jane@example.com,,true,2030-04-01T09:00:00Z\n",
+		},
+		{
+			name:    "payments as json lines with no date range",
+			userCtx: manager,
+			payload: &payloads.ExportPayload{Dataset: export.Payments, Format: export.NDJSON},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.exportRepo.EXPECT().StreamPayments(hotel.Id, time.Time{}, time.Time{}, gomock.Any()).DoAndReturn(
+					func(_ uuid.UUID, _, _ time.Time, visit func(*models.FolioLines) error) error {
+						return visit(&models.FolioLines{Id: guestID, BookingId: bookingID, Description: "card", Amount: 20000, PostedBy: bookingID, PostedAt: createdAt})
+					})
+			},
+			want: `{"id":"11111111-1111-1111-1111-111111111111","booking_id":"22222222-2222-2222-2222-222222222222","description":"card","amount":20000,` +
+				`"posted_by":"22222222-2222-2222-2222-222222222222","posted_at":"2030-04-01T09:00:00Z","voided_by":null,"voided_at":null,"void_reason":""}` + "\n",
+		},
+		{
+			name:    "query error writes nothing",
+			userCtx: manager,
+			payload: &payloads.ExportPayload{Dataset: export.BookedRooms, Format: export.CSV},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.exportRepo.EXPECT().StreamBookedRooms(hotel.Id, time.Time{}, time.Time{}, gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "empty bookings export still has a header",
+			userCtx: manager,
+			payload: &payloads.ExportPayload{Dataset: export.Bookings, Format: export.CSV},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.exportRepo.EXPECT().StreamBookings(hotel.Id, time.Time{}, time.Time{}, gomock.Any()).Return(nil)
+			},
+			want: "id,user_id,status,checkin,checkout,currency,total_amount,penalty_amount,refund_amount,group_code,estimated_arrival,notes,created_at\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, m := newService(ctrl)
+			tt.mockSetup(m)
+
+			var out bytes.Buffer
+			err := service.Export(tt.userCtx, hotel.Id, tt.payload, &out)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package folio_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/booking_repo"
+	"github.com/tktanisha/booking_system/internal/repository/folio_repo"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var (
+	ErrFolioAccessDenied  = errors.New("you are not allowed to access this folio")
+	ErrFolioClosed        = errors.New("folio is closed for this booking")
+	ErrBalanceOutstanding = errors.New("folio balance is not settled")
+)
+
+type FolioService struct {
+	FolioRepo   folio_repo.FolioRepoInterface
+	BookingRepo booking_repo.BookingRepoInterface
+}
+
+func NewFolioService(folioRepo folio_repo.FolioRepoInterface, bookingRepo booking_repo.BookingRepoInterface) *FolioService {
+	return &FolioService{
+		FolioRepo:   folioRepo,
+		BookingRepo: bookingRepo,
+	}
+}
+
+// GetFolio returns the folio of a booking to the guest who made it or to front-desk staff.
+func (f *FolioService) GetFolio(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Folio, error) {
+	booking, err := f.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserId != userCtx.Id && !permissions.IsFrontDesk(userCtx) {
+		return nil, ErrFolioAccessDenied
+	}
+
+	return f.GetFolioForBooking(booking)
+}
+
+func (f *FolioService) PostLine(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.PostFolioLinePayload) (*models.FolioLines, error) {
+	booking, err := f.openBooking(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.FolioRepo.CreateFolioLine(&models.FolioLines{
+		Id:          uuid.New(),
+		BookingId:   bookingId,
+		LineType:    payload.LineType,
+		Category:    payload.Category,
+		Description: payload.Description,
+		Amount:      currency.ToMinorUnits(payload.Amount, booking.Currency),
+		PostedBy:    userCtx.Id,
+		PostedAt:    time.Now(),
+	})
+}
+
+func (f *FolioService) VoidLine(userCtx *models.UserContext, bookingId, lineId uuid.UUID, payload *payloads.VoidFolioLinePayload) error {
+	if _, err := f.openBooking(bookingId); err != nil {
+		return err
+	}
+
+	return f.FolioRepo.VoidFolioLine(lineId, bookingId, userCtx.Id, payload.Reason, time.Now())
+}
+
+// CheckSettlement lets a booking check out only when nothing is left to pay or
+// the balance is being waived. It posts nothing; see WaiveBalance.
+func (f *FolioService) CheckSettlement(booking *models.Bookings, payload *payloads.CheckoutPayload) error {
+	account, err := f.GetFolioForBooking(booking)
+	if err != nil {
+		return err
+	}
+	if account.Balance != 0 && !payload.WaiveBalance {
+		return fmt.Errorf("%w: %d outstanding", ErrBalanceOutstanding, account.Balance)
+	}
+	return nil
+}
+
+// WaiveBalance writes off what is left on a checked-out booking's folio with an
+// adjustment so the folio still adds up. It runs after the checkout is saved, so
+// the balance it reads is final.
+func (f *FolioService) WaiveBalance(userCtx *models.UserContext, booking *models.Bookings, reason string) error {
+	account, err := f.GetFolioForBooking(booking)
+	if err != nil {
+		return err
+	}
+	if account.Balance == 0 {
+		return nil
+	}
+
+	_, err = f.FolioRepo.CreateFolioLine(&models.FolioLines{
+		Id:          uuid.New(),
+		BookingId:   booking.Id,
+		LineType:    folio.Adjustment,
+		Description: "Balance waived: " + reason,
+		Amount:      -account.Balance,
+		PostedBy:    userCtx.Id,
+		PostedAt:    time.Now(),
+	})
+	return err
+}
+
+// GetFolioForBooking totals the folio of a booking that the caller has already loaded.
+func (f *FolioService) GetFolioForBooking(booking *models.Bookings) (*models.Folio, error) {
+	lines, err := f.FolioRepo.GetFolioLinesByBookingId(booking.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &models.Folio{
+		BookingId:   booking.Id,
+		Currency:    booking.Currency,
+		Lines:       lines,
+		RoomCharges: booking.TotalAmount,
+	}
+	for _, line := range lines {
+		if line.IsVoided() {
+			continue
+		}
+		switch line.LineType {
+		case folio.Charge:
+			account.Charges += line.Amount
+		case folio.Payment:
+			account.Payments += line.Amount
+		case folio.Adjustment:
+			account.Adjustments += line.Amount
+		}
+	}
+	account.Balance = account.RoomCharges + account.Charges + account.Adjustments - account.Payments
+	return account, nil
+}
+
+// openBooking returns the booking if lines can still be posted to its folio.
+func (f *FolioService) openBooking(bookingId uuid.UUID) (*models.Bookings, error) {
+	booking, err := f.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status != booking_status.StatusConfirmed && booking.Status != booking_status.StatusCheckedIn {
+		return nil, ErrFolioClosed
+	}
+	return booking, nil
+}
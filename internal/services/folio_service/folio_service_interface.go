@@ -0,0 +1,18 @@
+package folio_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=folio_service_interface.go -destination=../../mocks/mock_folio_service.go -package=mocks
+
+type FolioServiceInterface interface {
+	GetFolio(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Folio, error)
+	GetFolioForBooking(booking *models.Bookings) (*models.Folio, error)
+	PostLine(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.PostFolioLinePayload) (*models.FolioLines, error)
+	VoidLine(userCtx *models.UserContext, bookingId, lineId uuid.UUID, payload *payloads.VoidFolioLinePayload) error
+	CheckSettlement(booking *models.Bookings, payload *payloads.CheckoutPayload) error
+	WaiveBalance(userCtx *models.UserContext, booking *models.Bookings, reason string) error
+}
@@ -0,0 +1,239 @@
+package folio_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestFolioService_GetFolio(t *testing.T) {
+	ownerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	otherCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	bookingID := uuid.New()
+	booking := &models.Bookings{Id: bookingID, UserId: ownerCtx.Id, TotalAmount: 20000}
+	voidedAt := time.Now()
+	lines := []*models.FolioLines{
+		{LineType: folio.Charge, Category: folio.Minibar, Amount: 800},
+		{LineType: folio.Charge, Category: folio.Parking, Amount: 1500},
+		{LineType: folio.Charge, Category: folio.Restaurant, Amount: 4000, VoidedAt: &voidedAt},
+		{LineType: folio.Adjustment, Amount: -300},
+		{LineType: folio.Payment, Amount: 10000},
+	}
+
+	tests := []struct {
+		name        string
+		userCtx     *models.UserContext
+		withLines   bool
+		wantErr     error
+		wantBalance int64
+	}{
+		{"owner sees the balance", ownerCtx, true, nil, 12000},
+		{"front desk sees the balance", frontDeskCtx, true, nil, 12000},
+		{"other guest is denied", otherCtx, false, folio_service.ErrFolioAccessDenied, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFolioRepo := mocks.NewMockFolioRepoInterface(ctrl)
+			mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+			svc := folio_service.NewFolioService(mockFolioRepo, mockBookingRepo)
+
+			mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(booking, nil)
+			if tt.withLines {
+				mockFolioRepo.EXPECT().GetFolioLinesByBookingId(bookingID).Return(lines, nil)
+			}
+
+			account, err := svc.GetFolio(tt.userCtx, bookingID)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// 20000 room + 2300 charges - 300 adjustment - 10000 paid; the voided dinner is ignored
+			if account.Balance != tt.wantBalance || account.Charges != 2300 || len(account.Lines) != len(lines) {
+				t.Errorf("unexpected folio totals: %+v", account)
+			}
+		})
+	}
+}
+
+func TestFolioService_PostLine(t *testing.T) {
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	bookingID := uuid.New()
+	payload := &payloads.PostFolioLinePayload{LineType: folio.Charge, Category: folio.Minibar, Description: "Two sodas", Amount: 8}
+
+	tests := []struct {
+		name       string
+		status     booking_status.BookingStatus
+		currency   string
+		wantAmount int64
+		wantErr    error
+	}{
+		{"checked in booking", booking_status.StatusCheckedIn, "USD", 800, nil},
+		{"confirmed booking", booking_status.StatusConfirmed, "USD", 800, nil},
+		{"zero-decimal currency", booking_status.StatusCheckedIn, "JPY", 8, nil},
+		{"checked out booking is closed", booking_status.StatusCheckedOut, "USD", 0, folio_service.ErrFolioClosed},
+		{"cancelled booking is closed", booking_status.StatusCancelled, "USD", 0, folio_service.ErrFolioClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFolioRepo := mocks.NewMockFolioRepoInterface(ctrl)
+			mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+			svc := folio_service.NewFolioService(mockFolioRepo, mockBookingRepo)
+
+			mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, Status: tt.status, Currency: tt.currency}, nil)
+			if tt.wantErr == nil {
+				mockFolioRepo.EXPECT().CreateFolioLine(gomock.Any()).DoAndReturn(
+					func(line *models.FolioLines) (*models.FolioLines, error) {
+						return line, nil
+					})
+			}
+
+			line, err := svc.PostLine(frontDeskCtx, bookingID, payload)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if line.Amount != tt.wantAmount || line.PostedBy != frontDeskCtx.Id || line.BookingId != bookingID {
+				t.Errorf("folio line not built from payload: %+v", line)
+			}
+		})
+	}
+}
+
+func TestFolioService_VoidLine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFolioRepo := mocks.NewMockFolioRepoInterface(ctrl)
+	mockBookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+	svc := folio_service.NewFolioService(mockFolioRepo, mockBookingRepo)
+
+	frontDeskCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleFrontDesk}
+	bookingID := uuid.New()
+	lineID := uuid.New()
+
+	mockBookingRepo.EXPECT().GetBookingById(bookingID).Return(&models.Bookings{Id: bookingID, Status: booking_status.StatusCheckedIn}, nil)
+	mockFolioRepo.EXPECT().VoidFolioLine(lineID, bookingID, frontDeskCtx.Id, "posted twice", gomock.Any()).Return(nil)
+
+	if err := svc.VoidLine(frontDeskCtx, bookingID, lineID, &payloads.VoidFolioLinePayload{Reason: "posted twice"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFolioService_CheckSettlement(t *testing.T) {
+	booking := &models.Bookings{Id: uuid.New(), TotalAmount: 20000}
+
+	tests := []struct {
+		name    string
+		lines   []*models.FolioLines
+		payload *payloads.CheckoutPayload
+		wantErr error
+	}{
+		{
+			name:    "settled folio",
+			lines:   []*models.FolioLines{{LineType: folio.Payment, Amount: 20000}},
+			payload: &payloads.CheckoutPayload{},
+		},
+		{
+			name:    "outstanding balance",
+			lines:   []*models.FolioLines{{LineType: folio.Payment, Amount: 15000}},
+			payload: &payloads.CheckoutPayload{},
+			wantErr: folio_service.ErrBalanceOutstanding,
+		},
+		{
+			name:    "waived balance is allowed without posting",
+			lines:   []*models.FolioLines{{LineType: folio.Payment, Amount: 15000}},
+			payload: &payloads.CheckoutPayload{WaiveBalance: true, WaiverReason: "service recovery"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFolioRepo := mocks.NewMockFolioRepoInterface(ctrl)
+			svc := folio_service.NewFolioService(mockFolioRepo, mocks.NewMockBookingRepoInterface(ctrl))
+
+			mockFolioRepo.EXPECT().GetFolioLinesByBookingId(booking.Id).Return(tt.lines, nil)
+
+			err := svc.CheckSettlement(booking, tt.payload)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestFolioService_WaiveBalance(t *testing.T) {
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	booking := &models.Bookings{Id: uuid.New(), TotalAmount: 20000}
+
+	tests := []struct {
+		name       string
+		lines      []*models.FolioLines
+		wantWaiver int64
+	}{
+		{"settled folio posts nothing", []*models.FolioLines{{LineType: folio.Payment, Amount: 20000}}, 0},
+		{"balance is written off", []*models.FolioLines{{LineType: folio.Payment, Amount: 15000}}, -5000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFolioRepo := mocks.NewMockFolioRepoInterface(ctrl)
+			svc := folio_service.NewFolioService(mockFolioRepo, mocks.NewMockBookingRepoInterface(ctrl))
+
+			mockFolioRepo.EXPECT().GetFolioLinesByBookingId(booking.Id).Return(tt.lines, nil)
+			if tt.wantWaiver != 0 {
+				mockFolioRepo.EXPECT().CreateFolioLine(gomock.Any()).DoAndReturn(
+					func(line *models.FolioLines) (*models.FolioLines, error) {
+						if line.LineType != folio.Adjustment || line.Amount != tt.wantWaiver {
+							t.Errorf("expected a %d adjustment, got %+v", tt.wantWaiver, line)
+						}
+						return line, nil
+					})
+			}
+
+			if err := svc.WaiveBalance(managerCtx, booking, "service recovery"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
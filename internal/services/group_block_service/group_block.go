@@ -0,0 +1,222 @@
+package group_block_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	group_block_status "github.com/tktanisha/booking_system/internal/enums/group_block"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var (
+	ErrGroupBlockAccessDenied = errors.New("you are not allowed to manage this hotel's group blocks")
+	ErrCutoffInPast           = errors.New("cutoff date must be after today at the hotel")
+	ErrInsufficientInventory  = errors.New("not enough rooms are free to hold for the group")
+	ErrGroupBlockReleased     = errors.New("group block has already been released")
+	ErrGroupRoomsUnavailable  = errors.New("group block has no rooms left for this booking")
+	ErrGroupStayMismatch      = errors.New("booking does not fall within the group block")
+)
+
+type GroupBlockService struct {
+	GroupBlockRepo  group_block_repo.GroupBlockRepoInterface
+	RoomService     room_service.RoomServiceInterface
+	HotelService    hotel_service.HotelServiceInterface
+	WaitlistService waitlist_service.WaitlistServiceInterface
+}
+
+func NewGroupBlockService(groupBlockRepo group_block_repo.GroupBlockRepoInterface, roomService room_service.RoomServiceInterface, hotelService hotel_service.HotelServiceInterface, waitlistService waitlist_service.WaitlistServiceInterface) *GroupBlockService {
+	return &GroupBlockService{
+		GroupBlockRepo:  groupBlockRepo,
+		RoomService:     roomService,
+		HotelService:    hotelService,
+		WaitlistService: waitlistService,
+	}
+}
+
+// CreateGroupBlock holds rooms for a group out of the hotel's free inventory.
+// A group code can hold one allotment per room type.
+func (s *GroupBlockService) CreateGroupBlock(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.GroupBlockPayload) (*models.GroupBlocks, error) {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrGroupBlockAccessDenied
+	}
+	if !payload.CutoffDate.After(hotel.Today(time.Now())) {
+		return nil, ErrCutoffInPast
+	}
+
+	block := &models.GroupBlocks{
+		Id:        uuid.New(),
+		HotelId:   hotelID,
+		Code:      payload.Code,
+		Name:      payload.Name,
+		RoomType:  payload.RoomType,
+		Quantity:  payload.Quantity,
+		CheckIn:   hotel.CheckInAt(payload.CheckIn.Time),
+		CheckOut:  hotel.CheckOutAt(payload.CheckOut.Time),
+		CutoffAt:  hotel.StartOfDay(payload.CutoffDate.Time),
+		Status:    group_block_status.StatusActive,
+		CreatedBy: userCtx.Id,
+		CreatedAt: time.Now(),
+	}
+
+	rooms := &payloads.RoomPayload{RoomType: block.RoomType, Quantity: block.Quantity}
+	if err := s.RoomService.CheckRestrictions(rooms, hotelID, block.CheckIn, block.CheckOut); err != nil {
+		return nil, err
+	}
+	if !s.RoomService.IsPhysicallyAvailable(rooms, hotelID, block.CheckIn, block.CheckOut) {
+		return nil, ErrInsufficientInventory
+	}
+
+	if err := s.GroupBlockRepo.CreateBlock(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// GetHotelGroupBlocks returns a hotel's group blocks by arrival date.
+func (s *GroupBlockService) GetHotelGroupBlocks(hotelID uuid.UUID) ([]*models.GroupBlocks, error) {
+	return s.GroupBlockRepo.GetBlocksByHotelId(hotelID)
+}
+
+// GetGroupBlocksByCode lets attendees see what their group code holds.
+func (s *GroupBlockService) GetGroupBlocksByCode(hotelID uuid.UUID, code string) ([]*models.GroupBlocks, error) {
+	blocks, err := s.GroupBlockRepo.GetBlocksByCode(hotelID, code)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, group_block_repo.ErrGroupBlockNotFound
+	}
+	return blocks, nil
+}
+
+// ReleaseGroupBlock returns a block's unpicked rooms to general inventory
+// before its cutoff and offers them to the waitlist. Bookings already made
+// against the block are kept.
+func (s *GroupBlockService) ReleaseGroupBlock(userCtx *models.UserContext, blockID uuid.UUID) (*models.GroupBlocks, error) {
+	block, err := s.GroupBlockRepo.GetBlockById(blockID)
+	if err != nil {
+		return nil, err
+	}
+	hotel, err := s.HotelService.GetHotelByID(block.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrGroupBlockAccessDenied
+	}
+
+	err = s.GroupBlockRepo.ReleaseBlock(block.Id)
+	if errors.Is(err, group_block_repo.ErrBlockUnavailable) {
+		return nil, ErrGroupBlockReleased
+	}
+	if err != nil {
+		return nil, err
+	}
+	block.Status = group_block_status.StatusReleased
+
+	// the block is released either way; a failed offer is retried by the waitlist job
+	_, _ = s.WaitlistService.OfferFreedRooms(block.HotelId, block.RoomType)
+	return block, nil
+}
+
+// ReleaseDueBlocks releases the blocks whose cutoff has passed and offers
+// their unpicked rooms to the waitlist. It returns the released blocks.
+func (s *GroupBlockService) ReleaseDueBlocks(now time.Time) ([]*models.GroupBlocks, error) {
+	released, err := s.GroupBlockRepo.ReleaseDueBlocks(now)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range released {
+		// a failed offer is retried by the waitlist job
+		_, _ = s.WaitlistService.OfferFreedRooms(block.HotelId, block.RoomType)
+	}
+	return released, nil
+}
+
+// PickUpRooms takes a booking's rooms out of its group's blocks so they count
+// as free for the booking being made. The stay must fall within the block
+// dates and every room type must be held for the group. Call ReturnRooms if
+// the booking is then not created.
+func (s *GroupBlockService) PickUpRooms(payload *payloads.BookingPayload) error {
+	blocks, err := s.GetGroupBlocksByCode(payload.HotelId, payload.GroupCode)
+	if err != nil {
+		return err
+	}
+	hotel, err := s.HotelService.GetHotelByID(payload.HotelId)
+	if err != nil {
+		return err
+	}
+	loc := hotel.Location()
+
+	requested := payloads.RoomsByType(payload.Rooms)
+	picks := make([]*models.GroupBlocks, 0, len(requested))
+	for _, req := range requested {
+		block := blockFor(blocks, req.RoomType)
+		if block == nil ||
+			payload.CheckIn.Before(payloads.NewDate(block.CheckIn.In(loc)).Time) ||
+			payload.CheckOut.After(payloads.NewDate(block.CheckOut.In(loc)).Time) {
+			return ErrGroupStayMismatch
+		}
+		picks = append(picks, block)
+	}
+
+	now := time.Now()
+	for i, block := range picks {
+		err := s.GroupBlockRepo.PickUpRooms(block.Id, requested[i].Quantity, now)
+		if err == nil {
+			continue
+		}
+		// put back what this booking already took
+		for j := 0; j < i; j++ {
+			_ = s.GroupBlockRepo.ReturnRooms(picks[j].Id, requested[j].Quantity, now)
+		}
+		if errors.Is(err, group_block_repo.ErrBlockUnavailable) {
+			return ErrGroupRoomsUnavailable
+		}
+		return err
+	}
+	return nil
+}
+
+// ReturnRooms puts rooms picked up from a group's blocks back into them.
+// Blocks that have been released are skipped, since their rooms already went
+// back to general inventory.
+func (s *GroupBlockService) ReturnRooms(hotelID uuid.UUID, code string, rooms []*payloads.RoomPayload) error {
+	blocks, err := s.GroupBlockRepo.GetBlocksByCode(hotelID, code)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, req := range payloads.RoomsByType(rooms) {
+		block := blockFor(blocks, req.RoomType)
+		if block == nil {
+			continue
+		}
+		err := s.GroupBlockRepo.ReturnRooms(block.Id, req.Quantity, now)
+		if err != nil && !errors.Is(err, group_block_repo.ErrBlockUnavailable) {
+			return err
+		}
+	}
+	return nil
+}
+
+func blockFor(blocks []*models.GroupBlocks, roomType room.RoomType) *models.GroupBlocks {
+	for _, block := range blocks {
+		if block.RoomType == roomType {
+			return block
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package group_block_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=group_block_service_interface.go -destination=../../mocks/mock_group_block_service.go -package=mocks
+
+type GroupBlockServiceInterface interface {
+	CreateGroupBlock(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.GroupBlockPayload) (*models.GroupBlocks, error)
+	GetHotelGroupBlocks(hotelID uuid.UUID) ([]*models.GroupBlocks, error)
+	GetGroupBlocksByCode(hotelID uuid.UUID, code string) ([]*models.GroupBlocks, error)
+	ReleaseGroupBlock(userCtx *models.UserContext, blockID uuid.UUID) (*models.GroupBlocks, error)
+	ReleaseDueBlocks(now time.Time) ([]*models.GroupBlocks, error)
+	PickUpRooms(payload *payloads.BookingPayload) error
+	ReturnRooms(hotelID uuid.UUID, code string, rooms []*payloads.RoomPayload) error
+}
@@ -0,0 +1,369 @@
+package group_block_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	group_block_status "github.com/tktanisha/booking_system/internal/enums/group_block"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/services/group_block_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+type serviceMocks struct {
+	groupBlockRepo  *mocks.MockGroupBlockRepoInterface
+	roomService     *mocks.MockRoomServiceInterface
+	hotelService    *mocks.MockHotelServiceInterface
+	waitlistService *mocks.MockWaitlistServiceInterface
+}
+
+func newService(ctrl *gomock.Controller) (*group_block_service.GroupBlockService, serviceMocks) {
+	m := serviceMocks{
+		groupBlockRepo:  mocks.NewMockGroupBlockRepoInterface(ctrl),
+		roomService:     mocks.NewMockRoomServiceInterface(ctrl),
+		hotelService:    mocks.NewMockHotelServiceInterface(ctrl),
+		waitlistService: mocks.NewMockWaitlistServiceInterface(ctrl),
+	}
+	return group_block_service.NewGroupBlockService(m.groupBlockRepo, m.roomService, m.hotelService, m.waitlistService), m
+}
+
+func newHotel(managerID uuid.UUID) *models.Hotels {
+	return &models.Hotels{Id: uuid.New(), ManagerId: managerID, Name: "Sea View", TimeZone: "Asia/Kolkata", CheckInTime: "14:00", CheckOutTime: "11:00"}
+}
+
+func TestGroupBlockService_CreateGroupBlock(t *testing.T) {
+	manager := &models.UserContext{Id: uuid.New()}
+	hotel := newHotel(manager.Id)
+	today := hotel.Today(time.Now())
+	payload := &payloads.GroupBlockPayload{
+		Code:       "SMITH-WEDDING",
+		Name:       "Smith wedding",
+		RoomType:   room.Double,
+		Quantity:   30,
+		CheckIn:    payloads.NewDate(today.AddDate(0, 1, 0)),
+		CheckOut:   payloads.NewDate(today.AddDate(0, 1, 2)),
+		CutoffDate: payloads.NewDate(today.AddDate(0, 0, 14)),
+	}
+
+	tests := []struct {
+		name      string
+		userCtx   *models.UserContext
+		payload   *payloads.GroupBlockPayload
+		mockSetup func(m serviceMocks)
+		wantErr   error
+		expectErr bool
+	}{
+		{
+			name:    "rooms are held for the group",
+			userCtx: manager,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(nil)
+				m.roomService.EXPECT().IsPhysicallyAvailable(&payloads.RoomPayload{RoomType: room.Double, Quantity: 30}, hotel.Id, gomock.Any(), gomock.Any()).Return(true)
+				m.groupBlockRepo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(block *models.GroupBlocks) error {
+					if block.Status != group_block_status.StatusActive || block.Quantity != 30 || block.CreatedBy != manager.Id {
+						t.Errorf("unexpected block %+v", block)
+					}
+					if !block.CutoffAt.Equal(hotel.StartOfDay(payload.CutoffDate.Time)) {
+						t.Errorf("expected cutoff at the start of the day in hotel time, got %v", block.CutoffAt)
+					}
+					if !block.CheckIn.Equal(hotel.CheckInAt(payload.CheckIn.Time)) || !block.CheckOut.Equal(hotel.CheckOutAt(payload.CheckOut.Time)) {
+						t.Errorf("expected the stay in hotel time, got %v to %v", block.CheckIn, block.CheckOut)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:    "another hotel's manager",
+			userCtx: &models.UserContext{Id: uuid.New()},
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+			},
+			wantErr:   group_block_service.ErrGroupBlockAccessDenied,
+			expectErr: true,
+		},
+		{
+			name:    "cutoff today",
+			userCtx: manager,
+			payload: &payloads.GroupBlockPayload{Code: "SMITH-WEDDING", Name: "Smith wedding", RoomType: room.Double, Quantity: 30,
+				CheckIn: payload.CheckIn, CheckOut: payload.CheckOut, CutoffDate: payloads.NewDate(today)},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+			},
+			wantErr:   group_block_service.ErrCutoffInPast,
+			expectErr: true,
+		},
+		{
+			name:    "stay restriction violated",
+			userCtx: manager,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).
+					Return(&stay_restriction_service.ViolationError{Code: restriction.MinLengthOfStay, Message: "min 3 nights"})
+			},
+			expectErr: true,
+		},
+		{
+			name:    "not enough free rooms",
+			userCtx: manager,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(nil)
+				m.roomService.EXPECT().IsPhysicallyAvailable(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(false)
+			},
+			wantErr:   group_block_service.ErrInsufficientInventory,
+			expectErr: true,
+		},
+		{
+			name:    "code already used for the room type",
+			userCtx: manager,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(nil)
+				m.roomService.EXPECT().IsPhysicallyAvailable(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(true)
+				m.groupBlockRepo.EXPECT().CreateBlock(gomock.Any()).Return(group_block_repo.ErrGroupBlockExists)
+			},
+			wantErr:   group_block_repo.ErrGroupBlockExists,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, m := newService(ctrl)
+			tt.mockSetup(m)
+
+			_, err := svc.CreateGroupBlock(tt.userCtx, hotel.Id, tt.payload)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestGroupBlockService_GetGroupBlocksByCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	hotelID := uuid.New()
+	m.groupBlockRepo.EXPECT().GetBlocksByCode(hotelID, "UNKNOWN").Return([]*models.GroupBlocks{}, nil)
+
+	if _, err := svc.GetGroupBlocksByCode(hotelID, "UNKNOWN"); !errors.Is(err, group_block_repo.ErrGroupBlockNotFound) {
+		t.Errorf("expected ErrGroupBlockNotFound, got %v", err)
+	}
+}
+
+func TestGroupBlockService_ReleaseGroupBlock(t *testing.T) {
+	manager := &models.UserContext{Id: uuid.New()}
+	hotel := newHotel(manager.Id)
+	block := &models.GroupBlocks{Id: uuid.New(), HotelId: hotel.Id, RoomType: room.Double, Status: group_block_status.StatusActive}
+
+	t.Run("unpicked rooms are offered to the waitlist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlockById(block.Id).Return(block, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		gomock.InOrder(
+			m.groupBlockRepo.EXPECT().ReleaseBlock(block.Id).Return(nil),
+			m.waitlistService.EXPECT().OfferFreedRooms(hotel.Id, room.Double).Return(nil, errors.New("db error")),
+		)
+
+		released, err := svc.ReleaseGroupBlock(manager, block.Id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if released.Status != group_block_status.StatusReleased {
+			t.Errorf("expected released block, got %v", released.Status)
+		}
+	})
+
+	t.Run("already released", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlockById(block.Id).Return(block, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		m.groupBlockRepo.EXPECT().ReleaseBlock(block.Id).Return(group_block_repo.ErrBlockUnavailable)
+
+		if _, err := svc.ReleaseGroupBlock(manager, block.Id); !errors.Is(err, group_block_service.ErrGroupBlockReleased) {
+			t.Errorf("expected ErrGroupBlockReleased, got %v", err)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlockById(block.Id).Return(block, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+
+		if _, err := svc.ReleaseGroupBlock(&models.UserContext{Id: uuid.New()}, block.Id); !errors.Is(err, group_block_service.ErrGroupBlockAccessDenied) {
+			t.Errorf("expected ErrGroupBlockAccessDenied, got %v", err)
+		}
+	})
+}
+
+func TestGroupBlockService_ReleaseDueBlocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	now := time.Now()
+	hotelID := uuid.New()
+	released := []*models.GroupBlocks{
+		{Id: uuid.New(), HotelId: hotelID, RoomType: room.Double},
+		{Id: uuid.New(), HotelId: hotelID, RoomType: room.Suite},
+	}
+	m.groupBlockRepo.EXPECT().ReleaseDueBlocks(now).Return(released, nil)
+	m.waitlistService.EXPECT().OfferFreedRooms(hotelID, room.Double).Return(nil, nil)
+	m.waitlistService.EXPECT().OfferFreedRooms(hotelID, room.Suite).Return(nil, nil)
+
+	got, err := svc.ReleaseDueBlocks(now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("expected 2 released blocks, got %d", len(got))
+	}
+}
+
+func TestGroupBlockService_PickUpRooms(t *testing.T) {
+	hotel := newHotel(uuid.New())
+	arrival := hotel.Today(time.Now()).AddDate(0, 1, 0)
+	doubles := &models.GroupBlocks{Id: uuid.New(), HotelId: hotel.Id, Code: "SMITH-WEDDING", RoomType: room.Double, Quantity: 30,
+		CheckIn: hotel.CheckInAt(arrival), CheckOut: hotel.CheckOutAt(arrival.AddDate(0, 0, 3)), Status: group_block_status.StatusActive}
+	suites := &models.GroupBlocks{Id: uuid.New(), HotelId: hotel.Id, Code: "SMITH-WEDDING", RoomType: room.Suite, Quantity: 2,
+		CheckIn: doubles.CheckIn, CheckOut: doubles.CheckOut, Status: group_block_status.StatusActive}
+	blocks := []*models.GroupBlocks{doubles, suites}
+
+	booking := func(checkIn, checkOut time.Time, rooms ...*payloads.RoomPayload) *payloads.BookingPayload {
+		return &payloads.BookingPayload{HotelId: hotel.Id, GroupCode: "SMITH-WEDDING", Rooms: rooms,
+			CheckIn: payloads.NewDate(checkIn), CheckOut: payloads.NewDate(checkOut)}
+	}
+
+	t.Run("rooms of a type are picked up together", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlocksByCode(hotel.Id, "SMITH-WEDDING").Return(blocks, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		m.groupBlockRepo.EXPECT().PickUpRooms(doubles.Id, 3, gomock.Any()).Return(nil)
+
+		payload := booking(arrival, arrival.AddDate(0, 0, 2),
+			&payloads.RoomPayload{RoomType: room.Double, Quantity: 1},
+			&payloads.RoomPayload{RoomType: room.Double, Quantity: 2})
+		if err := svc.PickUpRooms(payload); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("stay outside the block dates", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlocksByCode(hotel.Id, "SMITH-WEDDING").Return(blocks, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+
+		payload := booking(arrival.AddDate(0, 0, -1), arrival.AddDate(0, 0, 2), &payloads.RoomPayload{RoomType: room.Double, Quantity: 1})
+		if err := svc.PickUpRooms(payload); !errors.Is(err, group_block_service.ErrGroupStayMismatch) {
+			t.Errorf("expected ErrGroupStayMismatch, got %v", err)
+		}
+	})
+
+	t.Run("room type not held for the group", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlocksByCode(hotel.Id, "SMITH-WEDDING").Return(blocks, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+
+		payload := booking(arrival, arrival.AddDate(0, 0, 2), &payloads.RoomPayload{RoomType: room.Single, Quantity: 1})
+		if err := svc.PickUpRooms(payload); !errors.Is(err, group_block_service.ErrGroupStayMismatch) {
+			t.Errorf("expected ErrGroupStayMismatch, got %v", err)
+		}
+	})
+
+	t.Run("earlier pickups are returned when a block runs out", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlocksByCode(hotel.Id, "SMITH-WEDDING").Return(blocks, nil)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		gomock.InOrder(
+			m.groupBlockRepo.EXPECT().PickUpRooms(doubles.Id, 2, gomock.Any()).Return(nil),
+			m.groupBlockRepo.EXPECT().PickUpRooms(suites.Id, 3, gomock.Any()).Return(group_block_repo.ErrBlockUnavailable),
+			m.groupBlockRepo.EXPECT().ReturnRooms(doubles.Id, 2, gomock.Any()).Return(nil),
+		)
+
+		payload := booking(arrival, arrival.AddDate(0, 0, 3),
+			&payloads.RoomPayload{RoomType: room.Double, Quantity: 2},
+			&payloads.RoomPayload{RoomType: room.Suite, Quantity: 3})
+		if err := svc.PickUpRooms(payload); !errors.Is(err, group_block_service.ErrGroupRoomsUnavailable) {
+			t.Errorf("expected ErrGroupRoomsUnavailable, got %v", err)
+		}
+	})
+
+	t.Run("unknown group code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.groupBlockRepo.EXPECT().GetBlocksByCode(hotel.Id, "SMITH-WEDDING").Return(nil, nil)
+
+		payload := booking(arrival, arrival.AddDate(0, 0, 2), &payloads.RoomPayload{RoomType: room.Double, Quantity: 1})
+		if err := svc.PickUpRooms(payload); !errors.Is(err, group_block_repo.ErrGroupBlockNotFound) {
+			t.Errorf("expected ErrGroupBlockNotFound, got %v", err)
+		}
+	})
+}
+
+func TestGroupBlockService_ReturnRooms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	hotelID := uuid.New()
+	doubles := &models.GroupBlocks{Id: uuid.New(), HotelId: hotelID, RoomType: room.Double}
+	suites := &models.GroupBlocks{Id: uuid.New(), HotelId: hotelID, RoomType: room.Suite}
+	m.groupBlockRepo.EXPECT().GetBlocksByCode(hotelID, "SMITH-WEDDING").Return([]*models.GroupBlocks{doubles, suites}, nil)
+	m.groupBlockRepo.EXPECT().ReturnRooms(doubles.Id, 2, gomock.Any()).Return(nil)
+	// a block released at its cutoff keeps the rooms in general inventory
+	m.groupBlockRepo.EXPECT().ReturnRooms(suites.Id, 1, gomock.Any()).Return(group_block_repo.ErrBlockUnavailable)
+
+	err := svc.ReturnRooms(hotelID, "SMITH-WEDDING", []*payloads.RoomPayload{
+		{RoomType: room.Double, Quantity: 2},
+		{RoomType: room.Suite, Quantity: 1},
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -1,21 +1,43 @@
 package hotel_service
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/geocode_repo"
 	"github.com/tktanisha/booking_system/internal/repository/hotel_repo"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
+const (
+	defaultNoShowCutoffHours = 24
+	defaultTimeZone          = "UTC"
+	defaultCheckInTime       = "15:00"
+	defaultCheckOutTime      = "11:00"
+	defaultChildAgeLimit     = 12
+	defaultMinCheckInAge     = 18
+)
+
+var ErrHotelAccessDenied = errors.New("only the hotel's manager can edit it")
+
 type HotelService struct {
-	hotelRepo hotel_repo.HotelRepositoryInterface
+	hotelRepo  hotel_repo.HotelRepositoryInterface
+	reviewRepo review_repo.ReviewRepoInterface
+	mediaRepo  media_repo.MediaRepoInterface
+	geocoder   geocode_repo.GeocoderInterface // optional, nil when hotels aren't geocoded
 }
 
-func NewHotelService(hotelRepo hotel_repo.HotelRepositoryInterface) *HotelService {
+func NewHotelService(hotelRepo hotel_repo.HotelRepositoryInterface, reviewRepo review_repo.ReviewRepoInterface, mediaRepo media_repo.MediaRepoInterface, geocoder geocode_repo.GeocoderInterface) *HotelService {
 	return &HotelService{
-		hotelRepo: hotelRepo,
+		hotelRepo:  hotelRepo,
+		reviewRepo: reviewRepo,
+		mediaRepo:  mediaRepo,
+		geocoder:   geocoder,
 	}
 }
 
@@ -23,13 +45,133 @@ func (h *HotelService) GetHotelByID(hotelID uuid.UUID) (*models.Hotels, error) {
 	return h.hotelRepo.GetHotelByID(hotelID)
 }
 
+// GetHotelDetails returns a hotel together with its rating summary and photos,
+// for showing the hotel to guests. Room type photos are left to the room types.
+func (h *HotelService) GetHotelDetails(hotelID uuid.UUID) (*models.Hotels, error) {
+	hotel, err := h.hotelRepo.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	hotel.Rating, err = h.reviewRepo.GetRatingSummary(hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := h.mediaRepo.GetMediaByHotelId(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	hotel.Media = make([]*models.Media, 0, len(media))
+	for _, m := range media {
+		if m.RoomTypeId == nil {
+			hotel.Media = append(hotel.Media, m)
+		}
+	}
+	return hotel, nil
+}
+
+func (h *HotelService) SearchHotels(payload *payloads.HotelSearchPayload) ([]*models.Hotels, error) {
+	search := &models.HotelSearch{Amenities: payload.Amenities, Sort: payload.Sort}
+	if payload.Near != nil {
+		search.Near = &models.GeoPoint{Latitude: payload.Near.Latitude, Longitude: payload.Near.Longitude}
+		search.RadiusKm = payload.RadiusKm
+	}
+	return h.hotelRepo.SearchHotels(search)
+}
+
 func (h *HotelService) CreateHotel(ctx *models.UserContext, payload *payloads.CreateHotelPayload) (*models.Hotels, error) {
 	hotel := &models.Hotels{
-		Id:        uuid.New(),
-		Name:      payload.Name,
-		Address:   payload.Address,
-		CreatedAt: time.Now(),
-		ManagerId: ctx.Id,
+		Id:                 uuid.New(),
+		Name:               payload.Name,
+		Address:            payload.Address,
+		NoShowCutoffHours:  defaultNoShowCutoffHours,
+		NoShowChargeNights: payload.NoShowChargeNights,
+		HoldUntilInspected: payload.HoldUntilInspected,
+		Currency:           payload.Currency,
+		TimeZone:           payload.TimeZone,
+		CheckInTime:        payload.CheckInTime,
+		CheckOutTime:       payload.CheckOutTime,
+		CreatedAt:          time.Now(),
+		ManagerId:          ctx.Id,
+	}
+	hotel.Coordinates = h.locate(payload.Address, payload.Latitude, payload.Longitude)
+	applyContent(hotel, &payload.HotelContentPayload)
+	if payload.NoShowCutoffHours != nil {
+		hotel.NoShowCutoffHours = *payload.NoShowCutoffHours
+	}
+	if hotel.Currency == "" {
+		hotel.Currency = currency.Default
+	}
+	if hotel.TimeZone == "" {
+		hotel.TimeZone = defaultTimeZone
+	}
+	if hotel.CheckInTime == "" {
+		hotel.CheckInTime = defaultCheckInTime
+	}
+	if hotel.CheckOutTime == "" {
+		hotel.CheckOutTime = defaultCheckOutTime
 	}
 	return h.hotelRepo.CreateHotel(hotel)
 }
+
+// UpdateHotel replaces the name, address and content of a hotel. Only the
+// hotel's own manager may edit it.
+func (h *HotelService) UpdateHotel(ctx *models.UserContext, hotelID uuid.UUID, payload *payloads.UpdateHotelPayload) (*models.Hotels, error) {
+	hotel, err := h.hotelRepo.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != ctx.Id {
+		return nil, ErrHotelAccessDenied
+	}
+
+	hotel.Name = payload.Name
+	hotel.Address = payload.Address
+	hotel.Coordinates = h.locate(payload.Address, payload.Latitude, payload.Longitude)
+	applyContent(hotel, &payload.HotelContentPayload)
+	if err := h.hotelRepo.UpdateHotel(hotel); err != nil {
+		return nil, err
+	}
+	return hotel, nil
+}
+
+// locate returns the coordinates given for a hotel, or looks its address up
+// when there are none. A failed lookup leaves the hotel off the map rather than
+// failing the request; it just won't show in proximity searches.
+func (h *HotelService) locate(address string, latitude, longitude *float64) *models.GeoPoint {
+	if latitude != nil && longitude != nil {
+		return &models.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+	}
+	if h.geocoder == nil {
+		return nil
+	}
+	point, err := h.geocoder.Geocode(address)
+	if err != nil {
+		return nil
+	}
+	return point
+}
+
+func applyContent(hotel *models.Hotels, content *payloads.HotelContentPayload) {
+	hotel.Description = content.Description
+	hotel.StarRating = content.StarRating
+	hotel.Amenities = content.Amenities
+	hotel.Policies = models.HotelPolicies{
+		PetsAllowed:    content.Policies.PetsAllowed,
+		SmokingAllowed: content.Policies.SmokingAllowed,
+		ChildAgeLimit:  defaultChildAgeLimit,
+		MinCheckInAge:  defaultMinCheckInAge,
+	}
+	if content.Policies.ChildAgeLimit != nil {
+		hotel.Policies.ChildAgeLimit = *content.Policies.ChildAgeLimit
+	}
+	if content.Policies.MinCheckInAge != nil {
+		hotel.Policies.MinCheckInAge = *content.Policies.MinCheckInAge
+	}
+	hotel.Contact = models.HotelContact{
+		Phone:   content.Contact.Phone,
+		Email:   content.Contact.Email,
+		Website: content.Contact.Website,
+	}
+}
@@ -10,5 +10,8 @@ import (
 
 type HotelServiceInterface interface {
 	GetHotelByID(uuid.UUID) (*models.Hotels, error)
+	GetHotelDetails(uuid.UUID) (*models.Hotels, error)
 	CreateHotel(*models.UserContext, *payloads.CreateHotelPayload) (*models.Hotels, error)
+	SearchHotels(*payloads.HotelSearchPayload) ([]*models.Hotels, error)
+	UpdateHotel(*models.UserContext, uuid.UUID, *payloads.UpdateHotelPayload) (*models.Hotels, error)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
 	"github.com/tktanisha/booking_system/internal/mocks"
 	"github.com/tktanisha/booking_system/internal/models"
 	"github.com/tktanisha/booking_system/internal/services/hotel_service"
@@ -17,7 +18,7 @@ func TestHotelService_GetHotelByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockHotelRepositoryInterface(ctrl)
-	service := hotel_service.NewHotelService(mockRepo)
+	service := hotel_service.NewHotelService(mockRepo, mocks.NewMockReviewRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), nil)
 
 	HotelID := uuid.New()
 
@@ -58,16 +59,122 @@ func TestHotelService_GetHotelByID(t *testing.T) {
 
 }
 
+func TestHotelService_GetHotelDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockHotelRepositoryInterface(ctrl)
+	mockReviewRepo := mocks.NewMockReviewRepoInterface(ctrl)
+	mockMediaRepo := mocks.NewMockMediaRepoInterface(ctrl)
+	service := hotel_service.NewHotelService(mockRepo, mockReviewRepo, mockMediaRepo, nil)
+
+	hotelID := uuid.New()
+	summary := &models.RatingSummary{Average: 4.5, Count: 2, Distribution: map[int]int{4: 1, 5: 1}}
+	roomTypeID := uuid.New()
+	hotelPhoto := &models.Media{Id: uuid.New(), HotelId: hotelID}
+	roomPhoto := &models.Media{Id: uuid.New(), HotelId: hotelID, RoomTypeId: &roomTypeID}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		wantErr  bool
+	}{
+		{
+			name: "hotel with its rating summary and photos",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID}, nil)
+				mockReviewRepo.EXPECT().GetRatingSummary(hotelID).Return(summary, nil)
+				mockMediaRepo.EXPECT().GetMediaByHotelId(hotelID).Return([]*models.Media{hotelPhoto, roomPhoto}, nil)
+			},
+		},
+		{
+			name: "unable to get the hotel",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(nil, errors.New("not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unable to get the ratings",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID}, nil)
+				mockReviewRepo.EXPECT().GetRatingSummary(hotelID).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unable to get the photos",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID}, nil)
+				mockReviewRepo.EXPECT().GetRatingSummary(hotelID).Return(summary, nil)
+				mockMediaRepo.EXPECT().GetMediaByHotelId(hotelID).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+			hotel, err := service.GetHotelDetails(hotelID)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("want error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && hotel.Rating != summary {
+				t.Errorf("expected the rating summary on the hotel, got %+v", hotel.Rating)
+			}
+			if !tt.wantErr && (len(hotel.Media) != 1 || hotel.Media[0] != hotelPhoto) {
+				t.Errorf("expected only the hotel's own photos, got %+v", hotel.Media)
+			}
+		})
+	}
+}
+
+func TestHotelService_SearchHotels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockHotelRepositoryInterface(ctrl)
+	service := hotel_service.NewHotelService(mockRepo, mocks.NewMockReviewRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), nil)
+
+	amenities := []hotel.Amenity{hotel.Wifi, hotel.Pool}
+	mockRepo.EXPECT().SearchHotels(&models.HotelSearch{Amenities: amenities, Sort: hotel.SortByRating}).Return([]*models.Hotels{{Name: "Top Rated"}}, nil)
+
+	hotels, err := service.SearchHotels(&payloads.HotelSearchPayload{Amenities: amenities, Sort: hotel.SortByRating})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hotels) != 1 {
+		t.Errorf("expected one hotel, got %d", len(hotels))
+	}
+
+	near := &models.GeoPoint{Latitude: 19.0896, Longitude: 72.8656}
+	mockRepo.EXPECT().SearchHotels(&models.HotelSearch{Near: near, RadiusKm: 5, Sort: hotel.SortByDistance}).Return([]*models.Hotels{}, nil)
+
+	_, err = service.SearchHotels(&payloads.HotelSearchPayload{
+		Near:     &payloads.GeoPointPayload{Latitude: 19.0896, Longitude: 72.8656},
+		RadiusKm: 5,
+		Sort:     hotel.SortByDistance,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestHotelService_CreateHotel(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mocks.NewMockHotelRepositoryInterface(ctrl)
-	service := hotel_service.NewHotelService(mockRepo)
+	mockGeocoder := mocks.NewMockGeocoderInterface(ctrl)
+	service := hotel_service.NewHotelService(mockRepo, mocks.NewMockReviewRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), mockGeocoder)
 
 	managerCtx := &models.UserContext{
 		Id:   uuid.New(),
 		Role: "manager",
 	}
+	latitude, longitude := 19.0896, 72.8656
+	zeroHours := 0
 
 	tests := []struct {
 		name     string
@@ -84,12 +191,151 @@ func TestHotelService_CreateHotel(t *testing.T) {
 				Address: "123 Test St",
 			},
 			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode("123 Test St").Return(nil, errors.New("address not found"))
 				mockRepo.EXPECT().
 					CreateHotel(gomock.Any()).
 					Return(&models.Hotels{Id: uuid.New(), Name: "Test Hotel", Address: "123 Test St", ManagerId: managerCtx.Id}, nil)
 			},
 			wantErr: false,
 		},
+		{
+			name:    "Keeps the declared currency",
+			userCtx: managerCtx,
+			payload: &payloads.CreateHotelPayload{
+				Name:     "Tokyo Hotel",
+				Address:  "1 Ginza Street",
+				Currency: "JPY",
+			},
+			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode(gomock.Any()).Return(nil, errors.New("address not found"))
+				mockRepo.EXPECT().
+					CreateHotel(gomock.Any()).
+					DoAndReturn(func(hotel *models.Hotels) (*models.Hotels, error) {
+						if hotel.Currency != "JPY" {
+							t.Errorf("expected currency JPY, got %q", hotel.Currency)
+						}
+						return hotel, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Defaults no-show cutoff when omitted",
+			userCtx: managerCtx,
+			payload: &payloads.CreateHotelPayload{
+				Name:    "Default Hotel",
+				Address: "789 Default Ave",
+			},
+			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode(gomock.Any()).Return(nil, errors.New("address not found"))
+				mockRepo.EXPECT().
+					CreateHotel(gomock.Any()).
+					DoAndReturn(func(hotel *models.Hotels) (*models.Hotels, error) {
+						if hotel.NoShowCutoffHours != 24 {
+							t.Errorf("expected default cutoff of 24 hours, got %d", hotel.NoShowCutoffHours)
+						}
+						if hotel.Currency != "USD" {
+							t.Errorf("expected default currency USD, got %q", hotel.Currency)
+						}
+						if hotel.TimeZone != "UTC" || hotel.CheckInTime != "15:00" || hotel.CheckOutTime != "11:00" {
+							t.Errorf("expected UTC with 15:00 check-in and 11:00 check-out, got %q %q %q", hotel.TimeZone, hotel.CheckInTime, hotel.CheckOutTime)
+						}
+						if hotel.Policies.ChildAgeLimit != 12 || hotel.Policies.MinCheckInAge != 18 {
+							t.Errorf("expected child age limit 12 and minimum check-in age 18, got %+v", hotel.Policies)
+						}
+						if hotel.Coordinates != nil {
+							t.Errorf("expected no coordinates for an unknown address, got %+v", hotel.Coordinates)
+						}
+						return hotel, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Keeps a zero no-show cutoff",
+			userCtx: managerCtx,
+			payload: &payloads.CreateHotelPayload{
+				Name:              "Strict Hotel",
+				Address:           "12 Punctual Road",
+				NoShowCutoffHours: &zeroHours,
+			},
+			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode(gomock.Any()).Return(nil, errors.New("address not found"))
+				mockRepo.EXPECT().
+					CreateHotel(gomock.Any()).
+					DoAndReturn(func(hotel *models.Hotels) (*models.Hotels, error) {
+						if hotel.NoShowCutoffHours != 0 {
+							t.Errorf("expected a cutoff of 0 hours, got %d", hotel.NoShowCutoffHours)
+						}
+						return hotel, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Keeps zero age policies",
+			userCtx: managerCtx,
+			payload: &payloads.CreateHotelPayload{
+				Name:    "Open Hostel",
+				Address: "3 Backpacker Lane",
+				HotelContentPayload: payloads.HotelContentPayload{
+					Policies: payloads.HotelPoliciesPayload{ChildAgeLimit: &zeroHours, MinCheckInAge: &zeroHours},
+				},
+			},
+			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode(gomock.Any()).Return(nil, errors.New("address not found"))
+				mockRepo.EXPECT().
+					CreateHotel(gomock.Any()).
+					DoAndReturn(func(hotel *models.Hotels) (*models.Hotels, error) {
+						if hotel.Policies.ChildAgeLimit != 0 || hotel.Policies.MinCheckInAge != 0 {
+							t.Errorf("expected child age limit and minimum check-in age of 0, got %+v", hotel.Policies)
+						}
+						return hotel, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Keeps the given coordinates",
+			userCtx: managerCtx,
+			payload: &payloads.CreateHotelPayload{
+				Name:      "Airport Hotel",
+				Address:   "Terminal 2, Sahar Road, Mumbai",
+				Latitude:  &latitude,
+				Longitude: &longitude,
+			},
+			mockFunc: func() {
+				mockRepo.EXPECT().
+					CreateHotel(gomock.Any()).
+					DoAndReturn(func(hotel *models.Hotels) (*models.Hotels, error) {
+						if hotel.Coordinates == nil || hotel.Coordinates.Latitude != latitude || hotel.Coordinates.Longitude != longitude {
+							t.Errorf("expected the given coordinates, got %+v", hotel.Coordinates)
+						}
+						return hotel, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Geocodes the address when no coordinates are given",
+			userCtx: managerCtx,
+			payload: &payloads.CreateHotelPayload{
+				Name:    "Airport Hotel",
+				Address: "Terminal 2, Sahar Road, Mumbai",
+			},
+			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode("Terminal 2, Sahar Road, Mumbai").Return(&models.GeoPoint{Latitude: latitude, Longitude: longitude}, nil)
+				mockRepo.EXPECT().
+					CreateHotel(gomock.Any()).
+					DoAndReturn(func(hotel *models.Hotels) (*models.Hotels, error) {
+						if hotel.Coordinates == nil || hotel.Coordinates.Latitude != latitude {
+							t.Errorf("expected geocoded coordinates, got %+v", hotel.Coordinates)
+						}
+						return hotel, nil
+					})
+			},
+			wantErr: false,
+		},
 		{
 			name:    "Repository error during creation",
 			userCtx: managerCtx,
@@ -98,6 +344,7 @@ func TestHotelService_CreateHotel(t *testing.T) {
 				Address: "456 Error Rd",
 			},
 			mockFunc: func() {
+				mockGeocoder.EXPECT().Geocode(gomock.Any()).Return(nil, errors.New("address not found"))
 				mockRepo.EXPECT().
 					CreateHotel(gomock.Any()).
 					Return(nil, errors.New("repository error"))
@@ -120,3 +367,79 @@ func TestHotelService_CreateHotel(t *testing.T) {
 
 	}
 }
+
+func TestHotelService_UpdateHotel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockHotelRepositoryInterface(ctrl)
+	service := hotel_service.NewHotelService(mockRepo, mocks.NewMockReviewRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), nil)
+
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: "manager"}
+	hotelID := uuid.New()
+	childAgeLimit := 10
+	payload := &payloads.UpdateHotelPayload{
+		Name:    "Grand Plaza",
+		Address: "456 Avenue, Old Town",
+		HotelContentPayload: payloads.HotelContentPayload{
+			StarRating: 4,
+			Amenities:  []hotel.Amenity{hotel.Wifi, hotel.Parking},
+			Policies:   payloads.HotelPoliciesPayload{PetsAllowed: true, ChildAgeLimit: &childAgeLimit},
+			Contact:    payloads.HotelContactPayload{Email: "stay@plaza.example"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		wantErr  error
+	}{
+		{
+			name: "manager edits their hotel",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id, Currency: "EUR"}, nil)
+				mockRepo.EXPECT().UpdateHotel(gomock.Any()).DoAndReturn(func(h *models.Hotels) error {
+					if h.Name != "Grand Plaza" || h.StarRating != 4 || len(h.Amenities) != 2 || h.Contact.Email != "stay@plaza.example" {
+						t.Errorf("expected the payload to be applied, got %+v", h)
+					}
+					if !h.Policies.PetsAllowed || h.Policies.ChildAgeLimit != 10 || h.Policies.MinCheckInAge != 18 {
+						t.Errorf("expected pets allowed, child age limit 10 and default check-in age, got %+v", h.Policies)
+					}
+					if h.Currency != "EUR" {
+						t.Errorf("expected currency to be left alone, got %q", h.Currency)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name: "another manager's hotel",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+			},
+			wantErr: hotel_service.ErrHotelAccessDenied,
+		},
+		{
+			name: "hotel not found",
+			mockFunc: func() {
+				mockRepo.EXPECT().GetHotelByID(hotelID).Return(nil, errors.New("hotel not found"))
+			},
+			wantErr: errors.New("hotel not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			_, err := service.UpdateHotel(managerCtx, hotelID, payload)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			} else if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
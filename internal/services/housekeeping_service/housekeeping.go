@@ -0,0 +1,104 @@
+package housekeeping_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	housekeeping_status "github.com/tktanisha/booking_system/internal/enums/housekeeping"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/housekeeping_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_unit_repo"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+)
+
+var ErrTaskNotAssigned = errors.New("task is assigned to another staff member")
+
+type HousekeepingService struct {
+	HousekeepingRepo housekeeping_repo.HousekeepingRepoInterface
+	UnitRepo         room_unit_repo.RoomUnitRepoInterface
+}
+
+func NewHousekeepingService(housekeepingRepo housekeeping_repo.HousekeepingRepoInterface, unitRepo room_unit_repo.RoomUnitRepoInterface) *HousekeepingService {
+	return &HousekeepingService{
+		HousekeepingRepo: housekeepingRepo,
+		UnitRepo:         unitRepo,
+	}
+}
+
+// CreateCheckoutTasks opens a cleaning task for every unit the booking occupied and marks those units dirty.
+func (h *HousekeepingService) CreateCheckoutTasks(booking *models.Bookings) ([]*models.HousekeepingTasks, error) {
+	units, err := h.UnitRepo.GetUnitsByBookingId(booking.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*models.HousekeepingTasks, 0, len(units))
+	for _, unit := range units {
+		bookingId := booking.Id
+		task := &models.HousekeepingTasks{
+			Id:         uuid.New(),
+			HotelId:    booking.HotelId,
+			RoomUnitId: unit.Id,
+			RoomNumber: unit.Number,
+			BookingId:  &bookingId,
+			Status:     housekeeping_status.TaskPending,
+			CreatedAt:  time.Now(),
+		}
+		if err := h.HousekeepingRepo.CreateTask(task); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (h *HousekeepingService) GetOpenTasksByHotelID(hotelID uuid.UUID) ([]*models.HousekeepingTasks, error) {
+	return h.HousekeepingRepo.GetOpenTasksByHotelID(hotelID)
+}
+
+func (h *HousekeepingService) ClaimTask(userCtx *models.UserContext, taskId uuid.UUID) (*models.HousekeepingTasks, error) {
+	if err := h.HousekeepingRepo.ClaimTask(taskId, userCtx.Id, time.Now()); err != nil {
+		return nil, err
+	}
+	return h.HousekeepingRepo.GetTaskById(taskId)
+}
+
+// CompleteTask closes a task; only the staff member who claimed it or a manager may do so.
+func (h *HousekeepingService) CompleteTask(userCtx *models.UserContext, taskId uuid.UUID) (*models.HousekeepingTasks, error) {
+	task, err := h.HousekeepingRepo.GetTaskById(taskId)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Status != housekeeping_status.TaskInProgress {
+		return nil, errors.New("only claimed tasks can be completed")
+	}
+	if !permissions.IsManager(userCtx) && (task.AssignedTo == nil || *task.AssignedTo != userCtx.Id) {
+		return nil, ErrTaskNotAssigned
+	}
+
+	if err := h.HousekeepingRepo.CompleteTask(taskId, time.Now()); err != nil {
+		return nil, err
+	}
+	return h.HousekeepingRepo.GetTaskById(taskId)
+}
+
+// InspectUnit signs off a cleaned unit, releasing it for same-day sale at hotels that hold units until inspection.
+func (h *HousekeepingService) InspectUnit(unitId uuid.UUID) (*models.RoomUnits, error) {
+	unit, err := h.UnitRepo.GetUnitById(unitId)
+	if err != nil {
+		return nil, err
+	}
+
+	if unit.Cleanliness != room.CleanlinessClean {
+		return nil, errors.New("only clean units can be inspected")
+	}
+
+	if err := h.UnitRepo.UpdateCleanliness(unitId, room.CleanlinessInspected); err != nil {
+		return nil, err
+	}
+	unit.Cleanliness = room.CleanlinessInspected
+	return unit, nil
+}
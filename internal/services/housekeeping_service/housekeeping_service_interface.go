@@ -0,0 +1,16 @@
+package housekeeping_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=housekeeping_service_interface.go -destination=../../mocks/mock_housekeeping_service.go -package=mocks
+
+type HousekeepingServiceInterface interface {
+	CreateCheckoutTasks(*models.Bookings) ([]*models.HousekeepingTasks, error)
+	GetOpenTasksByHotelID(hotelID uuid.UUID) ([]*models.HousekeepingTasks, error)
+	ClaimTask(userCtx *models.UserContext, taskId uuid.UUID) (*models.HousekeepingTasks, error)
+	CompleteTask(userCtx *models.UserContext, taskId uuid.UUID) (*models.HousekeepingTasks, error)
+	InspectUnit(unitId uuid.UUID) (*models.RoomUnits, error)
+}
@@ -0,0 +1,229 @@
+package housekeeping_service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	housekeeping_status "github.com/tktanisha/booking_system/internal/enums/housekeeping"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/housekeeping_service"
+)
+
+func TestHousekeepingService_CreateCheckoutTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockHousekeepingRepoInterface(ctrl)
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	svc := housekeeping_service.NewHousekeepingService(mockRepo, mockUnitRepo)
+
+	booking := &models.Bookings{Id: uuid.New(), HotelId: uuid.New()}
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantTasks int
+		wantErr   bool
+	}{
+		{
+			name: "one task per occupied unit",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByBookingId(booking.Id).Return([]*models.RoomUnits{
+					{Id: uuid.New(), Number: "101"},
+					{Id: uuid.New(), Number: "102"},
+				}, nil)
+				mockRepo.EXPECT().CreateTask(gomock.Any()).DoAndReturn(func(task *models.HousekeepingTasks) error {
+					if task.Status != housekeeping_status.TaskPending || task.HotelId != booking.HotelId {
+						t.Errorf("unexpected task %+v", task)
+					}
+					return nil
+				}).Times(2)
+			},
+			wantTasks: 2,
+		},
+		{
+			name: "booking without assigned units",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByBookingId(booking.Id).Return(nil, nil)
+			},
+			wantTasks: 0,
+		},
+		{
+			name: "create task fails",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByBookingId(booking.Id).Return([]*models.RoomUnits{{Id: uuid.New()}}, nil)
+				mockRepo.EXPECT().CreateTask(gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			tasks, err := svc.CreateCheckoutTasks(booking)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && len(tasks) != tt.wantTasks {
+				t.Errorf("expected %d tasks, got %d", tt.wantTasks, len(tasks))
+			}
+		})
+	}
+}
+
+func TestHousekeepingService_CompleteTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockHousekeepingRepoInterface(ctrl)
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	svc := housekeeping_service.NewHousekeepingService(mockRepo, mockUnitRepo)
+
+	staffCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleHousekeeping}
+	otherStaffCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleHousekeeping}
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	taskID := uuid.New()
+	inProgress := &models.HousekeepingTasks{Id: taskID, Status: housekeeping_status.TaskInProgress, AssignedTo: &staffCtx.Id}
+
+	tests := []struct {
+		name      string
+		userCtx   *models.UserContext
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name:    "assignee completes the task",
+			userCtx: staffCtx,
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTaskById(taskID).Return(inProgress, nil)
+				mockRepo.EXPECT().CompleteTask(taskID, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().GetTaskById(taskID).Return(&models.HousekeepingTasks{Id: taskID, Status: housekeeping_status.TaskDone}, nil)
+			},
+		},
+		{
+			name:    "manager can complete any task",
+			userCtx: managerCtx,
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTaskById(taskID).Return(inProgress, nil)
+				mockRepo.EXPECT().CompleteTask(taskID, gomock.Any()).Return(nil)
+				mockRepo.EXPECT().GetTaskById(taskID).Return(&models.HousekeepingTasks{Id: taskID, Status: housekeeping_status.TaskDone}, nil)
+			},
+		},
+		{
+			name:    "other staff cannot complete",
+			userCtx: otherStaffCtx,
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTaskById(taskID).Return(inProgress, nil)
+			},
+			wantErr: housekeeping_service.ErrTaskNotAssigned,
+		},
+		{
+			name:    "unclaimed task",
+			userCtx: staffCtx,
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTaskById(taskID).Return(&models.HousekeepingTasks{Id: taskID, Status: housekeeping_status.TaskPending}, nil)
+			},
+			wantErr: errors.New("only claimed tasks can be completed"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, err := svc.CompleteTask(tt.userCtx, taskID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHousekeepingService_ClaimTask(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockHousekeepingRepoInterface(ctrl)
+	svc := housekeeping_service.NewHousekeepingService(mockRepo, mocks.NewMockRoomUnitRepoInterface(ctrl))
+
+	staffCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleHousekeeping}
+	taskID := uuid.New()
+
+	mockRepo.EXPECT().ClaimTask(taskID, staffCtx.Id, gomock.Any()).Return(nil)
+	mockRepo.EXPECT().GetTaskById(taskID).Return(&models.HousekeepingTasks{Id: taskID, Status: housekeeping_status.TaskInProgress, AssignedTo: &staffCtx.Id}, nil)
+
+	task, err := svc.ClaimTask(staffCtx, taskID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if task.Status != housekeeping_status.TaskInProgress {
+		t.Errorf("expected in_progress, got %q", task.Status)
+	}
+
+	mockRepo.EXPECT().ClaimTask(taskID, staffCtx.Id, gomock.Any()).Return(errors.New("task not found or already claimed"))
+	if _, err := svc.ClaimTask(staffCtx, taskID); err == nil {
+		t.Errorf("expected error when the task is already claimed")
+	}
+}
+
+func TestHousekeepingService_InspectUnit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	svc := housekeeping_service.NewHousekeepingService(mocks.NewMockHousekeepingRepoInterface(ctrl), mockUnitRepo)
+
+	unitID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "clean unit is inspected",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, Cleanliness: room.CleanlinessClean}, nil)
+				mockUnitRepo.EXPECT().UpdateCleanliness(unitID, room.CleanlinessInspected).Return(nil)
+			},
+		},
+		{
+			name: "dirty unit cannot be inspected",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, Cleanliness: room.CleanlinessDirty}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "unit not found",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(nil, errors.New("room unit not found"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			unit, err := svc.InspectUnit(unitID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && unit.Cleanliness != room.CleanlinessInspected {
+				t.Errorf("expected inspected, got %q", unit.Cleanliness)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package invoice_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/booking_repo"
+	"github.com/tktanisha/booking_system/internal/repository/invoice_repo"
+	"github.com/tktanisha/booking_system/internal/services/folio_service"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/permissions"
+)
+
+var (
+	ErrInvoiceAccessDenied = errors.New("you are not allowed to access this invoice")
+	ErrInvoiceNotAvailable = errors.New("an invoice is issued once the guest has checked out")
+)
+
+type InvoiceService struct {
+	InvoiceRepo  invoice_repo.InvoiceRepoInterface
+	BookingRepo  booking_repo.BookingRepoInterface
+	FolioService folio_service.FolioServiceInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewInvoiceService(invoiceRepo invoice_repo.InvoiceRepoInterface, bookingRepo booking_repo.BookingRepoInterface, folioService folio_service.FolioServiceInterface, hotelService hotel_service.HotelServiceInterface) *InvoiceService {
+	return &InvoiceService{
+		InvoiceRepo:  invoiceRepo,
+		BookingRepo:  bookingRepo,
+		FolioService: folioService,
+		HotelService: hotelService,
+	}
+}
+
+// GetInvoice returns the invoice of a checked-out booking, issuing it on first
+// request. Once issued the stored invoice is returned as is.
+func (s *InvoiceService) GetInvoice(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Invoices, error) {
+	booking, err := s.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserId != userCtx.Id && !permissions.IsFrontDesk(userCtx) {
+		return nil, ErrInvoiceAccessDenied
+	}
+
+	invoice, err := s.InvoiceRepo.GetInvoiceByBookingId(bookingId)
+	if err == nil {
+		return invoice, nil
+	}
+	if !errors.Is(err, invoice_repo.ErrInvoiceNotFound) {
+		return nil, err
+	}
+
+	if booking.Status != booking_status.StatusCheckedOut {
+		return nil, ErrInvoiceNotAvailable
+	}
+
+	invoice, err = s.buildInvoice(booking)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.InvoiceRepo.CreateInvoice(invoice)
+	if errors.Is(err, invoice_repo.ErrInvoiceExists) {
+		// issued by a concurrent request; that one is the invoice
+		return s.InvoiceRepo.GetInvoiceByBookingId(bookingId)
+	}
+	return created, err
+}
+
+func (s *InvoiceService) buildInvoice(booking *models.Bookings) (*models.Invoices, error) {
+	hotel, err := s.HotelService.GetHotelByID(booking.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	bookedRooms, err := s.BookingRepo.GetBookedRoomsByBookingId(booking.Id)
+	if err != nil {
+		return nil, err
+	}
+	guests, err := s.BookingRepo.GetBookingGuestsByBookingId(booking.Id)
+	if err != nil {
+		return nil, err
+	}
+	discounts, err := s.BookingRepo.GetBookingDiscountsByBookingId(booking.Id)
+	if err != nil {
+		return nil, err
+	}
+	taxes, err := s.BookingRepo.GetBookingTaxesByBookingId(booking.Id)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.FolioService.GetFolioForBooking(booking)
+	if err != nil {
+		return nil, err
+	}
+
+	// stay and issue dates are printed as the hotel's calendar dates
+	loc := hotel.Location()
+	checkIn, checkOut := booking.CheckIn.In(loc), booking.CheckOut.In(loc)
+	nights := utils.CountNights(checkIn, checkOut)
+	invoice := &models.Invoices{
+		Id:           uuid.New(),
+		HotelId:      booking.HotelId,
+		BookingId:    booking.Id,
+		IssuedAt:     time.Now().In(loc),
+		HotelName:    hotel.Name,
+		HotelAddress: hotel.Address,
+		CheckIn:      checkIn,
+		CheckOut:     checkOut,
+		Nights:       nights,
+		Currency:     booking.Currency,
+		Payments:     account.Payments,
+	}
+	for _, guest := range guests {
+		if guest.IsLead {
+			invoice.GuestName = guest.FullName
+		}
+	}
+
+	for _, bookedRoom := range bookedRooms {
+		roomNights := bookedRoom.RoomQuantity * nights
+		invoice.Lines = append(invoice.Lines, &models.InvoiceLines{
+			Description: string(bookedRoom.RoomType) + " room, per night",
+			Quantity:    roomNights,
+			UnitAmount:  bookedRoom.PricePerNight,
+			Amount:      bookedRoom.PricePerNight * int64(roomNights),
+		})
+	}
+	for _, discount := range discounts {
+		invoice.Lines = append(invoice.Lines, singleLine(discount.Description, -discount.Amount))
+	}
+	for _, line := range account.Lines {
+		if line.IsVoided() || line.LineType == folio.Payment {
+			continue
+		}
+		invoice.Lines = append(invoice.Lines, singleLine(line.Description, line.Amount))
+	}
+	for _, line := range invoice.Lines {
+		invoice.Subtotal += line.Amount
+	}
+
+	for _, tax := range taxes {
+		invoice.TaxLines = append(invoice.TaxLines, &models.InvoiceLines{
+			Description: tax.Name,
+			Quantity:    1,
+			UnitAmount:  tax.Amount,
+			Amount:      tax.Amount,
+			Inclusive:   tax.Inclusive,
+		})
+		if tax.Inclusive {
+			invoice.IncludedTaxes += tax.Amount
+		} else {
+			invoice.Taxes += tax.Amount
+		}
+	}
+
+	invoice.Total = invoice.Subtotal + invoice.Taxes
+	invoice.BalanceDue = invoice.Total - invoice.Payments
+	return invoice, nil
+}
+
+func singleLine(description string, amount int64) *models.InvoiceLines {
+	return &models.InvoiceLines{Description: description, Quantity: 1, UnitAmount: amount, Amount: amount}
+}
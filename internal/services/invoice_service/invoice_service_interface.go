@@ -0,0 +1,13 @@
+package invoice_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=invoice_service_interface.go -destination=../../mocks/mock_invoice_service.go -package=mocks
+
+type InvoiceServiceInterface interface {
+	GetInvoice(userCtx *models.UserContext, bookingId uuid.UUID) (*models.Invoices, error)
+	RenderPDF(invoice *models.Invoices) []byte
+}
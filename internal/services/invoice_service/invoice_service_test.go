@@ -0,0 +1,218 @@
+package invoice_service_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/invoice_repo"
+	"github.com/tktanisha/booking_system/internal/services/invoice_service"
+)
+
+type invoiceMocks struct {
+	invoiceRepo  *mocks.MockInvoiceRepoInterface
+	bookingRepo  *mocks.MockBookingRepoInterface
+	folioService *mocks.MockFolioServiceInterface
+	hotelService *mocks.MockHotelServiceInterface
+}
+
+func newInvoiceService(ctrl *gomock.Controller) (*invoice_service.InvoiceService, invoiceMocks) {
+	m := invoiceMocks{
+		invoiceRepo:  mocks.NewMockInvoiceRepoInterface(ctrl),
+		bookingRepo:  mocks.NewMockBookingRepoInterface(ctrl),
+		folioService: mocks.NewMockFolioServiceInterface(ctrl),
+		hotelService: mocks.NewMockHotelServiceInterface(ctrl),
+	}
+	return invoice_service.NewInvoiceService(m.invoiceRepo, m.bookingRepo, m.folioService, m.hotelService), m
+}
+
+func TestInvoiceService_GetInvoice(t *testing.T) {
+	ownerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	otherCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	checkIn := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	booking := &models.Bookings{
+		Id:          uuid.New(),
+		UserId:      ownerCtx.Id,
+		HotelId:     uuid.New(),
+		CheckIn:     checkIn,
+		CheckOut:    checkIn.AddDate(0, 0, 2),
+		Status:      booking_status.StatusCheckedOut,
+		TotalAmount: 39600,
+		Currency:    "EUR",
+	}
+	voidedAt := time.Now()
+
+	expectBookingData := func(m invoiceMocks) {
+		m.hotelService.EXPECT().GetHotelByID(booking.HotelId).Return(&models.Hotels{Name: "Sea View", Address: "1 Beach Road"}, nil)
+		m.bookingRepo.EXPECT().GetBookedRoomsByBookingId(booking.Id).Return([]*models.BookedRooms{
+			{RoomType: "Deluxe", RoomQuantity: 2, PricePerNight: 10000},
+		}, nil)
+		m.bookingRepo.EXPECT().GetBookingGuestsByBookingId(booking.Id).Return([]*models.BookingGuests{
+			{FullName: "Asha Rao", IsLead: true}, {FullName: "Vikram Rao"},
+		}, nil)
+		m.bookingRepo.EXPECT().GetBookingDiscountsByBookingId(booking.Id).Return([]*models.BookingDiscounts{
+			{Description: "Promo SUMMER10 (10% off)", Amount: 4000},
+		}, nil)
+		m.bookingRepo.EXPECT().GetBookingTaxesByBookingId(booking.Id).Return([]*models.BookingTaxes{
+			{Name: "City tax", Amount: 3600},
+			{Name: "VAT", Amount: 3000, Inclusive: true},
+		}, nil)
+		m.folioService.EXPECT().GetFolioForBooking(booking).Return(&models.Folio{
+			Lines: []*models.FolioLines{
+				{LineType: folio.Charge, Description: "Minibar", Amount: 800},
+				{LineType: folio.Charge, Description: "Dinner", Amount: 5000, VoidedAt: &voidedAt},
+				{LineType: folio.Payment, Description: "Card", Amount: 40400},
+			},
+			Payments: 40400,
+		}, nil)
+	}
+
+	t.Run("issues the invoice on first request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newInvoiceService(ctrl)
+
+		m.bookingRepo.EXPECT().GetBookingById(booking.Id).Return(booking, nil)
+		m.invoiceRepo.EXPECT().GetInvoiceByBookingId(booking.Id).Return(nil, invoice_repo.ErrInvoiceNotFound)
+		expectBookingData(m)
+		m.invoiceRepo.EXPECT().CreateInvoice(gomock.Any()).DoAndReturn(func(invoice *models.Invoices) (*models.Invoices, error) {
+			invoice.Number, invoice.InvoiceNumber = 1, "INV-000001"
+			return invoice, nil
+		})
+
+		invoice, err := svc.GetInvoice(ownerCtx, booking.Id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// 2 rooms x 2 nights x 10000 - 4000 promo + 800 minibar; the voided dinner and the payment are left out
+		if invoice.Subtotal != 36800 || invoice.Taxes != 3600 || invoice.IncludedTaxes != 3000 || invoice.Total != 40400 {
+			t.Errorf("unexpected totals: %+v", invoice)
+		}
+		if invoice.BalanceDue != 0 || invoice.GuestName != "Asha Rao" || invoice.Currency != "EUR" || len(invoice.Lines) != 3 || len(invoice.TaxLines) != 2 {
+			t.Errorf("unexpected invoice content: %+v", invoice)
+		}
+		if invoice.Lines[0].Quantity != 4 || invoice.Lines[0].Amount != 40000 {
+			t.Errorf("expected 4 room nights, got %+v", invoice.Lines[0])
+		}
+	})
+
+	t.Run("returns the stored invoice unchanged", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newInvoiceService(ctrl)
+
+		stored := &models.Invoices{BookingId: booking.Id, InvoiceNumber: "INV-000001"}
+		m.bookingRepo.EXPECT().GetBookingById(booking.Id).Return(booking, nil)
+		m.invoiceRepo.EXPECT().GetInvoiceByBookingId(booking.Id).Return(stored, nil)
+
+		invoice, err := svc.GetInvoice(ownerCtx, booking.Id)
+		if err != nil || invoice != stored {
+			t.Errorf("expected the stored invoice, got %v %v", invoice, err)
+		}
+	})
+
+	t.Run("concurrent issue returns the winning invoice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newInvoiceService(ctrl)
+
+		stored := &models.Invoices{BookingId: booking.Id, InvoiceNumber: "INV-000002"}
+		m.bookingRepo.EXPECT().GetBookingById(booking.Id).Return(booking, nil)
+		gomock.InOrder(
+			m.invoiceRepo.EXPECT().GetInvoiceByBookingId(booking.Id).Return(nil, invoice_repo.ErrInvoiceNotFound),
+			m.invoiceRepo.EXPECT().CreateInvoice(gomock.Any()).Return(nil, invoice_repo.ErrInvoiceExists),
+			m.invoiceRepo.EXPECT().GetInvoiceByBookingId(booking.Id).Return(stored, nil),
+		)
+		expectBookingData(m)
+
+		invoice, err := svc.GetInvoice(ownerCtx, booking.Id)
+		if err != nil || invoice != stored {
+			t.Errorf("expected the stored invoice, got %v %v", invoice, err)
+		}
+	})
+
+	t.Run("not checked out yet", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newInvoiceService(ctrl)
+
+		inHouse := *booking
+		inHouse.Status = booking_status.StatusCheckedIn
+		m.bookingRepo.EXPECT().GetBookingById(booking.Id).Return(&inHouse, nil)
+		m.invoiceRepo.EXPECT().GetInvoiceByBookingId(booking.Id).Return(nil, invoice_repo.ErrInvoiceNotFound)
+
+		if _, err := svc.GetInvoice(ownerCtx, booking.Id); !errors.Is(err, invoice_service.ErrInvoiceNotAvailable) {
+			t.Errorf("expected ErrInvoiceNotAvailable, got %v", err)
+		}
+	})
+
+	t.Run("other guest is denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newInvoiceService(ctrl)
+
+		m.bookingRepo.EXPECT().GetBookingById(booking.Id).Return(booking, nil)
+
+		if _, err := svc.GetInvoice(otherCtx, booking.Id); !errors.Is(err, invoice_service.ErrInvoiceAccessDenied) {
+			t.Errorf("expected ErrInvoiceAccessDenied, got %v", err)
+		}
+	})
+
+	t.Run("error loading folio", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		svc, m := newInvoiceService(ctrl)
+
+		m.bookingRepo.EXPECT().GetBookingById(booking.Id).Return(booking, nil)
+		m.invoiceRepo.EXPECT().GetInvoiceByBookingId(booking.Id).Return(nil, invoice_repo.ErrInvoiceNotFound)
+		m.hotelService.EXPECT().GetHotelByID(booking.HotelId).Return(&models.Hotels{}, nil)
+		m.bookingRepo.EXPECT().GetBookedRoomsByBookingId(booking.Id).Return(nil, nil)
+		m.bookingRepo.EXPECT().GetBookingGuestsByBookingId(booking.Id).Return(nil, nil)
+		m.bookingRepo.EXPECT().GetBookingDiscountsByBookingId(booking.Id).Return(nil, nil)
+		m.bookingRepo.EXPECT().GetBookingTaxesByBookingId(booking.Id).Return(nil, nil)
+		m.folioService.EXPECT().GetFolioForBooking(booking).Return(nil, errors.New("db error"))
+
+		if _, err := svc.GetInvoice(ownerCtx, booking.Id); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestInvoiceService_RenderPDF(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	svc, _ := newInvoiceService(ctrl)
+
+	invoice := &models.Invoices{
+		InvoiceNumber: "INV-000042",
+		HotelName:     "Sea View",
+		IssuedAt:      time.Date(2026, 3, 12, 11, 0, 0, 0, time.UTC),
+		Total:         40400,
+	}
+	for i := 0; i < 60; i++ {
+		invoice.Lines = append(invoice.Lines, &models.InvoiceLines{Description: "Minibar", Quantity: 1, UnitAmount: 500, Amount: 500})
+	}
+
+	out := svc.RenderPDF(invoice)
+
+	if !bytes.HasPrefix(out, []byte("%PDF-")) {
+		t.Fatalf("expected a PDF document")
+	}
+	if !bytes.Contains(out, []byte("(INV-000042) Tj")) || !bytes.Contains(out, []byte("(404.00) Tj")) {
+		t.Errorf("expected the invoice number and total in the document")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Errorf("expected the long invoice to continue on a second page")
+	}
+	if !bytes.Equal(out, svc.RenderPDF(invoice)) {
+		t.Errorf("expected rendering to be deterministic")
+	}
+}
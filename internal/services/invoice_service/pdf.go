@@ -0,0 +1,112 @@
+package invoice_service
+
+import (
+	"strconv"
+
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+	"github.com/tktanisha/booking_system/internal/utils/pdf"
+)
+
+const (
+	marginLeft   = 50.0
+	marginRight  = pdf.PageWidth - 50
+	marginBottom = 60.0
+	lineHeight   = 16.0
+	qtyColumn    = 360.0
+	unitColumn   = 450.0
+)
+
+// RenderPDF lays the stored invoice out on A4 pages. It only reads the invoice,
+// so the same invoice always renders the same document.
+func (s *InvoiceService) RenderPDF(invoice *models.Invoices) []byte {
+	doc := pdf.New()
+	page := doc.AddPage()
+	y := pdf.PageHeight - 60
+
+	page.Text(marginLeft, y, 20, true, "INVOICE")
+	page.TextRight(marginRight, y, 11, true, invoice.InvoiceNumber)
+	y -= 28
+	page.Text(marginLeft, y, 11, true, invoice.HotelName)
+	page.TextRight(marginRight, y, 10, false, "Issued "+invoice.IssuedAt.Format("2006-01-02"))
+	y -= lineHeight
+	page.Text(marginLeft, y, 10, false, invoice.HotelAddress)
+	y -= 2 * lineHeight
+
+	if invoice.GuestName != "" {
+		page.Text(marginLeft, y, 10, false, "Guest: "+invoice.GuestName)
+		y -= lineHeight
+	}
+	page.Text(marginLeft, y, 10, false, "Stay: "+invoice.CheckIn.Format("2006-01-02")+" to "+invoice.CheckOut.Format("2006-01-02")+
+		" ("+strconv.Itoa(invoice.Nights)+" nights)")
+	y -= lineHeight
+	page.Text(marginLeft, y, 10, false, "All amounts in "+invoice.Currency)
+	y -= 2 * lineHeight
+
+	header := func() {
+		page.Text(marginLeft, y, 10, true, "Description")
+		page.TextRight(qtyColumn, y, 10, true, "Qty")
+		page.TextRight(unitColumn, y, 10, true, "Unit")
+		page.TextRight(marginRight, y, 10, true, "Amount")
+		y -= 6
+		page.Line(marginLeft, y, marginRight, y)
+		y -= lineHeight
+	}
+	// row starts a new page with the column header when the current one is full
+	row := func(line *models.InvoiceLines) {
+		if y < marginBottom {
+			page = doc.AddPage()
+			y = pdf.PageHeight - 60
+			header()
+		}
+		page.Text(marginLeft, y, 10, false, truncate(line.Description, 48))
+		page.TextRight(qtyColumn, y, 10, false, strconv.Itoa(line.Quantity))
+		page.TextRight(unitColumn, y, 10, false, currency.FormatAmount(line.UnitAmount, invoice.Currency))
+		page.TextRight(marginRight, y, 10, false, currency.FormatAmount(line.Amount, invoice.Currency))
+		y -= lineHeight
+	}
+	total := func(label string, amount int64, bold bool) {
+		if y < marginBottom {
+			page = doc.AddPage()
+			y = pdf.PageHeight - 60
+		}
+		page.TextRight(unitColumn, y, 10, bold, label)
+		page.TextRight(marginRight, y, 10, bold, currency.FormatAmount(amount, invoice.Currency))
+		y -= lineHeight
+	}
+
+	header()
+	for _, line := range invoice.Lines {
+		row(line)
+	}
+	for _, line := range invoice.TaxLines {
+		if !line.Inclusive {
+			row(line)
+		}
+	}
+	y -= 4
+	page.Line(unitColumn-80, y+12, marginRight, y+12)
+
+	total("Subtotal", invoice.Subtotal, false)
+	total("Taxes and fees", invoice.Taxes, false)
+	total("Total", invoice.Total, true)
+	total("Paid", invoice.Payments, false)
+	total("Balance due", invoice.BalanceDue, true)
+
+	for _, line := range invoice.TaxLines {
+		if line.Inclusive {
+			total("incl. "+line.Description, line.Amount, false)
+		}
+	}
+
+	return doc.Bytes()
+}
+
+// truncate keeps a description clear of the quantity column.
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-3]) + "..."
+}
@@ -0,0 +1,175 @@
+package media_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/blob_repo"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/thumbnail"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var (
+	ErrMediaAccessDenied = errors.New("only the hotel's manager can manage its photos")
+	ErrInvalidImage      = errors.New("image could not be processed")
+)
+
+const thumbnailContentType = "image/jpeg"
+
+type MediaService struct {
+	MediaRepo    media_repo.MediaRepoInterface
+	BlobStore    blob_repo.BlobStoreInterface
+	RoomTypeRepo room_type_repo.RoomTypeRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewMediaService(mediaRepo media_repo.MediaRepoInterface, blobStore blob_repo.BlobStoreInterface, roomTypeRepo room_type_repo.RoomTypeRepoInterface, hotelService hotel_service.HotelServiceInterface) *MediaService {
+	return &MediaService{
+		MediaRepo:    mediaRepo,
+		BlobStore:    blobStore,
+		RoomTypeRepo: roomTypeRepo,
+		HotelService: hotelService,
+	}
+}
+
+// UploadHotelMedia adds a photo of the hotel itself after its other photos.
+func (s *MediaService) UploadHotelMedia(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error) {
+	if err := s.checkManager(userCtx, hotelID); err != nil {
+		return nil, err
+	}
+	return s.upload(userCtx, hotelID, nil, payload)
+}
+
+// UploadRoomTypeMedia adds a photo of a room type after its other photos.
+func (s *MediaService) UploadRoomTypeMedia(userCtx *models.UserContext, roomTypeID uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error) {
+	roomType, err := s.RoomTypeRepo.GetRoomTypeById(roomTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkManager(userCtx, roomType.HotelId); err != nil {
+		return nil, err
+	}
+	return s.upload(userCtx, roomType.HotelId, &roomType.Id, payload)
+}
+
+// GetHotelMedia lists the photos of the hotel followed by those of its room
+// types, each group in display order.
+func (s *MediaService) GetHotelMedia(hotelID uuid.UUID) ([]*models.Media, error) {
+	return s.MediaRepo.GetMediaByHotelId(hotelID)
+}
+
+// ReorderMedia shows the listed photos in the given order. Photos of the
+// hotel and of each room type are numbered separately, so a request usually
+// lists a single group.
+func (s *MediaService) ReorderMedia(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.MediaOrderPayload) ([]*models.Media, error) {
+	if err := s.checkManager(userCtx, hotelID); err != nil {
+		return nil, err
+	}
+	if err := s.MediaRepo.SetPositions(hotelID, payload.MediaIDs); err != nil {
+		return nil, err
+	}
+	return s.MediaRepo.GetMediaByHotelId(hotelID)
+}
+
+// DeleteMedia removes a photo. Its files are removed after the row, so a
+// failure there leaves unreferenced files rather than a broken listing.
+func (s *MediaService) DeleteMedia(userCtx *models.UserContext, mediaID uuid.UUID) error {
+	media, err := s.MediaRepo.GetMediaById(mediaID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkManager(userCtx, media.HotelId); err != nil {
+		return err
+	}
+	if err := s.MediaRepo.DeleteMedia(mediaID); err != nil {
+		return err
+	}
+
+	s.BlobStore.Delete(media.StorageKey)
+	s.BlobStore.Delete(media.ThumbnailKey)
+	return nil
+}
+
+// GetMediaFile returns the stored image, or its thumbnail, with its content type.
+func (s *MediaService) GetMediaFile(mediaID uuid.UUID, thumb bool) ([]byte, string, error) {
+	media, err := s.MediaRepo.GetMediaById(mediaID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, contentType := media.StorageKey, media.ContentType
+	if thumb {
+		key, contentType = media.ThumbnailKey, thumbnailContentType
+	}
+	data, err := s.BlobStore.Get(key)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, contentType, nil
+}
+
+func (s *MediaService) checkManager(userCtx *models.UserContext, hotelID uuid.UUID) error {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrMediaAccessDenied
+	}
+	return nil
+}
+
+func (s *MediaService) upload(userCtx *models.UserContext, hotelID uuid.UUID, roomTypeID *uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error) {
+	img, err := thumbnail.Generate(payload.Data, thumbnail.MaxEdge)
+	if errors.Is(err, thumbnail.ErrUnsupported) || errors.Is(err, thumbnail.ErrTooLarge) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidImage, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	media := &models.Media{
+		Id:          uuid.New(),
+		HotelId:     hotelID,
+		RoomTypeId:  roomTypeID,
+		ContentType: payload.ContentType,
+		SizeBytes:   int64(len(payload.Data)),
+		Width:       img.Width,
+		Height:      img.Height,
+		Caption:     payload.Caption,
+		UploadedBy:  userCtx.Id,
+		CreatedAt:   time.Now(),
+	}
+	prefix := "hotels/" + hotelID.String() + "/" + media.Id.String()
+	media.StorageKey = prefix + extension(payload.ContentType)
+	media.ThumbnailKey = prefix + "_thumb.jpg"
+
+	if err := s.BlobStore.Put(media.StorageKey, payload.Data); err != nil {
+		return nil, err
+	}
+	if err := s.BlobStore.Put(media.ThumbnailKey, img.Thumbnail); err != nil {
+		s.BlobStore.Delete(media.StorageKey)
+		return nil, err
+	}
+	if err := s.MediaRepo.CreateMedia(media); err != nil {
+		s.BlobStore.Delete(media.StorageKey)
+		s.BlobStore.Delete(media.ThumbnailKey)
+		return nil, err
+	}
+
+	media.SetURLs()
+	return media, nil
+}
+
+func extension(contentType string) string {
+	if contentType == "image/png" {
+		return ".png"
+	}
+	return ".jpg"
+}
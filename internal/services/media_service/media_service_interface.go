@@ -0,0 +1,18 @@
+package media_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=media_service_interface.go -destination=../../mocks/mock_media_service.go -package=mocks
+
+type MediaServiceInterface interface {
+	UploadHotelMedia(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error)
+	UploadRoomTypeMedia(userCtx *models.UserContext, roomTypeID uuid.UUID, payload *payloads.MediaUploadPayload) (*models.Media, error)
+	GetHotelMedia(hotelID uuid.UUID) ([]*models.Media, error)
+	ReorderMedia(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.MediaOrderPayload) ([]*models.Media, error)
+	DeleteMedia(userCtx *models.UserContext, mediaID uuid.UUID) error
+	GetMediaFile(mediaID uuid.UUID, thumb bool) ([]byte, string, error)
+}
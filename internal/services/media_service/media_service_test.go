@@ -0,0 +1,228 @@
+package media_service_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/services/media_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+type serviceMocks struct {
+	mediaRepo    *mocks.MockMediaRepoInterface
+	blobStore    *mocks.MockBlobStoreInterface
+	roomTypeRepo *mocks.MockRoomTypeRepoInterface
+	hotelService *mocks.MockHotelServiceInterface
+}
+
+func newService(ctrl *gomock.Controller) (*media_service.MediaService, serviceMocks) {
+	m := serviceMocks{
+		mediaRepo:    mocks.NewMockMediaRepoInterface(ctrl),
+		blobStore:    mocks.NewMockBlobStoreInterface(ctrl),
+		roomTypeRepo: mocks.NewMockRoomTypeRepoInterface(ctrl),
+		hotelService: mocks.NewMockHotelServiceInterface(ctrl),
+	}
+	return media_service.NewMediaService(m.mediaRepo, m.blobStore, m.roomTypeRepo, m.hotelService), m
+}
+
+func pngBytes(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestMediaService_UploadHotelMedia(t *testing.T) {
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	otherCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	hotelID := uuid.New()
+	hotel := &models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}
+	payload := &payloads.MediaUploadPayload{Data: pngBytes(t, 640, 480), ContentType: "image/png", Caption: "Lobby"}
+
+	tests := []struct {
+		name      string
+		userCtx   *models.UserContext
+		payload   *payloads.MediaUploadPayload
+		mockSetup func(m serviceMocks)
+		wantErr   error
+		expectErr bool
+	}{
+		{
+			name:    "manager uploads a photo",
+			userCtx: managerCtx,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotelID).Return(hotel, nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), payload.Data).Return(nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+				m.mediaRepo.EXPECT().CreateMedia(gomock.Any()).DoAndReturn(func(media *models.Media) error {
+					if media.HotelId != hotelID || media.RoomTypeId != nil || media.Width != 640 || media.Height != 480 || media.UploadedBy != managerCtx.Id {
+						t.Errorf("unexpected media %+v", media)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:    "another manager's hotel",
+			userCtx: otherCtx,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotelID).Return(hotel, nil)
+			},
+			wantErr:   media_service.ErrMediaAccessDenied,
+			expectErr: true,
+		},
+		{
+			name:    "undecodable image",
+			userCtx: managerCtx,
+			payload: &payloads.MediaUploadPayload{Data: []byte("\x89PNG\r\n\x1a\nbroken"), ContentType: "image/png"},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotelID).Return(hotel, nil)
+			},
+			wantErr:   media_service.ErrInvalidImage,
+			expectErr: true,
+		},
+		{
+			name:    "files removed when the row cannot be saved",
+			userCtx: managerCtx,
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotelID).Return(hotel, nil)
+				m.blobStore.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				m.mediaRepo.EXPECT().CreateMedia(gomock.Any()).Return(errors.New("db error"))
+				m.blobStore.EXPECT().Delete(gomock.Any()).Return(nil).Times(2)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, m := newService(ctrl)
+			tt.mockSetup(m)
+
+			media, err := svc.UploadHotelMedia(tt.userCtx, hotelID, tt.payload)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if !tt.expectErr && (media.URL == "" || media.ThumbnailURL == "") {
+				t.Errorf("expected URLs on the uploaded photo, got %+v", media)
+			}
+		})
+	}
+}
+
+func TestMediaService_UploadRoomTypeMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	roomType := &models.RoomTypes{Id: uuid.New(), HotelId: uuid.New()}
+
+	m.roomTypeRepo.EXPECT().GetRoomTypeById(roomType.Id).Return(roomType, nil)
+	m.hotelService.EXPECT().GetHotelByID(roomType.HotelId).Return(&models.Hotels{Id: roomType.HotelId, ManagerId: managerCtx.Id}, nil)
+	m.blobStore.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	m.mediaRepo.EXPECT().CreateMedia(gomock.Any()).Return(nil)
+
+	media, err := svc.UploadRoomTypeMedia(managerCtx, roomType.Id, &payloads.MediaUploadPayload{Data: pngBytes(t, 10, 10), ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if media.RoomTypeId == nil || *media.RoomTypeId != roomType.Id || media.HotelId != roomType.HotelId {
+		t.Errorf("expected a photo of the room type, got %+v", media)
+	}
+}
+
+func TestMediaService_DeleteMedia(t *testing.T) {
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	media := &models.Media{Id: uuid.New(), HotelId: uuid.New(), StorageKey: "hotels/a/b.png", ThumbnailKey: "hotels/a/b_thumb.jpg"}
+
+	t.Run("removes the row and its files", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.mediaRepo.EXPECT().GetMediaById(media.Id).Return(media, nil)
+		m.hotelService.EXPECT().GetHotelByID(media.HotelId).Return(&models.Hotels{ManagerId: managerCtx.Id}, nil)
+		m.mediaRepo.EXPECT().DeleteMedia(media.Id).Return(nil)
+		m.blobStore.EXPECT().Delete(media.StorageKey).Return(nil)
+		m.blobStore.EXPECT().Delete(media.ThumbnailKey).Return(errors.New("disk error"))
+
+		if err := svc.DeleteMedia(managerCtx, media.Id); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("unknown photo", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		m.mediaRepo.EXPECT().GetMediaById(media.Id).Return(nil, media_repo.ErrMediaNotFound)
+
+		if err := svc.DeleteMedia(managerCtx, media.Id); !errors.Is(err, media_repo.ErrMediaNotFound) {
+			t.Errorf("expected ErrMediaNotFound, got %v", err)
+		}
+	})
+}
+
+func TestMediaService_ReorderMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	hotelID := uuid.New()
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+
+	m.hotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{ManagerId: managerCtx.Id}, nil)
+	m.mediaRepo.EXPECT().SetPositions(hotelID, ids).Return(nil)
+	m.mediaRepo.EXPECT().GetMediaByHotelId(hotelID).Return([]*models.Media{{Id: ids[0]}, {Id: ids[1]}}, nil)
+
+	media, err := svc.ReorderMedia(managerCtx, hotelID, &payloads.MediaOrderPayload{MediaIDs: ids})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(media) != 2 {
+		t.Errorf("expected the reordered photos, got %+v", media)
+	}
+}
+
+func TestMediaService_GetMediaFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	media := &models.Media{Id: uuid.New(), ContentType: "image/png", StorageKey: "hotels/a/b.png", ThumbnailKey: "hotels/a/b_thumb.jpg"}
+	m.mediaRepo.EXPECT().GetMediaById(media.Id).Return(media, nil).Times(2)
+	m.blobStore.EXPECT().Get(media.StorageKey).Return([]byte("original"), nil)
+	m.blobStore.EXPECT().Get(media.ThumbnailKey).Return([]byte("thumb"), nil)
+
+	data, contentType, err := svc.GetMediaFile(media.Id, false)
+	if err != nil || string(data) != "original" || contentType != "image/png" {
+		t.Errorf("expected the original PNG, got %q %s %v", data, contentType, err)
+	}
+	data, contentType, err = svc.GetMediaFile(media.Id, true)
+	if err != nil || string(data) != "thumb" || contentType != "image/jpeg" {
+		t.Errorf("expected the JPEG thumbnail, got %q %s %v", data, contentType, err)
+	}
+}
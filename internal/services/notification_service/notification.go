@@ -0,0 +1,35 @@
+package notification_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/notification_repo"
+)
+
+type NotificationService struct {
+	NotificationRepo notification_repo.NotificationRepoInterface
+}
+
+func NewNotificationService(notificationRepo notification_repo.NotificationRepoInterface) *NotificationService {
+	return &NotificationService{
+		NotificationRepo: notificationRepo,
+	}
+}
+
+// Notify leaves a message for the user to read in the app.
+func (s *NotificationService) Notify(userId uuid.UUID, subject, body string) error {
+	return s.NotificationRepo.CreateNotification(&models.Notifications{
+		Id:        uuid.New(),
+		UserId:    userId,
+		Subject:   subject,
+		Body:      body,
+		CreatedAt: time.Now(),
+	})
+}
+
+// GetNotifications returns the user's own notifications, newest first.
+func (s *NotificationService) GetNotifications(userCtx *models.UserContext) ([]*models.Notifications, error) {
+	return s.NotificationRepo.GetNotificationsByUserId(userCtx.Id)
+}
@@ -0,0 +1,13 @@
+package notification_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+//go:generate mockgen -source=notification_service_interface.go -destination=../../mocks/mock_notification_service.go -package=mocks
+
+type NotificationServiceInterface interface {
+	Notify(userId uuid.UUID, subject, body string) error
+	GetNotifications(userCtx *models.UserContext) ([]*models.Notifications, error)
+}
@@ -0,0 +1,47 @@
+package notification_service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/notification_service"
+)
+
+func TestNotificationService_Notify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepoInterface(ctrl)
+	svc := notification_service.NewNotificationService(mockRepo)
+	userID := uuid.New()
+
+	mockRepo.EXPECT().CreateNotification(gomock.Any()).DoAndReturn(func(n *models.Notifications) error {
+		if n.UserId != userID || n.Subject != "Rooms available" || n.Body != "Book now" || n.Id == uuid.Nil || n.CreatedAt.IsZero() {
+			t.Errorf("unexpected notification %+v", n)
+		}
+		return nil
+	})
+
+	if err := svc.Notify(userID, "Rooms available", "Book now"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNotificationService_GetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockNotificationRepoInterface(ctrl)
+	svc := notification_service.NewNotificationService(mockRepo)
+	userCtx := &models.UserContext{Id: uuid.New()}
+
+	mockRepo.EXPECT().GetNotificationsByUserId(userCtx.Id).Return([]*models.Notifications{{Id: uuid.New()}}, nil)
+
+	notifications, err := svc.GetNotifications(userCtx)
+	if err != nil || len(notifications) != 1 {
+		t.Errorf("expected the user's notifications, got %+v %v", notifications, err)
+	}
+}
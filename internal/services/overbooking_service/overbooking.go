@@ -0,0 +1,196 @@
+package overbooking_service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/overbooking_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// defaultWalkListNights is how far ahead a walk list looks when no end date is given.
+const defaultWalkListNights = 7
+
+var ErrOverbookingAccessDenied = errors.New("you are not allowed to manage this hotel's overbooking")
+
+type OverbookingService struct {
+	OverbookingRepo overbooking_repo.OverbookingRepoInterface
+	RoomService     room_service.RoomServiceInterface
+	RoomTypeService room_type_service.RoomTypeServiceInterface
+	HotelService    hotel_service.HotelServiceInterface
+}
+
+func NewOverbookingService(overbookingRepo overbooking_repo.OverbookingRepoInterface, roomService room_service.RoomServiceInterface, roomTypeService room_type_service.RoomTypeServiceInterface, hotelService hotel_service.HotelServiceInterface) *OverbookingService {
+	return &OverbookingService{
+		OverbookingRepo: overbookingRepo,
+		RoomService:     roomService,
+		RoomTypeService: roomTypeService,
+		HotelService:    hotelService,
+	}
+}
+
+// SetLimit sets how far past its physical rooms a room type of the hotel may
+// be sold. Bookings already made are not affected.
+func (s *OverbookingService) SetLimit(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.OverbookingLimitPayload) (*models.OverbookingLimits, error) {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrOverbookingAccessDenied
+	}
+	if _, err := s.RoomTypeService.GetRoomType(hotelID, payload.RoomType); err != nil {
+		return nil, err
+	}
+
+	return s.OverbookingRepo.UpsertLimit(&models.OverbookingLimits{
+		Id:           uuid.New(),
+		HotelId:      hotelID,
+		RoomType:     payload.RoomType,
+		LimitPercent: payload.LimitPercent,
+		UpdatedBy:    userCtx.Id,
+		UpdatedAt:    time.Now(),
+	})
+}
+
+func (s *OverbookingService) GetLimits(hotelID uuid.UUID) ([]*models.OverbookingLimits, error) {
+	return s.OverbookingRepo.GetLimitsByHotelId(hotelID)
+}
+
+// OversoldRooms works out, before the rooms are taken from inventory, which
+// room types of a booking would be sold past physical inventory. It returns an
+// audit record for each, to be saved with RecordOversoldBooking once the
+// booking exists.
+func (s *OverbookingService) OversoldRooms(hotelID uuid.UUID, rooms []*payloads.RoomPayload, checkIn, checkOut time.Time) ([]*models.OverbookingAudits, error) {
+	audits := make([]*models.OverbookingAudits, 0)
+	for _, requested := range payloads.RoomsByType(rooms) {
+		oversold, err := s.RoomService.GetOversoldQuantity(requested, hotelID, checkIn, checkOut)
+		if err != nil {
+			return nil, err
+		}
+		if oversold == 0 {
+			continue
+		}
+
+		physical, err := s.RoomService.GetPhysicalQuantity(hotelID, requested.RoomType)
+		if err != nil {
+			return nil, err
+		}
+		limitPercent, err := s.OverbookingRepo.GetLimit(hotelID, requested.RoomType)
+		if err != nil {
+			return nil, err
+		}
+		audits = append(audits, &models.OverbookingAudits{
+			HotelId:          hotelID,
+			RoomType:         requested.RoomType,
+			RoomQuantity:     requested.Quantity,
+			OversoldQuantity: oversold,
+			PhysicalQuantity: physical,
+			LimitPercent:     limitPercent,
+		})
+	}
+	return audits, nil
+}
+
+// RecordOversoldBooking saves the audit records OversoldRooms returned for a booking.
+func (s *OverbookingService) RecordOversoldBooking(bookingID uuid.UUID, audits []*models.OverbookingAudits) error {
+	for _, audit := range audits {
+		audit.Id = uuid.New()
+		audit.BookingId = bookingID
+		audit.CreatedAt = time.Now()
+		if err := s.OverbookingRepo.CreateAudit(audit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAudits returns the hotel's oversold bookings, newest first.
+func (s *OverbookingService) GetAudits(hotelID uuid.UUID) ([]*models.OverbookingAudits, error) {
+	return s.OverbookingRepo.GetAuditsByHotelId(hotelID)
+}
+
+// GetWalkList returns the nights, by date and room type, on which more rooms
+// are booked than the hotel physically has. Without dates it covers the
+// hotel's next seven nights starting tonight.
+func (s *OverbookingService) GetWalkList(hotelID uuid.UUID, payload *payloads.WalkListPayload) ([]*models.WalkListNights, error) {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := payload.From.Time, payload.To.Time
+	if from.IsZero() {
+		from = hotel.Today(time.Now())
+	}
+	if to.IsZero() {
+		to = from.AddDate(0, 0, defaultWalkListNights)
+	}
+
+	stays, err := s.OverbookingRepo.GetBookedStays(hotelID, hotel.StartOfDay(from), hotel.StartOfDay(to))
+	if err != nil {
+		return nil, err
+	}
+
+	type nightKey struct {
+		date     time.Time
+		roomType room.RoomType
+	}
+	nights := make(map[nightKey]*models.WalkListNights)
+	loc := hotel.Location()
+	for _, stay := range stays {
+		first := payloads.NewDate(stay.CheckIn.In(loc)).Time
+		departure := payloads.NewDate(stay.CheckOut.In(loc)).Time
+		for date := maxTime(first, from); date.Before(departure) && date.Before(to); date = date.AddDate(0, 0, 1) {
+			key := nightKey{date, stay.RoomType}
+			night, ok := nights[key]
+			if !ok {
+				night = &models.WalkListNights{Date: date, RoomType: stay.RoomType, Bookings: make([]*models.BookedStays, 0)}
+				nights[key] = night
+			}
+			night.BookedQuantity += stay.Quantity
+			night.Bookings = append(night.Bookings, stay)
+		}
+	}
+
+	physical := make(map[room.RoomType]int)
+	walkList := make([]*models.WalkListNights, 0)
+	for key, night := range nights {
+		total, ok := physical[key.roomType]
+		if !ok {
+			total, err = s.RoomService.GetPhysicalQuantity(hotelID, key.roomType)
+			if err != nil {
+				return nil, err
+			}
+			physical[key.roomType] = total
+		}
+		if night.BookedQuantity <= total {
+			continue
+		}
+		night.PhysicalQuantity = total
+		night.OversoldQuantity = night.BookedQuantity - total
+		walkList = append(walkList, night)
+	}
+
+	sort.Slice(walkList, func(i, j int) bool {
+		if !walkList[i].Date.Equal(walkList[j].Date) {
+			return walkList[i].Date.Before(walkList[j].Date)
+		}
+		return walkList[i].RoomType < walkList[j].RoomType
+	})
+	return walkList, nil
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
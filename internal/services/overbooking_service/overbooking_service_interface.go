@@ -0,0 +1,20 @@
+package overbooking_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=overbooking_service_interface.go -destination=../../mocks/mock_overbooking_service.go -package=mocks
+
+type OverbookingServiceInterface interface {
+	SetLimit(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.OverbookingLimitPayload) (*models.OverbookingLimits, error)
+	GetLimits(hotelID uuid.UUID) ([]*models.OverbookingLimits, error)
+	OversoldRooms(hotelID uuid.UUID, rooms []*payloads.RoomPayload, checkIn, checkOut time.Time) ([]*models.OverbookingAudits, error)
+	RecordOversoldBooking(bookingID uuid.UUID, audits []*models.OverbookingAudits) error
+	GetAudits(hotelID uuid.UUID) ([]*models.OverbookingAudits, error)
+	GetWalkList(hotelID uuid.UUID, payload *payloads.WalkListPayload) ([]*models.WalkListNights, error)
+}
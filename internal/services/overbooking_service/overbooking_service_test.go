@@ -0,0 +1,203 @@
+package overbooking_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/overbooking_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+type serviceMocks struct {
+	overbookingRepo *mocks.MockOverbookingRepoInterface
+	roomService     *mocks.MockRoomServiceInterface
+	roomTypeService *mocks.MockRoomTypeServiceInterface
+	hotelService    *mocks.MockHotelServiceInterface
+}
+
+func newService(ctrl *gomock.Controller) (*overbooking_service.OverbookingService, serviceMocks) {
+	m := serviceMocks{
+		overbookingRepo: mocks.NewMockOverbookingRepoInterface(ctrl),
+		roomService:     mocks.NewMockRoomServiceInterface(ctrl),
+		roomTypeService: mocks.NewMockRoomTypeServiceInterface(ctrl),
+		hotelService:    mocks.NewMockHotelServiceInterface(ctrl),
+	}
+	return overbooking_service.NewOverbookingService(m.overbookingRepo, m.roomService, m.roomTypeService, m.hotelService), m
+}
+
+func newHotel(managerID uuid.UUID) *models.Hotels {
+	return &models.Hotels{Id: uuid.New(), ManagerId: managerID, Name: "Sea View", TimeZone: "Asia/Kolkata", CheckInTime: "14:00", CheckOutTime: "11:00"}
+}
+
+func TestOverbookingService_SetLimit(t *testing.T) {
+	manager := &models.UserContext{Id: uuid.New()}
+	hotel := newHotel(manager.Id)
+	payload := &payloads.OverbookingLimitPayload{RoomType: room.Double, LimitPercent: 10}
+
+	tests := []struct {
+		name      string
+		userCtx   *models.UserContext
+		mockSetup func(m serviceMocks)
+		wantErr   error
+	}{
+		{
+			name:    "another hotel's manager",
+			userCtx: &models.UserContext{Id: uuid.New()},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+			},
+			wantErr: overbooking_service.ErrOverbookingAccessDenied,
+		},
+		{
+			name:    "room type the hotel does not have",
+			userCtx: manager,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomTypeService.EXPECT().GetRoomType(hotel.Id, room.Double).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantErr: room_type_repo.ErrRoomTypeNotFound,
+		},
+		{
+			name:    "limit set",
+			userCtx: manager,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomTypeService.EXPECT().GetRoomType(hotel.Id, room.Double).Return(&models.RoomTypes{Code: room.Double}, nil)
+				m.overbookingRepo.EXPECT().UpsertLimit(gomock.Any()).DoAndReturn(func(limit *models.OverbookingLimits) (*models.OverbookingLimits, error) {
+					if limit.HotelId != hotel.Id || limit.LimitPercent != 10 || limit.UpdatedBy != manager.Id {
+						t.Errorf("unexpected limit %+v", limit)
+					}
+					return limit, nil
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, m := newService(ctrl)
+			tt.mockSetup(m)
+
+			_, err := service.SetLimit(tt.userCtx, hotel.Id, payload)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOverbookingService_OversoldRooms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, m := newService(ctrl)
+
+	hotelID := uuid.New()
+	checkIn, checkOut := time.Now().AddDate(0, 0, 1), time.Now().AddDate(0, 0, 3)
+	rooms := []*payloads.RoomPayload{
+		{RoomType: room.Double, Quantity: 2, Adults: 2},
+		{RoomType: room.Single, Quantity: 1},
+		{RoomType: room.Double, Quantity: 1, Adults: 1},
+	}
+
+	// the two double room lines are checked as one request for three rooms
+	m.roomService.EXPECT().GetOversoldQuantity(&payloads.RoomPayload{RoomType: room.Double, Quantity: 3}, hotelID, checkIn, checkOut).Return(2, nil)
+	m.roomService.EXPECT().GetOversoldQuantity(&payloads.RoomPayload{RoomType: room.Single, Quantity: 1}, hotelID, checkIn, checkOut).Return(0, nil)
+	m.roomService.EXPECT().GetPhysicalQuantity(hotelID, room.Double).Return(20, nil)
+	m.overbookingRepo.EXPECT().GetLimit(hotelID, room.Double).Return(10, nil)
+
+	audits, err := service.OversoldRooms(hotelID, rooms, checkIn, checkOut)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(audits) != 1 {
+		t.Fatalf("expected one oversold room type, got %d", len(audits))
+	}
+	want := models.OverbookingAudits{HotelId: hotelID, RoomType: room.Double, RoomQuantity: 3, OversoldQuantity: 2, PhysicalQuantity: 20, LimitPercent: 10}
+	if *audits[0] != want {
+		t.Errorf("expected %+v, got %+v", want, *audits[0])
+	}
+}
+
+func TestOverbookingService_RecordOversoldBooking(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, m := newService(ctrl)
+
+	bookingID := uuid.New()
+	audits := []*models.OverbookingAudits{{RoomType: room.Double, OversoldQuantity: 1}, {RoomType: room.Suite, OversoldQuantity: 1}}
+	m.overbookingRepo.EXPECT().CreateAudit(gomock.Any()).DoAndReturn(func(audit *models.OverbookingAudits) error {
+		if audit.BookingId != bookingID || audit.Id == uuid.Nil || audit.CreatedAt.IsZero() {
+			t.Errorf("unexpected audit %+v", audit)
+		}
+		return nil
+	}).Times(2)
+
+	if err := service.RecordOversoldBooking(bookingID, audits); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestOverbookingService_GetWalkList(t *testing.T) {
+	hotel := newHotel(uuid.New())
+	day := func(d int) time.Time { return time.Date(2030, 5, d, 0, 0, 0, 0, time.UTC) }
+	stay := func(roomType room.RoomType, quantity, from, to int) *models.BookedStays {
+		return &models.BookedStays{BookingId: uuid.New(), RoomType: roomType, Quantity: quantity,
+			CheckIn: hotel.CheckInAt(day(from)), CheckOut: hotel.CheckOutAt(day(to))}
+	}
+	later := stay(room.Double, 1, 2, 4)
+	earlier := stay(room.Double, 2, 1, 3)
+	// arrived on 29 April, before the walk list starts
+	suite := stay(room.Suite, 1, -1, 2)
+	stays := []*models.BookedStays{later, earlier, suite}
+
+	t.Run("nights booked past physical inventory", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newService(ctrl)
+
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		m.overbookingRepo.EXPECT().GetBookedStays(hotel.Id, hotel.StartOfDay(day(1)), hotel.StartOfDay(day(4))).Return(stays, nil)
+		m.roomService.EXPECT().GetPhysicalQuantity(hotel.Id, room.Double).Return(2, nil)
+		m.roomService.EXPECT().GetPhysicalQuantity(hotel.Id, room.Suite).Return(1, nil)
+
+		walkList, err := service.GetWalkList(hotel.Id, &payloads.WalkListPayload{From: payloads.NewDate(day(1)), To: payloads.NewDate(day(4))})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// only the night of the 2nd has both double bookings in house
+		if len(walkList) != 1 {
+			t.Fatalf("expected one oversold night, got %+v", walkList)
+		}
+		night := walkList[0]
+		if !night.Date.Equal(day(2)) || night.RoomType != room.Double || night.BookedQuantity != 3 || night.OversoldQuantity != 1 || night.PhysicalQuantity != 2 {
+			t.Errorf("unexpected night %+v", night)
+		}
+		if len(night.Bookings) != 2 || night.Bookings[0] != later || night.Bookings[1] != earlier {
+			t.Errorf("expected the latest booking first, got %+v", night.Bookings)
+		}
+	})
+
+	t.Run("defaults to the next seven nights", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newService(ctrl)
+
+		today := hotel.Today(time.Now())
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		m.overbookingRepo.EXPECT().GetBookedStays(hotel.Id, hotel.StartOfDay(today), hotel.StartOfDay(today.AddDate(0, 0, 7))).Return(nil, nil)
+
+		walkList, err := service.GetWalkList(hotel.Id, &payloads.WalkListPayload{})
+		if err != nil || len(walkList) != 0 {
+			t.Errorf("expected an empty walk list, got %+v, %v", walkList, err)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package promo_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/promo_code_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var (
+	ErrInvalidPromoCode     = errors.New("promo code cannot be applied")
+	ErrPromoCodeUnavailable = errors.New("promo code is no longer available")
+	ErrPromoAccessDenied    = errors.New("you are not allowed to manage this hotel's promo codes")
+)
+
+type PromoService struct {
+	PromoRepo    promo_code_repo.PromoCodeRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewPromoService(promoRepo promo_code_repo.PromoCodeRepoInterface, hotelService hotel_service.HotelServiceInterface) *PromoService {
+	return &PromoService{
+		PromoRepo:    promoRepo,
+		HotelService: hotelService,
+	}
+}
+
+func (p *PromoService) CreatePromoCode(userCtx *models.UserContext, payload *payloads.CreatePromoCodePayload) (*models.PromoCodes, error) {
+	hotel, err := p.HotelService.GetHotelByID(payload.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrPromoAccessDenied
+	}
+
+	promoCode := &models.PromoCodes{
+		Id:           uuid.New(),
+		Code:         payload.Code,
+		HotelId:      &payload.HotelId,
+		DiscountType: payload.DiscountType,
+		ValidFrom:    payload.ValidFrom,
+		ValidTo:      payload.ValidTo,
+		MaxUses:      payload.MaxUses,
+		PerUserLimit: payload.PerUserLimit,
+		CreatedBy:    userCtx.Id,
+		CreatedAt:    time.Now(),
+	}
+	if payload.DiscountType == promo.Percentage {
+		promoCode.Value = int64(payload.Value)
+	} else {
+		promoCode.Value = currency.ToMinorUnits(payload.Value, hotel.Currency)
+	}
+	return p.PromoRepo.CreatePromoCode(promoCode)
+}
+
+// GetPromoCodesByHotelID lists a hotel's codes to its manager only, since a code
+// is as good as a discount to whoever learns it.
+func (p *PromoService) GetPromoCodesByHotelID(userCtx *models.UserContext, hotelID uuid.UUID) ([]*models.PromoCodes, error) {
+	hotel, err := p.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrPromoAccessDenied
+	}
+	return p.PromoRepo.GetPromoCodesByHotelID(hotelID)
+}
+
+// ApplyPromoCode checks that the code can be used at the hotel right now, counts
+// the use against its caps and returns the discount line for the booking. The use
+// stays counted unless ReleasePromoCode is called for the booking.
+func (p *PromoService) ApplyPromoCode(userId, bookingId, hotelId uuid.UUID, code string, subtotal int64) (*models.BookingDiscounts, error) {
+	promoCode, err := p.PromoRepo.GetPromoCodeByCode(code)
+	if err != nil {
+		if errors.Is(err, promo_code_repo.ErrPromoCodeNotFound) {
+			return nil, fmt.Errorf("%w: %s does not exist", ErrInvalidPromoCode, code)
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if promoCode.HotelId != nil && *promoCode.HotelId != hotelId {
+		return nil, fmt.Errorf("%w: %s is not valid at this hotel", ErrInvalidPromoCode, code)
+	}
+	// a fixed amount is in its hotel's currency, so older codes without a hotel have none
+	if promoCode.HotelId == nil && promoCode.DiscountType == promo.Fixed {
+		return nil, fmt.Errorf("%w: %s has no currency", ErrInvalidPromoCode, code)
+	}
+	if now.Before(promoCode.ValidFrom) || now.After(promoCode.ValidTo) {
+		return nil, fmt.Errorf("%w: %s is only valid from %s to %s", ErrInvalidPromoCode, code,
+			promoCode.ValidFrom.Format(time.DateOnly), promoCode.ValidTo.Format(time.DateOnly))
+	}
+
+	if err := p.PromoRepo.RedeemPromoCode(promoCode.Id, userId, bookingId); err != nil {
+		if errors.Is(err, promo_code_repo.ErrPromoCodeExhausted) {
+			return nil, fmt.Errorf("%w: %s has reached its usage limit", ErrPromoCodeUnavailable, code)
+		}
+		return nil, err
+	}
+
+	return &models.BookingDiscounts{
+		Id:          uuid.New(),
+		BookingId:   bookingId,
+		PromoCodeId: &promoCode.Id,
+		Description: describe(promoCode),
+		Amount:      discountAmount(promoCode, subtotal),
+		CreatedAt:   now,
+	}, nil
+}
+
+func (p *PromoService) ReleasePromoCode(bookingId uuid.UUID) error {
+	return p.PromoRepo.ReleaseRedemption(bookingId)
+}
+
+// discountAmount never discounts more than the subtotal; percentages round down.
+func discountAmount(promoCode *models.PromoCodes, subtotal int64) int64 {
+	if promoCode.DiscountType == promo.Percentage {
+		return subtotal * promoCode.Value / 100
+	}
+	return min(promoCode.Value, subtotal)
+}
+
+func describe(promoCode *models.PromoCodes) string {
+	if promoCode.DiscountType == promo.Percentage {
+		return fmt.Sprintf("Promo %s (%d%% off)", promoCode.Code, promoCode.Value)
+	}
+	return fmt.Sprintf("Promo %s", promoCode.Code)
+}
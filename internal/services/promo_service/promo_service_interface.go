@@ -0,0 +1,16 @@
+package promo_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=promo_service_interface.go -destination=../../mocks/mock_promo_service.go -package=mocks
+
+type PromoServiceInterface interface {
+	CreatePromoCode(*models.UserContext, *payloads.CreatePromoCodePayload) (*models.PromoCodes, error)
+	GetPromoCodesByHotelID(userCtx *models.UserContext, hotelID uuid.UUID) ([]*models.PromoCodes, error)
+	ApplyPromoCode(userId, bookingId, hotelId uuid.UUID, code string, subtotal int64) (*models.BookingDiscounts, error)
+	ReleasePromoCode(bookingId uuid.UUID) error
+}
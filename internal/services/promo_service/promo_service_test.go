@@ -0,0 +1,193 @@
+package promo_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/promo_code_repo"
+	"github.com/tktanisha/booking_system/internal/services/promo_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestPromoService_CreatePromoCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockPromoCodeRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := promo_service.NewPromoService(mockRepo, mockHotelService)
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name      string
+		currency  string
+		payload   *payloads.CreatePromoCodePayload
+		wantValue int64
+	}{
+		{"percentage kept as whole percent", "JPY", &payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Percentage, Value: 10}, 10},
+		{"fixed converted to minor units", "USD", &payloads.CreatePromoCodePayload{Code: "WELCOME", HotelId: hotelID, DiscountType: promo.Fixed, Value: 25.50}, 2550},
+		{"fixed in a zero-decimal currency", "JPY", &payloads.CreatePromoCodePayload{Code: "WELCOME", HotelId: hotelID, DiscountType: promo.Fixed, Value: 1500}, 1500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id, Currency: tt.currency}, nil)
+			mockRepo.EXPECT().CreatePromoCode(gomock.Any()).DoAndReturn(
+				func(p *models.PromoCodes) (*models.PromoCodes, error) {
+					return p, nil
+				})
+
+			promoCode, err := svc.CreatePromoCode(userCtx, tt.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if promoCode.Value != tt.wantValue || promoCode.CreatedBy != userCtx.Id || *promoCode.HotelId != hotelID {
+				t.Errorf("promo code not built from payload: %+v", promoCode)
+			}
+		})
+	}
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+
+		_, err := svc.CreatePromoCode(userCtx, &payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Percentage, Value: 10})
+		if !errors.Is(err, promo_service.ErrPromoAccessDenied) {
+			t.Errorf("expected access denied, got %v", err)
+		}
+	})
+}
+
+func TestPromoService_GetPromoCodesByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockPromoCodeRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := promo_service.NewPromoService(mockRepo, mockHotelService)
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+
+	t.Run("hotel's manager", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id}, nil)
+		mockRepo.EXPECT().GetPromoCodesByHotelID(hotelID).Return([]*models.PromoCodes{{Code: "SUMMER10"}}, nil)
+
+		promoCodes, err := svc.GetPromoCodesByHotelID(userCtx, hotelID)
+		if err != nil || len(promoCodes) != 1 {
+			t.Errorf("expected the hotel's codes, got %v, %v", promoCodes, err)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+
+		_, err := svc.GetPromoCodesByHotelID(userCtx, hotelID)
+		if !errors.Is(err, promo_service.ErrPromoAccessDenied) {
+			t.Errorf("expected access denied, got %v", err)
+		}
+	})
+}
+
+func TestPromoService_ApplyPromoCode(t *testing.T) {
+	hotelID := uuid.New()
+	otherHotel := uuid.New()
+	userID := uuid.New()
+	bookingID := uuid.New()
+	now := time.Now()
+
+	active := func(p models.PromoCodes) *models.PromoCodes {
+		p.Id = uuid.New()
+		p.ValidFrom = now.Add(-time.Hour)
+		p.ValidTo = now.Add(time.Hour)
+		return &p
+	}
+
+	tests := []struct {
+		name       string
+		promoCode  *models.PromoCodes
+		lookupErr  error
+		redeem     bool
+		redeemErr  error
+		subtotal   int64
+		wantAmount int64
+		wantErr    error
+	}{
+		{
+			name:       "percentage off the subtotal",
+			promoCode:  active(models.PromoCodes{Code: "SUMMER10", HotelId: &hotelID, DiscountType: promo.Percentage, Value: 10}),
+			redeem:     true,
+			subtotal:   25050,
+			wantAmount: 2505,
+		},
+		{
+			name:       "fixed amount capped at the subtotal",
+			promoCode:  active(models.PromoCodes{Code: "WELCOME", HotelId: &hotelID, DiscountType: promo.Fixed, Value: 50000}),
+			redeem:     true,
+			subtotal:   20000,
+			wantAmount: 20000,
+		},
+		{
+			name:      "fixed amount without a hotel",
+			promoCode: active(models.PromoCodes{Code: "WELCOME", DiscountType: promo.Fixed, Value: 500}),
+			wantErr:   promo_service.ErrInvalidPromoCode,
+		},
+		{
+			name:      "unknown code",
+			lookupErr: promo_code_repo.ErrPromoCodeNotFound,
+			wantErr:   promo_service.ErrInvalidPromoCode,
+		},
+		{
+			name:      "code for another hotel",
+			promoCode: active(models.PromoCodes{Code: "SUMMER10", HotelId: &otherHotel, DiscountType: promo.Percentage, Value: 10}),
+			wantErr:   promo_service.ErrInvalidPromoCode,
+		},
+		{
+			name: "expired code",
+			promoCode: &models.PromoCodes{Code: "SPRING", HotelId: &hotelID, DiscountType: promo.Fixed, Value: 1000,
+				ValidFrom: now.Add(-48 * time.Hour), ValidTo: now.Add(-24 * time.Hour)},
+			wantErr: promo_service.ErrInvalidPromoCode,
+		},
+		{
+			name:      "usage cap reached",
+			promoCode: active(models.PromoCodes{Code: "SUMMER10", DiscountType: promo.Percentage, Value: 10}),
+			redeem:    true,
+			redeemErr: promo_code_repo.ErrPromoCodeExhausted,
+			wantErr:   promo_service.ErrPromoCodeUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockPromoCodeRepoInterface(ctrl)
+			svc := promo_service.NewPromoService(mockRepo, mocks.NewMockHotelServiceInterface(ctrl))
+
+			mockRepo.EXPECT().GetPromoCodeByCode("SUMMER10").Return(tt.promoCode, tt.lookupErr)
+			if tt.redeem {
+				mockRepo.EXPECT().RedeemPromoCode(tt.promoCode.Id, userID, bookingID).Return(tt.redeemErr)
+			}
+
+			discount, err := svc.ApplyPromoCode(userID, bookingID, hotelID, "SUMMER10", tt.subtotal)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if discount.Amount != tt.wantAmount {
+				t.Errorf("expected discount %d, got %d", tt.wantAmount, discount.Amount)
+			}
+			if discount.BookingId != bookingID || discount.PromoCodeId == nil || *discount.PromoCodeId != tt.promoCode.Id {
+				t.Errorf("discount not linked to booking and promo code: %+v", discount)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package report_service
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/report_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var ErrReportAccessDenied = errors.New("you are not allowed to view this hotel's reports")
+
+type ReportService struct {
+	ReportRepo   report_repo.ReportRepoInterface
+	RoomService  room_service.RoomServiceInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewReportService(reportRepo report_repo.ReportRepoInterface, roomService room_service.RoomServiceInterface, hotelService hotel_service.HotelServiceInterface) *ReportService {
+	return &ReportService{
+		ReportRepo:   reportRepo,
+		RoomService:  roomService,
+		HotelService: hotelService,
+	}
+}
+
+// GetReport works out the hotel's occupancy, ADR, RevPAR, cancellations and
+// pickup for the nights of the payload, per period and room type. Rooms
+// available are the hotel's current rooms of each type on every night, so
+// rooms added or taken away since are not reflected in past nights.
+func (s *ReportService) GetReport(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.ReportPayload) (*models.Reports, error) {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrReportAccessDenied
+	}
+
+	hotelRooms, err := s.RoomService.GetAllRoomByHotelID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	physical := make(map[room.RoomType]int)
+	for _, hotelRoom := range hotelRooms {
+		total, err := s.RoomService.GetPhysicalQuantity(hotelID, hotelRoom.RoomCategory)
+		if err != nil {
+			return nil, err
+		}
+		physical[hotelRoom.RoomCategory] = total
+	}
+
+	timeZone := hotel.Location().String()
+	from, to := hotel.StartOfDay(payload.From.Time), hotel.StartOfDay(payload.To.Time)
+	stayNights, err := s.ReportRepo.GetStayNights(hotelID, timeZone, from, to, payload.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	pickup, err := s.ReportRepo.GetPickup(hotelID, timeZone, from, to, payload.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.Reports{
+		HotelId:  hotelID,
+		From:     payload.From.Time,
+		To:       payload.To.Time,
+		GroupBy:  payload.GroupBy,
+		Currency: hotel.Currency,
+		Totals:   &models.ReportMetrics{},
+		Periods:  make([]*models.ReportPeriods, 0),
+	}
+
+	periods := make(map[time.Time]map[room.RoomType]*models.ReportMetrics)
+	for start := periodStart(payload.From.Time, payload.GroupBy); start.Before(payload.To.Time); start = nextPeriod(start, payload.GroupBy) {
+		nights := nightsBetween(maxTime(start, payload.From.Time), minTime(nextPeriod(start, payload.GroupBy), payload.To.Time))
+		byType := make(map[room.RoomType]*models.ReportMetrics)
+		for roomType, total := range physical {
+			byType[roomType] = &models.ReportMetrics{RoomType: roomType, RoomNightsAvailable: total * nights}
+		}
+		periods[start] = byType
+		result.Periods = append(result.Periods, &models.ReportPeriods{Start: start, Nights: nights})
+	}
+
+	for _, n := range append(stayNights, pickup...) {
+		// the driver may scan dates in another location, so they are keyed as UTC dates
+		byType, ok := periods[payloads.NewDate(n.Period).Time]
+		if !ok {
+			continue
+		}
+		metrics, ok := byType[n.RoomType]
+		if !ok {
+			// a room type the hotel no longer has still shows what it sold
+			metrics = &models.ReportMetrics{RoomType: n.RoomType}
+			byType[n.RoomType] = metrics
+		}
+		metrics.RoomNightsSold += n.RoomNightsSold
+		metrics.RoomRevenue += n.RoomRevenue
+		metrics.CancelledRoomNights += n.CancelledRoomNights
+		metrics.PickupRoomNights += n.PickupRoomNights
+	}
+
+	for _, period := range result.Periods {
+		period.Totals = &models.ReportMetrics{}
+		period.RoomTypes = make([]*models.ReportMetrics, 0, len(periods[period.Start]))
+		for _, metrics := range periods[period.Start] {
+			finishMetrics(metrics)
+			period.RoomTypes = append(period.RoomTypes, metrics)
+			addMetrics(period.Totals, metrics)
+		}
+		sort.Slice(period.RoomTypes, func(i, j int) bool {
+			return period.RoomTypes[i].RoomType < period.RoomTypes[j].RoomType
+		})
+		finishMetrics(period.Totals)
+		addMetrics(result.Totals, period.Totals)
+	}
+	finishMetrics(result.Totals)
+
+	return result, nil
+}
+
+func addMetrics(total, m *models.ReportMetrics) {
+	total.RoomNightsAvailable += m.RoomNightsAvailable
+	total.RoomNightsSold += m.RoomNightsSold
+	total.RoomRevenue += m.RoomRevenue
+	total.CancelledRoomNights += m.CancelledRoomNights
+	total.PickupRoomNights += m.PickupRoomNights
+}
+
+// finishMetrics works out the ratios of m from its counts. Occupancy is
+// rounded to two decimals and ADR and RevPAR to the nearest minor unit.
+func finishMetrics(m *models.ReportMetrics) {
+	m.OccupancyPercent, m.ADR, m.RevPAR = 0, 0, 0
+	if m.RoomNightsAvailable > 0 {
+		m.OccupancyPercent = math.Round(float64(m.RoomNightsSold)*10000/float64(m.RoomNightsAvailable)) / 100
+		m.RevPAR = int64(math.Round(float64(m.RoomRevenue) / float64(m.RoomNightsAvailable)))
+	}
+	if m.RoomNightsSold > 0 {
+		m.ADR = int64(math.Round(float64(m.RoomRevenue) / float64(m.RoomNightsSold)))
+	}
+}
+
+// periodStart returns the first day of the period date falls in, the way
+// Postgres' date_trunc does, so weeks start on Monday.
+func periodStart(date time.Time, groupBy report.Grouping) time.Time {
+	switch groupBy {
+	case report.GroupByWeek:
+		return date.AddDate(0, 0, -((int(date.Weekday()) + 6) % 7))
+	case report.GroupByMonth:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return date
+	}
+}
+
+func nextPeriod(start time.Time, groupBy report.Grouping) time.Time {
+	switch groupBy {
+	case report.GroupByWeek:
+		return start.AddDate(0, 0, 7)
+	case report.GroupByMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+func nightsBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,13 @@
+package report_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=report_service_interface.go -destination=../../mocks/mock_report_service.go -package=mocks
+
+type ReportServiceInterface interface {
+	GetReport(userCtx *models.UserContext, hotelID uuid.UUID, payload *payloads.ReportPayload) (*models.Reports, error)
+}
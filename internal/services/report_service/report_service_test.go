@@ -0,0 +1,116 @@
+package report_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/report_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+type serviceMocks struct {
+	reportRepo   *mocks.MockReportRepoInterface
+	roomService  *mocks.MockRoomServiceInterface
+	hotelService *mocks.MockHotelServiceInterface
+}
+
+func newService(ctrl *gomock.Controller) (*report_service.ReportService, serviceMocks) {
+	m := serviceMocks{
+		reportRepo:   mocks.NewMockReportRepoInterface(ctrl),
+		roomService:  mocks.NewMockRoomServiceInterface(ctrl),
+		hotelService: mocks.NewMockHotelServiceInterface(ctrl),
+	}
+	return report_service.NewReportService(m.reportRepo, m.roomService, m.hotelService), m
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestReportService_GetReport(t *testing.T) {
+	manager := &models.UserContext{Id: uuid.New()}
+	hotel := &models.Hotels{Id: uuid.New(), ManagerId: manager.Id, Currency: "EUR", TimeZone: "Asia/Kolkata", CheckInTime: "14:00", CheckOutTime: "11:00"}
+	// Wednesday 1 May to Wednesday 15 May, split into the weeks of 29 April, 6 May and 13 May
+	payload := &payloads.ReportPayload{From: payloads.NewDate(date(2030, 5, 1)), To: payloads.NewDate(date(2030, 5, 15)), GroupBy: report.GroupByWeek}
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newService(ctrl)
+
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+
+		_, err := service.GetReport(&models.UserContext{Id: uuid.New()}, hotel.Id, payload)
+		if !errors.Is(err, report_service.ErrReportAccessDenied) {
+			t.Errorf("expected ErrReportAccessDenied, got %v", err)
+		}
+	})
+
+	t.Run("figures per week and room type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newService(ctrl)
+
+		from, to := hotel.StartOfDay(payload.From.Time), hotel.StartOfDay(payload.To.Time)
+		m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+		m.roomService.EXPECT().GetAllRoomByHotelID(hotel.Id).Return([]*models.Rooms{
+			{RoomCategory: room.Double},
+			{RoomCategory: room.Suite},
+		}, nil)
+		m.roomService.EXPECT().GetPhysicalQuantity(hotel.Id, room.Double).Return(10, nil)
+		m.roomService.EXPECT().GetPhysicalQuantity(hotel.Id, room.Suite).Return(2, nil)
+		m.reportRepo.EXPECT().GetStayNights(hotel.Id, "Asia/Kolkata", from, to, report.GroupByWeek).Return([]*models.ReportNights{
+			{Period: date(2030, 4, 29), RoomType: room.Double, RoomNightsSold: 20, RoomRevenue: 200000, CancelledRoomNights: 3},
+			{Period: date(2030, 5, 6), RoomType: room.Double, RoomNightsSold: 35, RoomRevenue: 350000},
+			{Period: date(2030, 5, 6), RoomType: room.Suite, RoomNightsSold: 7, RoomRevenue: 210000},
+			{Period: date(2030, 5, 13), RoomType: "villa", RoomNightsSold: 1, RoomRevenue: 5000},
+		}, nil)
+		m.reportRepo.EXPECT().GetPickup(hotel.Id, "Asia/Kolkata", from, to, report.GroupByWeek).Return([]*models.ReportNights{
+			{Period: date(2030, 5, 6), RoomType: room.Double, PickupRoomNights: 12},
+		}, nil)
+
+		result, err := service.GetReport(manager, hotel.Id, payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Currency != "EUR" || len(result.Periods) != 3 {
+			t.Fatalf("unexpected report %+v", result)
+		}
+
+		first := result.Periods[0]
+		if !first.Start.Equal(date(2030, 4, 29)) || first.Nights != 5 {
+			t.Errorf("expected the first week to start on 29 April with 5 nights in range, got %v and %d", first.Start, first.Nights)
+		}
+		// 20 of 10 doubles x 5 nights sold at 10000 a night
+		double := first.RoomTypes[0]
+		wantDouble := models.ReportMetrics{RoomType: room.Double, RoomNightsAvailable: 50, RoomNightsSold: 20, OccupancyPercent: 40,
+			RoomRevenue: 200000, ADR: 10000, RevPAR: 4000, CancelledRoomNights: 3}
+		if *double != wantDouble {
+			t.Errorf("expected %+v, got %+v", wantDouble, *double)
+		}
+		wantFirstTotals := models.ReportMetrics{RoomNightsAvailable: 60, RoomNightsSold: 20, OccupancyPercent: 33.33,
+			RoomRevenue: 200000, ADR: 10000, RevPAR: 3333, CancelledRoomNights: 3}
+		if *first.Totals != wantFirstTotals {
+			t.Errorf("expected %+v, got %+v", wantFirstTotals, *first.Totals)
+		}
+
+		// a room type the hotel no longer has is listed with no rooms available
+		last := result.Periods[2]
+		if last.Nights != 2 || len(last.RoomTypes) != 3 || last.RoomTypes[2].RoomType != "villa" || last.RoomTypes[2].RoomNightsAvailable != 0 {
+			t.Errorf("unexpected last week %+v", last.RoomTypes)
+		}
+
+		wantTotals := models.ReportMetrics{RoomNightsAvailable: 168, RoomNightsSold: 63, OccupancyPercent: 37.5,
+			RoomRevenue: 765000, ADR: 12143, RevPAR: 4554, CancelledRoomNights: 3, PickupRoomNights: 12}
+		if *result.Totals != wantTotals {
+			t.Errorf("expected %+v, got %+v", wantTotals, *result.Totals)
+		}
+	})
+}
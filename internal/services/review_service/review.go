@@ -0,0 +1,96 @@
+package review_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/booking_repo"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var (
+	ErrReviewAccessDenied = errors.New("only the guest who made the booking can review it")
+	ErrStayNotVerified    = errors.New("only checked-out stays can be reviewed")
+	ErrReplyAccessDenied  = errors.New("only the hotel's manager can reply to its reviews")
+)
+
+type ReviewService struct {
+	ReviewRepo   review_repo.ReviewRepoInterface
+	BookingRepo  booking_repo.BookingRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewReviewService(reviewRepo review_repo.ReviewRepoInterface, bookingRepo booking_repo.BookingRepoInterface, hotelService hotel_service.HotelServiceInterface) *ReviewService {
+	return &ReviewService{
+		ReviewRepo:   reviewRepo,
+		BookingRepo:  bookingRepo,
+		HotelService: hotelService,
+	}
+}
+
+// CreateReview stores the review of the guest who made the booking. Only stays
+// that ended in check-out count as verified, so cancelled and no-show bookings
+// cannot be reviewed.
+func (s *ReviewService) CreateReview(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.ReviewPayload) (*models.Reviews, error) {
+	booking, err := s.BookingRepo.GetBookingById(bookingId)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.UserId != userCtx.Id {
+		return nil, ErrReviewAccessDenied
+	}
+	if booking.Status != booking_status.StatusCheckedOut {
+		return nil, ErrStayNotVerified
+	}
+
+	review := &models.Reviews{
+		Id:        uuid.New(),
+		HotelId:   booking.HotelId,
+		BookingId: booking.Id,
+		UserId:    userCtx.Id,
+		Rating:    payload.Rating,
+		Title:     payload.Title,
+		Body:      payload.Body,
+		CreatedAt: time.Now(),
+	}
+	if err := s.ReviewRepo.CreateReview(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// ReplyToReview sets the manager's reply on a review of their hotel, replacing
+// any earlier one.
+func (s *ReviewService) ReplyToReview(userCtx *models.UserContext, reviewId uuid.UUID, payload *payloads.ReviewReplyPayload) (*models.Reviews, error) {
+	review, err := s.ReviewRepo.GetReviewById(reviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	hotel, err := s.HotelService.GetHotelByID(review.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrReplyAccessDenied
+	}
+
+	now := time.Now()
+	review.Reply = payload.Reply
+	review.RepliedBy = &userCtx.Id
+	review.RepliedAt = &now
+	if err := s.ReviewRepo.SaveReply(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (s *ReviewService) GetHotelReviews(hotelId uuid.UUID) ([]*models.Reviews, error) {
+	return s.ReviewRepo.GetReviewsByHotelId(hotelId)
+}
@@ -0,0 +1,15 @@
+package review_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=review_service_interface.go -destination=../../mocks/mock_review_service.go -package=mocks
+
+type ReviewServiceInterface interface {
+	CreateReview(userCtx *models.UserContext, bookingId uuid.UUID, payload *payloads.ReviewPayload) (*models.Reviews, error)
+	ReplyToReview(userCtx *models.UserContext, reviewId uuid.UUID, payload *payloads.ReviewReplyPayload) (*models.Reviews, error)
+	GetHotelReviews(hotelId uuid.UUID) ([]*models.Reviews, error)
+}
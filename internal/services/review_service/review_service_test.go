@@ -0,0 +1,187 @@
+package review_service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	booking_status "github.com/tktanisha/booking_system/internal/enums/booking"
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/review_repo"
+	"github.com/tktanisha/booking_system/internal/services/review_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestReviewService_CreateReview(t *testing.T) {
+	guestCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	otherCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleUser}
+	bookingID := uuid.New()
+	hotelID := uuid.New()
+	payload := &payloads.ReviewPayload{Rating: 4, Title: "Great stay", Body: "Friendly staff and a quiet room"}
+
+	bookingWithStatus := func(status booking_status.BookingStatus) *models.Bookings {
+		return &models.Bookings{Id: bookingID, UserId: guestCtx.Id, HotelId: hotelID, Status: status}
+	}
+
+	tests := []struct {
+		name      string
+		userCtx   *models.UserContext
+		mockSetup func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface)
+		wantErr   error
+		expectErr bool
+	}{
+		{
+			name:    "checked-out guest reviews the stay",
+			userCtx: guestCtx,
+			mockSetup: func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface) {
+				bookingRepo.EXPECT().GetBookingById(bookingID).Return(bookingWithStatus(booking_status.StatusCheckedOut), nil)
+				reviewRepo.EXPECT().CreateReview(gomock.Any()).DoAndReturn(func(review *models.Reviews) error {
+					if review.HotelId != hotelID || review.UserId != guestCtx.Id || review.Rating != 4 {
+						t.Errorf("unexpected review %+v", review)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:    "someone else's booking",
+			userCtx: otherCtx,
+			mockSetup: func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface) {
+				bookingRepo.EXPECT().GetBookingById(bookingID).Return(bookingWithStatus(booking_status.StatusCheckedOut), nil)
+			},
+			wantErr:   review_service.ErrReviewAccessDenied,
+			expectErr: true,
+		},
+		{
+			name:    "stay not completed",
+			userCtx: guestCtx,
+			mockSetup: func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface) {
+				bookingRepo.EXPECT().GetBookingById(bookingID).Return(bookingWithStatus(booking_status.StatusCheckedIn), nil)
+			},
+			wantErr:   review_service.ErrStayNotVerified,
+			expectErr: true,
+		},
+		{
+			name:    "cancelled booking",
+			userCtx: guestCtx,
+			mockSetup: func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface) {
+				bookingRepo.EXPECT().GetBookingById(bookingID).Return(bookingWithStatus(booking_status.StatusCancelled), nil)
+			},
+			wantErr:   review_service.ErrStayNotVerified,
+			expectErr: true,
+		},
+		{
+			name:    "booking already reviewed",
+			userCtx: guestCtx,
+			mockSetup: func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface) {
+				bookingRepo.EXPECT().GetBookingById(bookingID).Return(bookingWithStatus(booking_status.StatusCheckedOut), nil)
+				reviewRepo.EXPECT().CreateReview(gomock.Any()).Return(review_repo.ErrReviewExists)
+			},
+			wantErr:   review_repo.ErrReviewExists,
+			expectErr: true,
+		},
+		{
+			name:    "booking lookup fails",
+			userCtx: guestCtx,
+			mockSetup: func(bookingRepo *mocks.MockBookingRepoInterface, reviewRepo *mocks.MockReviewRepoInterface) {
+				bookingRepo.EXPECT().GetBookingById(bookingID).Return(nil, errors.New("booking not found"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bookingRepo := mocks.NewMockBookingRepoInterface(ctrl)
+			reviewRepo := mocks.NewMockReviewRepoInterface(ctrl)
+			service := review_service.NewReviewService(reviewRepo, bookingRepo, mocks.NewMockHotelServiceInterface(ctrl))
+			tt.mockSetup(bookingRepo, reviewRepo)
+
+			_, err := service.CreateReview(tt.userCtx, bookingID, payload)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReviewService_ReplyToReview(t *testing.T) {
+	managerCtx := &models.UserContext{Id: uuid.New(), Role: user_role.RoleManager}
+	reviewID := uuid.New()
+	hotelID := uuid.New()
+	payload := &payloads.ReviewReplyPayload{Reply: "Thank you for staying with us"}
+	ownHotel := &models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}
+
+	t.Run("reply is stored with the manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := mocks.NewMockReviewRepoInterface(ctrl)
+		hotelService := mocks.NewMockHotelServiceInterface(ctrl)
+		service := review_service.NewReviewService(reviewRepo, mocks.NewMockBookingRepoInterface(ctrl), hotelService)
+		reviewRepo.EXPECT().GetReviewById(reviewID).Return(&models.Reviews{Id: reviewID, HotelId: hotelID, Rating: 5}, nil)
+		hotelService.EXPECT().GetHotelByID(hotelID).Return(ownHotel, nil)
+		reviewRepo.EXPECT().SaveReply(gomock.Any()).Return(nil)
+
+		review, err := service.ReplyToReview(managerCtx, reviewID, payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !review.HasReply() || review.Reply != payload.Reply || *review.RepliedBy != managerCtx.Id {
+			t.Errorf("expected the manager's reply, got %+v", review)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := mocks.NewMockReviewRepoInterface(ctrl)
+		hotelService := mocks.NewMockHotelServiceInterface(ctrl)
+		service := review_service.NewReviewService(reviewRepo, mocks.NewMockBookingRepoInterface(ctrl), hotelService)
+		reviewRepo.EXPECT().GetReviewById(reviewID).Return(&models.Reviews{Id: reviewID, HotelId: hotelID}, nil)
+		hotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+
+		if _, err := service.ReplyToReview(managerCtx, reviewID, payload); !errors.Is(err, review_service.ErrReplyAccessDenied) {
+			t.Errorf("expected ErrReplyAccessDenied, got %v", err)
+		}
+	})
+
+	t.Run("review not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := mocks.NewMockReviewRepoInterface(ctrl)
+		service := review_service.NewReviewService(reviewRepo, mocks.NewMockBookingRepoInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl))
+		reviewRepo.EXPECT().GetReviewById(reviewID).Return(nil, review_repo.ErrReviewNotFound)
+
+		if _, err := service.ReplyToReview(managerCtx, reviewID, payload); !errors.Is(err, review_repo.ErrReviewNotFound) {
+			t.Errorf("expected ErrReviewNotFound, got %v", err)
+		}
+	})
+
+	t.Run("save fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reviewRepo := mocks.NewMockReviewRepoInterface(ctrl)
+		hotelService := mocks.NewMockHotelServiceInterface(ctrl)
+		service := review_service.NewReviewService(reviewRepo, mocks.NewMockBookingRepoInterface(ctrl), hotelService)
+		reviewRepo.EXPECT().GetReviewById(reviewID).Return(&models.Reviews{Id: reviewID, HotelId: hotelID}, nil)
+		hotelService.EXPECT().GetHotelByID(hotelID).Return(ownHotel, nil)
+		reviewRepo.EXPECT().SaveReply(gomock.Any()).Return(errors.New("db error"))
+
+		if _, err := service.ReplyToReview(managerCtx, reviewID, payload); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
@@ -1,20 +1,22 @@
 package factory
 
 import (
-	"errors"
+	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
 )
 
-func GetRoomFactory(roomType room.RoomType) (RoomFactory, error) {
-	switch roomType {
-	case room.Single:
-		return &SingleRoomFactory{}, nil
-	case room.Double:
-		return &DoubleRoomFactory{}, nil
-	case room.Suite:
-		return &SuiteRoomFactory{}, nil
-	default:
-		return nil, errors.New("invalid room type")
+// RoomTypeRegistry resolves the room types a hotel sells.
+type RoomTypeRegistry interface {
+	GetRoomType(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error)
+}
+
+func GetRoomFactory(registry RoomTypeRegistry, hotelID uuid.UUID, roomType room.RoomType, currencyCode string) (RoomFactory, error) {
+	resolved, err := registry.GetRoomType(hotelID, roomType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid room type %q: %w", roomType, err)
 	}
+	return &RoomTypeFactory{RoomType: resolved, Currency: currencyCode}, nil
 }
@@ -1,30 +1,47 @@
 package factory_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
 	"github.com/tktanisha/booking_system/internal/services/room_service/factory"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
 func TestGetRoomFactory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	registry := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	hotelID := uuid.New()
+
 	tests := []struct {
 		name        string
 		roomType    room.RoomType
+		mockSetup   func()
 		expectError bool
 	}{
-		{"single room factory", room.Single, false},
-		{"double room factory", room.Double, false},
-		{"suite room factory", room.Suite, false},
-		{"invalid room type", "dhfkjs", true},
+		{"built-in room type", room.Single, func() {
+			registry.EXPECT().GetRoomType(hotelID, room.Single).Return(&models.RoomTypes{Code: room.Single}, nil)
+		}, false},
+		{"registered room type", "family", func() {
+			registry.EXPECT().GetRoomType(hotelID, room.RoomType("family")).Return(&models.RoomTypes{Code: "family"}, nil)
+		}, false},
+		{"unregistered room type", "dhfkjs", func() {
+			registry.EXPECT().GetRoomType(hotelID, room.RoomType("dhfkjs")).Return(nil, errors.New("room type not found"))
+		}, true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			f, err := factory.GetRoomFactory(tt.roomType)
+			tt.mockSetup()
+			f, err := factory.GetRoomFactory(registry, hotelID, tt.roomType, "USD")
 			if tt.expectError {
 				if err == nil {
 					t.Errorf("expected error, got nil")
@@ -44,27 +61,19 @@ func TestGetRoomFactory(t *testing.T) {
 	}
 }
 
-func TestRoomFactories_Create(t *testing.T) {
+func TestRoomTypeFactory_Create(t *testing.T) {
 	hotelID := uuid.New()
 	quantity := 5
 	payload := &payloads.CreateRoomPayload{
 		HotelID:  hotelID,
 		Quantity: quantity,
+		Price:    120.50,
 	}
 
-	factories := []struct {
-		name     string
-		factory  factory.RoomFactory
-		roomType room.RoomType
-	}{
-		{"single room", &factory.SingleRoomFactory{}, room.Single},
-		{"double room", &factory.DoubleRoomFactory{}, room.Double},
-		{"suite room", &factory.SuiteRoomFactory{}, room.Suite},
-	}
-
-	for _, tt := range factories {
-		t.Run(tt.name, func(t *testing.T) {
-			roomObj := tt.factory.Create(payload)
+	for _, roomType := range []room.RoomType{room.Single, room.Double, room.Suite, "villa"} {
+		t.Run(string(roomType), func(t *testing.T) {
+			f := &factory.RoomTypeFactory{RoomType: &models.RoomTypes{Code: roomType}, Currency: "USD"}
+			roomObj := f.Create(payload)
 
 			if roomObj.HotelId != hotelID {
 				t.Errorf("expected HotelId=%v, got %v", hotelID, roomObj.HotelId)
@@ -72,8 +81,14 @@ func TestRoomFactories_Create(t *testing.T) {
 			if roomObj.AvailableQuantity != quantity {
 				t.Errorf("expected Quantity=%d, got %d", quantity, roomObj.AvailableQuantity)
 			}
-			if roomObj.RoomCategory != tt.roomType {
-				t.Errorf("expected RoomCategory=%v, got %v", tt.roomType, roomObj.RoomCategory)
+			if roomObj.RoomCategory != roomType {
+				t.Errorf("expected RoomCategory=%v, got %v", roomType, roomObj.RoomCategory)
+			}
+			if roomObj.Price != 12050 {
+				t.Errorf("expected Price=12050, got %d", roomObj.Price)
+			}
+			if roomObj.Currency != "USD" {
+				t.Errorf("expected Currency=USD, got %q", roomObj.Currency)
 			}
 			if roomObj.Id == uuid.Nil {
 				t.Errorf("expected non-nil Id, got %v", roomObj.Id)
@@ -84,3 +99,12 @@ func TestRoomFactories_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestRoomTypeFactory_CreateUsesCurrencyExponent(t *testing.T) {
+	payload := &payloads.CreateRoomPayload{HotelID: uuid.New(), Quantity: 1, Price: 18000}
+	f := &factory.RoomTypeFactory{RoomType: &models.RoomTypes{Code: room.Single}, Currency: "JPY"}
+
+	if roomObj := f.Create(payload); roomObj.Price != 18000 {
+		t.Errorf("expected Price=18000 for a zero-decimal currency, got %d", roomObj.Price)
+	}
+}
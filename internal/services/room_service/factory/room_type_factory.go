@@ -0,0 +1,29 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// RoomTypeFactory creates rooms of a type resolved from the hotel's registry,
+// priced in the hotel's currency.
+type RoomTypeFactory struct {
+	RoomType *models.RoomTypes
+	Currency string
+}
+
+func (f *RoomTypeFactory) Create(payload *payloads.CreateRoomPayload) *models.Rooms {
+	return &models.Rooms{
+		Id:                uuid.New(),
+		HotelId:           payload.HotelID,
+		AvailableQuantity: payload.Quantity,
+		RoomCategory:      f.RoomType.Code,
+		Price:             currency.ToMinorUnits(payload.Price, f.Currency),
+		Currency:          f.Currency,
+		CreatedAt:         time.Now(),
+	}
+}
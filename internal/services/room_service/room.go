@@ -2,26 +2,68 @@ package room_service
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/group_block_repo"
+	"github.com/tktanisha/booking_system/internal/repository/overbooking_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_block_repo"
 	"github.com/tktanisha/booking_system/internal/repository/room_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_unit_repo"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+	"github.com/tktanisha/booking_system/internal/services/exchange_service"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
 	"github.com/tktanisha/booking_system/internal/services/room_service/factory"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
+var (
+	ErrBlockConflictsWithBookings = errors.New("block overlaps confirmed bookings")
+	ErrRoomTypeNotFound           = errors.New("room type not found")
+	ErrBlockAccessDenied          = errors.New("you are not allowed to manage this hotel's room blocks")
+)
+
 type RoomService struct {
-	RoomRepo room_repo.RoomRepoInterface
+	RoomRepo           room_repo.RoomRepoInterface
+	BlockRepo          room_block_repo.RoomBlockRepoInterface
+	UnitRepo           room_unit_repo.RoomUnitRepoInterface
+	WaitlistRepo       waitlist_repo.WaitlistRepoInterface
+	GroupBlockRepo     group_block_repo.GroupBlockRepoInterface
+	OverbookingRepo    overbooking_repo.OverbookingRepoInterface
+	RoomTypeService    room_type_service.RoomTypeServiceInterface
+	RestrictionService stay_restriction_service.StayRestrictionServiceInterface
+	HotelService       hotel_service.HotelServiceInterface
+	ExchangeService    exchange_service.ExchangeServiceInterface
 }
 
-func NewRoomService(roomRepo room_repo.RoomRepoInterface) *RoomService {
+func NewRoomService(roomRepo room_repo.RoomRepoInterface, blockRepo room_block_repo.RoomBlockRepoInterface, unitRepo room_unit_repo.RoomUnitRepoInterface, waitlistRepo waitlist_repo.WaitlistRepoInterface, groupBlockRepo group_block_repo.GroupBlockRepoInterface, overbookingRepo overbooking_repo.OverbookingRepoInterface, roomTypeService room_type_service.RoomTypeServiceInterface, restrictionService stay_restriction_service.StayRestrictionServiceInterface, hotelService hotel_service.HotelServiceInterface, exchangeService exchange_service.ExchangeServiceInterface) *RoomService {
 	return &RoomService{
-		RoomRepo: roomRepo,
+		RoomRepo:           roomRepo,
+		BlockRepo:          blockRepo,
+		UnitRepo:           unitRepo,
+		WaitlistRepo:       waitlistRepo,
+		GroupBlockRepo:     groupBlockRepo,
+		OverbookingRepo:    overbookingRepo,
+		RoomTypeService:    roomTypeService,
+		RestrictionService: restrictionService,
+		HotelService:       hotelService,
+		ExchangeService:    exchangeService,
 	}
 }
 
+// CreateRoom reads the price in the hotel's currency, so 18000 is 18000.00 for
+// a USD hotel and 18000 for a JPY one.
 func (r *RoomService) CreateRoom(payload *payloads.CreateRoomPayload) (*models.Rooms, error) {
-	factory, err := factory.GetRoomFactory(payload.RoomType)
+	hotel, err := r.HotelService.GetHotelByID(payload.HotelID)
+	if err != nil {
+		return nil, err
+	}
+	factory, err := factory.GetRoomFactory(r.RoomTypeService, payload.HotelID, payload.RoomType, hotel.Currency)
 	if err != nil {
 		return nil, err
 	}
@@ -35,18 +77,128 @@ func (r *RoomService) CreateRoom(payload *payloads.CreateRoomPayload) (*models.R
 	return room, nil
 }
 
-func (r *RoomService) IsAvailable(room *payloads.RoomPayload, hotelId uuid.UUID) bool {
-	rooms, err := r.RoomRepo.GetAllRoomByHotelID(hotelId)
+// IsAvailable reports whether the requested rooms can be sold for the stay,
+// after subtracting maintenance blocks, waitlist offer holds and group
+// allotments that overlap it. Stays starting today also exclude units that
+// housekeeping has not made ready yet. Once physical inventory runs out, the
+// room type's overbooking limit allows a few more rooms to be sold. Stays that
+// break a stay restriction or overlap a whole-hotel block are never available.
+func (r *RoomService) IsAvailable(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) bool {
+	free, ok := r.openQuantity(room, hotelId, checkIn, checkOut)
+	if !ok {
+		return false
+	}
+	if free >= room.Quantity {
+		return true
+	}
+
+	allowance, err := r.overbookingAllowance(hotelId, room.RoomType)
 	if err != nil {
 		return false
 	}
+	return free+allowance >= room.Quantity
+}
+
+// IsPhysicallyAvailable is IsAvailable without the overbooking allowance. Group
+// allotments and waitlist offers hold rooms for guests who have not booked yet,
+// so they only ever take rooms the hotel actually has free.
+func (r *RoomService) IsPhysicallyAvailable(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) bool {
+	free, ok := r.openQuantity(room, hotelId, checkIn, checkOut)
+	return ok && free >= room.Quantity
+}
+
+// openQuantity returns freeQuantity for a stay that breaks no stay restriction
+// and overlaps no whole-hotel block. It reports false otherwise, or when the
+// lookups fail.
+func (r *RoomService) openQuantity(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) (int, bool) {
+	if err := r.CheckRestrictions(room, hotelId, checkIn, checkOut); err != nil {
+		return 0, false
+	}
+
+	closed, err := r.BlockRepo.IsHotelClosed(hotelId, checkIn, checkOut)
+	if err != nil || closed {
+		return 0, false
+	}
+
+	free, err := r.freeQuantity(room.RoomType, hotelId, checkIn, checkOut)
+	if err != nil {
+		return 0, false
+	}
+	return free, true
+}
+
+// GetOversoldQuantity returns how many of the requested rooms would have to
+// come out of the overbooking allowance because physical inventory cannot
+// cover them.
+func (r *RoomService) GetOversoldQuantity(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) (int, error) {
+	free, err := r.freeQuantity(room.RoomType, hotelId, checkIn, checkOut)
+	if err != nil {
+		return 0, err
+	}
+	return room.Quantity - min(max(free, 0), room.Quantity), nil
+}
+
+// GetPhysicalQuantity returns how many rooms of a type the hotel has.
+func (r *RoomService) GetPhysicalQuantity(hotelId uuid.UUID, roomType room.RoomType) (int, error) {
+	return r.RoomRepo.GetTotalQuantity(hotelId, roomType)
+}
+
+// freeQuantity returns how many rooms of a type are left unsold for the stay.
+// It is negative while the type is oversold.
+func (r *RoomService) freeQuantity(roomType room.RoomType, hotelId uuid.UUID, checkIn, checkOut time.Time) (int, error) {
+	rooms, err := r.RoomRepo.GetAllRoomByHotelID(hotelId)
+	if err != nil {
+		return 0, err
+	}
 
 	for _, currentRoom := range rooms {
-		if room.RoomType == currentRoom.RoomCategory && currentRoom.AvailableQuantity >= room.Quantity {
-			return true
+		if roomType != currentRoom.RoomCategory {
+			continue
 		}
+		blocked, err := r.BlockRepo.GetBlockedQuantity(hotelId, roomType, checkIn, checkOut)
+		if err != nil {
+			return 0, err
+		}
+		held, err := r.WaitlistRepo.GetHeldQuantity(hotelId, roomType, checkIn, checkOut)
+		if err != nil {
+			return 0, err
+		}
+		grouped, err := r.GroupBlockRepo.GetHeldQuantity(hotelId, roomType, checkIn, checkOut)
+		if err != nil {
+			return 0, err
+		}
+		unready := 0
+		// checkIn carries the hotel's zone, so "today" is the hotel's today
+		if isSameDay(checkIn, time.Now().In(checkIn.Location())) {
+			unready, err = r.UnitRepo.GetUnreadyUnitCount(hotelId, roomType)
+			if err != nil {
+				return 0, err
+			}
+		}
+		return currentRoom.AvailableQuantity - blocked - held - grouped - unready, nil
 	}
-	return false
+	return 0, ErrRoomTypeNotFound
+}
+
+// overbookingAllowance returns how many rooms of a type may be sold past
+// physical inventory: the type's overbooking limit as a share of its rooms,
+// rounded down.
+func (r *RoomService) overbookingAllowance(hotelId uuid.UUID, roomType room.RoomType) (int, error) {
+	limitPercent, err := r.OverbookingRepo.GetLimit(hotelId, roomType)
+	if err != nil || limitPercent == 0 {
+		return 0, err
+	}
+	physical, err := r.RoomRepo.GetTotalQuantity(hotelId, roomType)
+	if err != nil {
+		return 0, err
+	}
+	return physical * limitPercent / 100, nil
+}
+
+// CheckRestrictions returns a *stay_restriction_service.ViolationError when the
+// stay breaks one of the hotel's stay restrictions for the room type.
+func (r *RoomService) CheckRestrictions(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) error {
+	return r.RestrictionService.CheckStay(hotelId, room.RoomType, checkIn, checkOut)
 }
 
 func (r *RoomService) ReduceRoomQuantity(room *payloads.RoomPayload, hotelId uuid.UUID) error {
@@ -83,9 +235,174 @@ func (r *RoomService) IncreaseRoomQuantity(room *payloads.RoomPayload, hotelId u
 		}
 	}
 
-	return nil, errors.New("room type not found")
+	return nil, ErrRoomTypeNotFound
 }
 
 func (r *RoomService) GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Rooms, error) {
 	return r.RoomRepo.GetAllRoomByHotelID(hotelID)
 }
+
+// SetDisplayPrices converts each room's price into currencyCode for display.
+// The stored price and currency are left alone.
+func (r *RoomService) SetDisplayPrices(rooms []*models.Rooms, currencyCode string) error {
+	for _, room := range rooms {
+		displayPrice, err := r.ExchangeService.ToDisplayPrice(room.Price, room.Currency, currencyCode)
+		if err != nil {
+			return err
+		}
+		room.DisplayPrice = displayPrice
+	}
+	return nil
+}
+
+// SetRoomMedia attaches to each room the photos of its room type.
+func (r *RoomService) SetRoomMedia(rooms []*models.Rooms, hotelID uuid.UUID) error {
+	roomTypes, err := r.RoomTypeService.GetRoomTypesByHotelID(hotelID)
+	if err != nil {
+		return err
+	}
+
+	mediaByCode := make(map[room.RoomType][]*models.Media, len(roomTypes))
+	for _, roomType := range roomTypes {
+		mediaByCode[roomType.Code] = roomType.Media
+	}
+	for _, currentRoom := range rooms {
+		currentRoom.Media = mediaByCode[currentRoom.RoomCategory]
+	}
+	return nil
+}
+
+// CreateBlock takes rooms out of service for a date range. Unless forced, a block is
+// refused when the rooms left over could no longer cover the confirmed bookings in that
+// range; a whole-hotel block is refused when any confirmed booking overlaps it.
+func (r *RoomService) CreateBlock(userCtx *models.UserContext, payload *payloads.CreateRoomBlockPayload) (*models.RoomBlocks, error) {
+	if err := r.checkHotelManager(userCtx, payload.HotelID); err != nil {
+		return nil, err
+	}
+
+	block := &models.RoomBlocks{
+		Id:           uuid.New(),
+		HotelId:      payload.HotelID,
+		WholeHotel:   payload.WholeHotel,
+		RoomCategory: payload.RoomType,
+		RoomUnitId:   payload.RoomUnitID,
+		Quantity:     payload.Quantity,
+		StartDate:    payload.StartDate,
+		EndDate:      payload.EndDate,
+		Reason:       payload.Reason,
+		Forced:       payload.Force,
+		CreatedBy:    userCtx.Id,
+		CreatedAt:    time.Now(),
+	}
+
+	if payload.RoomUnitID != nil {
+		unit, err := r.UnitRepo.GetUnitById(*payload.RoomUnitID)
+		if err != nil {
+			return nil, err
+		}
+		if unit.HotelId != payload.HotelID {
+			return nil, errors.New("room unit does not belong to this hotel")
+		}
+		block.RoomCategory = unit.RoomCategory
+		block.Quantity = 1
+	} else if !block.WholeHotel {
+		if _, err := r.RoomTypeService.GetRoomType(payload.HotelID, payload.RoomType); err != nil {
+			return nil, err
+		}
+	}
+
+	if !payload.Force {
+		check := r.checkBlockConflicts
+		if block.WholeHotel {
+			check = r.checkClosureConflicts
+		}
+		if err := check(block); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.BlockRepo.CreateBlock(block)
+}
+
+func (r *RoomService) GetBlocksByHotelID(userCtx *models.UserContext, hotelID uuid.UUID) ([]*models.RoomBlocks, error) {
+	if err := r.checkHotelManager(userCtx, hotelID); err != nil {
+		return nil, err
+	}
+	return r.BlockRepo.GetBlocksByHotelID(hotelID)
+}
+
+func (r *RoomService) DeleteBlock(userCtx *models.UserContext, blockId uuid.UUID) error {
+	block, err := r.BlockRepo.GetBlockById(blockId)
+	if err != nil {
+		return err
+	}
+	if err := r.checkHotelManager(userCtx, block.HotelId); err != nil {
+		return err
+	}
+	return r.BlockRepo.DeleteBlock(blockId)
+}
+
+// checkHotelManager refuses room blocks of a hotel to anyone but its manager.
+func (r *RoomService) checkHotelManager(userCtx *models.UserContext, hotelID uuid.UUID) error {
+	hotel, err := r.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrBlockAccessDenied
+	}
+	return nil
+}
+
+func (r *RoomService) checkBlockConflicts(block *models.RoomBlocks) error {
+	total, err := r.RoomRepo.GetTotalQuantity(block.HotelId, block.RoomCategory)
+	if err != nil {
+		return err
+	}
+
+	booked, err := r.RoomRepo.GetBookedQuantity(block.HotelId, block.RoomCategory, block.StartDate, block.EndDate)
+	if err != nil {
+		return err
+	}
+	if booked == 0 {
+		return nil
+	}
+
+	blocked, err := r.BlockRepo.GetBlockedQuantity(block.HotelId, block.RoomCategory, block.StartDate, block.EndDate)
+	if err != nil {
+		return err
+	}
+
+	if booked+blocked+block.Quantity > total {
+		return fmt.Errorf("%w: %d of %d %s rooms are booked and %d already blocked", ErrBlockConflictsWithBookings, booked, total, block.RoomCategory, blocked)
+	}
+	return nil
+}
+
+// checkClosureConflicts refuses a whole-hotel block while any room of the hotel is
+// booked in its range.
+func (r *RoomService) checkClosureConflicts(block *models.RoomBlocks) error {
+	rooms, err := r.RoomRepo.GetAllRoomByHotelID(block.HotelId)
+	if err != nil {
+		return err
+	}
+
+	booked := 0
+	for _, currentRoom := range rooms {
+		n, err := r.RoomRepo.GetBookedQuantity(block.HotelId, currentRoom.RoomCategory, block.StartDate, block.EndDate)
+		if err != nil {
+			return err
+		}
+		booked += n
+	}
+	if booked > 0 {
+		return fmt.Errorf("%w: %d rooms are booked while the hotel would be closed", ErrBlockConflictsWithBookings, booked)
+	}
+	return nil
+}
+
+func isSameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -1,7 +1,10 @@
 package room_service
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/models"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
@@ -10,8 +13,17 @@ import (
 
 type RoomServiceInterface interface {
 	CreateRoom(*payloads.CreateRoomPayload) (*models.Rooms, error)
-	IsAvailable(*payloads.RoomPayload, uuid.UUID) bool
+	IsAvailable(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) bool
+	IsPhysicallyAvailable(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) bool
+	CheckRestrictions(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) error
+	GetOversoldQuantity(room *payloads.RoomPayload, hotelId uuid.UUID, checkIn, checkOut time.Time) (int, error)
+	GetPhysicalQuantity(hotelId uuid.UUID, roomType room.RoomType) (int, error)
 	IncreaseRoomQuantity(*payloads.RoomPayload, uuid.UUID) (*models.Rooms, error)
 	ReduceRoomQuantity(*payloads.RoomPayload, uuid.UUID) error
 	GetAllRoomByHotelID(hotelID uuid.UUID) ([]*models.Rooms, error) //it also show how much room are available
+	SetDisplayPrices(rooms []*models.Rooms, currencyCode string) error
+	SetRoomMedia(rooms []*models.Rooms, hotelID uuid.UUID) error
+	CreateBlock(*models.UserContext, *payloads.CreateRoomBlockPayload) (*models.RoomBlocks, error)
+	GetBlocksByHotelID(userCtx *models.UserContext, hotelID uuid.UUID) ([]*models.RoomBlocks, error)
+	DeleteBlock(userCtx *models.UserContext, blockId uuid.UUID) error
 }
@@ -8,10 +8,15 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
 	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/mocks"
 	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_block_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/exchange_service"
 	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -20,7 +25,9 @@ func TestRoomService_CreateRoom(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
-	svc := room_service.NewRoomService(mockRepo)
+	mockTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_service.NewRoomService(mockRepo, mocks.NewMockRoomBlockRepoInterface(ctrl), mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mockTypeService, mocks.NewMockStayRestrictionServiceInterface(ctrl), mockHotelService, mocks.NewMockExchangeServiceInterface(ctrl))
 
 	test := []struct {
 		name     string
@@ -29,15 +36,28 @@ func TestRoomService_CreateRoom(t *testing.T) {
 		wantErr  bool
 	}{
 		{
-			name:     "get factory error of roomtype",
-			roomReq:  &payloads.CreateRoomPayload{HotelID: uuid.New(), RoomType: "invalid_type", Quantity: 1, Price: 456},
-			mockFunc: func() {},
-			wantErr:  true,
+			name:    "hotel lookup fails",
+			roomReq: &payloads.CreateRoomPayload{HotelID: uuid.New(), RoomType: room.Single, Quantity: 1, Price: 456},
+			mockFunc: func() {
+				mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(nil, errors.New("hotel not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name:    "get factory error of roomtype",
+			roomReq: &payloads.CreateRoomPayload{HotelID: uuid.New(), RoomType: "invalid_type", Quantity: 1, Price: 456},
+			mockFunc: func() {
+				mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(&models.Hotels{Currency: "USD"}, nil)
+				mockTypeService.EXPECT().GetRoomType(gomock.Any(), room.RoomType("invalid_type")).Return(nil, errors.New("room type not found"))
+			},
+			wantErr: true,
 		},
 		{
 			name:    "room repo on creating gives error",
 			roomReq: &payloads.CreateRoomPayload{HotelID: uuid.New(), RoomType: room.Single, Quantity: 1, Price: 456},
 			mockFunc: func() {
+				mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(&models.Hotels{Currency: "USD"}, nil)
+				mockTypeService.EXPECT().GetRoomType(gomock.Any(), room.Single).Return(&models.RoomTypes{Code: room.Single, MaxOccupancy: 1}, nil)
 				mockRepo.EXPECT().
 					CreateRoom(gomock.Any()).
 					Return(nil, errors.New("repository error"))
@@ -48,9 +68,14 @@ func TestRoomService_CreateRoom(t *testing.T) {
 			name:    "success of room creation",
 			roomReq: &payloads.CreateRoomPayload{HotelID: uuid.New(), RoomType: room.Single, Quantity: 1, Price: 456},
 			mockFunc: func() {
+				mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(&models.Hotels{Currency: "JPY"}, nil)
+				mockTypeService.EXPECT().GetRoomType(gomock.Any(), room.Single).Return(&models.RoomTypes{Code: room.Single, MaxOccupancy: 1}, nil)
 				mockRepo.EXPECT().
 					CreateRoom(gomock.Any()).
 					DoAndReturn(func(r *models.Rooms) (*models.Rooms, error) {
+						if r.Price != 456 || r.Currency != "JPY" {
+							t.Errorf("expected price 456 JPY, got %d %s", r.Price, r.Currency)
+						}
 						r.Id = uuid.New()
 						r.CreatedAt = time.Now()
 						return r, nil
@@ -73,21 +98,131 @@ func TestRoomService_CreateRoom(t *testing.T) {
 	}
 }
 
+func TestRoomService_SetDisplayPrices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExchange := mocks.NewMockExchangeServiceInterface(ctrl)
+	svc := room_service.NewRoomService(mocks.NewMockRoomRepoInterface(ctrl), mocks.NewMockRoomBlockRepoInterface(ctrl), mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockStayRestrictionServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl), mockExchange)
+
+	t.Run("converts every room", func(t *testing.T) {
+		rooms := []*models.Rooms{{Price: 10000, Currency: "USD"}, {Price: 20000, Currency: "USD"}}
+		mockExchange.EXPECT().ToDisplayPrice(int64(10000), "USD", "EUR").Return(&models.DisplayPrice{Currency: "EUR", Amount: 9200}, nil)
+		mockExchange.EXPECT().ToDisplayPrice(int64(20000), "USD", "EUR").Return(&models.DisplayPrice{Currency: "EUR", Amount: 18400}, nil)
+
+		if err := svc.SetDisplayPrices(rooms, "EUR"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rooms[1].DisplayPrice == nil || rooms[1].DisplayPrice.Amount != 18400 {
+			t.Errorf("expected display price 18400, got %+v", rooms[1].DisplayPrice)
+		}
+		if rooms[1].Price != 20000 || rooms[1].Currency != "USD" {
+			t.Errorf("stored price changed to %d %s", rooms[1].Price, rooms[1].Currency)
+		}
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		rooms := []*models.Rooms{{Price: 10000, Currency: "USD"}}
+		mockExchange.EXPECT().ToDisplayPrice(int64(10000), "USD", "GBP").Return(nil, exchange_service.ErrRateUnavailable)
+
+		if err := svc.SetDisplayPrices(rooms, "GBP"); !errors.Is(err, exchange_service.ErrRateUnavailable) {
+			t.Errorf("expected ErrRateUnavailable, got %v", err)
+		}
+	})
+}
+
+func TestRoomService_SetRoomMedia(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	svc := room_service.NewRoomService(mocks.NewMockRoomRepoInterface(ctrl), mocks.NewMockRoomBlockRepoInterface(ctrl), mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mockTypeService, mocks.NewMockStayRestrictionServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
+	hotelID := uuid.New()
+
+	t.Run("attaches the photos of each room's type", func(t *testing.T) {
+		photo := &models.Media{Id: uuid.New(), HotelId: hotelID}
+		rooms := []*models.Rooms{{RoomCategory: room.Suite}, {RoomCategory: room.Single}}
+		mockTypeService.EXPECT().GetRoomTypesByHotelID(hotelID).Return([]*models.RoomTypes{
+			{Code: room.Suite, Media: []*models.Media{photo}},
+			{Code: room.Single},
+		}, nil)
+
+		if err := svc.SetRoomMedia(rooms, hotelID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(rooms[0].Media) != 1 || rooms[0].Media[0] != photo {
+			t.Errorf("expected the suite photo, got %+v", rooms[0].Media)
+		}
+		if len(rooms[1].Media) != 0 {
+			t.Errorf("expected no photos for the single room, got %+v", rooms[1].Media)
+		}
+	})
+
+	t.Run("room types unavailable", func(t *testing.T) {
+		mockTypeService.EXPECT().GetRoomTypesByHotelID(hotelID).Return(nil, errors.New("db error"))
+
+		if err := svc.SetRoomMedia([]*models.Rooms{{RoomCategory: room.Suite}}, hotelID); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
 func TestRoomService_IsAvailable(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
-	svc := room_service.NewRoomService(mockRepo)
+	mockBlockRepo := mocks.NewMockRoomBlockRepoInterface(ctrl)
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	mockWaitlistRepo := mocks.NewMockWaitlistRepoInterface(ctrl)
+	mockGroupBlockRepo := mocks.NewMockGroupBlockRepoInterface(ctrl)
+	mockOverbookingRepo := mocks.NewMockOverbookingRepoInterface(ctrl)
+	mockRestrictions := mocks.NewMockStayRestrictionServiceInterface(ctrl)
+	svc := room_service.NewRoomService(mockRepo, mockBlockRepo, mockUnitRepo, mockWaitlistRepo, mockGroupBlockRepo, mockOverbookingRepo, mocks.NewMockRoomTypeServiceInterface(ctrl), mockRestrictions, mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
 
 	hotelID := uuid.New()
+	checkIn := time.Now().Add(24 * time.Hour)
+	checkOut := checkIn.Add(48 * time.Hour)
 
 	tests := []struct {
-		name      string
-		mockSetup func()
-		roomReq   *payloads.RoomPayload
-		want      bool
+		name           string
+		mockSetup      func()
+		restrictionErr error
+		closed         bool
+		roomReq        *payloads.RoomPayload
+		checkIn        time.Time
+		want           bool
 	}{
+		{
+			name:           "stay breaking a restriction returns false",
+			mockSetup:      func() {},
+			restrictionErr: &stay_restriction_service.ViolationError{Code: restriction.MinLengthOfStay},
+			roomReq:        &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:           false,
+		},
+		{
+			name:      "stay overlapping a whole-hotel block returns false",
+			mockSetup: func() {},
+			closed:    true,
+			roomReq:   &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:      false,
+		},
+		{
+			name: "same-day stay excludes units awaiting housekeeping",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 3},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, gomock.Any(), checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, gomock.Any(), checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, gomock.Any(), checkOut).Return(0, nil)
+				mockUnitRepo.EXPECT().GetUnreadyUnitCount(hotelID, room.Single).Return(2, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(0, nil)
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 2},
+			checkIn: time.Now(),
+			want:    false,
+		},
 		{
 			name: "repo error returns false",
 			mockSetup: func() {
@@ -102,6 +237,9 @@ func TestRoomService_IsAvailable(t *testing.T) {
 				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
 					{RoomCategory: room.Single, AvailableQuantity: 5},
 				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
 			},
 			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 2},
 			want:    true,
@@ -112,10 +250,136 @@ func TestRoomService_IsAvailable(t *testing.T) {
 				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
 					{RoomCategory: room.Single, AvailableQuantity: 1},
 				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(0, nil)
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 2},
+			want:    false,
+		},
+		{
+			name: "blocked rooms are subtracted",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 5},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(4, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(0, nil)
 			},
 			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 2},
 			want:    false,
 		},
+		{
+			name: "rooms held by waitlist offers are subtracted",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 3},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(2, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(0, nil)
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 2},
+			want:    false,
+		},
+		{
+			name: "rooms held for group blocks are subtracted",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 30},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(29, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(0, nil)
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 2},
+			want:    false,
+		},
+		{
+			name: "overbooking limit allows selling past physical inventory",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 1},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(10, nil)
+				mockRepo.EXPECT().GetTotalQuantity(hotelID, room.Single).Return(20, nil)
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 3},
+			want:    true,
+		},
+		{
+			name: "overbooking limit already used up returns false",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: -2},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(10, nil)
+				mockRepo.EXPECT().GetTotalQuantity(hotelID, room.Single).Return(20, nil)
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:    false,
+		},
+		{
+			name: "overbooking limit lookup error returns false",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 0},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockOverbookingRepo.EXPECT().GetLimit(hotelID, room.Single).Return(0, errors.New("db error"))
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:    false,
+		},
+		{
+			name: "group hold lookup error returns false",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 5},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, errors.New("db error"))
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:    false,
+		},
+		{
+			name: "hold lookup error returns false",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 5},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, errors.New("db error"))
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:    false,
+		},
+		{
+			name: "block lookup error returns false",
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: 5},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, errors.New("db error"))
+			},
+			roomReq: &payloads.RoomPayload{RoomType: room.Single, Quantity: 1},
+			want:    false,
+		},
 		{
 			name: "no matching room type returns false",
 			mockSetup: func() {
@@ -131,7 +395,15 @@ func TestRoomService_IsAvailable(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockSetup()
-			got := svc.IsAvailable(tt.roomReq, hotelID)
+			stayStart := checkIn
+			if !tt.checkIn.IsZero() {
+				stayStart = tt.checkIn
+			}
+			mockRestrictions.EXPECT().CheckStay(hotelID, tt.roomReq.RoomType, stayStart, checkOut).Return(tt.restrictionErr)
+			if tt.restrictionErr == nil {
+				mockBlockRepo.EXPECT().IsHotelClosed(hotelID, stayStart, checkOut).Return(tt.closed, nil)
+			}
+			got := svc.IsAvailable(tt.roomReq, hotelID, stayStart, checkOut)
 			if got != tt.want {
 				t.Fatalf("IsAvailable() = %v, want %v", got, tt.want)
 			}
@@ -139,11 +411,102 @@ func TestRoomService_IsAvailable(t *testing.T) {
 	}
 }
 
+func TestRoomService_IsPhysicallyAvailable(t *testing.T) {
+	hotelID := uuid.New()
+	checkIn := time.Now().Add(24 * time.Hour)
+	checkOut := checkIn.Add(48 * time.Hour)
+
+	tests := []struct {
+		name      string
+		available int
+		closed    bool
+		quantity  int
+		want      bool
+	}{
+		{"free rooms cover the request", 3, false, 2, true},
+		{"overbooking allowance is not used", 1, false, 3, false},
+		{"whole-hotel block returns false", 3, true, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
+			mockBlockRepo := mocks.NewMockRoomBlockRepoInterface(ctrl)
+			mockWaitlistRepo := mocks.NewMockWaitlistRepoInterface(ctrl)
+			mockGroupBlockRepo := mocks.NewMockGroupBlockRepoInterface(ctrl)
+			mockRestrictions := mocks.NewMockStayRestrictionServiceInterface(ctrl)
+			svc := room_service.NewRoomService(mockRepo, mockBlockRepo, mocks.NewMockRoomUnitRepoInterface(ctrl), mockWaitlistRepo, mockGroupBlockRepo, mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mockRestrictions, mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
+
+			mockRestrictions.EXPECT().CheckStay(hotelID, room.Single, checkIn, checkOut).Return(nil)
+			mockBlockRepo.EXPECT().IsHotelClosed(hotelID, checkIn, checkOut).Return(tt.closed, nil)
+			if !tt.closed {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{
+					{RoomCategory: room.Single, AvailableQuantity: tt.available},
+				}, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+				mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Single, checkIn, checkOut).Return(0, nil)
+			}
+
+			got := svc.IsPhysicallyAvailable(&payloads.RoomPayload{RoomType: room.Single, Quantity: tt.quantity}, hotelID, checkIn, checkOut)
+			if got != tt.want {
+				t.Errorf("IsPhysicallyAvailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoomService_GetOversoldQuantity(t *testing.T) {
+	hotelID := uuid.New()
+	checkIn := time.Now().Add(24 * time.Hour)
+	checkOut := checkIn.Add(48 * time.Hour)
+
+	tests := []struct {
+		name      string
+		available int
+		quantity  int
+		want      int
+	}{
+		{"covered by physical inventory", 5, 2, 0},
+		{"partly oversold", 1, 3, 2},
+		{"already oversold", -1, 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
+			mockBlockRepo := mocks.NewMockRoomBlockRepoInterface(ctrl)
+			mockWaitlistRepo := mocks.NewMockWaitlistRepoInterface(ctrl)
+			mockGroupBlockRepo := mocks.NewMockGroupBlockRepoInterface(ctrl)
+			svc := room_service.NewRoomService(mockRepo, mockBlockRepo, mocks.NewMockRoomUnitRepoInterface(ctrl), mockWaitlistRepo, mockGroupBlockRepo, mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockStayRestrictionServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
+
+			mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{RoomCategory: room.Double, AvailableQuantity: tt.available}}, nil)
+			mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Double, checkIn, checkOut).Return(0, nil)
+			mockWaitlistRepo.EXPECT().GetHeldQuantity(hotelID, room.Double, checkIn, checkOut).Return(0, nil)
+			mockGroupBlockRepo.EXPECT().GetHeldQuantity(hotelID, room.Double, checkIn, checkOut).Return(0, nil)
+
+			got, err := svc.GetOversoldQuantity(&payloads.RoomPayload{RoomType: room.Double, Quantity: tt.quantity}, hotelID, checkIn, checkOut)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GetOversoldQuantity() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRoomService_ReduceRoomQuantity(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
-	svc := room_service.NewRoomService(mockRepo)
+	svc := room_service.NewRoomService(mockRepo, mocks.NewMockRoomBlockRepoInterface(ctrl), mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockStayRestrictionServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
 
 	hotelID := uuid.New()
 
@@ -209,7 +572,7 @@ func TestRoomService_IncreaseRoomQuantity(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
-	svc := room_service.NewRoomService(mockRepo)
+	svc := room_service.NewRoomService(mockRepo, mocks.NewMockRoomBlockRepoInterface(ctrl), mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockStayRestrictionServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
 
 	hotelID := uuid.New()
 
@@ -275,7 +638,7 @@ func TestRoomService_GetAllRoomByHotelID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
-	service := room_service.NewRoomService(mockRepo)
+	service := room_service.NewRoomService(mockRepo, mocks.NewMockRoomBlockRepoInterface(ctrl), mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockStayRestrictionServiceInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl), mocks.NewMockExchangeServiceInterface(ctrl))
 
 	HotelID := uuid.New()
 
@@ -315,3 +678,212 @@ func TestRoomService_GetAllRoomByHotelID(t *testing.T) {
 	}
 
 }
+
+func TestRoomService_CreateBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRoomRepoInterface(ctrl)
+	mockBlockRepo := mocks.NewMockRoomBlockRepoInterface(ctrl)
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	mockTypeService := mocks.NewMockRoomTypeServiceInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_service.NewRoomService(mockRepo, mockBlockRepo, mockUnitRepo, mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mockTypeService, mocks.NewMockStayRestrictionServiceInterface(ctrl), mockHotelService, mocks.NewMockExchangeServiceInterface(ctrl))
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	otherHotelID := uuid.New()
+	unitID := uuid.New()
+	start := time.Now().Add(24 * time.Hour)
+	end := start.Add(72 * time.Hour)
+	mockTypeService.EXPECT().GetRoomType(hotelID, room.Double).Return(&models.RoomTypes{Code: room.Double}, nil).AnyTimes()
+	mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id}, nil).AnyTimes()
+	mockHotelService.EXPECT().GetHotelByID(otherHotelID).Return(&models.Hotels{Id: otherHotelID, ManagerId: uuid.New()}, nil).AnyTimes()
+
+	typeBlock := func(quantity int, force bool) *payloads.CreateRoomBlockPayload {
+		return &payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: room.Double, Quantity: quantity, StartDate: start, EndDate: end, Reason: "renovation", Force: force}
+	}
+
+	tests := []struct {
+		name      string
+		payload   *payloads.CreateRoomBlockPayload
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name:    "no overlapping bookings",
+			payload: typeBlock(3, false),
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTotalQuantity(hotelID, room.Double).Return(5, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Double, start, end).Return(0, nil)
+				mockBlockRepo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(b *models.RoomBlocks) (*models.RoomBlocks, error) { return b, nil })
+			},
+		},
+		{
+			name:    "enough rooms left for bookings",
+			payload: typeBlock(2, false),
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTotalQuantity(hotelID, room.Double).Return(5, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Double, start, end).Return(2, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Double, start, end).Return(1, nil)
+				mockBlockRepo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(b *models.RoomBlocks) (*models.RoomBlocks, error) { return b, nil })
+			},
+		},
+		{
+			name:    "block would break bookings",
+			payload: typeBlock(3, false),
+			mockSetup: func() {
+				mockRepo.EXPECT().GetTotalQuantity(hotelID, room.Double).Return(5, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Double, start, end).Return(3, nil)
+				mockBlockRepo.EXPECT().GetBlockedQuantity(hotelID, room.Double, start, end).Return(0, nil)
+			},
+			wantErr: room_service.ErrBlockConflictsWithBookings,
+		},
+		{
+			name:    "forced block skips the conflict check",
+			payload: typeBlock(3, true),
+			mockSetup: func() {
+				mockBlockRepo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(b *models.RoomBlocks) (*models.RoomBlocks, error) {
+					if !b.Forced {
+						t.Errorf("expected block to be recorded as forced")
+					}
+					return b, nil
+				})
+			},
+		},
+		{
+			name:    "unit block takes the unit category",
+			payload: &payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomUnitID: &unitID, Quantity: 1, StartDate: start, EndDate: end, Reason: "broken AC"},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, HotelId: hotelID, RoomCategory: room.Suite}, nil)
+				mockRepo.EXPECT().GetTotalQuantity(hotelID, room.Suite).Return(2, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Suite, start, end).Return(0, nil)
+				mockBlockRepo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(b *models.RoomBlocks) (*models.RoomBlocks, error) {
+					if b.RoomCategory != room.Suite || b.Quantity != 1 {
+						t.Errorf("unexpected unit block %+v", b)
+					}
+					return b, nil
+				})
+			},
+		},
+		{
+			name:    "unknown room type",
+			payload: &payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: "penthouse", Quantity: 1, StartDate: start, EndDate: end, Reason: "renovation"},
+			mockSetup: func() {
+				mockTypeService.EXPECT().GetRoomType(hotelID, room.RoomType("penthouse")).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantErr: room_type_repo.ErrRoomTypeNotFound,
+		},
+		{
+			name:    "whole-hotel block with no bookings",
+			payload: &payloads.CreateRoomBlockPayload{HotelID: hotelID, WholeHotel: true, StartDate: start, EndDate: end, Reason: "closed for the season"},
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{RoomCategory: room.Single}, {RoomCategory: room.Double}}, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Single, start, end).Return(0, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Double, start, end).Return(0, nil)
+				mockBlockRepo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(b *models.RoomBlocks) (*models.RoomBlocks, error) {
+					if !b.WholeHotel {
+						t.Errorf("expected a whole-hotel block, got %+v", b)
+					}
+					return b, nil
+				})
+			},
+		},
+		{
+			name:    "whole-hotel block over a booking",
+			payload: &payloads.CreateRoomBlockPayload{HotelID: hotelID, WholeHotel: true, StartDate: start, EndDate: end, Reason: "closed for the season"},
+			mockSetup: func() {
+				mockRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{RoomCategory: room.Single}, {RoomCategory: room.Double}}, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Single, start, end).Return(0, nil)
+				mockRepo.EXPECT().GetBookedQuantity(hotelID, room.Double, start, end).Return(1, nil)
+			},
+			wantErr: room_service.ErrBlockConflictsWithBookings,
+		},
+		{
+			name:      "another hotel's manager",
+			payload:   &payloads.CreateRoomBlockPayload{HotelID: otherHotelID, WholeHotel: true, StartDate: start, EndDate: end, Reason: "closed for the season"},
+			mockSetup: func() {},
+			wantErr:   room_service.ErrBlockAccessDenied,
+		},
+		{
+			name:    "unit from another hotel",
+			payload: &payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomUnitID: &unitID, Quantity: 1, StartDate: start, EndDate: end, Reason: "broken AC"},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, HotelId: uuid.New(), RoomCategory: room.Suite}, nil)
+			},
+			wantErr: errors.New("room unit does not belong to this hotel"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, err := svc.CreateBlock(userCtx, tt.payload)
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("expected no error, got %v", err)
+			case tt.wantErr != nil && err == nil:
+				t.Fatalf("expected error %v, got nil", tt.wantErr)
+			case tt.wantErr == room_service.ErrBlockConflictsWithBookings && !errors.Is(err, tt.wantErr):
+				t.Fatalf("expected conflict error, got %v", err)
+			case tt.wantErr == room_type_repo.ErrRoomTypeNotFound && !errors.Is(err, tt.wantErr):
+				t.Fatalf("expected room type not found, got %v", err)
+			case tt.wantErr == room_service.ErrBlockAccessDenied && !errors.Is(err, tt.wantErr):
+				t.Fatalf("expected access denied, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRoomService_DeleteBlock(t *testing.T) {
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	blockID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func(blockRepo *mocks.MockRoomBlockRepoInterface, hotelService *mocks.MockHotelServiceInterface)
+		wantErr   error
+	}{
+		{
+			name: "hotel manager removes the block",
+			mockSetup: func(blockRepo *mocks.MockRoomBlockRepoInterface, hotelService *mocks.MockHotelServiceInterface) {
+				blockRepo.EXPECT().GetBlockById(blockID).Return(&models.RoomBlocks{Id: blockID, HotelId: hotelID}, nil)
+				hotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil)
+				blockRepo.EXPECT().DeleteBlock(blockID).Return(nil)
+			},
+		},
+		{
+			name: "another hotel's manager",
+			mockSetup: func(blockRepo *mocks.MockRoomBlockRepoInterface, hotelService *mocks.MockHotelServiceInterface) {
+				blockRepo.EXPECT().GetBlockById(blockID).Return(&models.RoomBlocks{Id: blockID, HotelId: hotelID}, nil)
+				hotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+			},
+			wantErr: room_service.ErrBlockAccessDenied,
+		},
+		{
+			name: "block not found",
+			mockSetup: func(blockRepo *mocks.MockRoomBlockRepoInterface, hotelService *mocks.MockHotelServiceInterface) {
+				blockRepo.EXPECT().GetBlockById(blockID).Return(nil, room_block_repo.ErrRoomBlockNotFound)
+			},
+			wantErr: room_block_repo.ErrRoomBlockNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBlockRepo := mocks.NewMockRoomBlockRepoInterface(ctrl)
+			mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+			svc := room_service.NewRoomService(mocks.NewMockRoomRepoInterface(ctrl), mockBlockRepo, mocks.NewMockRoomUnitRepoInterface(ctrl), mocks.NewMockWaitlistRepoInterface(ctrl), mocks.NewMockGroupBlockRepoInterface(ctrl), mocks.NewMockOverbookingRepoInterface(ctrl), mocks.NewMockRoomTypeServiceInterface(ctrl), mocks.NewMockStayRestrictionServiceInterface(ctrl), mockHotelService, mocks.NewMockExchangeServiceInterface(ctrl))
+			tt.mockSetup(mockBlockRepo, mockHotelService)
+
+			err := svc.DeleteBlock(managerCtx, blockID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package room_type_service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/media_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var (
+	ErrRoomTypeInUse        = errors.New("room type still has rooms")
+	ErrRoomTypeAccessDenied = errors.New("you are not allowed to manage this hotel's room types")
+)
+
+// builtinRoomTypes back the original single/double/suite codes for hotels that
+// have not registered their own definition of them.
+var builtinRoomTypes = map[room.RoomType]models.RoomTypes{
+	room.Single: {Code: room.Single, Name: "Single Room", MaxOccupancy: 1, BaseOccupancy: 1, BedConfiguration: "1 single bed"},
+	room.Double: {Code: room.Double, Name: "Double Room", MaxOccupancy: 2, BaseOccupancy: 2, BedConfiguration: "1 double bed"},
+	room.Suite:  {Code: room.Suite, Name: "Suite", MaxOccupancy: 4, BaseOccupancy: 4, BedConfiguration: "1 king bed, 1 sofa bed"},
+}
+
+type RoomTypeService struct {
+	TypeRepo     room_type_repo.RoomTypeRepoInterface
+	RoomRepo     room_repo.RoomRepoInterface
+	MediaRepo    media_repo.MediaRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewRoomTypeService(typeRepo room_type_repo.RoomTypeRepoInterface, roomRepo room_repo.RoomRepoInterface, mediaRepo media_repo.MediaRepoInterface, hotelService hotel_service.HotelServiceInterface) *RoomTypeService {
+	return &RoomTypeService{
+		TypeRepo:     typeRepo,
+		RoomRepo:     roomRepo,
+		MediaRepo:    mediaRepo,
+		HotelService: hotelService,
+	}
+}
+
+func (s *RoomTypeService) CreateRoomType(userCtx *models.UserContext, payload *payloads.CreateRoomTypePayload) (*models.RoomTypes, error) {
+	hotel, err := s.HotelService.GetHotelByID(payload.HotelID)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrRoomTypeAccessDenied
+	}
+	if _, err := s.TypeRepo.GetRoomTypeByCode(payload.HotelID, payload.Code); err == nil {
+		return nil, errors.New("room type already registered for this hotel")
+	} else if !errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		return nil, err
+	}
+
+	roomType := &models.RoomTypes{
+		Id:               uuid.New(),
+		HotelId:          payload.HotelID,
+		Code:             payload.Code,
+		Name:             payload.Name,
+		MaxOccupancy:     payload.MaxOccupancy,
+		BaseOccupancy:    payload.BaseOccupancy,
+		ExtraGuestFee:    currency.ToMinorUnits(payload.ExtraGuestFee, hotel.Currency),
+		BedConfiguration: payload.BedConfiguration,
+		SizeSqm:          payload.SizeSqm,
+		Amenities:        payload.Amenities,
+		CreatedAt:        time.Now(),
+	}
+	return s.TypeRepo.CreateRoomType(roomType)
+}
+
+// GetRoomTypesByHotelID lists the hotel's registered types, with their photos,
+// followed by the built-in types it has not overridden.
+func (s *RoomTypeService) GetRoomTypesByHotelID(hotelID uuid.UUID) ([]*models.RoomTypes, error) {
+	roomTypes, err := s.TypeRepo.GetRoomTypesByHotelID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := s.MediaRepo.GetMediaByHotelId(hotelID)
+	if err != nil {
+		return nil, err
+	}
+	mediaByType := make(map[uuid.UUID][]*models.Media)
+	for _, m := range media {
+		if m.RoomTypeId != nil {
+			mediaByType[*m.RoomTypeId] = append(mediaByType[*m.RoomTypeId], m)
+		}
+	}
+
+	registered := make(map[room.RoomType]bool)
+	for _, roomType := range roomTypes {
+		registered[roomType.Code] = true
+		roomType.Media = mediaByType[roomType.Id]
+	}
+	for _, code := range []room.RoomType{room.Single, room.Double, room.Suite} {
+		if !registered[code] {
+			roomTypes = append(roomTypes, builtinRoomType(hotelID, code))
+		}
+	}
+	return roomTypes, nil
+}
+
+// GetRoomType resolves a code for a hotel, falling back to the built-in types.
+func (s *RoomTypeService) GetRoomType(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error) {
+	roomType, err := s.TypeRepo.GetRoomTypeByCode(hotelID, code)
+	if err == nil {
+		return roomType, nil
+	}
+	if !errors.Is(err, room_type_repo.ErrRoomTypeNotFound) {
+		return nil, err
+	}
+	if _, ok := builtinRoomTypes[code]; ok {
+		return builtinRoomType(hotelID, code), nil
+	}
+	return nil, err
+}
+
+func (s *RoomTypeService) UpdateRoomType(userCtx *models.UserContext, typeId uuid.UUID, payload *payloads.UpdateRoomTypePayload) (*models.RoomTypes, error) {
+	roomType, err := s.TypeRepo.GetRoomTypeById(typeId)
+	if err != nil {
+		return nil, err
+	}
+	hotel, err := s.HotelService.GetHotelByID(roomType.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrRoomTypeAccessDenied
+	}
+
+	roomType.Name = payload.Name
+	roomType.MaxOccupancy = payload.MaxOccupancy
+	roomType.BaseOccupancy = payload.BaseOccupancy
+	roomType.ExtraGuestFee = currency.ToMinorUnits(payload.ExtraGuestFee, hotel.Currency)
+	roomType.BedConfiguration = payload.BedConfiguration
+	roomType.SizeSqm = payload.SizeSqm
+	roomType.Amenities = payload.Amenities
+
+	return s.TypeRepo.UpdateRoomType(roomType)
+}
+
+// DeleteRoomType removes a registered type. A custom type that still has rooms
+// cannot be deleted; a built-in code simply reverts to its default definition.
+func (s *RoomTypeService) DeleteRoomType(userCtx *models.UserContext, typeId uuid.UUID) error {
+	roomType, err := s.TypeRepo.GetRoomTypeById(typeId)
+	if err != nil {
+		return err
+	}
+	hotel, err := s.HotelService.GetHotelByID(roomType.HotelId)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrRoomTypeAccessDenied
+	}
+
+	if _, ok := builtinRoomTypes[roomType.Code]; !ok {
+		rooms, err := s.RoomRepo.GetAllRoomByHotelID(roomType.HotelId)
+		if err != nil {
+			return err
+		}
+		for _, currentRoom := range rooms {
+			if currentRoom.RoomCategory == roomType.Code {
+				return ErrRoomTypeInUse
+			}
+		}
+	}
+
+	return s.TypeRepo.DeleteRoomType(typeId)
+}
+
+func builtinRoomType(hotelID uuid.UUID, code room.RoomType) *models.RoomTypes {
+	roomType := builtinRoomTypes[code]
+	roomType.HotelId = hotelID
+	roomType.Amenities = []string{}
+	return &roomType
+}
@@ -0,0 +1,18 @@
+package room_type_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=room_type_interface.go -destination=../../mocks/mock_room_type_service.go -package=mocks
+
+type RoomTypeServiceInterface interface {
+	CreateRoomType(*models.UserContext, *payloads.CreateRoomTypePayload) (*models.RoomTypes, error)
+	GetRoomTypesByHotelID(hotelID uuid.UUID) ([]*models.RoomTypes, error)
+	GetRoomType(hotelID uuid.UUID, code room.RoomType) (*models.RoomTypes, error)
+	UpdateRoomType(userCtx *models.UserContext, typeId uuid.UUID, payload *payloads.UpdateRoomTypePayload) (*models.RoomTypes, error)
+	DeleteRoomType(userCtx *models.UserContext, typeId uuid.UUID) error
+}
@@ -0,0 +1,322 @@
+package room_type_service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestRoomTypeService_CreateRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := mocks.NewMockRoomTypeRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_type_service.NewRoomTypeService(mockTypeRepo, mocks.NewMockRoomRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), mockHotelService)
+
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	payload := &payloads.CreateRoomTypePayload{HotelID: uuid.New(), Code: "villa", Name: "Garden Villa", MaxOccupancy: 6, ExtraGuestFee: 2500, Amenities: []string{"private pool"}}
+	hotel := &models.Hotels{Id: payload.HotelID, ManagerId: managerCtx.Id, Currency: "JPY"}
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(payload.HotelID).Return(hotel, nil)
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(payload.HotelID, payload.Code).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+				mockTypeRepo.EXPECT().CreateRoomType(gomock.Any()).DoAndReturn(func(roomType *models.RoomTypes) (*models.RoomTypes, error) {
+					if roomType.Code != "villa" || roomType.MaxOccupancy != 6 || roomType.ExtraGuestFee != 2500 || roomType.Id == uuid.Nil {
+						t.Errorf("unexpected room type %+v", roomType)
+					}
+					return roomType, nil
+				})
+			},
+		},
+		{
+			name: "already registered",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(payload.HotelID).Return(hotel, nil)
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(payload.HotelID, payload.Code).Return(&models.RoomTypes{Code: "villa"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "lookup fails",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(payload.HotelID).Return(hotel, nil)
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(payload.HotelID, payload.Code).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown hotel",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(payload.HotelID).Return(nil, errors.New("hotel not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "another hotel's manager",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(payload.HotelID).Return(&models.Hotels{Id: payload.HotelID, ManagerId: uuid.New()}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, err := svc.CreateRoomType(managerCtx, payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRoomTypeService_GetRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := mocks.NewMockRoomTypeRepoInterface(ctrl)
+	svc := room_type_service.NewRoomTypeService(mockTypeRepo, mocks.NewMockRoomRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), mocks.NewMockHotelServiceInterface(ctrl))
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name          string
+		code          room.RoomType
+		mockSetup     func()
+		wantOccupancy int
+		wantErr       bool
+	}{
+		{
+			name: "registered type",
+			code: "family",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(hotelID, room.RoomType("family")).Return(&models.RoomTypes{Code: "family", MaxOccupancy: 4}, nil)
+			},
+			wantOccupancy: 4,
+		},
+		{
+			name: "registered override of a built-in type",
+			code: room.Double,
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(hotelID, room.Double).Return(&models.RoomTypes{Code: room.Double, MaxOccupancy: 3}, nil)
+			},
+			wantOccupancy: 3,
+		},
+		{
+			name: "built-in fallback",
+			code: room.Suite,
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(hotelID, room.Suite).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantOccupancy: 4,
+		},
+		{
+			name: "unknown type",
+			code: "penthouse",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(hotelID, room.RoomType("penthouse")).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantErr: true,
+		},
+		{
+			name: "repo error is not masked by the fallback",
+			code: room.Single,
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeByCode(hotelID, room.Single).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			roomType, err := svc.GetRoomType(hotelID, tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && roomType.MaxOccupancy != tt.wantOccupancy {
+				t.Errorf("expected max occupancy %d, got %d", tt.wantOccupancy, roomType.MaxOccupancy)
+			}
+		})
+	}
+}
+
+func TestRoomTypeService_GetRoomTypesByHotelID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := mocks.NewMockRoomTypeRepoInterface(ctrl)
+	mockMediaRepo := mocks.NewMockMediaRepoInterface(ctrl)
+	svc := room_type_service.NewRoomTypeService(mockTypeRepo, mocks.NewMockRoomRepoInterface(ctrl), mockMediaRepo, mocks.NewMockHotelServiceInterface(ctrl))
+	hotelID := uuid.New()
+	villaID := uuid.New()
+	villaPhoto := &models.Media{Id: uuid.New(), HotelId: hotelID, RoomTypeId: &villaID}
+
+	mockTypeRepo.EXPECT().GetRoomTypesByHotelID(hotelID).Return([]*models.RoomTypes{
+		{Code: room.Double, MaxOccupancy: 3},
+		{Id: villaID, Code: "villa", MaxOccupancy: 6},
+	}, nil)
+	mockMediaRepo.EXPECT().GetMediaByHotelId(hotelID).Return([]*models.Media{{Id: uuid.New(), HotelId: hotelID}, villaPhoto}, nil)
+
+	roomTypes, err := svc.GetRoomTypesByHotelID(hotelID)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	codes := make(map[room.RoomType]int)
+	for _, roomType := range roomTypes {
+		codes[roomType.Code]++
+	}
+	if len(roomTypes) != 4 || codes[room.Double] != 1 || codes[room.Single] != 1 || codes[room.Suite] != 1 || codes["villa"] != 1 {
+		t.Errorf("expected registered types plus non-overridden built-ins, got %v", codes)
+	}
+	for _, roomType := range roomTypes {
+		wantPhotos := 0
+		if roomType.Code == "villa" {
+			wantPhotos = 1
+		}
+		if len(roomType.Media) != wantPhotos {
+			t.Errorf("expected %d photos on %s, got %+v", wantPhotos, roomType.Code, roomType.Media)
+		}
+	}
+}
+
+func TestRoomTypeService_DeleteRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := mocks.NewMockRoomTypeRepoInterface(ctrl)
+	mockRoomRepo := mocks.NewMockRoomRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_type_service.NewRoomTypeService(mockTypeRepo, mockRoomRepo, mocks.NewMockMediaRepoInterface(ctrl), mockHotelService)
+
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	typeID := uuid.New()
+	otherHotelID := uuid.New()
+	mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil).AnyTimes()
+	mockHotelService.EXPECT().GetHotelByID(otherHotelID).Return(&models.Hotels{Id: otherHotelID, ManagerId: uuid.New()}, nil).AnyTimes()
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name: "unused custom type",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(&models.RoomTypes{Id: typeID, HotelId: hotelID, Code: "villa"}, nil)
+				mockRoomRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{RoomCategory: room.Single}}, nil)
+				mockTypeRepo.EXPECT().DeleteRoomType(typeID).Return(nil)
+			},
+		},
+		{
+			name: "custom type still has rooms",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(&models.RoomTypes{Id: typeID, HotelId: hotelID, Code: "villa"}, nil)
+				mockRoomRepo.EXPECT().GetAllRoomByHotelID(hotelID).Return([]*models.Rooms{{RoomCategory: "villa"}}, nil)
+			},
+			wantErr: room_type_service.ErrRoomTypeInUse,
+		},
+		{
+			name: "another hotel's manager",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(&models.RoomTypes{Id: typeID, HotelId: otherHotelID, Code: "villa"}, nil)
+			},
+			wantErr: room_type_service.ErrRoomTypeAccessDenied,
+		},
+		{
+			name: "built-in override reverts to the default",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(&models.RoomTypes{Id: typeID, HotelId: hotelID, Code: room.Double}, nil)
+				mockTypeRepo.EXPECT().DeleteRoomType(typeID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			err := svc.DeleteRoomType(managerCtx, typeID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRoomTypeService_UpdateRoomType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := mocks.NewMockRoomTypeRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_type_service.NewRoomTypeService(mockTypeRepo, mocks.NewMockRoomRepoInterface(ctrl), mocks.NewMockMediaRepoInterface(ctrl), mockHotelService)
+
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	typeID := uuid.New()
+	payload := &payloads.UpdateRoomTypePayload{Name: "Garden Villa", MaxOccupancy: 6, BaseOccupancy: 2, ExtraGuestFee: 25}
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   error
+	}{
+		{
+			name: "hotel manager updates the type",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(&models.RoomTypes{Id: typeID, HotelId: hotelID, Code: "villa"}, nil)
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id, Currency: "USD"}, nil)
+				mockTypeRepo.EXPECT().UpdateRoomType(gomock.Any()).DoAndReturn(func(roomType *models.RoomTypes) (*models.RoomTypes, error) {
+					if roomType.MaxOccupancy != 6 || roomType.ExtraGuestFee != 2500 {
+						t.Errorf("unexpected room type %+v", roomType)
+					}
+					return roomType, nil
+				})
+			},
+		},
+		{
+			name: "another hotel's manager",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(&models.RoomTypes{Id: typeID, HotelId: hotelID, Code: "villa"}, nil)
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New(), Currency: "USD"}, nil)
+			},
+			wantErr: room_type_service.ErrRoomTypeAccessDenied,
+		},
+		{
+			name: "unknown type",
+			mockSetup: func() {
+				mockTypeRepo.EXPECT().GetRoomTypeById(typeID).Return(nil, room_type_repo.ErrRoomTypeNotFound)
+			},
+			wantErr: room_type_repo.ErrRoomTypeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, err := svc.UpdateRoomType(managerCtx, typeID, payload)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package room_unit_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/room_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_type_repo"
+	"github.com/tktanisha/booking_system/internal/repository/room_unit_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var ErrUnitAccessDenied = errors.New("you are not allowed to manage this hotel's room units")
+
+type RoomUnitService struct {
+	UnitRepo     room_unit_repo.RoomUnitRepoInterface
+	RoomRepo     room_repo.RoomRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewRoomUnitService(unitRepo room_unit_repo.RoomUnitRepoInterface, roomRepo room_repo.RoomRepoInterface, hotelService hotel_service.HotelServiceInterface) *RoomUnitService {
+	return &RoomUnitService{
+		UnitRepo:     unitRepo,
+		RoomRepo:     roomRepo,
+		HotelService: hotelService,
+	}
+}
+
+// CreateUnit adds a physical room to an existing category and re-derives the category's availability.
+func (s *RoomUnitService) CreateUnit(userCtx *models.UserContext, payload *payloads.CreateRoomUnitPayload) (*models.RoomUnits, error) {
+	if err := s.checkHotelManager(userCtx, payload.HotelID); err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.RoomRepo.GetAllRoomByHotelID(payload.HotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var category *models.Rooms
+	for _, currentRoom := range rooms {
+		if currentRoom.RoomCategory == payload.RoomType {
+			category = currentRoom
+			break
+		}
+	}
+	if category == nil {
+		return nil, fmt.Errorf("%w: the hotel has no %s rooms", room_type_repo.ErrRoomTypeNotFound, payload.RoomType)
+	}
+
+	unit := &models.RoomUnits{
+		Id:           uuid.New(),
+		RoomId:       category.Id,
+		HotelId:      payload.HotelID,
+		RoomCategory: category.RoomCategory,
+		Number:       payload.Number,
+		Floor:        payload.Floor,
+		Status:       room.UnitActive,
+		Cleanliness:  room.CleanlinessInspected,
+		CreatedAt:    time.Now(),
+	}
+
+	unit, err = s.UnitRepo.CreateUnit(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.RoomRepo.SyncAvailabilityFromUnits(category.Id); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+func (s *RoomUnitService) GetUnitsByHotelID(hotelID uuid.UUID) ([]*models.RoomUnits, error) {
+	return s.UnitRepo.GetUnitsByHotelID(hotelID)
+}
+
+// UpdateUnitStatus takes a unit in or out of service and re-derives the category's availability.
+func (s *RoomUnitService) UpdateUnitStatus(userCtx *models.UserContext, unitId uuid.UUID, status room.UnitStatus) (*models.RoomUnits, error) {
+	unit, err := s.UnitRepo.GetUnitById(unitId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkHotelManager(userCtx, unit.HotelId); err != nil {
+		return nil, err
+	}
+
+	if err := s.UnitRepo.UpdateUnitStatus(unitId, status); err != nil {
+		return nil, err
+	}
+	unit.Status = status
+
+	if err := s.RoomRepo.SyncAvailabilityFromUnits(unit.RoomId); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+// PickUnits picks a free unit for every room in the booking, honouring requestedUnitIds first,
+// and returns the assignments with the unit of each. Nothing is written; the caller saves the
+// assignments with the check-in. Categories that have no units configured are skipped so hotels
+// can adopt units gradually.
+func (s *RoomUnitService) PickUnits(hotelID uuid.UUID, bookedRooms []*models.BookedRooms, requestedUnitIds []uuid.UUID) ([]*models.BookedRoomUnits, []*models.RoomUnits, error) {
+	units, err := s.UnitRepo.GetUnitsByHotelID(hotelID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	categoriesWithUnits := make(map[room.RoomType]bool)
+	for _, unit := range units {
+		categoriesWithUnits[unit.RoomCategory] = true
+	}
+
+	requested := make(map[uuid.UUID]bool)
+	for _, unitId := range requestedUnitIds {
+		requested[unitId] = true
+	}
+
+	type pick struct {
+		bookedRoomId uuid.UUID
+		unit         *models.RoomUnits
+	}
+	var picks []pick
+	used := make(map[uuid.UUID]bool)
+
+	for _, bookedRoom := range bookedRooms {
+		if !categoriesWithUnits[bookedRoom.RoomType] {
+			continue
+		}
+
+		free, err := s.UnitRepo.GetFreeUnits(hotelID, bookedRoom.RoomType)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var preferred, others []*models.RoomUnits
+		for _, unit := range free {
+			if used[unit.Id] {
+				continue
+			}
+			if requested[unit.Id] {
+				preferred = append(preferred, unit)
+			} else {
+				others = append(others, unit)
+			}
+		}
+
+		candidates := append(preferred, others...)
+		if len(candidates) < bookedRoom.RoomQuantity {
+			return nil, nil, fmt.Errorf("not enough free %s units to check in", bookedRoom.RoomType)
+		}
+
+		for _, unit := range candidates[:bookedRoom.RoomQuantity] {
+			used[unit.Id] = true
+			picks = append(picks, pick{bookedRoomId: bookedRoom.Id, unit: unit})
+		}
+	}
+
+	for unitId := range requested {
+		if !used[unitId] {
+			return nil, nil, fmt.Errorf("unit %s cannot be assigned to this booking", unitId)
+		}
+	}
+
+	assignments := make([]*models.BookedRoomUnits, 0, len(picks))
+	assigned := make([]*models.RoomUnits, 0, len(picks))
+	for _, p := range picks {
+		assignments = append(assignments, &models.BookedRoomUnits{
+			Id:           uuid.New(),
+			BookedRoomId: p.bookedRoomId,
+			RoomUnitId:   p.unit.Id,
+			CreatedAt:    time.Now(),
+		})
+		assigned = append(assigned, p.unit)
+	}
+	return assignments, assigned, nil
+}
+
+// checkHotelManager refuses the units of a hotel to anyone but its manager, since
+// they decide how many of its rooms can be sold.
+func (s *RoomUnitService) checkHotelManager(userCtx *models.UserContext, hotelID uuid.UUID) error {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrUnitAccessDenied
+	}
+	return nil
+}
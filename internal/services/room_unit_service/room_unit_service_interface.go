@@ -0,0 +1,17 @@
+package room_unit_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=room_unit_service_interface.go -destination=../../mocks/mock_room_unit_service.go -package=mocks
+
+type RoomUnitServiceInterface interface {
+	CreateUnit(*models.UserContext, *payloads.CreateRoomUnitPayload) (*models.RoomUnits, error)
+	GetUnitsByHotelID(hotelID uuid.UUID) ([]*models.RoomUnits, error)
+	UpdateUnitStatus(userCtx *models.UserContext, unitId uuid.UUID, status room.UnitStatus) (*models.RoomUnits, error)
+	PickUnits(hotelID uuid.UUID, bookedRooms []*models.BookedRooms, requestedUnitIds []uuid.UUID) ([]*models.BookedRoomUnits, []*models.RoomUnits, error)
+}
@@ -0,0 +1,252 @@
+package room_unit_service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/services/room_unit_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestRoomUnitService_CreateUnit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	mockRoomRepo := mocks.NewMockRoomRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_unit_service.NewRoomUnitService(mockUnitRepo, mockRoomRepo, mockHotelService)
+
+	hotelID := uuid.New()
+	roomID := uuid.New()
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	payload := &payloads.CreateRoomUnitPayload{HotelID: hotelID, RoomType: room.Double, Number: "201", Floor: 2}
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "unit created and availability synced",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil)
+				mockRoomRepo.EXPECT().GetAllRoomByHotelID(hotelID).
+					Return([]*models.Rooms{{Id: roomID, HotelId: hotelID, RoomCategory: room.Double}}, nil)
+				mockUnitRepo.EXPECT().CreateUnit(gomock.Any()).DoAndReturn(func(u *models.RoomUnits) (*models.RoomUnits, error) {
+					if u.RoomId != roomID || u.Status != room.UnitActive {
+						t.Errorf("unexpected unit %+v", u)
+					}
+					return u, nil
+				})
+				mockRoomRepo.EXPECT().SyncAvailabilityFromUnits(roomID).Return(nil)
+			},
+		},
+		{
+			name: "another hotel's manager",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "room type not configured",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil)
+				mockRoomRepo.EXPECT().GetAllRoomByHotelID(hotelID).
+					Return([]*models.Rooms{{Id: roomID, HotelId: hotelID, RoomCategory: room.Single}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "create fails",
+			mockSetup: func() {
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil)
+				mockRoomRepo.EXPECT().GetAllRoomByHotelID(hotelID).
+					Return([]*models.Rooms{{Id: roomID, HotelId: hotelID, RoomCategory: room.Double}}, nil)
+				mockUnitRepo.EXPECT().CreateUnit(gomock.Any()).Return(nil, errors.New("duplicate number"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			_, err := svc.CreateUnit(managerCtx, payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRoomUnitService_UpdateUnitStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	mockRoomRepo := mocks.NewMockRoomRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_unit_service.NewRoomUnitService(mockUnitRepo, mockRoomRepo, mockHotelService)
+
+	managerCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	unitID := uuid.New()
+	roomID := uuid.New()
+
+	tests := []struct {
+		name      string
+		mockSetup func()
+		wantErr   bool
+	}{
+		{
+			name: "unit taken out of order",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, HotelId: hotelID, RoomId: roomID, Status: room.UnitActive}, nil)
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil)
+				mockUnitRepo.EXPECT().UpdateUnitStatus(unitID, room.UnitOutOfOrder).Return(nil)
+				mockRoomRepo.EXPECT().SyncAvailabilityFromUnits(roomID).Return(nil)
+			},
+		},
+		{
+			name: "unit not found",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(nil, errors.New("room unit not found"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "another hotel's manager",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, HotelId: hotelID, RoomId: roomID}, nil)
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "sync fails",
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitById(unitID).Return(&models.RoomUnits{Id: unitID, HotelId: hotelID, RoomId: roomID}, nil)
+				mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: managerCtx.Id}, nil)
+				mockUnitRepo.EXPECT().UpdateUnitStatus(unitID, room.UnitOutOfOrder).Return(nil)
+				mockRoomRepo.EXPECT().SyncAvailabilityFromUnits(roomID).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			unit, err := svc.UpdateUnitStatus(managerCtx, unitID, room.UnitOutOfOrder)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if !tt.wantErr && unit.Status != room.UnitOutOfOrder {
+				t.Errorf("expected status out_of_order, got %q", unit.Status)
+			}
+		})
+	}
+}
+
+func TestRoomUnitService_PickUnits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUnitRepo := mocks.NewMockRoomUnitRepoInterface(ctrl)
+	mockRoomRepo := mocks.NewMockRoomRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := room_unit_service.NewRoomUnitService(mockUnitRepo, mockRoomRepo, mockHotelService)
+
+	hotelID := uuid.New()
+	unit101 := &models.RoomUnits{Id: uuid.New(), HotelId: hotelID, RoomCategory: room.Single, Number: "101"}
+	unit102 := &models.RoomUnits{Id: uuid.New(), HotelId: hotelID, RoomCategory: room.Single, Number: "102"}
+	singleRoom := &models.BookedRooms{Id: uuid.New(), RoomType: room.Single, RoomQuantity: 1}
+	suiteRoom := &models.BookedRooms{Id: uuid.New(), RoomType: room.Suite, RoomQuantity: 1}
+
+	tests := []struct {
+		name        string
+		bookedRooms []*models.BookedRooms
+		requested   []uuid.UUID
+		mockSetup   func()
+		wantNumbers []string
+		wantErr     bool
+	}{
+		{
+			name:        "first free unit assigned automatically",
+			bookedRooms: []*models.BookedRooms{singleRoom},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByHotelID(hotelID).Return([]*models.RoomUnits{unit101, unit102}, nil)
+				mockUnitRepo.EXPECT().GetFreeUnits(hotelID, room.Single).Return([]*models.RoomUnits{unit101, unit102}, nil)
+			},
+			wantNumbers: []string{"101"},
+		},
+		{
+			name:        "requested unit preferred",
+			bookedRooms: []*models.BookedRooms{singleRoom},
+			requested:   []uuid.UUID{unit102.Id},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByHotelID(hotelID).Return([]*models.RoomUnits{unit101, unit102}, nil)
+				mockUnitRepo.EXPECT().GetFreeUnits(hotelID, room.Single).Return([]*models.RoomUnits{unit101, unit102}, nil)
+			},
+			wantNumbers: []string{"102"},
+		},
+		{
+			name:        "categories without units are skipped",
+			bookedRooms: []*models.BookedRooms{suiteRoom},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByHotelID(hotelID).Return([]*models.RoomUnits{unit101}, nil)
+			},
+			wantNumbers: []string{},
+		},
+		{
+			name:        "not enough free units",
+			bookedRooms: []*models.BookedRooms{{Id: uuid.New(), RoomType: room.Single, RoomQuantity: 2}},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByHotelID(hotelID).Return([]*models.RoomUnits{unit101, unit102}, nil)
+				mockUnitRepo.EXPECT().GetFreeUnits(hotelID, room.Single).Return([]*models.RoomUnits{unit101}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:        "requested unit not usable",
+			bookedRooms: []*models.BookedRooms{singleRoom},
+			requested:   []uuid.UUID{uuid.New()},
+			mockSetup: func() {
+				mockUnitRepo.EXPECT().GetUnitsByHotelID(hotelID).Return([]*models.RoomUnits{unit101}, nil)
+				mockUnitRepo.EXPECT().GetFreeUnits(hotelID, room.Single).Return([]*models.RoomUnits{unit101}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+			assignments, units, err := svc.PickUnits(hotelID, tt.bookedRooms, tt.requested)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error: %v, got: %v", tt.wantErr, err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(units) != len(tt.wantNumbers) || len(assignments) != len(units) {
+				t.Fatalf("expected %d units and assignments, got %d and %d", len(tt.wantNumbers), len(units), len(assignments))
+			}
+			for i, unit := range units {
+				if unit.Number != tt.wantNumbers[i] {
+					t.Errorf("expected unit %s, got %s", tt.wantNumbers[i], unit.Number)
+				}
+				if assignments[i].RoomUnitId != unit.Id || assignments[i].BookedRoomId != tt.bookedRooms[0].Id {
+					t.Errorf("unexpected assignment %+v for unit %s", assignments[i], unit.Number)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package stay_restriction_service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/stay_restriction_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// ViolationError is returned by CheckStay when a stay breaks a restriction.
+type ViolationError struct {
+	Code     restriction.ViolationCode
+	RoomType room.RoomType
+	Date     time.Time
+	Message  string
+}
+
+func (e *ViolationError) Error() string {
+	return e.Message
+}
+
+var ErrRestrictionAccessDenied = errors.New("you are not allowed to manage this hotel's stay restrictions")
+
+type StayRestrictionService struct {
+	RestrictionRepo stay_restriction_repo.StayRestrictionRepoInterface
+	HotelService    hotel_service.HotelServiceInterface
+}
+
+func NewStayRestrictionService(restrictionRepo stay_restriction_repo.StayRestrictionRepoInterface, hotelService hotel_service.HotelServiceInterface) *StayRestrictionService {
+	return &StayRestrictionService{
+		RestrictionRepo: restrictionRepo,
+		HotelService:    hotelService,
+	}
+}
+
+// CreateRestriction adds a restriction to a hotel. Only the hotel's manager may add one.
+func (s *StayRestrictionService) CreateRestriction(userCtx *models.UserContext, payload *payloads.StayRestrictionPayload) (*models.StayRestrictions, error) {
+	if err := s.checkManager(userCtx, payload.HotelId); err != nil {
+		return nil, err
+	}
+
+	restriction := &models.StayRestrictions{
+		Id:                uuid.New(),
+		HotelId:           payload.HotelId,
+		RoomCategory:      payload.RoomCategory,
+		StartDate:         dateOf(payload.StartDate),
+		EndDate:           dateOf(payload.EndDate),
+		DaysOfWeek:        payload.DaysOfWeek,
+		MinLengthOfStay:   payload.MinLengthOfStay,
+		MaxLengthOfStay:   payload.MaxLengthOfStay,
+		ClosedToArrival:   payload.ClosedToArrival,
+		ClosedToDeparture: payload.ClosedToDeparture,
+		StopSell:          payload.StopSell,
+		CreatedBy:         userCtx.Id,
+		CreatedAt:         time.Now(),
+	}
+	return s.RestrictionRepo.CreateRestriction(restriction)
+}
+
+func (s *StayRestrictionService) GetRestrictionsByHotelID(hotelID uuid.UUID) ([]*models.StayRestrictions, error) {
+	return s.RestrictionRepo.GetRestrictionsByHotelID(hotelID)
+}
+
+// DeleteRestriction removes a restriction. Only the manager of its hotel may remove it.
+func (s *StayRestrictionService) DeleteRestriction(userCtx *models.UserContext, restrictionId uuid.UUID) error {
+	restriction, err := s.RestrictionRepo.GetRestrictionById(restrictionId)
+	if err != nil {
+		return err
+	}
+	if err := s.checkManager(userCtx, restriction.HotelId); err != nil {
+		return err
+	}
+	return s.RestrictionRepo.DeleteRestriction(restrictionId)
+}
+
+func (s *StayRestrictionService) checkManager(userCtx *models.UserContext, hotelID uuid.UUID) error {
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrRestrictionAccessDenied
+	}
+	return nil
+}
+
+// CheckStay returns a *ViolationError for the first restriction the stay breaks.
+// Stop-sell applies to every night of the stay; closed to arrival and the length
+// of stay limits are taken from the arrival date, closed to departure from the
+// departure date.
+func (s *StayRestrictionService) CheckStay(hotelID uuid.UUID, roomType room.RoomType, checkIn, checkOut time.Time) error {
+	arrival := dateOf(checkIn)
+	departure := dateOf(checkOut)
+
+	restrictions, err := s.RestrictionRepo.GetRestrictionsForStay(hotelID, roomType, arrival, departure)
+	if err != nil {
+		return err
+	}
+	if len(restrictions) == 0 {
+		return nil
+	}
+
+	nights := utils.CountNights(checkIn, checkOut)
+	violation := func(code restriction.ViolationCode, date time.Time, format string, args ...any) error {
+		return &ViolationError{Code: code, RoomType: roomType, Date: date, Message: fmt.Sprintf(format, args...)}
+	}
+
+	for night := arrival; night.Before(departure); night = night.AddDate(0, 0, 1) {
+		for _, r := range restrictions {
+			if r.StopSell && appliesOn(r, night) {
+				return violation(restriction.StopSell, night, "%s rooms are not sold for the night of %s", roomType, night.Format(time.DateOnly))
+			}
+		}
+	}
+
+	for _, r := range restrictions {
+		if !appliesOn(r, arrival) {
+			continue
+		}
+		switch {
+		case r.ClosedToArrival:
+			return violation(restriction.ClosedToArrival, arrival, "%s rooms are closed to arrival on %s", roomType, arrival.Format(time.DateOnly))
+		case r.MinLengthOfStay > 0 && nights < r.MinLengthOfStay:
+			return violation(restriction.MinLengthOfStay, arrival, "%s stays arriving on %s require at least %d nights", roomType, arrival.Format(time.DateOnly), r.MinLengthOfStay)
+		case r.MaxLengthOfStay > 0 && nights > r.MaxLengthOfStay:
+			return violation(restriction.MaxLengthOfStay, arrival, "%s stays arriving on %s are limited to %d nights", roomType, arrival.Format(time.DateOnly), r.MaxLengthOfStay)
+		}
+	}
+
+	for _, r := range restrictions {
+		if r.ClosedToDeparture && appliesOn(r, departure) {
+			return violation(restriction.ClosedToDeparture, departure, "%s rooms are closed to departure on %s", roomType, departure.Format(time.DateOnly))
+		}
+	}
+
+	return nil
+}
+
+func appliesOn(r *models.StayRestrictions, day time.Time) bool {
+	if day.Before(dateOf(r.StartDate)) || day.After(dateOf(r.EndDate)) {
+		return false
+	}
+	return len(r.DaysOfWeek) == 0 || slices.Contains(r.DaysOfWeek, int(day.Weekday()))
+}
+
+// dateOf drops the clock so stays and restrictions are compared by calendar date.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,19 @@
+package stay_restriction_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=stay_restriction_service_interface.go -destination=../../mocks/mock_stay_restriction_service.go -package=mocks
+
+type StayRestrictionServiceInterface interface {
+	CreateRestriction(*models.UserContext, *payloads.StayRestrictionPayload) (*models.StayRestrictions, error)
+	GetRestrictionsByHotelID(hotelID uuid.UUID) ([]*models.StayRestrictions, error)
+	DeleteRestriction(userCtx *models.UserContext, restrictionId uuid.UUID) error
+	CheckStay(hotelID uuid.UUID, roomType room.RoomType, checkIn, checkOut time.Time) error
+}
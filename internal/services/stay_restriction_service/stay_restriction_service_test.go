@@ -0,0 +1,247 @@
+package stay_restriction_service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/stay_restriction_repo"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestStayRestrictionService_CreateRestriction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockStayRestrictionRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := stay_restriction_service.NewStayRestrictionService(mockRepo, mockHotelService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	payload := &payloads.StayRestrictionPayload{
+		HotelId:         hotelID,
+		StartDate:       time.Date(2026, 10, 1, 15, 30, 0, 0, time.UTC),
+		EndDate:         time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+		DaysOfWeek:      []int{5},
+		MinLengthOfStay: 2,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id}, nil)
+		mockRepo.EXPECT().CreateRestriction(gomock.Any()).DoAndReturn(
+			func(r *models.StayRestrictions) (*models.StayRestrictions, error) {
+				return r, nil
+			})
+
+		restriction, err := svc.CreateRestriction(userCtx, payload)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if restriction.HotelId != payload.HotelId || restriction.MinLengthOfStay != 2 || restriction.CreatedBy != userCtx.Id {
+			t.Errorf("restriction not built from payload: %+v", restriction)
+		}
+		if restriction.StartDate.Hour() != 0 || restriction.StartDate.Minute() != 0 {
+			t.Errorf("expected start date without time of day, got %v", restriction.StartDate)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+
+		if _, err := svc.CreateRestriction(userCtx, payload); !errors.Is(err, stay_restriction_service.ErrRestrictionAccessDenied) {
+			t.Errorf("expected access denied, got %v", err)
+		}
+	})
+
+	t.Run("repo error", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id}, nil)
+		mockRepo.EXPECT().CreateRestriction(gomock.Any()).Return(nil, errors.New("db error"))
+
+		if _, err := svc.CreateRestriction(userCtx, payload); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
+
+func TestStayRestrictionService_DeleteRestriction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockStayRestrictionRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := stay_restriction_service.NewStayRestrictionService(mockRepo, mockHotelService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	restrictionID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetRestrictionById(restrictionID).Return(&models.StayRestrictions{Id: restrictionID, HotelId: hotelID}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id}, nil)
+		mockRepo.EXPECT().DeleteRestriction(restrictionID).Return(nil)
+
+		if err := svc.DeleteRestriction(userCtx, restrictionID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		mockRepo.EXPECT().GetRestrictionById(restrictionID).Return(&models.StayRestrictions{Id: restrictionID, HotelId: hotelID}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+
+		if err := svc.DeleteRestriction(userCtx, restrictionID); !errors.Is(err, stay_restriction_service.ErrRestrictionAccessDenied) {
+			t.Errorf("expected access denied, got %v", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetRestrictionById(restrictionID).Return(nil, stay_restriction_repo.ErrRestrictionNotFound)
+
+		if err := svc.DeleteRestriction(userCtx, restrictionID); !errors.Is(err, stay_restriction_repo.ErrRestrictionNotFound) {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+}
+
+func TestStayRestrictionService_CheckStay(t *testing.T) {
+	hotelID := uuid.New()
+	friday := time.Date(2026, 10, 23, 14, 0, 0, 0, time.UTC)
+	day := func(offset int) time.Time { return time.Date(2026, 10, 23+offset, 0, 0, 0, 0, time.UTC) }
+	season := func(r models.StayRestrictions) *models.StayRestrictions {
+		r.HotelId = hotelID
+		r.StartDate = day(-30)
+		r.EndDate = day(30)
+		return &r
+	}
+
+	tests := []struct {
+		name         string
+		restrictions []*models.StayRestrictions
+		repoErr      error
+		checkIn      time.Time
+		checkOut     time.Time
+		wantCode     restriction.ViolationCode
+		wantErr      bool
+	}{
+		{
+			name:     "no restrictions",
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 1),
+		},
+		{
+			name:         "friday minimum stay met",
+			restrictions: []*models.StayRestrictions{season(models.StayRestrictions{DaysOfWeek: []int{5}, MinLengthOfStay: 2})},
+			checkIn:      friday,
+			checkOut:     friday.AddDate(0, 0, 2),
+		},
+		{
+			name:         "friday minimum stay broken",
+			restrictions: []*models.StayRestrictions{season(models.StayRestrictions{DaysOfWeek: []int{5}, MinLengthOfStay: 2})},
+			checkIn:      friday,
+			checkOut:     friday.AddDate(0, 0, 1),
+			wantCode:     restriction.MinLengthOfStay,
+		},
+		{
+			name:         "friday minimum stay ignored on saturday arrival",
+			restrictions: []*models.StayRestrictions{season(models.StayRestrictions{DaysOfWeek: []int{5}, MinLengthOfStay: 2})},
+			checkIn:      friday.AddDate(0, 0, 1),
+			checkOut:     friday.AddDate(0, 0, 2),
+		},
+		{
+			name:         "maximum stay exceeded",
+			restrictions: []*models.StayRestrictions{season(models.StayRestrictions{MaxLengthOfStay: 3})},
+			checkIn:      friday,
+			checkOut:     friday.AddDate(0, 0, 4),
+			wantCode:     restriction.MaxLengthOfStay,
+		},
+		{
+			name: "closed to arrival on a single day",
+			restrictions: []*models.StayRestrictions{
+				{HotelId: hotelID, StartDate: day(0), EndDate: day(0), ClosedToArrival: true},
+			},
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 2),
+			wantCode: restriction.ClosedToArrival,
+		},
+		{
+			name: "closed to arrival does not block stays through the day",
+			restrictions: []*models.StayRestrictions{
+				{HotelId: hotelID, StartDate: day(1), EndDate: day(1), ClosedToArrival: true},
+			},
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 3),
+		},
+		{
+			name: "closed to departure",
+			restrictions: []*models.StayRestrictions{
+				{HotelId: hotelID, StartDate: day(2), EndDate: day(2), ClosedToDeparture: true},
+			},
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 2),
+			wantCode: restriction.ClosedToDeparture,
+		},
+		{
+			name: "stop sell on a night inside the stay",
+			restrictions: []*models.StayRestrictions{
+				{HotelId: hotelID, StartDate: day(1), EndDate: day(1), StopSell: true},
+			},
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 3),
+			wantCode: restriction.StopSell,
+		},
+		{
+			name: "stop sell on the departure day does not apply",
+			restrictions: []*models.StayRestrictions{
+				{HotelId: hotelID, StartDate: day(2), EndDate: day(2), StopSell: true},
+			},
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 2),
+		},
+		{
+			name:     "repo error",
+			repoErr:  errors.New("db error"),
+			checkIn:  friday,
+			checkOut: friday.AddDate(0, 0, 1),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockStayRestrictionRepoInterface(ctrl)
+			svc := stay_restriction_service.NewStayRestrictionService(mockRepo, mocks.NewMockHotelServiceInterface(ctrl))
+
+			mockRepo.EXPECT().GetRestrictionsForStay(hotelID, room.Double, gomock.Any(), gomock.Any()).Return(tt.restrictions, tt.repoErr)
+
+			err := svc.CheckStay(hotelID, room.Double, tt.checkIn, tt.checkOut)
+
+			var violation *stay_restriction_service.ViolationError
+			switch {
+			case tt.wantErr:
+				if err == nil || errors.As(err, &violation) {
+					t.Errorf("expected plain error, got %v", err)
+				}
+			case tt.wantCode != "":
+				if !errors.As(err, &violation) {
+					t.Fatalf("expected violation %s, got %v", tt.wantCode, err)
+				}
+				if violation.Code != tt.wantCode {
+					t.Errorf("expected code %s, got %s", tt.wantCode, violation.Code)
+				}
+			case err != nil:
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
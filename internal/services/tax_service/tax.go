@@ -0,0 +1,128 @@
+package tax_service
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/tax_rule_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var ErrTaxRuleAccessDenied = errors.New("you are not allowed to manage this hotel's tax rules")
+
+type TaxService struct {
+	TaxRuleRepo  tax_rule_repo.TaxRuleRepoInterface
+	HotelService hotel_service.HotelServiceInterface
+}
+
+func NewTaxService(taxRuleRepo tax_rule_repo.TaxRuleRepoInterface, hotelService hotel_service.HotelServiceInterface) *TaxService {
+	return &TaxService{
+		TaxRuleRepo:  taxRuleRepo,
+		HotelService: hotelService,
+	}
+}
+
+func (t *TaxService) CreateTaxRule(userCtx *models.UserContext, payload *payloads.CreateTaxRulePayload) (*models.TaxRules, error) {
+	hotel, err := t.HotelService.GetHotelByID(payload.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return nil, ErrTaxRuleAccessDenied
+	}
+
+	rule := &models.TaxRules{
+		Id:         uuid.New(),
+		HotelId:    payload.HotelId,
+		Name:       payload.Name,
+		ChargeType: payload.ChargeType,
+		Value:      currency.ToMinorUnits(payload.Value, hotel.Currency),
+		Inclusive:  payload.Inclusive,
+		CreatedAt:  time.Now(),
+	}
+	if payload.ChargeType == tax.Percentage {
+		rule.Value = toBasisPoints(payload.Value)
+	}
+	return t.TaxRuleRepo.CreateTaxRule(rule)
+}
+
+func (t *TaxService) GetTaxRulesByHotelID(hotelID uuid.UUID) ([]*models.TaxRules, error) {
+	return t.TaxRuleRepo.GetTaxRulesByHotelID(hotelID)
+}
+
+func (t *TaxService) DeleteTaxRule(userCtx *models.UserContext, taxRuleId uuid.UUID) error {
+	rule, err := t.TaxRuleRepo.GetTaxRuleById(taxRuleId)
+	if err != nil {
+		return err
+	}
+	hotel, err := t.HotelService.GetHotelByID(rule.HotelId)
+	if err != nil {
+		return err
+	}
+	if hotel.ManagerId != userCtx.Id {
+		return ErrTaxRuleAccessDenied
+	}
+	return t.TaxRuleRepo.DeleteTaxRule(taxRuleId)
+}
+
+// CalculateTaxes itemizes the hotel's taxes and fees for a stay. Percentage rules
+// apply to roomCharge, the room total after discounts; an inclusive percentage is
+// the share of roomCharge that is already tax.
+func (t *TaxService) CalculateTaxes(hotelId, bookingId uuid.UUID, bookedRooms []*models.BookedRooms, nights int, roomCharge int64) ([]*models.BookingTaxes, error) {
+	rules, err := t.TaxRuleRepo.GetTaxRulesByHotelID(hotelId)
+	if err != nil {
+		return nil, err
+	}
+
+	var rooms, guests int64
+	for _, bookedRoom := range bookedRooms {
+		rooms += int64(bookedRoom.RoomQuantity)
+		guests += int64((bookedRoom.Adults + bookedRoom.Children) * bookedRoom.RoomQuantity)
+	}
+
+	taxes := make([]*models.BookingTaxes, 0, len(rules))
+	for _, rule := range rules {
+		var amount int64
+		switch rule.ChargeType {
+		case tax.PerNight:
+			amount = rule.Value * rooms * int64(nights)
+		case tax.PerPerson:
+			amount = rule.Value * guests * int64(nights)
+		case tax.Percentage:
+			if rule.Inclusive {
+				amount = divRound(roomCharge*rule.Value, 10000+rule.Value)
+			} else {
+				amount = divRound(roomCharge*rule.Value, 10000)
+			}
+		}
+
+		ruleId := rule.Id
+		taxes = append(taxes, &models.BookingTaxes{
+			Id:         uuid.New(),
+			BookingId:  bookingId,
+			TaxRuleId:  &ruleId,
+			Name:       rule.Name,
+			ChargeType: rule.ChargeType,
+			Inclusive:  rule.Inclusive,
+			Amount:     amount,
+			CreatedAt:  time.Now(),
+		})
+	}
+	return taxes, nil
+}
+
+// toBasisPoints stores a percentage such as 12.5 as 1250.
+func toBasisPoints(percent float64) int64 {
+	return int64(math.Round(percent * 100))
+}
+
+// divRound divides non-negative amounts, rounding half up.
+func divRound(a, b int64) int64 {
+	return (a + b/2) / b
+}
@@ -0,0 +1,16 @@
+package tax_service
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=tax_service_interface.go -destination=../../mocks/mock_tax_service.go -package=mocks
+
+type TaxServiceInterface interface {
+	CreateTaxRule(*models.UserContext, *payloads.CreateTaxRulePayload) (*models.TaxRules, error)
+	GetTaxRulesByHotelID(hotelID uuid.UUID) ([]*models.TaxRules, error)
+	DeleteTaxRule(userCtx *models.UserContext, taxRuleId uuid.UUID) error
+	CalculateTaxes(hotelId, bookingId uuid.UUID, bookedRooms []*models.BookedRooms, nights int, roomCharge int64) ([]*models.BookingTaxes, error)
+}
@@ -0,0 +1,172 @@
+package tax_service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/tax_rule_repo"
+	"github.com/tktanisha/booking_system/internal/services/tax_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestTaxService_CreateTaxRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaxRuleRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := tax_service.NewTaxService(mockRepo, mockHotelService)
+	userCtx := &models.UserContext{Id: uuid.New()}
+
+	tests := []struct {
+		name      string
+		currency  string
+		payload   *payloads.CreateTaxRulePayload
+		wantValue int64
+	}{
+		{"percentage stored as basis points", "JPY", &payloads.CreateTaxRulePayload{Name: "VAT", ChargeType: tax.Percentage, Value: 12.5}, 1250},
+		{"fee stored in minor units", "USD", &payloads.CreateTaxRulePayload{Name: "City tax", ChargeType: tax.PerPerson, Value: 2.75}, 275},
+		{"fee in a zero-decimal currency", "JPY", &payloads.CreateTaxRulePayload{Name: "Bath tax", ChargeType: tax.PerPerson, Value: 150}, 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockHotelService.EXPECT().GetHotelByID(tt.payload.HotelId).Return(&models.Hotels{ManagerId: userCtx.Id, Currency: tt.currency}, nil)
+			mockRepo.EXPECT().CreateTaxRule(gomock.Any()).DoAndReturn(
+				func(r *models.TaxRules) (*models.TaxRules, error) {
+					return r, nil
+				})
+
+			rule, err := svc.CreateTaxRule(userCtx, tt.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rule.Value != tt.wantValue || rule.Name != tt.payload.Name {
+				t.Errorf("tax rule not built from payload: %+v", rule)
+			}
+		})
+	}
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(&models.Hotels{ManagerId: uuid.New(), Currency: "USD"}, nil)
+		_, err := svc.CreateTaxRule(userCtx, &payloads.CreateTaxRulePayload{Name: "VAT", ChargeType: tax.Percentage, Value: 10})
+		if !errors.Is(err, tax_service.ErrTaxRuleAccessDenied) {
+			t.Errorf("expected access denied, got %v", err)
+		}
+	})
+
+	t.Run("unknown hotel", func(t *testing.T) {
+		mockHotelService.EXPECT().GetHotelByID(gomock.Any()).Return(nil, errors.New("hotel not found"))
+		if _, err := svc.CreateTaxRule(userCtx, &payloads.CreateTaxRulePayload{Name: "VAT", ChargeType: tax.Percentage, Value: 10}); err == nil {
+			t.Errorf("expected error for an unknown hotel")
+		}
+	})
+}
+
+func TestTaxService_DeleteTaxRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaxRuleRepoInterface(ctrl)
+	mockHotelService := mocks.NewMockHotelServiceInterface(ctrl)
+	svc := tax_service.NewTaxService(mockRepo, mockHotelService)
+
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+	taxRuleID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo.EXPECT().GetTaxRuleById(taxRuleID).Return(&models.TaxRules{Id: taxRuleID, HotelId: hotelID}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: userCtx.Id}, nil)
+		mockRepo.EXPECT().DeleteTaxRule(taxRuleID).Return(nil)
+
+		if err := svc.DeleteTaxRule(userCtx, taxRuleID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("another hotel's manager", func(t *testing.T) {
+		mockRepo.EXPECT().GetTaxRuleById(taxRuleID).Return(&models.TaxRules{Id: taxRuleID, HotelId: hotelID}, nil)
+		mockHotelService.EXPECT().GetHotelByID(hotelID).Return(&models.Hotels{Id: hotelID, ManagerId: uuid.New()}, nil)
+
+		if err := svc.DeleteTaxRule(userCtx, taxRuleID); !errors.Is(err, tax_service.ErrTaxRuleAccessDenied) {
+			t.Errorf("expected access denied, got %v", err)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo.EXPECT().GetTaxRuleById(taxRuleID).Return(nil, tax_rule_repo.ErrTaxRuleNotFound)
+
+		if err := svc.DeleteTaxRule(userCtx, taxRuleID); !errors.Is(err, tax_rule_repo.ErrTaxRuleNotFound) {
+			t.Errorf("expected not found, got %v", err)
+		}
+	})
+}
+
+func TestTaxService_CalculateTaxes(t *testing.T) {
+	hotelID := uuid.New()
+	bookingID := uuid.New()
+	// 2 rooms with 2 adults and 1 child each
+	bookedRooms := []*models.BookedRooms{{RoomQuantity: 2, Adults: 2, Children: 1}}
+
+	tests := []struct {
+		name       string
+		rule       models.TaxRules
+		nights     int
+		roomCharge int64
+		wantAmount int64
+	}{
+		{"per night per room", models.TaxRules{ChargeType: tax.PerNight, Value: 500}, 3, 60000, 3000},
+		{"per person per night", models.TaxRules{ChargeType: tax.PerPerson, Value: 200}, 3, 60000, 3600},
+		{"exclusive percentage", models.TaxRules{ChargeType: tax.Percentage, Value: 1250}, 3, 60000, 7500},
+		{"inclusive percentage is the tax share of the charge", models.TaxRules{ChargeType: tax.Percentage, Value: 2000, Inclusive: true}, 3, 60000, 10000},
+		{"percentage rounds half up", models.TaxRules{ChargeType: tax.Percentage, Value: 1250}, 1, 10001, 1250},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaxRuleRepoInterface(ctrl)
+			svc := tax_service.NewTaxService(mockRepo, mocks.NewMockHotelServiceInterface(ctrl))
+
+			rule := tt.rule
+			rule.Id = uuid.New()
+			rule.Name = "rule"
+			mockRepo.EXPECT().GetTaxRulesByHotelID(hotelID).Return([]*models.TaxRules{&rule}, nil)
+
+			taxes, err := svc.CalculateTaxes(hotelID, bookingID, bookedRooms, tt.nights, tt.roomCharge)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(taxes) != 1 {
+				t.Fatalf("expected one tax line, got %d", len(taxes))
+			}
+			if taxes[0].Amount != tt.wantAmount {
+				t.Errorf("expected amount %d, got %d", tt.wantAmount, taxes[0].Amount)
+			}
+			if taxes[0].BookingId != bookingID || *taxes[0].TaxRuleId != rule.Id || taxes[0].Inclusive != rule.Inclusive {
+				t.Errorf("tax line not linked to booking and rule: %+v", taxes[0])
+			}
+		})
+	}
+
+	t.Run("repo error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockTaxRuleRepoInterface(ctrl)
+		svc := tax_service.NewTaxService(mockRepo, mocks.NewMockHotelServiceInterface(ctrl))
+		mockRepo.EXPECT().GetTaxRulesByHotelID(hotelID).Return(nil, errors.New("db error"))
+
+		if _, err := svc.CalculateTaxes(hotelID, bookingID, bookedRooms, 1, 10000); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	})
+}
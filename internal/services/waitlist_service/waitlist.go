@@ -0,0 +1,261 @@
+package waitlist_service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+	"github.com/tktanisha/booking_system/internal/services/hotel_service"
+	"github.com/tktanisha/booking_system/internal/services/notification_service"
+	"github.com/tktanisha/booking_system/internal/services/room_service"
+	"github.com/tktanisha/booking_system/internal/services/room_type_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// OfferHoldDuration is how long an offered guest has to book before the held
+// rooms pass to the next guest in the queue.
+const OfferHoldDuration = 2 * time.Hour
+
+var (
+	ErrWaitlistAccessDenied = errors.New("you are not allowed to access this waitlist entry")
+	ErrRoomsAvailable       = errors.New("rooms are available for this stay, book them instead")
+	ErrStayInPast           = errors.New("checkin date has already passed at the hotel")
+	ErrEntryClosed          = errors.New("waitlist entry is no longer open")
+	ErrOfferUnavailable     = errors.New("waitlist offer is not open")
+	ErrOfferMismatch        = errors.New("booking does not match the waitlist offer")
+)
+
+type WaitlistService struct {
+	WaitlistRepo        waitlist_repo.WaitlistRepoInterface
+	RoomService         room_service.RoomServiceInterface
+	RoomTypeService     room_type_service.RoomTypeServiceInterface
+	HotelService        hotel_service.HotelServiceInterface
+	NotificationService notification_service.NotificationServiceInterface
+}
+
+func NewWaitlistService(waitlistRepo waitlist_repo.WaitlistRepoInterface, roomService room_service.RoomServiceInterface, roomTypeService room_type_service.RoomTypeServiceInterface, hotelService hotel_service.HotelServiceInterface, notificationService notification_service.NotificationServiceInterface) *WaitlistService {
+	return &WaitlistService{
+		WaitlistRepo:        waitlistRepo,
+		RoomService:         roomService,
+		RoomTypeService:     roomTypeService,
+		HotelService:        hotelService,
+		NotificationService: notificationService,
+	}
+}
+
+// JoinWaitlist queues the guest for rooms that are sold out for the stay.
+// Stays that can be booked right away, or never because of a stay
+// restriction, are turned away.
+func (s *WaitlistService) JoinWaitlist(userCtx *models.UserContext, payload *payloads.WaitlistPayload) (*models.WaitlistEntries, error) {
+	hotel, err := s.HotelService.GetHotelByID(payload.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	if payload.CheckIn.Before(hotel.Today(time.Now())) {
+		return nil, ErrStayInPast
+	}
+	if _, err := s.RoomTypeService.GetRoomType(payload.HotelId, payload.RoomType); err != nil {
+		return nil, err
+	}
+
+	entry := &models.WaitlistEntries{
+		Id:        uuid.New(),
+		HotelId:   payload.HotelId,
+		UserId:    userCtx.Id,
+		RoomType:  payload.RoomType,
+		Quantity:  payload.Quantity,
+		CheckIn:   hotel.CheckInAt(payload.CheckIn.Time),
+		CheckOut:  hotel.CheckOutAt(payload.CheckOut.Time),
+		Status:    waitlist_status.StatusWaiting,
+		CreatedAt: time.Now(),
+	}
+
+	rooms := waitlistRooms(entry)
+	if err := s.RoomService.CheckRestrictions(rooms, entry.HotelId, entry.CheckIn, entry.CheckOut); err != nil {
+		return nil, err
+	}
+	if s.RoomService.IsAvailable(rooms, entry.HotelId, entry.CheckIn, entry.CheckOut) {
+		return nil, ErrRoomsAvailable
+	}
+
+	if err := s.WaitlistRepo.CreateEntry(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetMyWaitlist returns the guest's own waitlist entries, newest first.
+func (s *WaitlistService) GetMyWaitlist(userCtx *models.UserContext) ([]*models.WaitlistEntries, error) {
+	return s.WaitlistRepo.GetEntriesByUserId(userCtx.Id)
+}
+
+// GetHotelWaitlist returns a hotel's waitlist in queue order.
+func (s *WaitlistService) GetHotelWaitlist(hotelID uuid.UUID) ([]*models.WaitlistEntries, error) {
+	return s.WaitlistRepo.GetEntriesByHotelId(hotelID)
+}
+
+// LeaveWaitlist takes the guest off the waitlist. Rooms held by an open offer
+// go to the next guest in the queue.
+func (s *WaitlistService) LeaveWaitlist(userCtx *models.UserContext, entryId uuid.UUID) (*models.WaitlistEntries, error) {
+	entry, err := s.WaitlistRepo.GetEntryById(entryId)
+	if err != nil {
+		return nil, err
+	}
+	if entry.UserId != userCtx.Id {
+		return nil, ErrWaitlistAccessDenied
+	}
+	if entry.Status != waitlist_status.StatusWaiting && entry.Status != waitlist_status.StatusOffered {
+		return nil, ErrEntryClosed
+	}
+
+	err = s.WaitlistRepo.UpdateStatus(entry.Id, entry.Status, waitlist_status.StatusCancelled)
+	if errors.Is(err, waitlist_repo.ErrEntryStatusChanged) {
+		return nil, ErrEntryClosed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	released := entry.Status == waitlist_status.StatusOffered
+	entry.Status = waitlist_status.StatusCancelled
+	if released {
+		// the guest has left either way; a failed offer is retried by the waitlist job
+		_, _ = s.OfferFreedRooms(entry.HotelId, entry.RoomType)
+	}
+	return entry, nil
+}
+
+// OfferFreedRooms walks the queue for a room type, first come first served,
+// and offers rooms to every waiting guest whose stay can now be booked. Guests
+// whose stay still does not fit keep their place. Each offer holds the rooms
+// for OfferHoldDuration and notifies the guest.
+func (s *WaitlistService) OfferFreedRooms(hotelID uuid.UUID, roomType room.RoomType) ([]*models.WaitlistEntries, error) {
+	now := time.Now()
+	waiting, err := s.WaitlistRepo.GetWaitingEntries(hotelID, roomType, now)
+	if err != nil || len(waiting) == 0 {
+		return nil, err
+	}
+
+	hotel, err := s.HotelService.GetHotelByID(hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	offered := make([]*models.WaitlistEntries, 0)
+	for _, entry := range waiting {
+		if !s.RoomService.IsPhysicallyAvailable(waitlistRooms(entry), entry.HotelId, entry.CheckIn, entry.CheckOut) {
+			continue
+		}
+
+		expiresAt := now.Add(OfferHoldDuration)
+		err := s.WaitlistRepo.OfferEntry(entry.Id, now, expiresAt)
+		if errors.Is(err, waitlist_repo.ErrEntryStatusChanged) {
+			continue
+		}
+		if err != nil {
+			return offered, err
+		}
+		entry.Status = waitlist_status.StatusOffered
+		entry.OfferedAt = &now
+		entry.OfferExpiresAt = &expiresAt
+		offered = append(offered, entry)
+
+		if err := s.notifyOffer(hotel, entry); err != nil {
+			return offered, err
+		}
+	}
+	return offered, nil
+}
+
+// ProcessWaitlist expires lapsed offers and stays that have begun, then offers
+// rooms to every queue, which also picks up inventory freed without an offer,
+// such as a deleted block. It returns the new offers.
+func (s *WaitlistService) ProcessWaitlist(now time.Time) ([]*models.WaitlistEntries, error) {
+	if _, err := s.WaitlistRepo.ExpireEntries(now); err != nil {
+		return nil, err
+	}
+
+	queues, err := s.WaitlistRepo.GetWaitingQueues(now)
+	if err != nil {
+		return nil, err
+	}
+
+	offered := make([]*models.WaitlistEntries, 0)
+	for _, queue := range queues {
+		entries, err := s.OfferFreedRooms(queue.HotelId, queue.RoomType)
+		offered = append(offered, entries...)
+		if err != nil {
+			return offered, err
+		}
+	}
+	return offered, nil
+}
+
+// ClaimOffer marks the guest's open offer as booked so its held rooms count as
+// free for the booking being made. The booking must be for the offer's hotel,
+// dates and room type. Call RestoreOffer if the booking is then not created.
+func (s *WaitlistService) ClaimOffer(userCtx *models.UserContext, entryId uuid.UUID, payload *payloads.BookingPayload) error {
+	entry, err := s.WaitlistRepo.GetEntryById(entryId)
+	if err != nil {
+		return err
+	}
+	if entry.UserId != userCtx.Id {
+		return ErrWaitlistAccessDenied
+	}
+	if entry.Status != waitlist_status.StatusOffered || !entry.OfferExpiresAt.After(time.Now()) {
+		return ErrOfferUnavailable
+	}
+
+	hotel, err := s.HotelService.GetHotelByID(entry.HotelId)
+	if err != nil {
+		return err
+	}
+	loc := hotel.Location()
+	if payload.HotelId != entry.HotelId ||
+		payloads.NewDate(entry.CheckIn.In(loc)) != payload.CheckIn ||
+		payloads.NewDate(entry.CheckOut.In(loc)) != payload.CheckOut ||
+		!requestsRoomType(payload.Rooms, entry.RoomType) {
+		return ErrOfferMismatch
+	}
+
+	err = s.WaitlistRepo.UpdateStatus(entry.Id, waitlist_status.StatusOffered, waitlist_status.StatusBooked)
+	if errors.Is(err, waitlist_repo.ErrEntryStatusChanged) {
+		return ErrOfferUnavailable
+	}
+	return err
+}
+
+// RestoreOffer reopens an offer claimed for a booking that was not created.
+// The offer keeps its original expiry.
+func (s *WaitlistService) RestoreOffer(entryId uuid.UUID) error {
+	return s.WaitlistRepo.UpdateStatus(entryId, waitlist_status.StatusBooked, waitlist_status.StatusOffered)
+}
+
+func (s *WaitlistService) notifyOffer(hotel *models.Hotels, entry *models.WaitlistEntries) error {
+	loc := hotel.Location()
+	body := fmt.Sprintf("%d %s room(s) at %s are held for you from %s to %s. Book them with waitlist entry %s before %s.",
+		entry.Quantity, entry.RoomType, hotel.Name,
+		entry.CheckIn.In(loc).Format(payloads.DateLayout), entry.CheckOut.In(loc).Format(payloads.DateLayout),
+		entry.Id, entry.OfferExpiresAt.In(loc).Format("2006-01-02 15:04 MST"))
+	return s.NotificationService.Notify(entry.UserId, "Rooms available from your waitlist", body)
+}
+
+// requestsRoomType reports whether a booking asks for at least one room of roomType.
+func requestsRoomType(rooms []*payloads.RoomPayload, roomType room.RoomType) bool {
+	for _, room := range rooms {
+		if room.RoomType == roomType {
+			return true
+		}
+	}
+	return false
+}
+
+// waitlistRooms is the room request a waitlist entry is waiting to book.
+func waitlistRooms(entry *models.WaitlistEntries) *payloads.RoomPayload {
+	return &payloads.RoomPayload{RoomType: entry.RoomType, Quantity: entry.Quantity}
+}
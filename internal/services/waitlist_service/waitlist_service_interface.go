@@ -0,0 +1,23 @@
+package waitlist_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+//go:generate mockgen -source=waitlist_service_interface.go -destination=../../mocks/mock_waitlist_service.go -package=mocks
+
+type WaitlistServiceInterface interface {
+	JoinWaitlist(userCtx *models.UserContext, payload *payloads.WaitlistPayload) (*models.WaitlistEntries, error)
+	GetMyWaitlist(userCtx *models.UserContext) ([]*models.WaitlistEntries, error)
+	GetHotelWaitlist(hotelID uuid.UUID) ([]*models.WaitlistEntries, error)
+	LeaveWaitlist(userCtx *models.UserContext, entryId uuid.UUID) (*models.WaitlistEntries, error)
+	OfferFreedRooms(hotelID uuid.UUID, roomType room.RoomType) ([]*models.WaitlistEntries, error)
+	ProcessWaitlist(now time.Time) ([]*models.WaitlistEntries, error)
+	ClaimOffer(userCtx *models.UserContext, entryId uuid.UUID, payload *payloads.BookingPayload) error
+	RestoreOffer(entryId uuid.UUID) error
+}
@@ -0,0 +1,316 @@
+package waitlist_service_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/restriction"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	waitlist_status "github.com/tktanisha/booking_system/internal/enums/waitlist"
+	"github.com/tktanisha/booking_system/internal/mocks"
+	"github.com/tktanisha/booking_system/internal/models"
+	"github.com/tktanisha/booking_system/internal/repository/waitlist_repo"
+	"github.com/tktanisha/booking_system/internal/services/stay_restriction_service"
+	"github.com/tktanisha/booking_system/internal/services/waitlist_service"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+type serviceMocks struct {
+	waitlistRepo        *mocks.MockWaitlistRepoInterface
+	roomService         *mocks.MockRoomServiceInterface
+	roomTypeService     *mocks.MockRoomTypeServiceInterface
+	hotelService        *mocks.MockHotelServiceInterface
+	notificationService *mocks.MockNotificationServiceInterface
+}
+
+func newService(ctrl *gomock.Controller) (*waitlist_service.WaitlistService, serviceMocks) {
+	m := serviceMocks{
+		waitlistRepo:        mocks.NewMockWaitlistRepoInterface(ctrl),
+		roomService:         mocks.NewMockRoomServiceInterface(ctrl),
+		roomTypeService:     mocks.NewMockRoomTypeServiceInterface(ctrl),
+		hotelService:        mocks.NewMockHotelServiceInterface(ctrl),
+		notificationService: mocks.NewMockNotificationServiceInterface(ctrl),
+	}
+	return waitlist_service.NewWaitlistService(m.waitlistRepo, m.roomService, m.roomTypeService, m.hotelService, m.notificationService), m
+}
+
+func newHotel() *models.Hotels {
+	return &models.Hotels{Id: uuid.New(), Name: "Sea View", TimeZone: "Asia/Kolkata", CheckInTime: "14:00", CheckOutTime: "11:00"}
+}
+
+func TestWaitlistService_JoinWaitlist(t *testing.T) {
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotel := newHotel()
+	tomorrow := payloads.NewDate(hotel.Today(time.Now()).AddDate(0, 0, 1))
+	payload := &payloads.WaitlistPayload{HotelId: hotel.Id, RoomType: room.Suite, Quantity: 2,
+		CheckIn: tomorrow, CheckOut: payloads.NewDate(tomorrow.AddDate(0, 0, 2))}
+
+	tests := []struct {
+		name      string
+		payload   *payloads.WaitlistPayload
+		mockSetup func(m serviceMocks)
+		wantErr   error
+		expectErr bool
+	}{
+		{
+			name:    "sold out stay is queued",
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomTypeService.EXPECT().GetRoomType(hotel.Id, room.Suite).Return(&models.RoomTypes{Code: room.Suite}, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(nil)
+				m.roomService.EXPECT().IsAvailable(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(false)
+				m.waitlistRepo.EXPECT().CreateEntry(gomock.Any()).DoAndReturn(func(entry *models.WaitlistEntries) error {
+					if entry.Status != waitlist_status.StatusWaiting || entry.UserId != userCtx.Id || entry.Quantity != 2 {
+						t.Errorf("unexpected entry %+v", entry)
+					}
+					if !entry.CheckIn.Equal(hotel.CheckInAt(payload.CheckIn.Time)) || !entry.CheckOut.Equal(hotel.CheckOutAt(payload.CheckOut.Time)) {
+						t.Errorf("expected the stay in hotel time, got %v to %v", entry.CheckIn, entry.CheckOut)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:    "rooms can be booked right away",
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomTypeService.EXPECT().GetRoomType(hotel.Id, room.Suite).Return(&models.RoomTypes{Code: room.Suite}, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(nil)
+				m.roomService.EXPECT().IsAvailable(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).Return(true)
+			},
+			wantErr:   waitlist_service.ErrRoomsAvailable,
+			expectErr: true,
+		},
+		{
+			name:    "stay that can never be booked",
+			payload: payload,
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.roomTypeService.EXPECT().GetRoomType(hotel.Id, room.Suite).Return(&models.RoomTypes{Code: room.Suite}, nil)
+				m.roomService.EXPECT().CheckRestrictions(gomock.Any(), hotel.Id, gomock.Any(), gomock.Any()).
+					Return(&stay_restriction_service.ViolationError{Code: restriction.MinLengthOfStay, Message: "min 3 nights"})
+			},
+			expectErr: true,
+		},
+		{
+			name: "stay already begun",
+			payload: &payloads.WaitlistPayload{HotelId: hotel.Id, RoomType: room.Suite, Quantity: 1,
+				CheckIn: payloads.NewDate(hotel.Today(time.Now()).AddDate(0, 0, -1)), CheckOut: tomorrow},
+			mockSetup: func(m serviceMocks) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+			},
+			wantErr:   waitlist_service.ErrStayInPast,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, m := newService(ctrl)
+			tt.mockSetup(m)
+
+			_, err := svc.JoinWaitlist(userCtx, tt.payload)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expected error: %v, got: %v", tt.expectErr, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitlistService_LeaveWaitlist(t *testing.T) {
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotelID := uuid.New()
+
+	t.Run("leaving an offer passes the rooms on", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		entry := &models.WaitlistEntries{Id: uuid.New(), HotelId: hotelID, UserId: userCtx.Id, RoomType: room.Double, Status: waitlist_status.StatusOffered}
+		m.waitlistRepo.EXPECT().GetEntryById(entry.Id).Return(entry, nil)
+		m.waitlistRepo.EXPECT().UpdateStatus(entry.Id, waitlist_status.StatusOffered, waitlist_status.StatusCancelled).Return(nil)
+		m.waitlistRepo.EXPECT().GetWaitingEntries(hotelID, room.Double, gomock.Any()).Return(nil, nil)
+
+		left, err := svc.LeaveWaitlist(userCtx, entry.Id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if left.Status != waitlist_status.StatusCancelled {
+			t.Errorf("expected a cancelled entry, got %s", left.Status)
+		}
+	})
+
+	t.Run("someone else's entry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		entry := &models.WaitlistEntries{Id: uuid.New(), UserId: uuid.New(), Status: waitlist_status.StatusWaiting}
+		m.waitlistRepo.EXPECT().GetEntryById(entry.Id).Return(entry, nil)
+
+		if _, err := svc.LeaveWaitlist(userCtx, entry.Id); !errors.Is(err, waitlist_service.ErrWaitlistAccessDenied) {
+			t.Errorf("expected ErrWaitlistAccessDenied, got %v", err)
+		}
+	})
+
+	t.Run("entry already booked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		svc, m := newService(ctrl)
+		entry := &models.WaitlistEntries{Id: uuid.New(), UserId: userCtx.Id, Status: waitlist_status.StatusBooked}
+		m.waitlistRepo.EXPECT().GetEntryById(entry.Id).Return(entry, nil)
+
+		if _, err := svc.LeaveWaitlist(userCtx, entry.Id); !errors.Is(err, waitlist_service.ErrEntryClosed) {
+			t.Errorf("expected ErrEntryClosed, got %v", err)
+		}
+	})
+}
+
+func TestWaitlistService_OfferFreedRooms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	hotel := newHotel()
+	checkIn := time.Now().AddDate(0, 0, 5)
+	tooLong := &models.WaitlistEntries{Id: uuid.New(), HotelId: hotel.Id, UserId: uuid.New(), RoomType: room.Double, Quantity: 1, CheckIn: checkIn, CheckOut: checkIn.AddDate(0, 0, 7)}
+	fits := &models.WaitlistEntries{Id: uuid.New(), HotelId: hotel.Id, UserId: uuid.New(), RoomType: room.Double, Quantity: 1, CheckIn: checkIn, CheckOut: checkIn.AddDate(0, 0, 1)}
+	gone := &models.WaitlistEntries{Id: uuid.New(), HotelId: hotel.Id, UserId: uuid.New(), RoomType: room.Double, Quantity: 1, CheckIn: checkIn, CheckOut: checkIn.AddDate(0, 0, 1)}
+
+	m.waitlistRepo.EXPECT().GetWaitingEntries(hotel.Id, room.Double, gomock.Any()).Return([]*models.WaitlistEntries{tooLong, fits, gone}, nil)
+	m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+	m.roomService.EXPECT().IsPhysicallyAvailable(gomock.Any(), hotel.Id, tooLong.CheckIn, tooLong.CheckOut).Return(false)
+	m.roomService.EXPECT().IsPhysicallyAvailable(gomock.Any(), hotel.Id, fits.CheckIn, fits.CheckOut).Return(true).Times(2)
+	m.waitlistRepo.EXPECT().OfferEntry(fits.Id, gomock.Any(), gomock.Any()).DoAndReturn(func(_ uuid.UUID, offeredAt, expiresAt time.Time) error {
+		if expiresAt.Sub(offeredAt) != waitlist_service.OfferHoldDuration {
+			t.Errorf("expected the offer to be held for %v, got %v", waitlist_service.OfferHoldDuration, expiresAt.Sub(offeredAt))
+		}
+		return nil
+	})
+	m.waitlistRepo.EXPECT().OfferEntry(gone.Id, gomock.Any(), gomock.Any()).Return(waitlist_repo.ErrEntryStatusChanged)
+	m.notificationService.EXPECT().Notify(fits.UserId, gomock.Any(), gomock.Any()).DoAndReturn(func(_ uuid.UUID, _, body string) error {
+		if !strings.Contains(body, fits.Id.String()) || !strings.Contains(body, hotel.Name) {
+			t.Errorf("expected the notification to name the hotel and entry, got %q", body)
+		}
+		return nil
+	})
+
+	offered, err := svc.OfferFreedRooms(hotel.Id, room.Double)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(offered) != 1 || offered[0].Id != fits.Id || offered[0].Status != waitlist_status.StatusOffered || offered[0].OfferExpiresAt == nil {
+		t.Errorf("expected only the fitting entry to be offered, got %+v", offered)
+	}
+}
+
+func TestWaitlistService_ProcessWaitlist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc, m := newService(ctrl)
+	now := time.Now()
+	queue := &models.WaitlistQueue{HotelId: uuid.New(), RoomType: room.Single}
+
+	m.waitlistRepo.EXPECT().ExpireEntries(now).Return([]*models.WaitlistEntries{{}}, nil)
+	m.waitlistRepo.EXPECT().GetWaitingQueues(now).Return([]*models.WaitlistQueue{queue}, nil)
+	m.waitlistRepo.EXPECT().GetWaitingEntries(queue.HotelId, queue.RoomType, gomock.Any()).Return(nil, nil)
+
+	offered, err := svc.ProcessWaitlist(now)
+	if err != nil || len(offered) != 0 {
+		t.Errorf("expected no offers, got %+v %v", offered, err)
+	}
+}
+
+func TestWaitlistService_ClaimOffer(t *testing.T) {
+	userCtx := &models.UserContext{Id: uuid.New()}
+	hotel := newHotel()
+	checkIn := hotel.CheckInAt(hotel.Today(time.Now()).AddDate(0, 0, 3))
+	checkOut := hotel.CheckOutAt(hotel.Today(time.Now()).AddDate(0, 0, 5))
+	open := time.Now().Add(time.Hour)
+	lapsed := time.Now().Add(-time.Minute)
+	payload := &payloads.BookingPayload{
+		HotelId:  hotel.Id,
+		CheckIn:  payloads.NewDate(hotel.Today(time.Now()).AddDate(0, 0, 3)),
+		CheckOut: payloads.NewDate(hotel.Today(time.Now()).AddDate(0, 0, 5)),
+		Rooms:    []*payloads.RoomPayload{{RoomType: room.Suite, Quantity: 1}},
+	}
+	offer := func(expiresAt time.Time) *models.WaitlistEntries {
+		return &models.WaitlistEntries{Id: uuid.New(), HotelId: hotel.Id, UserId: userCtx.Id, RoomType: room.Suite, Quantity: 1,
+			CheckIn: checkIn, CheckOut: checkOut, Status: waitlist_status.StatusOffered, OfferExpiresAt: &expiresAt}
+	}
+
+	tests := []struct {
+		name      string
+		entry     *models.WaitlistEntries
+		payload   *payloads.BookingPayload
+		mockSetup func(m serviceMocks, entry *models.WaitlistEntries)
+		wantErr   error
+	}{
+		{
+			name:    "open offer is claimed",
+			entry:   offer(open),
+			payload: payload,
+			mockSetup: func(m serviceMocks, entry *models.WaitlistEntries) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.waitlistRepo.EXPECT().UpdateStatus(entry.Id, waitlist_status.StatusOffered, waitlist_status.StatusBooked).Return(nil)
+			},
+		},
+		{
+			name:      "offer lapsed",
+			entry:     offer(lapsed),
+			payload:   payload,
+			mockSetup: func(m serviceMocks, entry *models.WaitlistEntries) {},
+			wantErr:   waitlist_service.ErrOfferUnavailable,
+		},
+		{
+			name:  "booking for other dates",
+			entry: offer(open),
+			payload: &payloads.BookingPayload{HotelId: hotel.Id, CheckIn: payload.CheckIn,
+				CheckOut: payloads.NewDate(payload.CheckOut.AddDate(0, 0, 1)), Rooms: payload.Rooms},
+			mockSetup: func(m serviceMocks, entry *models.WaitlistEntries) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+			},
+			wantErr: waitlist_service.ErrOfferMismatch,
+		},
+		{
+			name:    "offer claimed by another request",
+			entry:   offer(open),
+			payload: payload,
+			mockSetup: func(m serviceMocks, entry *models.WaitlistEntries) {
+				m.hotelService.EXPECT().GetHotelByID(hotel.Id).Return(hotel, nil)
+				m.waitlistRepo.EXPECT().UpdateStatus(entry.Id, waitlist_status.StatusOffered, waitlist_status.StatusBooked).Return(waitlist_repo.ErrEntryStatusChanged)
+			},
+			wantErr: waitlist_service.ErrOfferUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc, m := newService(ctrl)
+			m.waitlistRepo.EXPECT().GetEntryById(tt.entry.Id).Return(tt.entry, nil)
+			tt.mockSetup(m, tt.entry)
+
+			err := svc.ClaimOffer(userCtx, tt.entry.Id, tt.payload)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Package currency knows the ISO 4217 codes the system accepts and how many
+// minor units each one has, so amounts can be stored as integers.
+package currency
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Default is used for hotels that do not declare a currency.
+const Default = "USD"
+
+// exponents maps each supported ISO 4217 code to its number of minor-unit
+// digits.
+var exponents = map[string]int{
+	"AED": 2, "AUD": 2, "BHD": 3, "BRL": 2, "CAD": 2, "CHF": 2, "CNY": 2,
+	"CZK": 2, "DKK": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "IDR": 2,
+	"INR": 2, "JOD": 3, "JPY": 0, "KRW": 0, "KWD": 3, "MXN": 2, "MYR": 2,
+	"NOK": 2, "NZD": 2, "OMR": 3, "PHP": 2, "PLN": 2, "SAR": 2, "SEK": 2,
+	"SGD": 2, "THB": 2, "TRY": 2, "USD": 2, "VND": 0, "ZAR": 2,
+}
+
+// Normalize upper-cases and trims a currency code.
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValid reports whether code is a supported ISO 4217 code.
+func IsValid(code string) bool {
+	_, ok := exponents[code]
+	return ok
+}
+
+// Exponent returns the number of minor-unit digits of code, falling back to
+// two for unknown codes.
+func Exponent(code string) int {
+	if exp, ok := exponents[code]; ok {
+		return exp
+	}
+	return 2
+}
+
+// ToMinorUnits converts a decimal amount in code into minor units, so 12.34
+// USD becomes 1234 and 1200 JPY stays 1200.
+func ToMinorUnits(amount float64, code string) int64 {
+	return int64(math.Round(amount * math.Pow10(Exponent(code))))
+}
+
+// FormatAmount renders minor units as a plain decimal amount, such as "12.34"
+// for USD or "1200" for JPY.
+func FormatAmount(amount int64, code string) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	exp := Exponent(code)
+	if exp == 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+	scale := int64(math.Pow10(exp))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, exp, amount%scale)
+}
+
+// Format renders minor units followed by the code, such as "12.34 USD".
+func Format(amount int64, code string) string {
+	return FormatAmount(amount, code) + " " + code
+}
+
+// Convert turns minor units of from into minor units of to using rate, the
+// price of one unit of from in to.
+func Convert(amount int64, from, to string, rate float64) int64 {
+	scale := math.Pow10(Exponent(to) - Exponent(from))
+	return int64(math.Round(float64(amount) * rate * scale))
+}
@@ -0,0 +1,118 @@
+package currency_test
+
+import (
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/currency"
+)
+
+func TestIsValid(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"USD", true},
+		{"JPY", true},
+		{"usd", false},
+		{"XYZ", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := currency.IsValid(tt.code); got != tt.want {
+				t.Errorf("IsValid(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMinorUnits(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		code   string
+		want   int64
+	}{
+		{"two decimals", 12.34, "USD", 1234},
+		{"no decimals", 1200, "JPY", 1200},
+		{"three decimals", 1.234, "KWD", 1234},
+		{"rounds float error", 0.29, "EUR", 29},
+		{"unknown code uses two decimals", 1.5, "XYZ", 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currency.ToMinorUnits(tt.amount, tt.code); got != tt.want {
+				t.Errorf("ToMinorUnits(%v, %q) = %d, want %d", tt.amount, tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		code   string
+		want   string
+	}{
+		{"two decimals", 5, "USD", "0.05"},
+		{"no decimals", -1200, "JPY", "-1200"},
+		{"three decimals", 1005, "KWD", "1.005"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currency.FormatAmount(tt.amount, tt.code); got != tt.want {
+				t.Errorf("FormatAmount(%d, %q) = %q, want %q", tt.amount, tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		code   string
+		want   string
+	}{
+		{"two decimals", 1234, "USD", "12.34 USD"},
+		{"no decimals", 1200, "JPY", "1200 JPY"},
+		{"three decimals", 1005, "KWD", "1.005 KWD"},
+		{"negative", -1250, "EUR", "-12.50 EUR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currency.Format(tt.amount, tt.code); got != tt.want {
+				t.Errorf("Format(%d, %q) = %q, want %q", tt.amount, tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		from   string
+		to     string
+		rate   float64
+		want   int64
+	}{
+		{"same exponent", 10000, "USD", "EUR", 0.92, 9200},
+		{"to fewer decimals", 10000, "USD", "JPY", 150.5, 15050},
+		{"from fewer decimals", 15050, "JPY", "USD", 1 / 150.5, 10000},
+		{"to more decimals", 10000, "USD", "KWD", 0.307, 30700},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currency.Convert(tt.amount, tt.from, tt.to, tt.rate); got != tt.want {
+				t.Errorf("Convert(%d, %q, %q, %v) = %d, want %d", tt.amount, tt.from, tt.to, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
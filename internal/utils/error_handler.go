@@ -10,9 +10,14 @@ type ErrorResponse struct {
 	StatusCode int    `json:"status_code"`
 	Error      string `json:"error"`
 	Details    string `json:"details,omitempty"`
+	Code       string `json:"code,omitempty"` // machine-readable reason, when the client can act on it
 }
 
 func WriteErrorResponse(w http.ResponseWriter, statusCode int, errMsg string, details string) {
+	WriteErrorResponseWithCode(w, statusCode, errMsg, details, "")
+}
+
+func WriteErrorResponseWithCode(w http.ResponseWriter, statusCode int, errMsg string, details string, code string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 
@@ -21,5 +26,6 @@ func WriteErrorResponse(w http.ResponseWriter, statusCode int, errMsg string, de
 		StatusCode: statusCode,
 		Error:      errMsg,
 		Details:    details,
+		Code:       code,
 	})
 }
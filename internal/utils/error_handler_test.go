@@ -72,3 +72,22 @@ func TestWriteErrorResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteErrorResponseWithCode(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	utils.WriteErrorResponseWithCode(w, http.StatusUnprocessableEntity, "Stay restriction violated", "min 2 nights", "MIN_LOS")
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected status code %d, got %d", http.StatusUnprocessableEntity, resp.StatusCode)
+	}
+
+	var body utils.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+	if body.Code != "MIN_LOS" || body.Details != "min 2 nights" || body.Status {
+		t.Errorf("unexpected error body: %+v", body)
+	}
+}
@@ -0,0 +1,15 @@
+package utils
+
+import "time"
+
+// CountNights returns the number of nights between the check-in and check-out
+// calendar dates, never returning less than one.
+func CountNights(checkIn, checkOut time.Time) int {
+	in := time.Date(checkIn.Year(), checkIn.Month(), checkIn.Day(), 0, 0, 0, 0, time.UTC)
+	out := time.Date(checkOut.Year(), checkOut.Month(), checkOut.Day(), 0, 0, 0, 0, time.UTC)
+	nights := int(out.Sub(in).Hours() / 24)
+	if nights < 1 {
+		return 1
+	}
+	return nights
+}
@@ -0,0 +1,32 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/utils"
+)
+
+func TestCountNights(t *testing.T) {
+	checkIn := time.Date(2025, 1, 10, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		checkOut time.Time
+		want     int
+	}{
+		{"two nights", checkIn.Add(48 * time.Hour), 2},
+		{"late checkout on the next day", checkIn.Add(22 * time.Hour), 1},
+		{"early checkout after two dates", checkIn.Add(40 * time.Hour), 2},
+		{"same day is one night", checkIn, 1},
+		{"checkout before checkin is one night", checkIn.Add(-time.Hour), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := utils.CountNights(checkIn, tt.checkOut); got != tt.want {
+				t.Errorf("CountNights() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package pdf_test
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/pdf"
+)
+
+func TestDocumentBytes(t *testing.T) {
+	doc := pdf.New()
+	first := doc.AddPage()
+	first.Text(50, 800, 12, true, "Invoice (copy)")
+	first.TextRight(545, 780, 10, false, "1,234.50")
+	first.Line(50, 770, 545, 770)
+	doc.AddPage().Text(50, 800, 10, false, "Café")
+
+	out := doc.Bytes()
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatalf("missing PDF header or trailer")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Errorf("expected two pages in the page tree")
+	}
+	if !bytes.Contains(out, []byte(`(Invoice \(copy\)) Tj`)) {
+		t.Errorf("expected parentheses to be escaped")
+	}
+	if !bytes.Contains(out, []byte(`(Caf\351) Tj`)) {
+		t.Errorf("expected Latin-1 characters as octal escapes")
+	}
+
+	// every xref entry must point at the object it numbers
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if startxref == nil {
+		t.Fatalf("missing startxref")
+	}
+	xrefAt, _ := strconv.Atoi(string(startxref[1]))
+	if !bytes.HasPrefix(out[xrefAt:], []byte("xref\n")) {
+		t.Fatalf("startxref does not point at the xref table")
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xrefAt:], -1)
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		want := strconv.Itoa(i+1) + " 0 obj"
+		if !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Errorf("xref entry %d does not point at %q", i+1, want)
+		}
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	// digits are 556/1000 em and the decimal point 278/1000 em in Helvetica
+	if got := pdf.TextWidth("10.00", 10); got != 25.02 {
+		t.Errorf("expected 25.02, got %v", got)
+	}
+}
@@ -0,0 +1,12 @@
+package permissions
+
+import (
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+// IsFrontDesk reports whether the user may perform front desk operations.
+// Managers can always act as front desk staff.
+func IsFrontDesk(userCtx *models.UserContext) bool {
+	return userCtx != nil && (userCtx.Role == user_role.RoleFrontDesk || userCtx.Role == user_role.RoleManager)
+}
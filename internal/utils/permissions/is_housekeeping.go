@@ -0,0 +1,12 @@
+package permissions
+
+import (
+	user_role "github.com/tktanisha/booking_system/internal/enums/user"
+	"github.com/tktanisha/booking_system/internal/models"
+)
+
+// IsHousekeeping reports whether the user may work housekeeping tasks.
+// Managers can always act as housekeeping staff.
+func IsHousekeeping(userCtx *models.UserContext) bool {
+	return userCtx != nil && (userCtx.Role == user_role.RoleHousekeeping || userCtx.Role == user_role.RoleManager)
+}
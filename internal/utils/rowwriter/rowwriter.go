@@ -0,0 +1,144 @@
+// Package rowwriter writes a table as CSV or as JSON Lines, one row at a time,
+// so a table of any size can be streamed without holding it in memory.
+package rowwriter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/export"
+)
+
+// Writer writes the rows of a table with a fixed set of columns. Values are
+// given in column order. Nothing reaches the underlying writer before the
+// first row or Close, and Close must be called to flush the last rows.
+type Writer interface {
+	WriteRow(values ...any) error
+	Close() error
+}
+
+func New(w io.Writer, format export.Format, columns []string) Writer {
+	if format == export.NDJSON {
+		return &ndjsonWriter{w: bufio.NewWriter(w), columns: columns}
+	}
+	return &csvWriter{w: csv.NewWriter(w), columns: columns}
+}
+
+// csvWriter writes a header line with the column names, even for an empty table.
+type csvWriter struct {
+	w             *csv.Writer
+	columns       []string
+	headerWritten bool
+}
+
+func (c *csvWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+	c.headerWritten = true
+	return c.w.Write(c.columns)
+}
+
+func (c *csvWriter) WriteRow(values ...any) error {
+	if len(values) != len(c.columns) {
+		return fmt.Errorf("row has %d values for %d columns", len(values), len(c.columns))
+	}
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = csvValue(value)
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// csvValue formats value for a CSV cell. Times are written as RFC 3339 and nil
+// pointers as empty cells.
+func csvValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return escapeFormula(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.Format(time.RFC3339)
+	case *uuid.UUID:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case bool:
+		return strconv.FormatBool(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// escapeFormula stops a spreadsheet from running free text, such as a guest's
+// name, as a formula by prefixing it with a quote.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// ndjsonWriter writes each row as a JSON object keyed by column name, with
+// the keys in column order.
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (n *ndjsonWriter) WriteRow(values ...any) error {
+	if len(values) != len(n.columns) {
+		return fmt.Errorf("row has %d values for %d columns", len(values), len(n.columns))
+	}
+	n.w.WriteByte('{')
+	for i, column := range n.columns {
+		if i > 0 {
+			n.w.WriteByte(',')
+		}
+		key, _ := json.Marshal(column)
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		n.w.Write(key)
+		n.w.WriteByte(':')
+		n.w.Write(value)
+	}
+	_, err := n.w.WriteString("}\n")
+	return err
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.w.Flush()
+}
@@ -0,0 +1,79 @@
+package rowwriter_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/export"
+	"github.com/tktanisha/booking_system/internal/utils/rowwriter"
+)
+
+func TestWriter(t *testing.T) {
+	id := uuid.MustParse("8b7f4a52-5c1e-4d3a-9f0b-2d6c1e7a9b40")
+	postedAt := time.Date(2030, 5, 1, 14, 30, 0, 0, time.UTC)
+	columns := []string{"id", "name", "amount", "posted_at", "voided_at"}
+
+	tests := []struct {
+		name   string
+		format export.Format
+		rows   [][]any
+		want   string
+	}{
+		{
+			name:   "csv",
+			format: export.CSV,
+			rows:   [][]any{{id, "Smith, Jane", int64(12500), postedAt, (*time.Time)(nil)}},
+			want:   "id,name,amount,posted_at,voided_at\n8b7f4a52-5c1e-4d3a-9f0b-2d6c1e7a9b40,\"Smith, Jane\",12500,2030-05-01T14:30:00Z,\n",
+		},
+		{
+			name:   "csv header for an empty table",
+			format: export.CSV,
+			want:   "id,name,amount,posted_at,voided_at\n",
+		},
+		{
+			name:   "csv formulas in free text are neutralised",
+			format: export.CSV,
+			rows:   [][]any{{id, "=HYPERLINK(\"x\")", int64(-500), postedAt, &postedAt}},
+			want:   "id,name,amount,posted_at,voided_at\n8b7f4a52-5c1e-4d3a-9f0b-2d6c1e7a9b40,\"'=HYPERLINK(\"\"x\"\")\",-500,2030-05-01T14:30:00Z,2030-05-01T14:30:00Z\n",
+		},
+		{
+			name:   "json lines keep the column order",
+			format: export.NDJSON,
+			rows:   [][]any{{id, "Jane", int64(12500), postedAt, (*time.Time)(nil)}, {id, "=1+1", int64(0), postedAt, (*time.Time)(nil)}},
+			want: `{"id":"8b7f4a52-5c1e-4d3a-9f0b-2d6c1e7a9b40","name":"Jane","amount":12500,"posted_at":"2030-05-01T14:30:00Z","voided_at":null}` + "\n" +
+				`{"id":"8b7f4a52-5c1e-4d3a-9f0b-2d6c1e7a9b40","name":"=1+1","amount":0,"posted_at":"2030-05-01T14:30:00Z","voided_at":null}` + "\n",
+		},
+		{
+			name:   "json lines for an empty table",
+			format: export.NDJSON,
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			w := rowwriter.New(&out, tt.format, columns)
+			for _, row := range tt.rows {
+				if err := w.WriteRow(row...); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if out.String() != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, out.String())
+			}
+		})
+	}
+}
+
+func TestWriter_WrongNumberOfValues(t *testing.T) {
+	w := rowwriter.New(&bytes.Buffer{}, export.CSV, []string{"id", "name"})
+	if err := w.WriteRow("only one"); err == nil {
+		t.Errorf("expected an error for a short row")
+	}
+}
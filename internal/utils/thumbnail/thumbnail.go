@@ -0,0 +1,119 @@
+// Package thumbnail scales photos down for listings. It only uses the
+// standard library codecs, so it reads JPEG and PNG and always writes JPEG.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png" // registers the PNG decoder
+)
+
+const (
+	MaxEdge = 320 // longer edge of a thumbnail in pixels
+
+	// maxPixels refuses images that would take too much memory to decode,
+	// such as a small file claiming huge dimensions. 20 MP covers phone and
+	// most camera photos.
+	maxPixels   = 20_000_000
+	jpegQuality = 80
+)
+
+var (
+	ErrUnsupported = errors.New("image could not be decoded as JPEG or PNG")
+	ErrTooLarge    = errors.New("image dimensions are too large")
+)
+
+// Image describes a decoded upload and its thumbnail.
+type Image struct {
+	Width     int
+	Height    int
+	Thumbnail []byte // JPEG no larger than maxEdge on either side
+}
+
+// Generate decodes data and returns its size together with a JPEG thumbnail
+// whose longer edge is at most maxEdge. Smaller images keep their size.
+// Transparent areas are flattened onto white.
+func Generate(data []byte, maxEdge int) (*Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrUnsupported
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+
+	width, height := fit(config.Width, config.Height, maxEdge)
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, scale(src, width, height), &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return &Image{Width: config.Width, Height: config.Height, Thumbnail: buf.Bytes()}, nil
+}
+
+// fit returns the size of a width x height image scaled down, keeping its
+// aspect ratio, so neither side is longer than maxEdge.
+func fit(width, height, maxEdge int) (int, int) {
+	if width <= maxEdge && height <= maxEdge {
+		return width, height
+	}
+	if width >= height {
+		return maxEdge, max(1, height*maxEdge/width)
+	}
+	return max(1, width*maxEdge/height), maxEdge
+}
+
+// scale resizes src to width x height by averaging the block of source pixels
+// behind each thumbnail pixel, then composites the result over white. Source
+// rows are converted to RGBA one at a time, so scaling needs no full-size copy
+// of the decoded image.
+func scale(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	row := image.NewRGBA(image.Rect(0, 0, srcW, 1))
+	sums := make([]int, width*4)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcH/height, max((y+1)*srcH/height, y*srcH/height+1)
+
+		clear(sums)
+		for sy := y0; sy < y1; sy++ {
+			draw.Draw(row, row.Bounds(), src, image.Pt(bounds.Min.X, bounds.Min.Y+sy), draw.Src)
+			for x := 0; x < width; x++ {
+				x0, x1 := x*srcW/width, max((x+1)*srcW/width, x*srcW/width+1)
+				sum := sums[x*4 : x*4+4]
+				for sx := x0; sx < x1; sx++ {
+					p := row.Pix[sx*4 : sx*4+4]
+					sum[0], sum[1], sum[2], sum[3] = sum[0]+int(p[0]), sum[1]+int(p[1]), sum[2]+int(p[2]), sum[3]+int(p[3])
+				}
+			}
+		}
+
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcW/width, max((x+1)*srcW/width, x*srcW/width+1)
+			n := (y1 - y0) * (x1 - x0)
+			sum := sums[x*4 : x*4+4]
+
+			// The pixels are alpha-premultiplied, so adding the uncovered
+			// share of white flattens them.
+			white := 255 - sum[3]/n
+			i := y*dst.Stride + x*4
+			dst.Pix[i+0] = uint8(sum[0]/n + white)
+			dst.Pix[i+1] = uint8(sum[1]/n + white)
+			dst.Pix[i+2] = uint8(sum[2]/n + white)
+			dst.Pix[i+3] = 255
+		}
+	}
+	return dst
+}
@@ -0,0 +1,124 @@
+package thumbnail_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/thumbnail"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("failed to encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name                   string
+		width, height          int
+		wantThumbW, wantThumbH int
+	}{
+		{"landscape is scaled to the max edge", 1200, 800, 320, 213},
+		{"portrait is scaled to the max edge", 600, 1800, 106, 320},
+		{"small images keep their size", 200, 100, 200, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := image.NewNRGBA(image.Rect(0, 0, tt.width, tt.height))
+			for y := 0; y < tt.height; y++ {
+				for x := 0; x < tt.width; x++ {
+					src.Set(x, y, color.NRGBA{R: 200, G: 40, B: 40, A: 255})
+				}
+			}
+
+			img, err := thumbnail.Generate(encodePNG(t, src), thumbnail.MaxEdge)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if img.Width != tt.width || img.Height != tt.height {
+				t.Errorf("expected original size %dx%d, got %dx%d", tt.width, tt.height, img.Width, img.Height)
+			}
+
+			thumb, err := jpeg.Decode(bytes.NewReader(img.Thumbnail))
+			if err != nil {
+				t.Fatalf("thumbnail is not a JPEG: %v", err)
+			}
+			if got := thumb.Bounds(); got.Dx() != tt.wantThumbW || got.Dy() != tt.wantThumbH {
+				t.Errorf("expected thumbnail %dx%d, got %dx%d", tt.wantThumbW, tt.wantThumbH, got.Dx(), got.Dy())
+			}
+			r, g, _, _ := thumb.At(0, 0).RGBA()
+			if r>>8 < 180 || g>>8 > 70 {
+				t.Errorf("expected the thumbnail to keep the colour, got r=%d g=%d", r>>8, g>>8)
+			}
+		})
+	}
+}
+
+func TestGenerate_FlattensTransparencyOntoWhite(t *testing.T) {
+	img, err := thumbnail.Generate(encodePNG(t, image.NewNRGBA(image.Rect(0, 0, 10, 10))), thumbnail.MaxEdge)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	thumb, _ := jpeg.Decode(bytes.NewReader(img.Thumbnail))
+	if r, g, b, _ := thumb.At(5, 5).RGBA(); r>>8 < 250 || g>>8 < 250 || b>>8 < 250 {
+		t.Errorf("expected white, got %d %d %d", r>>8, g>>8, b>>8)
+	}
+}
+
+func TestGenerate_ScalesJPEGStripes(t *testing.T) {
+	// Alternating black and white rows average out to grey.
+	src := image.NewRGBA(image.Rect(0, 0, 640, 640))
+	for y := 0; y < 640; y++ {
+		shade := uint8(0)
+		if y%2 == 0 {
+			shade = 255
+		}
+		for x := 0; x < 640; x++ {
+			src.Set(x, y, color.RGBA{R: shade, G: shade, B: shade, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, src, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("failed to encode jpeg: %v", err)
+	}
+
+	img, err := thumbnail.Generate(buf.Bytes(), thumbnail.MaxEdge)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	thumb, _ := jpeg.Decode(bytes.NewReader(img.Thumbnail))
+	if got := thumb.Bounds(); got.Dx() != 320 || got.Dy() != 320 {
+		t.Fatalf("expected a 320x320 thumbnail, got %dx%d", got.Dx(), got.Dy())
+	}
+	if r, _, _, _ := thumb.At(160, 160).RGBA(); r>>8 < 100 || r>>8 > 155 {
+		t.Errorf("expected grey, got %d", r>>8)
+	}
+}
+
+func TestGenerate_Rejects(t *testing.T) {
+	if _, err := thumbnail.Generate([]byte("not an image"), thumbnail.MaxEdge); !errors.Is(err, thumbnail.ErrUnsupported) {
+		t.Errorf("expected ErrUnsupported, got %v", err)
+	}
+
+	// A PNG header claiming 6000x4000 pixels, 24 MP, is refused before decoding.
+	header := encodePNG(t, image.NewNRGBA(image.Rect(0, 0, 1, 1)))
+	huge := append([]byte{}, header...)
+	binary.BigEndian.PutUint32(huge[16:20], 6000)
+	binary.BigEndian.PutUint32(huge[20:24], 4000)
+	binary.BigEndian.PutUint32(huge[29:33], crc32.ChecksumIEEE(huge[12:29]))
+	if _, err := thumbnail.Generate(huge, thumbnail.MaxEdge); !errors.Is(err, thumbnail.ErrTooLarge) {
+		t.Errorf("expected ErrTooLarge, got %v", err)
+	}
+}
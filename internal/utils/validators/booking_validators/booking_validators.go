@@ -6,7 +6,9 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/group_block_validators"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+	"github.com/tktanisha/booking_system/internal/utils/validators/promo_validators"
 )
 
 func CreateBookingValidator(r *http.Request) (*payloads.BookingPayload, error) {
@@ -24,7 +26,7 @@ func CreateBookingValidator(r *http.Request) (*payloads.BookingPayload, error) {
 	if payload.CheckOut.IsZero() {
 		return nil, errors.New("checkout date is required")
 	}
-	if payload.CheckIn.After(payload.CheckOut) {
+	if !payload.CheckIn.Before(payload.CheckOut.Time) {
 		return nil, errors.New("checkin date must be before checkout date")
 	}
 	if len(payload.Rooms) == 0 {
@@ -34,9 +36,39 @@ func CreateBookingValidator(r *http.Request) (*payloads.BookingPayload, error) {
 		if room.RoomType == "" {
 			return nil, errors.New("room_type is required")
 		}
-		if room.Quantity < 0 {
+		if room.Quantity <= 0 {
 			return nil, errors.New("quantity must be positive")
 		}
+		if room.Adults < 0 || room.Children < 0 {
+			return nil, errors.New("adults and children cannot be negative")
+		}
+		// bookings that do not state a party size are for one adult per room
+		if room.Adults == 0 && room.Children == 0 {
+			room.Adults = 1
+		}
+		if room.Adults == 0 {
+			return nil, errors.New("each room needs at least one adult")
+		}
+	}
+	if err := validateGuestDetails(&payload); err != nil {
+		return nil, err
+	}
+	if payload.PromoCode != "" {
+		code, err := promo_validators.NormalizePromoCode(payload.PromoCode)
+		if err != nil {
+			return nil, err
+		}
+		payload.PromoCode = code
+	}
+	if payload.GroupCode != "" {
+		if payload.WaitlistEntryId != nil {
+			return nil, errors.New("a booking cannot use both a group code and a waitlist offer")
+		}
+		code, err := group_block_validators.NormalizeGroupCode(payload.GroupCode)
+		if err != nil {
+			return nil, err
+		}
+		payload.GroupCode = code
 	}
 	return &payload, nil
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,8 +17,8 @@ import (
 
 func TestCreateBookingValidator(t *testing.T) {
 	validHotelID := uuid.New()
-	now := time.Now()
-	later := now.Add(24 * time.Hour)
+	now := payloads.NewDate(time.Now())
+	later := payloads.NewDate(now.AddDate(0, 0, 1))
 
 	tests := []struct {
 		name        string
@@ -64,6 +65,17 @@ func TestCreateBookingValidator(t *testing.T) {
 			expectError: true,
 			errorMsg:    "checkin date must be before checkout date",
 		},
+		{
+			name: "checkin and checkout on the same day",
+			payload: payloads.BookingPayload{
+				HotelId:  validHotelID,
+				CheckIn:  now,
+				CheckOut: now,
+				Rooms:    []*payloads.RoomPayload{{RoomType: room.Single, Quantity: 1}},
+			},
+			expectError: true,
+			errorMsg:    "checkin date must be before checkout date",
+		},
 		{
 			name: "no rooms",
 			payload: payloads.BookingPayload{
@@ -97,6 +109,38 @@ func TestCreateBookingValidator(t *testing.T) {
 			expectError: true,
 			errorMsg:    "quantity must be positive",
 		},
+		{
+			name: "guest counts per room",
+			payload: payloads.BookingPayload{
+				HotelId:  validHotelID,
+				CheckIn:  now,
+				CheckOut: later,
+				Rooms:    []*payloads.RoomPayload{{RoomType: "family", Quantity: 1, Adults: 2, Children: 2}},
+			},
+			expectError: false,
+		},
+		{
+			name: "children without an adult",
+			payload: payloads.BookingPayload{
+				HotelId:  validHotelID,
+				CheckIn:  now,
+				CheckOut: later,
+				Rooms:    []*payloads.RoomPayload{{RoomType: room.Double, Quantity: 1, Children: 2}},
+			},
+			expectError: true,
+			errorMsg:    "each room needs at least one adult",
+		},
+		{
+			name: "negative guest count",
+			payload: payloads.BookingPayload{
+				HotelId:  validHotelID,
+				CheckIn:  now,
+				CheckOut: later,
+				Rooms:    []*payloads.RoomPayload{{RoomType: room.Double, Quantity: 1, Adults: 2, Children: -1}},
+			},
+			expectError: true,
+			errorMsg:    "adults and children cannot be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -127,12 +171,125 @@ func TestCreateBookingValidator(t *testing.T) {
 					t.Errorf("expected payload, got nil")
 				} else if got.HotelId != tt.payload.HotelId {
 					t.Errorf("expected hotel_id %v, got %v", tt.payload.HotelId, got.HotelId)
+				} else if got.Rooms[0].Adults < 1 {
+					t.Errorf("expected at least one adult per room, got %d", got.Rooms[0].Adults)
 				}
 			}
 		})
 	}
 }
 
+func TestCreateBookingValidator_GuestDetails(t *testing.T) {
+	base := func() payloads.BookingPayload {
+		return payloads.BookingPayload{
+			HotelId:  uuid.New(),
+			CheckIn:  payloads.NewDate(time.Now()),
+			CheckOut: payloads.NewDate(time.Now().AddDate(0, 0, 1)),
+			Rooms:    []*payloads.RoomPayload{{RoomType: room.Double, Quantity: 1, Adults: 2}},
+		}
+	}
+	lead := &payloads.GuestPayload{FullName: "Asha Rao", Email: "asha@example.com"}
+
+	tests := []struct {
+		name        string
+		mutate      func(p *payloads.BookingPayload)
+		expectError bool
+		errorMsg    string
+	}{
+		{"no guest details", func(p *payloads.BookingPayload) {}, false, ""},
+		{"lead guest with companion, eta and notes", func(p *payloads.BookingPayload) {
+			p.LeadGuest = lead
+			p.Guests = []*payloads.GuestPayload{{FullName: "Vikram Rao"}}
+			p.EstimatedArrival = "21:30"
+			p.Notes = "Late arrival, quiet room please"
+		}, false, ""},
+		{"guests without lead", func(p *payloads.BookingPayload) {
+			p.Guests = []*payloads.GuestPayload{{FullName: "Vikram Rao"}}
+		}, true, "lead_guest is required"},
+		{"lead without name", func(p *payloads.BookingPayload) {
+			p.LeadGuest = &payloads.GuestPayload{FullName: "  "}
+		}, true, "lead_guest: full_name is required"},
+		{"invalid guest email", func(p *payloads.BookingPayload) {
+			p.LeadGuest = lead
+			p.Guests = []*payloads.GuestPayload{{FullName: "Vikram Rao", Email: "not-an-email"}}
+		}, true, "guests[0]: invalid email format"},
+		{"more names than booked guests", func(p *payloads.BookingPayload) {
+			p.LeadGuest = lead
+			p.Guests = []*payloads.GuestPayload{{FullName: "Vikram Rao"}, {FullName: "Meera Rao"}}
+		}, true, "3 guests named but the rooms are booked for 2"},
+		{"malformed eta", func(p *payloads.BookingPayload) {
+			p.EstimatedArrival = "9pm"
+		}, true, "estimated_arrival must be a time in HH:MM format"},
+		{"promo code is normalized", func(p *payloads.BookingPayload) {
+			p.PromoCode = " summer10 "
+		}, false, ""},
+		{"malformed promo code", func(p *payloads.BookingPayload) {
+			p.PromoCode = "10% OFF"
+		}, true, "promo_code must be 3-32 letters, digits, '-' or '_'"},
+		{"notes too long", func(p *payloads.BookingPayload) {
+			p.Notes = strings.Repeat("x", 1001)
+		}, true, "notes cannot be longer than 1000 characters"},
+		{"group code is normalized", func(p *payloads.BookingPayload) {
+			p.GroupCode = " smith-wedding "
+		}, false, ""},
+		{"malformed group code", func(p *payloads.BookingPayload) {
+			p.GroupCode = "smith wedding"
+		}, true, "group_code must be 3-32 letters, digits, '-' or '_'"},
+		{"group code with a waitlist offer", func(p *payloads.BookingPayload) {
+			entryID := uuid.New()
+			p.GroupCode = "SMITH-WEDDING"
+			p.WaitlistEntryId = &entryID
+		}, true, "a booking cannot use both a group code and a waitlist offer"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := base()
+			tt.mutate(&payload)
+			body, _ := json.Marshal(payload)
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+
+			_, err := booking_validators.CreateBookingValidator(req)
+			if tt.expectError {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCreateBookingValidator_StayDates(t *testing.T) {
+	tests := []struct {
+		name        string
+		checkIn     string
+		checkOut    string
+		expectError bool
+	}{
+		{"calendar dates", "2026-12-24", "2026-12-27", false},
+		{"timestamp instead of a date", "2026-12-24T15:00:00Z", "2026-12-27", true},
+		{"not a date", "2026-02-30", "2026-03-02", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"hotel_id":"` + uuid.New().String() + `","checkin":"` + tt.checkIn + `","checkout":"` + tt.checkOut +
+				`","rooms":[{"room_type":"double","quantity":1}]}`
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(body))
+
+			payload, err := booking_validators.CreateBookingValidator(req)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if !tt.expectError && payload.CheckIn.String() != tt.checkIn {
+				t.Errorf("expected checkin %s, got %s", tt.checkIn, payload.CheckIn)
+			}
+		})
+	}
+}
+
 func TestValidateCancelBooking(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -171,6 +328,63 @@ func TestValidateCancelBooking(t *testing.T) {
 	}
 }
 
+func TestValidateCheckInPayload(t *testing.T) {
+	unitID := uuid.New()
+
+	tests := []struct {
+		name        string
+		body        string
+		wantUnits   int
+		expectError bool
+	}{
+		{"empty body", "", 0, false},
+		{"explicit units", `{"unit_ids":["` + unitID.String() + `"]}`, 1, false},
+		{"duplicate units", `{"unit_ids":["` + unitID.String() + `","` + unitID.String() + `"]}`, 0, true},
+		{"nil unit", `{"unit_ids":["` + uuid.Nil.String() + `"]}`, 0, true},
+		{"invalid JSON", `{invalid`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			payload, err := booking_validators.ValidateCheckInPayload(req)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if !tt.expectError && len(payload.UnitIds) != tt.wantUnits {
+				t.Errorf("expected %d unit ids, got %d", tt.wantUnits, len(payload.UnitIds))
+			}
+		})
+	}
+}
+
+func TestValidateCheckoutPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantWaive   bool
+		expectError bool
+	}{
+		{"empty body", "", false, false},
+		{"waiver with reason", `{"waive_balance":true,"waiver_reason":"service recovery"}`, true, false},
+		{"waiver without reason", `{"waive_balance":true,"waiver_reason":"  "}`, false, true},
+		{"invalid JSON", `{invalid`, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			payload, err := booking_validators.ValidateCheckoutPayload(req)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if !tt.expectError && payload.WaiveBalance != tt.wantWaive {
+				t.Errorf("expected waive_balance %v, got %v", tt.wantWaive, payload.WaiveBalance)
+			}
+		})
+	}
+}
+
 // helper function to match substrings
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || bytes.Contains([]byte(s), []byte(substr)))
@@ -0,0 +1,37 @@
+package booking_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// ValidateCheckInPayload accepts an empty body, in which case every unit is assigned automatically.
+func ValidateCheckInPayload(r *http.Request) (*payloads.CheckInPayload, error) {
+	var payload payloads.CheckInPayload
+	if r.Body == nil {
+		return &payload, nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &payload, nil
+		}
+		return nil, errors.New("invalid request payload")
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for _, unitId := range payload.UnitIds {
+		if unitId == uuid.Nil {
+			return nil, errors.New("unit_ids cannot contain empty ids")
+		}
+		if seen[unitId] {
+			return nil, errors.New("unit_ids cannot contain duplicates")
+		}
+		seen[unitId] = true
+	}
+	return &payload, nil
+}
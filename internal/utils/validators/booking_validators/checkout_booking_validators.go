@@ -0,0 +1,31 @@
+package booking_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// ValidateCheckoutPayload accepts an empty body for a plain checkout.
+func ValidateCheckoutPayload(r *http.Request) (*payloads.CheckoutPayload, error) {
+	var payload payloads.CheckoutPayload
+	if r.Body == nil {
+		return &payload, nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &payload, nil
+		}
+		return nil, errors.New("invalid request payload")
+	}
+
+	payload.WaiverReason = strings.TrimSpace(payload.WaiverReason)
+	if payload.WaiveBalance && payload.WaiverReason == "" {
+		return nil, errors.New("waiver_reason is required to waive the balance")
+	}
+	return &payload, nil
+}
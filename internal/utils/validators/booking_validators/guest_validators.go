@@ -0,0 +1,75 @@
+package booking_validators
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/auth_validators"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+const maxNotesLength = 1000
+
+// validateGuestDetails checks the optional guest fields of a booking. Named guests
+// need a lead guest and cannot outnumber the guests the rooms were booked for.
+func validateGuestDetails(payload *payloads.BookingPayload) error {
+	if payload.LeadGuest == nil && len(payload.Guests) > 0 {
+		return errors.New("lead_guest is required when guests are listed")
+	}
+
+	if payload.LeadGuest != nil {
+		if err := validateGuest(payload.LeadGuest); err != nil {
+			return fmt.Errorf("lead_guest: %w", err)
+		}
+	}
+	for i, guest := range payload.Guests {
+		if guest == nil {
+			return fmt.Errorf("guests[%d]: guest cannot be empty", i)
+		}
+		if err := validateGuest(guest); err != nil {
+			return fmt.Errorf("guests[%d]: %w", i, err)
+		}
+	}
+
+	if payload.LeadGuest != nil {
+		capacity := 0
+		for _, room := range payload.Rooms {
+			capacity += (room.Adults + room.Children) * room.Quantity
+		}
+		if named := 1 + len(payload.Guests); named > capacity {
+			return fmt.Errorf("%d guests named but the rooms are booked for %d", named, capacity)
+		}
+	}
+
+	payload.EstimatedArrival = strings.TrimSpace(payload.EstimatedArrival)
+	if payload.EstimatedArrival != "" {
+		if _, err := time.Parse("15:04", payload.EstimatedArrival); err != nil {
+			return errors.New("estimated_arrival must be a time in HH:MM format")
+		}
+	}
+
+	payload.Notes = strings.TrimSpace(payload.Notes)
+	if len(payload.Notes) > maxNotesLength {
+		return fmt.Errorf("notes cannot be longer than %d characters", maxNotesLength)
+	}
+	return nil
+}
+
+func validateGuest(guest *payloads.GuestPayload) error {
+	guest.FullName = strings.TrimSpace(guest.FullName)
+	if guest.FullName == "" {
+		return errors.New("full_name is required")
+	}
+
+	guest.Email = strings.TrimSpace(guest.Email)
+	if guest.Email != "" {
+		if err := auth_validators.ValidateEmail(guest.Email); err != nil {
+			return err
+		}
+	}
+
+	guest.Phone = strings.TrimSpace(guest.Phone)
+	return nil
+}
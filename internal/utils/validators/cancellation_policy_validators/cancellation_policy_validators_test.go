@@ -0,0 +1,98 @@
+package cancellation_policy_validators_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/utils/validators/cancellation_policy_validators"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func TestValidateCancellationPolicyPayload(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name        string
+		body        any
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid hotel-wide policy",
+			body:        payloads.CancellationPolicyPayload{HotelId: hotelID, FreeCancelHours: 48, PenaltyNights: 1},
+			expectError: false,
+		},
+		{
+			name:        "valid non-refundable room policy",
+			body:        payloads.CancellationPolicyPayload{HotelId: hotelID, RoomCategory: room.Suite, NonRefundable: true},
+			expectError: false,
+		},
+		{
+			name:        "invalid JSON",
+			body:        "{invalid",
+			expectError: true,
+			errorMsg:    "invalid request payload",
+		},
+		{
+			name:        "missing hotel id",
+			body:        payloads.CancellationPolicyPayload{FreeCancelHours: 48},
+			expectError: true,
+			errorMsg:    "hotel_id is required",
+		},
+		{
+			name:        "invalid room category",
+			body:        payloads.CancellationPolicyPayload{HotelId: hotelID, RoomCategory: "Pent House"},
+			expectError: true,
+			errorMsg:    "invalid room_category",
+		},
+		{
+			name:        "negative free hours",
+			body:        payloads.CancellationPolicyPayload{HotelId: hotelID, FreeCancelHours: -1},
+			expectError: true,
+			errorMsg:    "free_cancel_hours cannot be negative",
+		},
+		{
+			name:        "negative penalty nights",
+			body:        payloads.CancellationPolicyPayload{HotelId: hotelID, PenaltyNights: -2},
+			expectError: true,
+			errorMsg:    "penalty_nights cannot be negative",
+		},
+		{
+			name:        "non-refundable with free window",
+			body:        payloads.CancellationPolicyPayload{HotelId: hotelID, FreeCancelHours: 24, NonRefundable: true},
+			expectError: true,
+			errorMsg:    "non_refundable policies cannot define free_cancel_hours or penalty_nights",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if s, ok := tt.body.(string); ok {
+				body = []byte(s)
+			} else {
+				body, _ = json.Marshal(tt.body)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+
+			got, err := cancellation_policy_validators.ValidateCancellationPolicyPayload(req)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+				} else if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				if got != nil {
+					t.Errorf("expected nil payload, got %v", got)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
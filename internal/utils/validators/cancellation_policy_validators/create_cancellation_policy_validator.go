@@ -0,0 +1,38 @@
+package cancellation_policy_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateCancellationPolicyPayload(r *http.Request) (*payloads.CancellationPolicyPayload, error) {
+	var payload payloads.CancellationPolicyPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelId == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+
+	// an empty room_category makes the policy apply to the whole hotel
+	if payload.RoomCategory != "" && !payload.RoomCategory.IsValid() {
+		return nil, errors.New("invalid room_category")
+	}
+
+	if payload.FreeCancelHours < 0 {
+		return nil, errors.New("free_cancel_hours cannot be negative")
+	}
+	if payload.PenaltyNights < 0 {
+		return nil, errors.New("penalty_nights cannot be negative")
+	}
+	if payload.NonRefundable && (payload.FreeCancelHours > 0 || payload.PenaltyNights > 0) {
+		return nil, errors.New("non_refundable policies cannot define free_cancel_hours or penalty_nights")
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,53 @@
+package export_validators
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/enums/export"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// ValidateExportParams reads the {dataset} of an export path and its ?format=,
+// ?from= and ?to=. Exports are CSV unless format says otherwise.
+func ValidateExportParams(r *http.Request) (*payloads.ExportPayload, error) {
+	payload := &payloads.ExportPayload{
+		Dataset: export.Dataset(r.PathValue("dataset")),
+		Format:  export.CSV,
+	}
+	if !payload.Dataset.IsValid() {
+		return nil, errors.New("dataset must be one of bookings, booked_rooms, payments or guests")
+	}
+
+	query := r.URL.Query()
+
+	if raw := strings.TrimSpace(query.Get("format")); raw != "" {
+		payload.Format = export.Format(strings.ToLower(raw))
+		if !payload.Format.IsValid() {
+			return nil, errors.New("format must be csv or ndjson")
+		}
+	}
+
+	if raw := strings.TrimSpace(query.Get("from")); raw != "" {
+		from, err := payloads.ParseDate(raw)
+		if err != nil {
+			return nil, err
+		}
+		payload.From = from
+	}
+
+	if raw := strings.TrimSpace(query.Get("to")); raw != "" {
+		to, err := payloads.ParseDate(raw)
+		if err != nil {
+			return nil, err
+		}
+		payload.To = to
+	}
+
+	if !payload.From.IsZero() && !payload.To.IsZero() && !payload.From.Before(payload.To.Time) {
+		return nil, errors.New("from date must be before to date")
+	}
+
+	return payload, nil
+}
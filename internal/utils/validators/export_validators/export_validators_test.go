@@ -0,0 +1,48 @@
+package export_validators_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/enums/export"
+	"github.com/tktanisha/booking_system/internal/utils/validators/export_validators"
+)
+
+func TestValidateExportParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataset  string
+		query    string
+		format   export.Format
+		errorMsg string
+	}{
+		{"csv by default", "bookings", "", export.CSV, ""},
+		{"json lines with dates", "payments", "?format=NDJSON&from=2030-05-01&to=2030-06-01", export.NDJSON, ""},
+		{"open-ended range", "guests", "?to=2030-06-01", export.CSV, ""},
+		{"unknown dataset", "invoices", "", "", "dataset must be one of bookings, booked_rooms, payments or guests"},
+		{"unknown format", "bookings", "?format=xlsx", "", "format must be csv or ndjson"},
+		{"invalid date", "bookings", "?from=May", "", `invalid date "May", expected YYYY-MM-DD`},
+		{"to before from", "booked_rooms", "?from=2030-06-01&to=2030-05-01", "", "from date must be before to date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+			req.SetPathValue("dataset", tt.dataset)
+			payload, err := export_validators.ValidateExportParams(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if payload.Format != tt.format || string(payload.Dataset) != tt.dataset {
+					t.Errorf("unexpected payload %+v", payload)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package folio_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidatePostFolioLinePayload(r *http.Request) (*payloads.PostFolioLinePayload, error) {
+	var payload payloads.PostFolioLinePayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if !payload.LineType.IsValid() {
+		return nil, errors.New("line_type must be charge, payment or adjustment")
+	}
+
+	payload.Description = strings.TrimSpace(payload.Description)
+	if payload.Description == "" {
+		return nil, errors.New("description is required")
+	}
+	if len(payload.Description) > 200 {
+		return nil, errors.New("description cannot be longer than 200 characters")
+	}
+
+	switch payload.LineType {
+	case folio.Charge:
+		if payload.Category == "" {
+			payload.Category = folio.Other
+		}
+		if !payload.Category.IsValid() {
+			return nil, errors.New("category must be minibar, restaurant, parking or other")
+		}
+		if payload.Amount <= 0 {
+			return nil, errors.New("amount must be positive")
+		}
+	case folio.Payment:
+		if payload.Category != "" {
+			return nil, errors.New("category only applies to charges")
+		}
+		if payload.Amount <= 0 {
+			return nil, errors.New("amount must be positive")
+		}
+	case folio.Adjustment:
+		if payload.Category != "" {
+			return nil, errors.New("category only applies to charges")
+		}
+		if payload.Amount == 0 {
+			return nil, errors.New("amount cannot be zero")
+		}
+	}
+
+	return &payload, nil
+}
+
+func ValidateVoidFolioLinePayload(r *http.Request) (*payloads.VoidFolioLinePayload, error) {
+	var payload payloads.VoidFolioLinePayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	payload.Reason = strings.TrimSpace(payload.Reason)
+	if payload.Reason == "" {
+		return nil, errors.New("reason is required")
+	}
+	return &payload, nil
+}
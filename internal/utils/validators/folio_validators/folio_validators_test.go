@@ -0,0 +1,127 @@
+package folio_validators_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/enums/folio"
+	"github.com/tktanisha/booking_system/internal/utils/validators/folio_validators"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func newRequest(body any) *http.Request {
+	var raw []byte
+	if s, ok := body.(string); ok {
+		raw = []byte(s)
+	} else {
+		raw, _ = json.Marshal(body)
+	}
+	return httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(raw))
+}
+
+func TestValidatePostFolioLinePayload(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         any
+		expectError  bool
+		errorMsg     string
+		wantCategory folio.Category
+	}{
+		{
+			name:         "minibar charge",
+			body:         payloads.PostFolioLinePayload{LineType: folio.Charge, Category: folio.Minibar, Description: "Two sodas", Amount: 8},
+			wantCategory: folio.Minibar,
+		},
+		{
+			name:         "charge defaults to other",
+			body:         payloads.PostFolioLinePayload{LineType: folio.Charge, Description: "Laundry", Amount: 12},
+			wantCategory: folio.Other,
+		},
+		{
+			name: "payment",
+			body: payloads.PostFolioLinePayload{LineType: folio.Payment, Description: "Card", Amount: 200},
+		},
+		{
+			name: "negative adjustment",
+			body: payloads.PostFolioLinePayload{LineType: folio.Adjustment, Description: "Goodwill credit", Amount: -15},
+		},
+		{
+			name:        "invalid JSON",
+			body:        "{invalid",
+			expectError: true,
+			errorMsg:    "invalid request payload",
+		},
+		{
+			name:        "unknown line type",
+			body:        payloads.PostFolioLinePayload{LineType: "refund", Description: "Refund", Amount: 10},
+			expectError: true,
+			errorMsg:    "line_type must be charge, payment or adjustment",
+		},
+		{
+			name:        "missing description",
+			body:        payloads.PostFolioLinePayload{LineType: folio.Charge, Description: " ", Amount: 10},
+			expectError: true,
+			errorMsg:    "description is required",
+		},
+		{
+			name:        "unknown category",
+			body:        payloads.PostFolioLinePayload{LineType: folio.Charge, Category: "spa", Description: "Massage", Amount: 10},
+			expectError: true,
+			errorMsg:    "category must be minibar, restaurant, parking or other",
+		},
+		{
+			name:        "negative charge",
+			body:        payloads.PostFolioLinePayload{LineType: folio.Charge, Description: "Dinner", Amount: -10},
+			expectError: true,
+			errorMsg:    "amount must be positive",
+		},
+		{
+			name:        "category on a payment",
+			body:        payloads.PostFolioLinePayload{LineType: folio.Payment, Category: folio.Parking, Description: "Card", Amount: 10},
+			expectError: true,
+			errorMsg:    "category only applies to charges",
+		},
+		{
+			name:        "zero adjustment",
+			body:        payloads.PostFolioLinePayload{LineType: folio.Adjustment, Description: "Nothing", Amount: 0},
+			expectError: true,
+			errorMsg:    "amount cannot be zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := folio_validators.ValidatePostFolioLinePayload(newRequest(tt.body))
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got nil")
+				}
+				if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Category != tt.wantCategory {
+				t.Errorf("expected category %q, got %q", tt.wantCategory, got.Category)
+			}
+		})
+	}
+}
+
+func TestValidateVoidFolioLinePayload(t *testing.T) {
+	if _, err := folio_validators.ValidateVoidFolioLinePayload(newRequest(payloads.VoidFolioLinePayload{Reason: "posted twice"})); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if _, err := folio_validators.ValidateVoidFolioLinePayload(newRequest(payloads.VoidFolioLinePayload{Reason: "  "})); err == nil {
+		t.Errorf("expected error for empty reason")
+	}
+	if _, err := folio_validators.ValidateVoidFolioLinePayload(newRequest("{invalid")); err == nil {
+		t.Errorf("expected error for invalid JSON")
+	}
+}
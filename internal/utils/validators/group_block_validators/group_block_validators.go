@@ -0,0 +1,67 @@
+package group_block_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var groupCodeFormat = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+const maxGroupNameLength = 120
+
+// NormalizeGroupCode upper-cases a group code so attendees can type it in any case.
+func NormalizeGroupCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !groupCodeFormat.MatchString(code) {
+		return "", errors.New("group_code must be 3-32 letters, digits, '-' or '_'")
+	}
+	return code, nil
+}
+
+func ValidateGroupBlockPayload(r *http.Request) (*payloads.GroupBlockPayload, error) {
+	var payload payloads.GroupBlockPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	code, err := NormalizeGroupCode(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	payload.Code = code
+
+	payload.Name = strings.TrimSpace(payload.Name)
+	if payload.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	if len(payload.Name) > maxGroupNameLength {
+		return nil, errors.New("name cannot be longer than 120 characters")
+	}
+	if payload.RoomType == "" {
+		return nil, errors.New("room_type is required")
+	}
+	if !payload.RoomType.IsValid() {
+		return nil, errors.New("room_type is not a valid room type code")
+	}
+	if payload.Quantity <= 0 {
+		return nil, errors.New("quantity must be positive")
+	}
+	if payload.CheckIn.IsZero() || payload.CheckOut.IsZero() {
+		return nil, errors.New("checkin and checkout dates are required")
+	}
+	if !payload.CheckIn.Before(payload.CheckOut.Time) {
+		return nil, errors.New("checkin date must be before checkout date")
+	}
+	if payload.CutoffDate.IsZero() {
+		return nil, errors.New("cutoff_date is required")
+	}
+	if payload.CutoffDate.After(payload.CheckIn.Time) {
+		return nil, errors.New("cutoff_date cannot be after the checkin date")
+	}
+	return &payload, nil
+}
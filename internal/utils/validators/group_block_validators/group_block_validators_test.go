@@ -0,0 +1,69 @@
+package group_block_validators_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/group_block_validators"
+)
+
+func TestValidateGroupBlockPayload(t *testing.T) {
+	stay := `"checkin": "2030-05-01", "checkout": "2030-05-03"`
+	tests := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{"valid block", `{"code": " smith-wedding ", "name": "Smith wedding", "room_type": "double", "quantity": 30, ` + stay + `, "cutoff_date": "2030-04-15"}`, ""},
+		{"invalid code", `{"code": "a b", "name": "Smith wedding", "room_type": "double", "quantity": 30, ` + stay + `, "cutoff_date": "2030-04-15"}`, "group_code must be 3-32 letters, digits, '-' or '_'"},
+		{"missing name", `{"code": "SMITH", "room_type": "double", "quantity": 30, ` + stay + `, "cutoff_date": "2030-04-15"}`, "name is required"},
+		{"missing room type", `{"code": "SMITH", "name": "Smith wedding", "quantity": 30, ` + stay + `, "cutoff_date": "2030-04-15"}`, "room_type is required"},
+		{"invalid room type", `{"code": "SMITH", "name": "Smith wedding", "room_type": "Sea View", "quantity": 30, ` + stay + `, "cutoff_date": "2030-04-15"}`, "room_type is not a valid room type code"},
+		{"zero quantity", `{"code": "SMITH", "name": "Smith wedding", "room_type": "double", ` + stay + `, "cutoff_date": "2030-04-15"}`, "quantity must be positive"},
+		{"check-out before check-in", `{"code": "SMITH", "name": "Smith wedding", "room_type": "double", "quantity": 30, "checkin": "2030-05-03", "checkout": "2030-05-01", "cutoff_date": "2030-04-15"}`, "checkin date must be before checkout date"},
+		{"missing cutoff", `{"code": "SMITH", "name": "Smith wedding", "room_type": "double", "quantity": 30, ` + stay + `}`, "cutoff_date is required"},
+		{"cutoff after arrival", `{"code": "SMITH", "name": "Smith wedding", "room_type": "double", "quantity": 30, ` + stay + `, "cutoff_date": "2030-05-02"}`, "cutoff_date cannot be after the checkin date"},
+		{"invalid JSON", `{invalid`, "invalid request payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			payload, err := group_block_validators.ValidateGroupBlockPayload(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if payload.Code != "SMITH-WEDDING" || payload.CutoffDate.Format("2006-01-02") != "2030-04-15" {
+					t.Errorf("unexpected payload %+v", payload)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeGroupCode(t *testing.T) {
+	tests := []struct {
+		code    string
+		want    string
+		wantErr bool
+	}{
+		{"smith-wedding", "SMITH-WEDDING", false},
+		{" conf_2030 ", "CONF_2030", false},
+		{"ab", "", true},
+		{"smith wedding", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := group_block_validators.NormalizeGroupCode(tt.code)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("NormalizeGroupCode(%q) = %q, %v; want %q", tt.code, got, err, tt.want)
+		}
+	}
+}
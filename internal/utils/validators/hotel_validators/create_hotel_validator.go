@@ -3,8 +3,11 @@ package hotel_validators
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
+	"time"
 
+	"github.com/tktanisha/booking_system/internal/utils/currency"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -15,21 +18,91 @@ func ValidateHotelPayload(r *http.Request) (*payloads.CreateHotelPayload, error)
 		return nil, errors.New("invalid request payload")
 	}
 
-	if payload.Name == "" {
-		return nil, errors.New("name is required")
+	if err := validateNameAndAddress(payload.Name, payload.Address); err != nil {
+		return nil, err
 	}
 
-	if len(payload.Name) < 3 || len(payload.Name) > 100 {
-		return nil, errors.New("name must be between 3 and 100 characters")
+	if payload.NoShowCutoffHours != nil && *payload.NoShowCutoffHours < 0 {
+		return nil, errors.New("no_show_cutoff_hours cannot be negative")
 	}
 
-	if payload.Address == "" {
-		return nil, errors.New("address is required")
+	if payload.NoShowChargeNights < 0 {
+		return nil, errors.New("no_show_charge_nights cannot be negative")
 	}
 
-	if len(payload.Address) < 10 || len(payload.Address) > 200 {
-		return nil, errors.New("address must be between 10 and 200 characters")
+	if payload.Currency != "" {
+		payload.Currency = currency.Normalize(payload.Currency)
+		if !currency.IsValid(payload.Currency) {
+			return nil, errors.New("currency must be a supported ISO 4217 code")
+		}
+	}
+
+	if payload.TimeZone != "" {
+		if _, err := time.LoadLocation(payload.TimeZone); err != nil {
+			return nil, errors.New("time_zone must be an IANA time zone such as Europe/Paris")
+		}
+	}
+
+	if payload.CheckInTime != "" {
+		if _, err := time.Parse("15:04", payload.CheckInTime); err != nil {
+			return nil, errors.New("check_in_time must be in HH:MM format")
+		}
+	}
+
+	if payload.CheckOutTime != "" {
+		if _, err := time.Parse("15:04", payload.CheckOutTime); err != nil {
+			return nil, errors.New("check_out_time must be in HH:MM format")
+		}
+	}
+
+	if err := validateCoordinates(payload.Latitude, payload.Longitude); err != nil {
+		return nil, err
+	}
+
+	if err := validateHotelContent(&payload.HotelContentPayload); err != nil {
+		return nil, err
 	}
 
 	return &payload, nil
 }
+
+func validateNameAndAddress(name, address string) error {
+	if name == "" {
+		return errors.New("name is required")
+	}
+
+	if len(name) < 3 || len(name) > 100 {
+		return errors.New("name must be between 3 and 100 characters")
+	}
+
+	if address == "" {
+		return errors.New("address is required")
+	}
+
+	if len(address) < 10 || len(address) > 200 {
+		return errors.New("address must be between 10 and 200 characters")
+	}
+	return nil
+}
+
+// validateCoordinates accepts either both a latitude and a longitude or
+// neither.
+func validateCoordinates(latitude, longitude *float64) error {
+	if (latitude == nil) != (longitude == nil) {
+		return errors.New("latitude and longitude must be given together")
+	}
+	if latitude == nil {
+		return nil
+	}
+	return validateGeoPoint(*latitude, *longitude)
+}
+
+func validateGeoPoint(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return errors.New("latitude must be between -90 and 90")
+	}
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return errors.New("longitude must be between -180 and 180")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package hotel_validators
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
+	"github.com/tktanisha/booking_system/internal/utils/validators/auth_validators"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+const (
+	maxDescriptionLength = 2000
+	maxPhoneLength       = 30
+	maxWebsiteLength     = 200
+	maxChildAgeLimit     = 17
+	maxMinCheckInAge     = 25
+)
+
+// validateHotelContent checks the descriptive content shared by the create and
+// update payloads, trimming text fields and dropping repeated amenities.
+func validateHotelContent(content *payloads.HotelContentPayload) error {
+	content.Description = strings.TrimSpace(content.Description)
+	if len(content.Description) > maxDescriptionLength {
+		return fmt.Errorf("description cannot be longer than %d characters", maxDescriptionLength)
+	}
+
+	if content.StarRating < 0 || content.StarRating > 5 {
+		return errors.New("star_rating must be between 1 and 5, or 0 when unclassified")
+	}
+
+	amenities, err := normalizeAmenities(content.Amenities)
+	if err != nil {
+		return err
+	}
+	content.Amenities = amenities
+
+	if limit := content.Policies.ChildAgeLimit; limit != nil && (*limit < 0 || *limit > maxChildAgeLimit) {
+		return fmt.Errorf("policies.child_age_limit must be between 0 and %d", maxChildAgeLimit)
+	}
+	if age := content.Policies.MinCheckInAge; age != nil && (*age < 0 || *age > maxMinCheckInAge) {
+		return fmt.Errorf("policies.min_check_in_age must be between 0 and %d", maxMinCheckInAge)
+	}
+
+	contact := &content.Contact
+	contact.Phone = strings.TrimSpace(contact.Phone)
+	if len(contact.Phone) > maxPhoneLength {
+		return fmt.Errorf("contact.phone cannot be longer than %d characters", maxPhoneLength)
+	}
+
+	contact.Email = strings.TrimSpace(contact.Email)
+	if contact.Email != "" {
+		if err := auth_validators.ValidateEmail(contact.Email); err != nil {
+			return err
+		}
+	}
+
+	contact.Website = strings.TrimSpace(contact.Website)
+	if contact.Website != "" {
+		u, err := url.Parse(contact.Website)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(contact.Website) > maxWebsiteLength {
+			return errors.New("contact.website must be an http or https URL")
+		}
+	}
+	return nil
+}
+
+// normalizeAmenities lowercases amenities, rejects unknown ones and drops
+// repeats, keeping the first occurrence.
+func normalizeAmenities(amenities []hotel.Amenity) ([]hotel.Amenity, error) {
+	seen := make(map[hotel.Amenity]bool, len(amenities))
+	normalized := make([]hotel.Amenity, 0, len(amenities))
+	for _, a := range amenities {
+		a = hotel.Amenity(strings.ToLower(strings.TrimSpace(string(a))))
+		if !a.IsValid() {
+			return nil, fmt.Errorf("unknown amenity %q", a)
+		}
+		if seen[a] {
+			continue
+		}
+		seen[a] = true
+		normalized = append(normalized, a)
+	}
+	return normalized, nil
+}
@@ -3,9 +3,11 @@ package hotel_validators_test
 import (
 	"bytes"
 	"encoding/json"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
 	"github.com/tktanisha/booking_system/internal/utils/validators/hotel_validators"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
@@ -87,6 +89,143 @@ func TestValidateHotelPayload(t *testing.T) {
 			expectError: true,
 			errorMsg:    "address must be between 10 and 200 characters",
 		},
+		{
+			name: "negative no-show cutoff",
+			body: map[string]any{
+				"name":                 "Grand Hotel",
+				"address":              "123 Main Street, City Center",
+				"no_show_cutoff_hours": -1,
+			},
+			expectError: true,
+			errorMsg:    "no_show_cutoff_hours cannot be negative",
+		},
+		{
+			name: "zero no-show cutoff",
+			body: map[string]any{
+				"name":                 "Grand Hotel",
+				"address":              "123 Main Street, City Center",
+				"no_show_cutoff_hours": 0,
+			},
+			expectError: false,
+		},
+		{
+			name: "zero age policies",
+			body: map[string]any{
+				"name":     "Grand Hotel",
+				"address":  "123 Main Street, City Center",
+				"policies": map[string]any{"child_age_limit": 0, "min_check_in_age": 0},
+			},
+			expectError: false,
+		},
+		{
+			name: "negative no-show charge",
+			body: payloads.CreateHotelPayload{
+				Name:               "Grand Hotel",
+				Address:            "123 Main Street, City Center",
+				NoShowChargeNights: -1,
+			},
+			expectError: true,
+			errorMsg:    "no_show_charge_nights cannot be negative",
+		},
+		{
+			name: "lower-case currency",
+			body: payloads.CreateHotelPayload{
+				Name:     "Grand Hotel",
+				Address:  "123 Main Street, City Center",
+				Currency: "eur",
+			},
+			expectError: false,
+		},
+		{
+			name: "unsupported currency",
+			body: payloads.CreateHotelPayload{
+				Name:     "Grand Hotel",
+				Address:  "123 Main Street, City Center",
+				Currency: "ABC",
+			},
+			expectError: true,
+			errorMsg:    "currency must be a supported ISO 4217 code",
+		},
+		{
+			name: "time zone and standard times",
+			body: payloads.CreateHotelPayload{
+				Name:         "Grand Hotel",
+				Address:      "123 Main Street, City Center",
+				TimeZone:     "Asia/Kolkata",
+				CheckInTime:  "14:00",
+				CheckOutTime: "12:00",
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown time zone",
+			body: payloads.CreateHotelPayload{
+				Name:     "Grand Hotel",
+				Address:  "123 Main Street, City Center",
+				TimeZone: "Mars/Olympus",
+			},
+			expectError: true,
+			errorMsg:    "time_zone must be an IANA time zone such as Europe/Paris",
+		},
+		{
+			name: "malformed check-in time",
+			body: payloads.CreateHotelPayload{
+				Name:        "Grand Hotel",
+				Address:     "123 Main Street, City Center",
+				CheckInTime: "3pm",
+			},
+			expectError: true,
+			errorMsg:    "check_in_time must be in HH:MM format",
+		},
+		{
+			name: "star rating above five",
+			body: payloads.CreateHotelPayload{
+				Name:                "Grand Hotel",
+				Address:             "123 Main Street, City Center",
+				HotelContentPayload: payloads.HotelContentPayload{StarRating: 6},
+			},
+			expectError: true,
+			errorMsg:    "star_rating must be between 1 and 5, or 0 when unclassified",
+		},
+		{
+			name: "unknown amenity",
+			body: payloads.CreateHotelPayload{
+				Name:                "Grand Hotel",
+				Address:             "123 Main Street, City Center",
+				HotelContentPayload: payloads.HotelContentPayload{Amenities: []hotel.Amenity{hotel.Wifi, "casino"}},
+			},
+			expectError: true,
+			errorMsg:    `unknown amenity "casino"`,
+		},
+		{
+			name: "invalid contact email",
+			body: payloads.CreateHotelPayload{
+				Name:    "Grand Hotel",
+				Address: "123 Main Street, City Center",
+				HotelContentPayload: payloads.HotelContentPayload{
+					Contact: payloads.HotelContactPayload{Email: "front-desk"},
+				},
+			},
+			expectError: true,
+			errorMsg:    "invalid email format",
+		},
+		{
+			name:        "latitude without longitude",
+			body:        `{"name":"Grand Hotel","address":"123 Main Street, City Center","latitude":19.08}`,
+			expectError: true,
+			errorMsg:    "latitude and longitude must be given together",
+		},
+		{
+			name:        "longitude out of range",
+			body:        `{"name":"Grand Hotel","address":"123 Main Street, City Center","latitude":19.08,"longitude":181}`,
+			expectError: true,
+			errorMsg:    "longitude must be between -180 and 180",
+		},
+		{
+			name:        "valid coordinates",
+			body:        `{"name":"Grand Hotel","address":"123 Main Street, City Center","latitude":-33.8688,"longitude":151.2093}`,
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -112,3 +251,102 @@ func TestValidateHotelPayload(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHotelSearchParams(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantSort      hotel.SortOrder
+		wantAmenities int
+		expectError   bool
+	}{
+		{"defaults to name", "", hotel.SortByName, 0, false},
+		{"sort by rating", "?sort=rating", hotel.SortByRating, 0, false},
+		{"sort is case insensitive", "?sort=Newest", hotel.SortByNewest, 0, false},
+		{"unknown sort", "?sort=price", "", 0, true},
+		{"amenities with repeats", "?amenities=wifi,%20Pool,wifi", hotel.SortByName, 2, false},
+		{"unknown amenity", "?amenities=wifi,casino", "", 0, true},
+		{"near sorts by distance", "?near=19.0896,72.8656", hotel.SortByDistance, 0, false},
+		{"near with another sort", "?near=19.0896,72.8656&radius_km=25&sort=rating", hotel.SortByRating, 0, false},
+		{"malformed near", "?near=19.0896", "", 0, true},
+		{"near out of range", "?near=91,72.8656", "", 0, true},
+		{"radius without near", "?radius_km=5", "", 0, true},
+		{"radius too large", "?near=19.0896,72.8656&radius_km=500", "", 0, true},
+		{"distance sort without near", "?sort=distance", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/hotels"+tt.query, nil)
+			payload, err := hotel_validators.ValidateHotelSearchParams(req)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if !tt.expectError && payload.Sort != tt.wantSort {
+				t.Errorf("expected sort %q, got %q", tt.wantSort, payload.Sort)
+			}
+			if !tt.expectError && len(payload.Amenities) != tt.wantAmenities {
+				t.Errorf("expected %d amenities, got %v", tt.wantAmenities, payload.Amenities)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateHotelPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "valid payload",
+			body: `{"name":"Grand Hotel","address":"123 Main Street, City Center","star_rating":4,"amenities":["wifi","POOL"],
+				"policies":{"pets_allowed":true,"child_age_limit":12},"contact":{"email":"stay@grand.example","website":"https://grand.example"}}`,
+		},
+		{
+			name:        "invalid JSON",
+			body:        "{invalid json",
+			expectError: true,
+			errorMsg:    "invalid request payload",
+		},
+		{
+			name:        "missing name",
+			body:        `{"address":"123 Main Street, City Center"}`,
+			expectError: true,
+			errorMsg:    "name is required",
+		},
+		{
+			name:        "negative child age limit",
+			body:        `{"name":"Grand Hotel","address":"123 Main Street, City Center","policies":{"child_age_limit":-1}}`,
+			expectError: true,
+			errorMsg:    "policies.child_age_limit must be between 0 and 17",
+		},
+		{
+			name:        "website without scheme",
+			body:        `{"name":"Grand Hotel","address":"123 Main Street, City Center","contact":{"website":"grand.example"}}`,
+			expectError: true,
+			errorMsg:    "contact.website must be an http or https URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(tt.body))
+			payload, err := hotel_validators.ValidateUpdateHotelPayload(req)
+
+			if tt.expectError {
+				if err == nil || err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(payload.Amenities) != 2 || payload.Amenities[1] != hotel.Pool {
+				t.Errorf("expected normalized amenities, got %v", payload.Amenities)
+			}
+		})
+	}
+}
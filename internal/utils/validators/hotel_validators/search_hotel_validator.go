@@ -0,0 +1,90 @@
+package hotel_validators
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/enums/hotel"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+const (
+	defaultRadiusKm = 10
+	maxRadiusKm     = 200
+)
+
+// ValidateHotelSearchParams reads a hotel search from the query string. A
+// proximity search (?near=lat,lng) is sorted nearest first unless another sort
+// is asked for.
+func ValidateHotelSearchParams(r *http.Request) (*payloads.HotelSearchPayload, error) {
+	query := r.URL.Query()
+
+	payload := &payloads.HotelSearchPayload{}
+
+	if raw := strings.TrimSpace(query.Get("near")); raw != "" {
+		near, err := parseNear(raw)
+		if err != nil {
+			return nil, err
+		}
+		payload.Near = near
+		payload.RadiusKm = defaultRadiusKm
+	}
+
+	if raw := strings.TrimSpace(query.Get("radius_km")); raw != "" {
+		if payload.Near == nil {
+			return nil, errors.New("radius_km can only be used with near")
+		}
+		radius, err := strconv.ParseFloat(raw, 64)
+		if err != nil || !(radius > 0 && radius <= maxRadiusKm) {
+			return nil, errors.New("radius_km must be a number greater than 0 and at most 200")
+		}
+		payload.RadiusKm = radius
+	}
+
+	payload.Sort = hotel.SortByName
+	if payload.Near != nil {
+		payload.Sort = hotel.SortByDistance
+	}
+	if sort := strings.TrimSpace(query.Get("sort")); sort != "" {
+		payload.Sort = hotel.SortOrder(strings.ToLower(sort))
+		if !payload.Sort.IsValid() {
+			return nil, errors.New("sort must be name, rating, newest or distance")
+		}
+		if payload.Sort == hotel.SortByDistance && payload.Near == nil {
+			return nil, errors.New("sort=distance needs near")
+		}
+	}
+
+	if raw := strings.TrimSpace(query.Get("amenities")); raw != "" {
+		amenities := make([]hotel.Amenity, 0)
+		for _, a := range strings.Split(raw, ",") {
+			amenities = append(amenities, hotel.Amenity(a))
+		}
+		normalized, err := normalizeAmenities(amenities)
+		if err != nil {
+			return nil, err
+		}
+		payload.Amenities = normalized
+	}
+
+	return payload, nil
+}
+
+// parseNear parses a "latitude,longitude" pair.
+func parseNear(raw string) (*payloads.GeoPointPayload, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != 2 {
+		return nil, errors.New("near must be latitude,longitude")
+	}
+	latitude, latErr := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	longitude, lngErr := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if latErr != nil || lngErr != nil {
+		return nil, errors.New("near must be latitude,longitude")
+	}
+	if err := validateGeoPoint(latitude, longitude); err != nil {
+		return nil, err
+	}
+	return &payloads.GeoPointPayload{Latitude: latitude, Longitude: longitude}, nil
+}
@@ -0,0 +1,31 @@
+package hotel_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateUpdateHotelPayload(r *http.Request) (*payloads.UpdateHotelPayload, error) {
+	var payload payloads.UpdateHotelPayload
+
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if err := validateNameAndAddress(payload.Name, payload.Address); err != nil {
+		return nil, err
+	}
+
+	if err := validateCoordinates(payload.Latitude, payload.Longitude); err != nil {
+		return nil, err
+	}
+
+	if err := validateHotelContent(&payload.HotelContentPayload); err != nil {
+		return nil, err
+	}
+
+	return &payload, nil
+}
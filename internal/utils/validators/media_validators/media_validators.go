@@ -0,0 +1,95 @@
+package media_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+const (
+	MaxUploadBytes   = 5 << 20
+	maxCaptionLength = 200
+	maxOrderedMedia  = 100
+
+	// formOverhead leaves room for the multipart boundaries and the caption.
+	formOverhead = 64 << 10
+)
+
+var allowedContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+// ValidateMediaUpload reads a photo from the "file" field of a multipart form,
+// with an optional "caption" field. The content type is sniffed from the file
+// itself.
+func ValidateMediaUpload(r *http.Request) (*payloads.MediaUploadPayload, error) {
+	r.Body = http.MaxBytesReader(nil, r.Body, MaxUploadBytes+formOverhead)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, errors.New("file must be at most 5 MB")
+		}
+		return nil, errors.New("invalid request payload")
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, errors.New("file is required")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxUploadBytes+1))
+	if err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+	if len(data) == 0 {
+		return nil, errors.New("file is required")
+	}
+	if len(data) > MaxUploadBytes {
+		return nil, errors.New("file must be at most 5 MB")
+	}
+
+	payload := &payloads.MediaUploadPayload{
+		Data:        data,
+		ContentType: http.DetectContentType(data),
+		Caption:     strings.TrimSpace(r.FormValue("caption")),
+	}
+	if !allowedContentTypes[payload.ContentType] {
+		return nil, errors.New("file must be a JPEG or PNG image")
+	}
+	if len(payload.Caption) > maxCaptionLength {
+		return nil, errors.New("caption cannot be longer than 200 characters")
+	}
+
+	return payload, nil
+}
+
+func ValidateMediaOrderPayload(r *http.Request) (*payloads.MediaOrderPayload, error) {
+	var payload payloads.MediaOrderPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if len(payload.MediaIDs) == 0 {
+		return nil, errors.New("media_ids is required")
+	}
+	if len(payload.MediaIDs) > maxOrderedMedia {
+		return nil, errors.New("media_ids cannot list more than 100 photos")
+	}
+	seen := make(map[uuid.UUID]bool, len(payload.MediaIDs))
+	for _, id := range payload.MediaIDs {
+		if seen[id] {
+			return nil, errors.New("media_ids cannot list a photo twice")
+		}
+		seen[id] = true
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,133 @@
+package media_validators_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/media_validators"
+)
+
+func pngBytes(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatalf("failed to encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func uploadRequest(t *testing.T, file []byte, caption string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if file != nil {
+		part, _ := writer.CreateFormFile("file", "photo")
+		part.Write(file)
+	}
+	if caption != "" {
+		writer.WriteField("caption", caption)
+	}
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/hotels/id/media", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestValidateMediaUpload(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      func(t *testing.T) *http.Request
+		errorMsg string
+	}{
+		{
+			name: "png with caption",
+			req:  func(t *testing.T) *http.Request { return uploadRequest(t, pngBytes(t), "  Rooftop pool ") },
+		},
+		{
+			name: "not multipart",
+			req: func(t *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/hotels/id/media", strings.NewReader(`{"file":"x"}`))
+			},
+			errorMsg: "invalid request payload",
+		},
+		{
+			name:     "missing file",
+			req:      func(t *testing.T) *http.Request { return uploadRequest(t, nil, "Lobby") },
+			errorMsg: "file is required",
+		},
+		{
+			name:     "not an image",
+			req:      func(t *testing.T) *http.Request { return uploadRequest(t, []byte("%PDF-1.4 invoice"), "") },
+			errorMsg: "file must be a JPEG or PNG image",
+		},
+		{
+			name: "too large",
+			req: func(t *testing.T) *http.Request {
+				return uploadRequest(t, append(pngBytes(t), make([]byte, media_validators.MaxUploadBytes)...), "")
+			},
+			errorMsg: "file must be at most 5 MB",
+		},
+		{
+			name:     "caption too long",
+			req:      func(t *testing.T) *http.Request { return uploadRequest(t, pngBytes(t), strings.Repeat("a", 201)) },
+			errorMsg: "caption cannot be longer than 200 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := media_validators.ValidateMediaUpload(tt.req(t))
+			if tt.errorMsg != "" {
+				if err == nil || err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if payload.ContentType != "image/png" || payload.Caption != "Rooftop pool" {
+				t.Errorf("expected a trimmed png upload, got %q %q", payload.ContentType, payload.Caption)
+			}
+		})
+	}
+}
+
+func TestValidateMediaOrderPayload(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{"valid", `{"media_ids":["` + id.String() + `","` + uuid.New().String() + `"]}`, ""},
+		{"invalid JSON", `{"media_ids":`, "invalid request payload"},
+		{"empty", `{"media_ids":[]}`, "media_ids is required"},
+		{"repeated photo", `{"media_ids":["` + id.String() + `","` + id.String() + `"]}`, "media_ids cannot list a photo twice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/hotels/id/media/order", strings.NewReader(tt.body))
+			_, err := media_validators.ValidateMediaOrderPayload(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
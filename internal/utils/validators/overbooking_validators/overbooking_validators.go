@@ -0,0 +1,68 @@
+package overbooking_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+const (
+	maxLimitPercent   = 50
+	maxWalkListNights = 31
+)
+
+func ValidateOverbookingLimitPayload(r *http.Request) (*payloads.OverbookingLimitPayload, error) {
+	var payload payloads.OverbookingLimitPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.RoomType == "" {
+		return nil, errors.New("room_type is required")
+	}
+	if !payload.RoomType.IsValid() {
+		return nil, errors.New("room_type is not a valid room type code")
+	}
+	if payload.LimitPercent < 0 || payload.LimitPercent > maxLimitPercent {
+		return nil, errors.New("limit_percent must be between 0 and 50")
+	}
+	return &payload, nil
+}
+
+// ValidateWalkListParams reads the ?from= and ?to= nights of a walk list. A
+// to date needs a from date, and a walk list covers at most 31 nights.
+func ValidateWalkListParams(r *http.Request) (*payloads.WalkListPayload, error) {
+	query := r.URL.Query()
+
+	payload := &payloads.WalkListPayload{}
+
+	if raw := strings.TrimSpace(query.Get("from")); raw != "" {
+		from, err := payloads.ParseDate(raw)
+		if err != nil {
+			return nil, err
+		}
+		payload.From = from
+	}
+
+	if raw := strings.TrimSpace(query.Get("to")); raw != "" {
+		if payload.From.IsZero() {
+			return nil, errors.New("to can only be used with from")
+		}
+		to, err := payloads.ParseDate(raw)
+		if err != nil {
+			return nil, err
+		}
+		if !payload.From.Before(to.Time) {
+			return nil, errors.New("from date must be before to date")
+		}
+		if to.After(payload.From.AddDate(0, 0, maxWalkListNights)) {
+			return nil, errors.New("a walk list covers at most 31 nights")
+		}
+		payload.To = to
+	}
+
+	return payload, nil
+}
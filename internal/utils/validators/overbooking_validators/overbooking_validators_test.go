@@ -0,0 +1,74 @@
+package overbooking_validators_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/overbooking_validators"
+)
+
+func TestValidateOverbookingLimitPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{"valid limit", `{"room_type": "double", "limit_percent": 5}`, ""},
+		{"overbooking turned off", `{"room_type": "double", "limit_percent": 0}`, ""},
+		{"missing room type", `{"limit_percent": 5}`, "room_type is required"},
+		{"invalid room type", `{"room_type": "Sea View", "limit_percent": 5}`, "room_type is not a valid room type code"},
+		{"negative limit", `{"room_type": "double", "limit_percent": -1}`, "limit_percent must be between 0 and 50"},
+		{"limit too high", `{"room_type": "double", "limit_percent": 51}`, "limit_percent must be between 0 and 50"},
+		{"invalid JSON", `{invalid`, "invalid request payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(tt.body))
+			_, err := overbooking_validators.ValidateOverbookingLimitPayload(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestValidateWalkListParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		errorMsg string
+	}{
+		{"defaults", "", ""},
+		{"from only", "?from=2030-05-01", ""},
+		{"from and to", "?from=2030-05-01&to=2030-05-08", ""},
+		{"to without from", "?to=2030-05-08", "to can only be used with from"},
+		{"to before from", "?from=2030-05-08&to=2030-05-01", "from date must be before to date"},
+		{"range too long", "?from=2030-05-01&to=2030-06-02", "a walk list covers at most 31 nights"},
+		{"not a date", "?from=tomorrow", `invalid date "tomorrow", expected YYYY-MM-DD`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+			_, err := overbooking_validators.ValidateWalkListParams(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
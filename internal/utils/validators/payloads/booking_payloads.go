@@ -1,14 +1,36 @@
 package payloads
 
 import (
-	"time"
-
 	"github.com/google/uuid"
 )
 
 type BookingPayload struct {
-	HotelId  uuid.UUID      `json:"hotel_id"`
-	CheckIn  time.Time      `json:"checkin"`
-	CheckOut time.Time      `json:"checkout"`
-	Rooms    []*RoomPayload `json:"rooms"`
+	HotelId          uuid.UUID       `json:"hotel_id"`
+	CheckIn          Date            `json:"checkin"`  // first night, in hotel time
+	CheckOut         Date            `json:"checkout"` // departure day, in hotel time
+	Rooms            []*RoomPayload  `json:"rooms"`
+	LeadGuest        *GuestPayload   `json:"lead_guest,omitempty"`
+	Guests           []*GuestPayload `json:"guests,omitempty"`
+	EstimatedArrival string          `json:"estimated_arrival,omitempty"` // "HH:MM"
+	Notes            string          `json:"notes,omitempty"`
+	PromoCode        string          `json:"promo_code,omitempty"`
+	WaitlistEntryId  *uuid.UUID      `json:"waitlist_entry_id,omitempty"` // books the rooms held by a waitlist offer
+	GroupCode        string          `json:"group_code,omitempty"`        // books rooms out of the group's block
+}
+
+type GuestPayload struct {
+	FullName string `json:"full_name"`
+	Email    string `json:"email,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+}
+
+// CheckInPayload lets the front desk pick specific units; rooms left unmatched are assigned automatically.
+type CheckInPayload struct {
+	UnitIds []uuid.UUID `json:"unit_ids"`
+}
+
+// CheckoutPayload lets a manager waive whatever is left on the folio so the guest can leave.
+type CheckoutPayload struct {
+	WaiveBalance bool   `json:"waive_balance"`
+	WaiverReason string `json:"waiver_reason,omitempty"`
 }
@@ -0,0 +1,14 @@
+package payloads
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+type CancellationPolicyPayload struct {
+	HotelId         uuid.UUID     `json:"hotel_id"`
+	RoomCategory    room.RoomType `json:"room_category"`
+	FreeCancelHours int           `json:"free_cancel_hours"`
+	PenaltyNights   int           `json:"penalty_nights"`
+	NonRefundable   bool          `json:"non_refundable"`
+}
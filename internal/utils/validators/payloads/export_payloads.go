@@ -0,0 +1,12 @@
+package payloads
+
+import "github.com/tktanisha/booking_system/internal/enums/export"
+
+// ExportPayload selects the rows of a dataset dated from From up to, but not
+// including, To. Either date may be left out to leave that end open.
+type ExportPayload struct {
+	Dataset export.Dataset
+	Format  export.Format
+	From    Date
+	To      Date
+}
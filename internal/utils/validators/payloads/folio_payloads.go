@@ -0,0 +1,16 @@
+package payloads
+
+import "github.com/tktanisha/booking_system/internal/enums/folio"
+
+// PostFolioLinePayload takes Amount as a currency amount. Adjustments may be
+// negative to credit the guest; charges and payments are always positive.
+type PostFolioLinePayload struct {
+	LineType    folio.LineType `json:"line_type"`
+	Category    folio.Category `json:"category,omitempty"`
+	Description string         `json:"description"`
+	Amount      float64        `json:"amount"`
+}
+
+type VoidFolioLinePayload struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,17 @@
+package payloads
+
+import (
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// GroupBlockPayload holds Quantity rooms of RoomType for a group until the
+// start of CutoffDate, when unpicked rooms return to general inventory.
+type GroupBlockPayload struct {
+	Code       string        `json:"code"`
+	Name       string        `json:"name"`
+	RoomType   room.RoomType `json:"room_type"`
+	Quantity   int           `json:"quantity"`
+	CheckIn    Date          `json:"checkin"`     // first night, in hotel time
+	CheckOut   Date          `json:"checkout"`    // departure day, in hotel time
+	CutoffDate Date          `json:"cutoff_date"` // release day, in hotel time
+}
@@ -1,6 +1,63 @@
 package payloads
 
+import "github.com/tktanisha/booking_system/internal/enums/hotel"
+
 type CreateHotelPayload struct {
-	Name    string `json:"name"`
-	Address string `json:"address"`
+	Name               string   `json:"name"`
+	Address            string   `json:"address"`
+	NoShowCutoffHours  *int     `json:"no_show_cutoff_hours"` // defaults to 24 when omitted; 0 is a no-show at check-in
+	NoShowChargeNights int      `json:"no_show_charge_nights"`
+	HoldUntilInspected bool     `json:"hold_until_inspected"`
+	Currency           string   `json:"currency"`       // ISO 4217 code, defaults to USD when omitted
+	TimeZone           string   `json:"time_zone"`      // IANA name, defaults to UTC when omitted
+	CheckInTime        string   `json:"check_in_time"`  // "15:04", defaults to 15:00 when omitted
+	CheckOutTime       string   `json:"check_out_time"` // "15:04", defaults to 11:00 when omitted
+	Latitude           *float64 `json:"latitude"`       // looked up from the address when omitted
+	Longitude          *float64 `json:"longitude"`
+	HotelContentPayload
+}
+
+// UpdateHotelPayload replaces the details a manager can edit once the hotel
+// exists. Omitted content fields are cleared.
+type UpdateHotelPayload struct {
+	Name      string   `json:"name"`
+	Address   string   `json:"address"`
+	Latitude  *float64 `json:"latitude"` // looked up from the address when omitted
+	Longitude *float64 `json:"longitude"`
+	HotelContentPayload
+}
+
+// HotelContentPayload is the descriptive content of a hotel shown to guests.
+type HotelContentPayload struct {
+	Description string               `json:"description"`
+	StarRating  int                  `json:"star_rating"` // 1 to 5, or 0 when unclassified
+	Amenities   []hotel.Amenity      `json:"amenities"`
+	Policies    HotelPoliciesPayload `json:"policies"`
+	Contact     HotelContactPayload  `json:"contact"`
+}
+
+type HotelPoliciesPayload struct {
+	PetsAllowed    bool `json:"pets_allowed"`
+	SmokingAllowed bool `json:"smoking_allowed"`
+	ChildAgeLimit  *int `json:"child_age_limit"`  // defaults to 12 when omitted; 0 counts no guest as a child
+	MinCheckInAge  *int `json:"min_check_in_age"` // defaults to 18 when omitted; 0 sets no minimum age
+}
+
+type HotelContactPayload struct {
+	Phone   string `json:"phone"`
+	Email   string `json:"email"`
+	Website string `json:"website"`
+}
+
+// HotelSearchPayload is read from the query string of a hotel search.
+type HotelSearchPayload struct {
+	Amenities []hotel.Amenity
+	Near      *GeoPointPayload
+	RadiusKm  float64
+	Sort      hotel.SortOrder
+}
+
+type GeoPointPayload struct {
+	Latitude  float64
+	Longitude float64
 }
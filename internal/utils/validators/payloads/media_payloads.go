@@ -0,0 +1,15 @@
+package payloads
+
+import "github.com/google/uuid"
+
+// MediaUploadPayload is a photo read from a multipart upload.
+type MediaUploadPayload struct {
+	Data        []byte
+	ContentType string // sniffed from Data rather than trusted from the client
+	Caption     string
+}
+
+// MediaOrderPayload lists photos in the order they should be shown.
+type MediaOrderPayload struct {
+	MediaIDs []uuid.UUID `json:"media_ids"`
+}
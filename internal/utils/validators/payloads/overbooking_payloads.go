@@ -0,0 +1,20 @@
+package payloads
+
+import (
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// OverbookingLimitPayload sets how far past its physical rooms a room type may
+// be sold. A LimitPercent of 0 turns overbooking off for the type.
+type OverbookingLimitPayload struct {
+	RoomType     room.RoomType `json:"room_type"`
+	LimitPercent int           `json:"limit_percent"`
+}
+
+// WalkListPayload is read from the query string of a walk list. The nights
+// run from From up to but not including To, in hotel time; unset dates are
+// filled in by the service.
+type WalkListPayload struct {
+	From Date
+	To   Date
+}
@@ -0,0 +1,21 @@
+package payloads
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+)
+
+// CreatePromoCodePayload takes Value as a whole percent for percentage codes and
+// as an amount in the hotel's currency for fixed ones. The code is only valid at HotelId.
+type CreatePromoCodePayload struct {
+	Code         string             `json:"code"`
+	HotelId      uuid.UUID          `json:"hotel_id"`
+	DiscountType promo.DiscountType `json:"discount_type"`
+	Value        float64            `json:"value"`
+	ValidFrom    time.Time          `json:"valid_from"`
+	ValidTo      time.Time          `json:"valid_to"`
+	MaxUses      int                `json:"max_uses"`
+	PerUserLimit int                `json:"per_user_limit"`
+}
@@ -0,0 +1,10 @@
+package payloads
+
+import "github.com/tktanisha/booking_system/internal/enums/report"
+
+// ReportPayload selects the stay nights from From up to, but not including, To.
+type ReportPayload struct {
+	From    Date
+	To      Date
+	GroupBy report.Grouping
+}
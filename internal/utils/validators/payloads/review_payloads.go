@@ -0,0 +1,11 @@
+package payloads
+
+type ReviewPayload struct {
+	Rating int    `json:"rating"` // 1 to 5 stars
+	Title  string `json:"title,omitempty"`
+	Body   string `json:"body"`
+}
+
+type ReviewReplyPayload struct {
+	Reply string `json:"reply"`
+}
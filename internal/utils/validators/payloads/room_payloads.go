@@ -1,6 +1,8 @@
 package payloads
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/enums/room"
 )
@@ -11,7 +13,73 @@ type CreateRoomPayload struct {
 	Price    float64       `json:"price"`
 	Quantity int           `json:"quantity"`
 }
+
+// RoomPayload books Quantity rooms of RoomType; Adults and Children are the guests staying in each of them.
 type RoomPayload struct {
 	RoomType room.RoomType `json:"room_type"`
 	Quantity int           `json:"quantity"`
+	Adults   int           `json:"adults"`
+	Children int           `json:"children"`
+}
+
+// RoomsByType adds up the rooms requested of each type, in request order.
+func RoomsByType(rooms []*RoomPayload) []*RoomPayload {
+	totals := make([]*RoomPayload, 0, len(rooms))
+	index := make(map[room.RoomType]int)
+	for _, r := range rooms {
+		if i, ok := index[r.RoomType]; ok {
+			totals[i].Quantity += r.Quantity
+			continue
+		}
+		index[r.RoomType] = len(totals)
+		totals = append(totals, &RoomPayload{RoomType: r.RoomType, Quantity: r.Quantity})
+	}
+	return totals
+}
+
+type CreateRoomUnitPayload struct {
+	HotelID  uuid.UUID     `json:"hotel_id"`
+	RoomType room.RoomType `json:"room_type"`
+	Number   string        `json:"number"`
+	Floor    int           `json:"floor"`
+}
+
+type RoomUnitStatusPayload struct {
+	Status room.UnitStatus `json:"status"`
+}
+
+// CreateRoomBlockPayload blocks either Quantity rooms of RoomType or, when RoomUnitID is set, that single unit.
+// A WholeHotel block closes the whole property and takes neither.
+type CreateRoomBlockPayload struct {
+	HotelID    uuid.UUID     `json:"hotel_id"`
+	WholeHotel bool          `json:"whole_hotel"`
+	RoomType   room.RoomType `json:"room_type"`
+	RoomUnitID *uuid.UUID    `json:"room_unit_id"`
+	Quantity   int           `json:"quantity"`
+	StartDate  time.Time     `json:"start_date"`
+	EndDate    time.Time     `json:"end_date"`
+	Reason     string        `json:"reason"`
+	Force      bool          `json:"force"`
+}
+
+type CreateRoomTypePayload struct {
+	HotelID          uuid.UUID     `json:"hotel_id"`
+	Code             room.RoomType `json:"code"`
+	Name             string        `json:"name"`
+	MaxOccupancy     int           `json:"max_occupancy"`
+	BaseOccupancy    int           `json:"base_occupancy"`
+	ExtraGuestFee    float64       `json:"extra_guest_fee"`
+	BedConfiguration string        `json:"bed_configuration"`
+	SizeSqm          int           `json:"size_sqm"`
+	Amenities        []string      `json:"amenities"`
+}
+
+type UpdateRoomTypePayload struct {
+	Name             string   `json:"name"`
+	MaxOccupancy     int      `json:"max_occupancy"`
+	BaseOccupancy    int      `json:"base_occupancy"`
+	ExtraGuestFee    float64  `json:"extra_guest_fee"`
+	BedConfiguration string   `json:"bed_configuration"`
+	SizeSqm          int      `json:"size_sqm"`
+	Amenities        []string `json:"amenities"`
 }
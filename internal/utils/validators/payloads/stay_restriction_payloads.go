@@ -0,0 +1,23 @@
+package payloads
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// StayRestrictionPayload covers StartDate to EndDate inclusive. An empty RoomCategory
+// applies to every room type and empty DaysOfWeek to every day in the range.
+type StayRestrictionPayload struct {
+	HotelId           uuid.UUID     `json:"hotel_id"`
+	RoomCategory      room.RoomType `json:"room_category"`
+	StartDate         time.Time     `json:"start_date"`
+	EndDate           time.Time     `json:"end_date"`
+	DaysOfWeek        []int         `json:"days_of_week"`
+	MinLengthOfStay   int           `json:"min_los"`
+	MaxLengthOfStay   int           `json:"max_los"`
+	ClosedToArrival   bool          `json:"closed_to_arrival"`
+	ClosedToDeparture bool          `json:"closed_to_departure"`
+	StopSell          bool          `json:"stop_sell"`
+}
@@ -0,0 +1,16 @@
+package payloads
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+)
+
+// CreateTaxRulePayload takes Value as a percent (12.5) for percentage rules and
+// as a currency amount for per-night and per-person rules.
+type CreateTaxRulePayload struct {
+	HotelId    uuid.UUID      `json:"hotel_id"`
+	Name       string         `json:"name"`
+	ChargeType tax.ChargeType `json:"charge_type"`
+	Value      float64        `json:"value"`
+	Inclusive  bool           `json:"inclusive"`
+}
@@ -0,0 +1,15 @@
+package payloads
+
+import (
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+)
+
+// WaitlistPayload asks for Quantity rooms of RoomType that are sold out for the stay.
+type WaitlistPayload struct {
+	HotelId  uuid.UUID     `json:"hotel_id"`
+	RoomType room.RoomType `json:"room_type"`
+	Quantity int           `json:"quantity"`
+	CheckIn  Date          `json:"checkin"`  // first night, in hotel time
+	CheckOut Date          `json:"checkout"` // departure day, in hotel time
+}
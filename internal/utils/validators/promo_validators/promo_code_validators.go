@@ -0,0 +1,71 @@
+package promo_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+var promoCodeFormat = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// NormalizePromoCode upper-cases a promo code so lookups are case-insensitive.
+func NormalizePromoCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !promoCodeFormat.MatchString(code) {
+		return "", errors.New("promo_code must be 3-32 letters, digits, '-' or '_'")
+	}
+	return code, nil
+}
+
+func ValidateCreatePromoCodePayload(r *http.Request) (*payloads.CreatePromoCodePayload, error) {
+	var payload payloads.CreatePromoCodePayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	code, err := NormalizePromoCode(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	payload.Code = code
+
+	if payload.HotelId == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+
+	switch payload.DiscountType {
+	case promo.Percentage:
+		if payload.Value != math.Trunc(payload.Value) || payload.Value < 1 || payload.Value > 100 {
+			return nil, errors.New("percentage value must be a whole number from 1 to 100")
+		}
+	case promo.Fixed:
+		if payload.Value <= 0 {
+			return nil, errors.New("fixed value must be positive")
+		}
+	default:
+		return nil, errors.New("discount_type must be percentage or fixed")
+	}
+
+	if payload.ValidFrom.IsZero() || payload.ValidTo.IsZero() {
+		return nil, errors.New("valid_from and valid_to are required")
+	}
+	if !payload.ValidTo.After(payload.ValidFrom) {
+		return nil, errors.New("valid_to must be after valid_from")
+	}
+
+	if payload.MaxUses < 0 || payload.PerUserLimit < 0 {
+		return nil, errors.New("max_uses and per_user_limit cannot be negative")
+	}
+	if payload.MaxUses > 0 && payload.PerUserLimit > payload.MaxUses {
+		return nil, errors.New("per_user_limit cannot be greater than max_uses")
+	}
+
+	return &payload, nil
+}
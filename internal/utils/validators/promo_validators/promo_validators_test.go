@@ -0,0 +1,157 @@
+package promo_validators_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/promo"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+	"github.com/tktanisha/booking_system/internal/utils/validators/promo_validators"
+)
+
+func TestNormalizePromoCode(t *testing.T) {
+	tests := []struct {
+		code        string
+		want        string
+		expectError bool
+	}{
+		{" summer-10 ", "SUMMER-10", false},
+		{"WELCOME_2026", "WELCOME_2026", false},
+		{"ab", "", true},
+		{"HALF OFF", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			got, err := promo_validators.NormalizePromoCode(tt.code)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("expected error: %v, got: %v", tt.expectError, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateCreatePromoCodePayload(t *testing.T) {
+	from := time.Now()
+	to := from.Add(30 * 24 * time.Hour)
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name        string
+		body        any
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid percentage code for a hotel",
+			body:        payloads.CreatePromoCodePayload{Code: "summer10", HotelId: hotelID, DiscountType: promo.Percentage, Value: 10, ValidFrom: from, ValidTo: to, MaxUses: 100, PerUserLimit: 1},
+			expectError: false,
+		},
+		{
+			name:        "valid fixed code",
+			body:        payloads.CreatePromoCodePayload{Code: "WELCOME", HotelId: hotelID, DiscountType: promo.Fixed, Value: 25.50, ValidFrom: from, ValidTo: to},
+			expectError: false,
+		},
+		{
+			name:        "invalid JSON",
+			body:        "{invalid",
+			expectError: true,
+			errorMsg:    "invalid request payload",
+		},
+		{
+			name:        "malformed code",
+			body:        payloads.CreatePromoCodePayload{Code: "50% OFF", HotelId: hotelID, DiscountType: promo.Fixed, Value: 5, ValidFrom: from, ValidTo: to},
+			expectError: true,
+			errorMsg:    "promo_code must be 3-32 letters, digits, '-' or '_'",
+		},
+		{
+			name:        "missing hotel id",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", DiscountType: promo.Fixed, Value: 5, ValidFrom: from, ValidTo: to},
+			expectError: true,
+			errorMsg:    "hotel_id is required",
+		},
+		{
+			name:        "percentage above 100",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Percentage, Value: 120, ValidFrom: from, ValidTo: to},
+			expectError: true,
+			errorMsg:    "percentage value must be a whole number from 1 to 100",
+		},
+		{
+			name:        "fractional percentage",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Percentage, Value: 12.5, ValidFrom: from, ValidTo: to},
+			expectError: true,
+			errorMsg:    "percentage value must be a whole number from 1 to 100",
+		},
+		{
+			name:        "non-positive fixed value",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Fixed, Value: 0, ValidFrom: from, ValidTo: to},
+			expectError: true,
+			errorMsg:    "fixed value must be positive",
+		},
+		{
+			name:        "unknown discount type",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: "bogo", Value: 1, ValidFrom: from, ValidTo: to},
+			expectError: true,
+			errorMsg:    "discount_type must be percentage or fixed",
+		},
+		{
+			name:        "missing window",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Fixed, Value: 5},
+			expectError: true,
+			errorMsg:    "valid_from and valid_to are required",
+		},
+		{
+			name:        "window ends before it starts",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Fixed, Value: 5, ValidFrom: to, ValidTo: from},
+			expectError: true,
+			errorMsg:    "valid_to must be after valid_from",
+		},
+		{
+			name:        "negative cap",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Fixed, Value: 5, ValidFrom: from, ValidTo: to, MaxUses: -1},
+			expectError: true,
+			errorMsg:    "max_uses and per_user_limit cannot be negative",
+		},
+		{
+			name:        "per user limit above cap",
+			body:        payloads.CreatePromoCodePayload{Code: "SUMMER10", HotelId: hotelID, DiscountType: promo.Fixed, Value: 5, ValidFrom: from, ValidTo: to, MaxUses: 1, PerUserLimit: 2},
+			expectError: true,
+			errorMsg:    "per_user_limit cannot be greater than max_uses",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if s, ok := tt.body.(string); ok {
+				body = []byte(s)
+			} else {
+				body, _ = json.Marshal(tt.body)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+
+			got, err := promo_validators.ValidateCreatePromoCodePayload(req)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+				} else if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				if got != nil {
+					t.Errorf("expected nil payload, got %v", got)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
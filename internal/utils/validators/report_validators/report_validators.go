@@ -0,0 +1,48 @@
+package report_validators
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+const maxReportNights = 366
+
+// ValidateReportParams reads the ?from=, ?to= and ?group_by= of a report. Both
+// dates are required, a report covers at most 366 nights and figures are
+// grouped by day unless group_by says otherwise.
+func ValidateReportParams(r *http.Request) (*payloads.ReportPayload, error) {
+	query := r.URL.Query()
+
+	rawFrom, rawTo := strings.TrimSpace(query.Get("from")), strings.TrimSpace(query.Get("to"))
+	if rawFrom == "" || rawTo == "" {
+		return nil, errors.New("from and to dates are required")
+	}
+	from, err := payloads.ParseDate(rawFrom)
+	if err != nil {
+		return nil, err
+	}
+	to, err := payloads.ParseDate(rawTo)
+	if err != nil {
+		return nil, err
+	}
+	if !from.Before(to.Time) {
+		return nil, errors.New("from date must be before to date")
+	}
+	if to.After(from.AddDate(0, 0, maxReportNights)) {
+		return nil, errors.New("a report covers at most 366 nights")
+	}
+
+	groupBy := report.GroupByDay
+	if raw := strings.TrimSpace(query.Get("group_by")); raw != "" {
+		groupBy = report.Grouping(strings.ToLower(raw))
+		if !groupBy.IsValid() {
+			return nil, errors.New("group_by must be one of day, week or month")
+		}
+	}
+
+	return &payloads.ReportPayload{From: from, To: to, GroupBy: groupBy}, nil
+}
@@ -0,0 +1,46 @@
+package report_validators_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/enums/report"
+	"github.com/tktanisha/booking_system/internal/utils/validators/report_validators"
+)
+
+func TestValidateReportParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		groupBy  report.Grouping
+		errorMsg string
+	}{
+		{"grouped by day by default", "?from=2030-05-01&to=2030-06-01", report.GroupByDay, ""},
+		{"grouped by month", "?from=2030-01-01&to=2031-01-01&group_by=Month", report.GroupByMonth, ""},
+		{"missing to", "?from=2030-05-01", "", "from and to dates are required"},
+		{"invalid date", "?from=2030-05-01&to=01/06/2030", "", `invalid date "01/06/2030", expected YYYY-MM-DD`},
+		{"to before from", "?from=2030-05-02&to=2030-05-01", "", "from date must be before to date"},
+		{"range too long", "?from=2030-01-01&to=2031-01-03", "", "a report covers at most 366 nights"},
+		{"unknown grouping", "?from=2030-05-01&to=2030-06-01&group_by=quarter", "", "group_by must be one of day, week or month"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/"+tt.query, nil)
+			payload, err := report_validators.ValidateReportParams(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if payload.GroupBy != tt.groupBy {
+					t.Errorf("expected grouping %q, got %q", tt.groupBy, payload.GroupBy)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
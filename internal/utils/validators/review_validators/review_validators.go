@@ -0,0 +1,53 @@
+package review_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateReviewPayload(r *http.Request) (*payloads.ReviewPayload, error) {
+	var payload payloads.ReviewPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.Rating < 1 || payload.Rating > 5 {
+		return nil, errors.New("rating must be between 1 and 5")
+	}
+
+	payload.Title = strings.TrimSpace(payload.Title)
+	if len(payload.Title) > 120 {
+		return nil, errors.New("title cannot be longer than 120 characters")
+	}
+
+	payload.Body = strings.TrimSpace(payload.Body)
+	if payload.Body == "" {
+		return nil, errors.New("body is required")
+	}
+	if len(payload.Body) > 2000 {
+		return nil, errors.New("body cannot be longer than 2000 characters")
+	}
+
+	return &payload, nil
+}
+
+func ValidateReviewReplyPayload(r *http.Request) (*payloads.ReviewReplyPayload, error) {
+	var payload payloads.ReviewReplyPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	payload.Reply = strings.TrimSpace(payload.Reply)
+	if payload.Reply == "" {
+		return nil, errors.New("reply is required")
+	}
+	if len(payload.Reply) > 2000 {
+		return nil, errors.New("reply cannot be longer than 2000 characters")
+	}
+
+	return &payload, nil
+}
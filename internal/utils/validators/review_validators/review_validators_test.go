@@ -0,0 +1,68 @@
+package review_validators_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/review_validators"
+)
+
+func TestValidateReviewPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{"valid review", `{"rating": 5, "title": "Perfect", "body": "  Lovely staff  "}`, ""},
+		{"rating too low", `{"rating": 0, "body": "ok"}`, "rating must be between 1 and 5"},
+		{"rating too high", `{"rating": 6, "body": "ok"}`, "rating must be between 1 and 5"},
+		{"missing body", `{"rating": 3, "body": "   "}`, "body is required"},
+		{"title too long", `{"rating": 3, "title": "` + strings.Repeat("x", 121) + `", "body": "ok"}`, "title cannot be longer than 120 characters"},
+		{"body too long", `{"rating": 3, "body": "` + strings.Repeat("x", 2001) + `"}`, "body cannot be longer than 2000 characters"},
+		{"invalid JSON", `{invalid`, "invalid request payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			payload, err := review_validators.ValidateReviewPayload(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if payload.Body != "Lovely staff" {
+					t.Errorf("expected trimmed body, got %q", payload.Body)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}
+
+func TestValidateReviewReplyPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{"valid reply", `{"reply": "Thank you!"}`, false},
+		{"empty reply", `{"reply": "  "}`, true},
+		{"reply too long", `{"reply": "` + strings.Repeat("x", 2001) + `"}`, true},
+		{"invalid JSON", `{invalid`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			if _, err := review_validators.ValidateReviewReplyPayload(req); (err != nil) != tt.expectError {
+				t.Errorf("expected error: %v, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"net/http"
 
 	"github.com/google/uuid"
-	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -20,12 +19,7 @@ func ValidateCreateRoomPayload(r *http.Request) (*payloads.CreateRoomPayload, er
 		return nil, errors.New("hotel_id is required")
 	}
 
-	validRoomTypes := map[room.RoomType]bool{
-		room.Single: true,
-		room.Double: true,
-		room.Suite:  true,
-	}
-	if !validRoomTypes[payload.RoomType] {
+	if !payload.RoomType.IsValid() {
 		return nil, errors.New("invalid room_type")
 	}
 
@@ -0,0 +1,56 @@
+package room_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateCreateRoomBlockPayload(r *http.Request) (*payloads.CreateRoomBlockPayload, error) {
+	var payload payloads.CreateRoomBlockPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelID == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+
+	if payload.WholeHotel {
+		if payload.RoomType != "" || payload.RoomUnitID != nil || payload.Quantity != 0 {
+			return nil, errors.New("a whole-hotel block cannot name a room_type, room_unit_id or quantity")
+		}
+	} else if payload.RoomUnitID != nil {
+		if *payload.RoomUnitID == uuid.Nil {
+			return nil, errors.New("room_unit_id cannot be empty")
+		}
+		if payload.Quantity > 1 {
+			return nil, errors.New("a unit block covers exactly one room")
+		}
+		payload.Quantity = 1
+	} else {
+		if !payload.RoomType.IsValid() {
+			return nil, errors.New("invalid room_type")
+		}
+		if payload.Quantity <= 0 {
+			return nil, errors.New("quantity must be positive")
+		}
+	}
+
+	if payload.StartDate.IsZero() || payload.EndDate.IsZero() {
+		return nil, errors.New("start_date and end_date are required")
+	}
+	if !payload.EndDate.After(payload.StartDate) {
+		return nil, errors.New("end_date must be after start_date")
+	}
+
+	payload.Reason = strings.TrimSpace(payload.Reason)
+	if payload.Reason == "" {
+		return nil, errors.New("reason is required")
+	}
+	return &payload, nil
+}
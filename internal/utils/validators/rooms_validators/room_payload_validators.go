@@ -5,7 +5,6 @@ import (
 	"errors"
 	"net/http"
 
-	"github.com/tktanisha/booking_system/internal/enums/room"
 	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
 )
 
@@ -15,18 +14,12 @@ func ValidateRoomPayload(r *http.Request) ([]*payloads.RoomPayload, error) {
 		return nil, err
 	}
 
-	validRoomTypes := map[room.RoomType]bool{
-		room.Single: true,
-		room.Double: true,
-		room.Suite:  true,
-	}
-
 	for _, payload := range payload {
 		if payload.Quantity <= 0 {
 			return nil, errors.New("quantity must be positive")
 		}
 
-		if !validRoomTypes[payload.RoomType] {
+		if !payload.RoomType.IsValid() {
 			return nil, errors.New("invalid room type")
 		}
 	}
@@ -0,0 +1,91 @@
+package room_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateCreateRoomTypePayload(r *http.Request) (*payloads.CreateRoomTypePayload, error) {
+	var payload payloads.CreateRoomTypePayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelID == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+	if !payload.Code.IsValid() {
+		return nil, errors.New("invalid code: use lowercase letters, digits and underscores")
+	}
+
+	attributes := payloads.UpdateRoomTypePayload{
+		Name:             payload.Name,
+		MaxOccupancy:     payload.MaxOccupancy,
+		BaseOccupancy:    payload.BaseOccupancy,
+		ExtraGuestFee:    payload.ExtraGuestFee,
+		BedConfiguration: payload.BedConfiguration,
+		SizeSqm:          payload.SizeSqm,
+		Amenities:        payload.Amenities,
+	}
+	if err := validateRoomTypeAttributes(&attributes); err != nil {
+		return nil, err
+	}
+
+	payload.Name = attributes.Name
+	payload.BaseOccupancy = attributes.BaseOccupancy
+	payload.BedConfiguration = attributes.BedConfiguration
+	payload.Amenities = attributes.Amenities
+	return &payload, nil
+}
+
+func ValidateUpdateRoomTypePayload(r *http.Request) (*payloads.UpdateRoomTypePayload, error) {
+	var payload payloads.UpdateRoomTypePayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if err := validateRoomTypeAttributes(&payload); err != nil {
+		return nil, err
+	}
+	return &payload, nil
+}
+
+func validateRoomTypeAttributes(payload *payloads.UpdateRoomTypePayload) error {
+	payload.Name = strings.TrimSpace(payload.Name)
+	if payload.Name == "" {
+		return errors.New("name is required")
+	}
+	if payload.MaxOccupancy <= 0 {
+		return errors.New("max_occupancy must be positive")
+	}
+	// without an explicit base occupancy the room price covers every guest it sleeps
+	if payload.BaseOccupancy == 0 {
+		payload.BaseOccupancy = payload.MaxOccupancy
+	}
+	if payload.BaseOccupancy < 0 || payload.BaseOccupancy > payload.MaxOccupancy {
+		return errors.New("base_occupancy must be between 1 and max_occupancy")
+	}
+	if payload.ExtraGuestFee < 0 {
+		return errors.New("extra_guest_fee cannot be negative")
+	}
+	if payload.SizeSqm < 0 {
+		return errors.New("size_sqm cannot be negative")
+	}
+
+	payload.BedConfiguration = strings.TrimSpace(payload.BedConfiguration)
+	if payload.Amenities == nil {
+		payload.Amenities = []string{}
+	}
+	for i, amenity := range payload.Amenities {
+		payload.Amenities[i] = strings.TrimSpace(amenity)
+		if payload.Amenities[i] == "" {
+			return errors.New("amenities cannot contain blank entries")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package room_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateCreateRoomUnitPayload(r *http.Request) (*payloads.CreateRoomUnitPayload, error) {
+	var payload payloads.CreateRoomUnitPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelID == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+
+	if !payload.RoomType.IsValid() {
+		return nil, errors.New("invalid room_type")
+	}
+
+	payload.Number = strings.TrimSpace(payload.Number)
+	if payload.Number == "" {
+		return nil, errors.New("number is required")
+	}
+	if payload.Floor < 0 {
+		return nil, errors.New("floor cannot be negative")
+	}
+	return &payload, nil
+}
+
+func ValidateRoomUnitStatusPayload(r *http.Request) (*payloads.RoomUnitStatusPayload, error) {
+	var payload payloads.RoomUnitStatusPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	validStatuses := map[room.UnitStatus]bool{
+		room.UnitActive:     true,
+		room.UnitOutOfOrder: true,
+	}
+	if !validStatuses[payload.Status] {
+		return nil, errors.New("invalid status")
+	}
+	return &payload, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tktanisha/booking_system/internal/enums/room"
@@ -27,6 +28,12 @@ func TestValidateCreateRoomPayload(t *testing.T) {
 		errorMsg    string
 	}{
 		{"valid payload", validPayload, false, ""},
+		{"registry room type", payloads.CreateRoomPayload{
+			HotelID:  uuid.New(),
+			RoomType: "family_room",
+			Price:    180,
+			Quantity: 3,
+		}, false, ""},
 		{"invalid JSON", "{invalid json", true, "invalid request payload"},
 		{"missing hotel_id", payloads.CreateRoomPayload{
 			HotelID:  uuid.Nil,
@@ -36,7 +43,7 @@ func TestValidateCreateRoomPayload(t *testing.T) {
 		}, true, "hotel_id is required"},
 		{"invalid room_type", payloads.CreateRoomPayload{
 			HotelID:  uuid.New(),
-			RoomType: "invalid",
+			RoomType: "Invalid Type!",
 			Price:    100,
 			Quantity: 2,
 		}, true, "invalid room_type"},
@@ -102,7 +109,7 @@ func TestValidateRoomPayload(t *testing.T) {
 		}, true, "quantity must be positive"},
 		{"invalid room type", []*payloads.RoomPayload{
 			{
-				RoomType: "invalid",
+				RoomType: "Invalid Type!",
 				Quantity: 2,
 			},
 		}, true, "invalid room type"},
@@ -132,6 +139,188 @@ func TestValidateRoomPayload(t *testing.T) {
 	}
 }
 
+func TestValidateCreateRoomUnitPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{"valid payload", payloads.CreateRoomUnitPayload{HotelID: uuid.New(), RoomType: room.Suite, Number: "412", Floor: 4}, false, ""},
+		{"invalid JSON", "{invalid json", true, "invalid request payload"},
+		{"missing hotel_id", payloads.CreateRoomUnitPayload{RoomType: room.Suite, Number: "412", Floor: 4}, true, "hotel_id is required"},
+		{"invalid room_type", payloads.CreateRoomUnitPayload{HotelID: uuid.New(), RoomType: "Invalid Type!", Number: "412"}, true, "invalid room_type"},
+		{"blank number", payloads.CreateRoomUnitPayload{HotelID: uuid.New(), RoomType: room.Single, Number: "  "}, true, "number is required"},
+		{"negative floor", payloads.CreateRoomUnitPayload{HotelID: uuid.New(), RoomType: room.Single, Number: "B1", Floor: -1}, true, "floor cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			switch v := tt.body.(type) {
+			case string:
+				body = []byte(v)
+			default:
+				body, _ = json.Marshal(v)
+			}
+
+			req := httptest.NewRequest("POST", "/", bytes.NewBuffer(body))
+			_, err := room_validators.ValidateCreateRoomUnitPayload(req)
+
+			if tt.expectError {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateRoomUnitStatusPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{"active", `{"status":"active"}`, false},
+		{"out of order", `{"status":"out_of_order"}`, false},
+		{"unknown status", `{"status":"broken"}`, true},
+		{"invalid JSON", `{invalid`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PUT", "/", bytes.NewBufferString(tt.body))
+			_, err := room_validators.ValidateRoomUnitStatusPayload(req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error: %v, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestValidateCreateRoomBlockPayload(t *testing.T) {
+	hotelID := uuid.New()
+	unitID := uuid.New()
+	start := time.Now().Add(24 * time.Hour)
+	end := start.Add(48 * time.Hour)
+
+	tests := []struct {
+		name        string
+		body        interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{"valid type block", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: room.Double, Quantity: 2, StartDate: start, EndDate: end, Reason: "renovation"}, false, ""},
+		{"valid unit block", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomUnitID: &unitID, StartDate: start, EndDate: end, Reason: "broken AC"}, false, ""},
+		{"valid whole-hotel block", payloads.CreateRoomBlockPayload{HotelID: hotelID, WholeHotel: true, StartDate: start, EndDate: end, Reason: "closed for the season"}, false, ""},
+		{"whole-hotel block with room_type", payloads.CreateRoomBlockPayload{HotelID: hotelID, WholeHotel: true, RoomType: room.Double, Quantity: 2, StartDate: start, EndDate: end, Reason: "closed for the season"}, true, "a whole-hotel block cannot name"},
+		{"invalid JSON", "{invalid json", true, "invalid request payload"},
+		{"missing hotel_id", payloads.CreateRoomBlockPayload{RoomType: room.Double, Quantity: 2, StartDate: start, EndDate: end, Reason: "renovation"}, true, "hotel_id is required"},
+		{"unit block with quantity", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomUnitID: &unitID, Quantity: 3, StartDate: start, EndDate: end, Reason: "broken AC"}, true, "exactly one room"},
+		{"invalid room_type", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: "Invalid Type!", Quantity: 2, StartDate: start, EndDate: end, Reason: "renovation"}, true, "invalid room_type"},
+		{"non-positive quantity", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: room.Double, StartDate: start, EndDate: end, Reason: "renovation"}, true, "quantity must be positive"},
+		{"end before start", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: room.Double, Quantity: 1, StartDate: end, EndDate: start, Reason: "renovation"}, true, "end_date must be after start_date"},
+		{"missing reason", payloads.CreateRoomBlockPayload{HotelID: hotelID, RoomType: room.Double, Quantity: 1, StartDate: start, EndDate: end}, true, "reason is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			switch v := tt.body.(type) {
+			case string:
+				body = []byte(v)
+			default:
+				body, _ = json.Marshal(v)
+			}
+
+			req := httptest.NewRequest("POST", "/", bytes.NewBuffer(body))
+			_, err := room_validators.ValidateCreateRoomBlockPayload(req)
+
+			if tt.expectError {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateCreateRoomTypePayload(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name        string
+		body        interface{}
+		expectError bool
+		errorMsg    string
+	}{
+		{"valid payload", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "villa", Name: "Garden Villa", MaxOccupancy: 6, BedConfiguration: "2 king beds", SizeSqm: 120, Amenities: []string{"private pool"}}, false, ""},
+		{"invalid JSON", "{invalid json", true, "invalid request payload"},
+		{"missing hotel_id", payloads.CreateRoomTypePayload{Code: "villa", Name: "Garden Villa", MaxOccupancy: 6}, true, "hotel_id is required"},
+		{"malformed code", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "Garden Villa", Name: "Garden Villa", MaxOccupancy: 6}, true, "invalid code"},
+		{"blank name", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "villa", Name: " ", MaxOccupancy: 6}, true, "name is required"},
+		{"non-positive occupancy", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "dorm_bed", Name: "Dorm Bed"}, true, "max_occupancy must be positive"},
+		{"base above max occupancy", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "family", Name: "Family Room", MaxOccupancy: 4, BaseOccupancy: 5}, true, "base_occupancy must be between 1 and max_occupancy"},
+		{"negative extra guest fee", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "family", Name: "Family Room", MaxOccupancy: 4, BaseOccupancy: 2, ExtraGuestFee: -10}, true, "extra_guest_fee cannot be negative"},
+		{"negative size", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "villa", Name: "Garden Villa", MaxOccupancy: 6, SizeSqm: -5}, true, "size_sqm cannot be negative"},
+		{"blank amenity", payloads.CreateRoomTypePayload{HotelID: hotelID, Code: "villa", Name: "Garden Villa", MaxOccupancy: 6, Amenities: []string{"wifi", ""}}, true, "amenities cannot contain blank entries"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			switch v := tt.body.(type) {
+			case string:
+				body = []byte(v)
+			default:
+				body, _ = json.Marshal(v)
+			}
+
+			req := httptest.NewRequest("POST", "/", bytes.NewBuffer(body))
+			payload, err := room_validators.ValidateCreateRoomTypePayload(req)
+			if err == nil && payload.BaseOccupancy == 0 {
+				t.Errorf("expected base_occupancy to default to max_occupancy")
+			}
+
+			if tt.expectError {
+				if err == nil || !contains(err.Error(), tt.errorMsg) {
+					t.Errorf("expected error containing %q, got %v", tt.errorMsg, err)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateRoomTypePayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{"valid payload", `{"name":"Family Room","max_occupancy":4,"amenities":["crib"]}`, false},
+		{"missing name", `{"max_occupancy":4}`, true},
+		{"zero occupancy", `{"name":"Family Room","max_occupancy":0}`, true},
+		{"invalid JSON", `{invalid`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PUT", "/", bytes.NewBufferString(tt.body))
+			_, err := room_validators.ValidateUpdateRoomTypePayload(req)
+			if (err != nil) != tt.expectError {
+				t.Errorf("expected error: %v, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || bytes.Contains([]byte(s), []byte(substr)))
 }
@@ -0,0 +1,58 @@
+package stay_restriction_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateStayRestrictionPayload(r *http.Request) (*payloads.StayRestrictionPayload, error) {
+	var payload payloads.StayRestrictionPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelId == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+
+	// an empty room_category makes the restriction apply to the whole hotel
+	if payload.RoomCategory != "" && !payload.RoomCategory.IsValid() {
+		return nil, errors.New("invalid room_category")
+	}
+
+	if payload.StartDate.IsZero() || payload.EndDate.IsZero() {
+		return nil, errors.New("start_date and end_date are required")
+	}
+	if payload.EndDate.Before(payload.StartDate) {
+		return nil, errors.New("end_date cannot be before start_date")
+	}
+
+	seen := make(map[int]bool)
+	for _, day := range payload.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return nil, errors.New("days_of_week must be between 0 (Sunday) and 6 (Saturday)")
+		}
+		if seen[day] {
+			return nil, errors.New("days_of_week cannot contain duplicates")
+		}
+		seen[day] = true
+	}
+
+	if payload.MinLengthOfStay < 0 || payload.MaxLengthOfStay < 0 {
+		return nil, errors.New("min_los and max_los cannot be negative")
+	}
+	if payload.MaxLengthOfStay > 0 && payload.MinLengthOfStay > payload.MaxLengthOfStay {
+		return nil, errors.New("min_los cannot be greater than max_los")
+	}
+
+	if payload.MinLengthOfStay == 0 && payload.MaxLengthOfStay == 0 &&
+		!payload.ClosedToArrival && !payload.ClosedToDeparture && !payload.StopSell {
+		return nil, errors.New("restriction must set min_los, max_los, closed_to_arrival, closed_to_departure or stop_sell")
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,126 @@
+package stay_restriction_validators_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/room"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+	"github.com/tktanisha/booking_system/internal/utils/validators/stay_restriction_validators"
+)
+
+func TestValidateStayRestrictionPayload(t *testing.T) {
+	hotelID := uuid.New()
+	start := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 3, 0)
+
+	tests := []struct {
+		name        string
+		body        any
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid closed to arrival on a single day",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: start, EndDate: start, ClosedToArrival: true},
+			expectError: false,
+		},
+		{
+			name: "valid friday minimum stay for a room type",
+			body: payloads.StayRestrictionPayload{HotelId: hotelID, RoomCategory: room.Suite, StartDate: start, EndDate: end,
+				DaysOfWeek: []int{5}, MinLengthOfStay: 2},
+			expectError: false,
+		},
+		{
+			name:        "invalid JSON",
+			body:        "{invalid",
+			expectError: true,
+			errorMsg:    "invalid request payload",
+		},
+		{
+			name:        "missing hotel id",
+			body:        payloads.StayRestrictionPayload{StartDate: start, EndDate: end, StopSell: true},
+			expectError: true,
+			errorMsg:    "hotel_id is required",
+		},
+		{
+			name:        "invalid room category",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, RoomCategory: "Pent House", StartDate: start, EndDate: end, StopSell: true},
+			expectError: true,
+			errorMsg:    "invalid room_category",
+		},
+		{
+			name:        "missing dates",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StopSell: true},
+			expectError: true,
+			errorMsg:    "start_date and end_date are required",
+		},
+		{
+			name:        "end before start",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: end, EndDate: start, StopSell: true},
+			expectError: true,
+			errorMsg:    "end_date cannot be before start_date",
+		},
+		{
+			name:        "day of week out of range",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: start, EndDate: end, DaysOfWeek: []int{7}, StopSell: true},
+			expectError: true,
+			errorMsg:    "days_of_week must be between 0 (Sunday) and 6 (Saturday)",
+		},
+		{
+			name:        "duplicate day of week",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: start, EndDate: end, DaysOfWeek: []int{5, 5}, StopSell: true},
+			expectError: true,
+			errorMsg:    "days_of_week cannot contain duplicates",
+		},
+		{
+			name:        "negative length of stay",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: start, EndDate: end, MinLengthOfStay: -1},
+			expectError: true,
+			errorMsg:    "min_los and max_los cannot be negative",
+		},
+		{
+			name:        "min above max",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: start, EndDate: end, MinLengthOfStay: 5, MaxLengthOfStay: 3},
+			expectError: true,
+			errorMsg:    "min_los cannot be greater than max_los",
+		},
+		{
+			name:        "no rule set",
+			body:        payloads.StayRestrictionPayload{HotelId: hotelID, StartDate: start, EndDate: end},
+			expectError: true,
+			errorMsg:    "restriction must set min_los, max_los, closed_to_arrival, closed_to_departure or stop_sell",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if s, ok := tt.body.(string); ok {
+				body = []byte(s)
+			} else {
+				body, _ = json.Marshal(tt.body)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+
+			got, err := stay_restriction_validators.ValidateStayRestrictionPayload(req)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+				} else if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				if got != nil {
+					t.Errorf("expected nil payload, got %v", got)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package tax_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+func ValidateCreateTaxRulePayload(r *http.Request) (*payloads.CreateTaxRulePayload, error) {
+	var payload payloads.CreateTaxRulePayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelId == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+
+	payload.Name = strings.TrimSpace(payload.Name)
+	if payload.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	if len(payload.Name) > 64 {
+		return nil, errors.New("name cannot be longer than 64 characters")
+	}
+
+	if !payload.ChargeType.IsValid() {
+		return nil, errors.New("charge_type must be per_night, per_person or percentage")
+	}
+	if payload.Value <= 0 {
+		return nil, errors.New("value must be positive")
+	}
+	if payload.ChargeType == tax.Percentage && payload.Value > 100 {
+		return nil, errors.New("percentage value cannot be greater than 100")
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,98 @@
+package tax_validators_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/enums/tax"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+	"github.com/tktanisha/booking_system/internal/utils/validators/tax_validators"
+)
+
+func TestValidateCreateTaxRulePayload(t *testing.T) {
+	hotelID := uuid.New()
+
+	tests := []struct {
+		name        string
+		body        any
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid inclusive VAT",
+			body:        payloads.CreateTaxRulePayload{HotelId: hotelID, Name: "VAT", ChargeType: tax.Percentage, Value: 12.5, Inclusive: true},
+			expectError: false,
+		},
+		{
+			name:        "valid city tax per person",
+			body:        payloads.CreateTaxRulePayload{HotelId: hotelID, Name: " City tax ", ChargeType: tax.PerPerson, Value: 2.5},
+			expectError: false,
+		},
+		{
+			name:        "invalid JSON",
+			body:        "{invalid",
+			expectError: true,
+			errorMsg:    "invalid request payload",
+		},
+		{
+			name:        "missing hotel id",
+			body:        payloads.CreateTaxRulePayload{Name: "VAT", ChargeType: tax.Percentage, Value: 12},
+			expectError: true,
+			errorMsg:    "hotel_id is required",
+		},
+		{
+			name:        "missing name",
+			body:        payloads.CreateTaxRulePayload{HotelId: hotelID, Name: "  ", ChargeType: tax.Percentage, Value: 12},
+			expectError: true,
+			errorMsg:    "name is required",
+		},
+		{
+			name:        "unknown charge type",
+			body:        payloads.CreateTaxRulePayload{HotelId: hotelID, Name: "VAT", ChargeType: "per_booking", Value: 12},
+			expectError: true,
+			errorMsg:    "charge_type must be per_night, per_person or percentage",
+		},
+		{
+			name:        "non-positive value",
+			body:        payloads.CreateTaxRulePayload{HotelId: hotelID, Name: "Service fee", ChargeType: tax.PerNight, Value: 0},
+			expectError: true,
+			errorMsg:    "value must be positive",
+		},
+		{
+			name:        "percentage above 100",
+			body:        payloads.CreateTaxRulePayload{HotelId: hotelID, Name: "VAT", ChargeType: tax.Percentage, Value: 120},
+			expectError: true,
+			errorMsg:    "percentage value cannot be greater than 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []byte
+			if s, ok := tt.body.(string); ok {
+				body = []byte(s)
+			} else {
+				body, _ = json.Marshal(tt.body)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBuffer(body))
+
+			got, err := tax_validators.ValidateCreateTaxRulePayload(req)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+				} else if err.Error() != tt.errorMsg {
+					t.Errorf("expected error %q, got %q", tt.errorMsg, err.Error())
+				}
+				if got != nil {
+					t.Errorf("expected nil payload, got %v", got)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package waitlist_validators
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tktanisha/booking_system/internal/utils/validators/payloads"
+)
+
+// maxWaitlistRooms keeps a single entry from holding a large share of a hotel
+// once it is offered rooms.
+const maxWaitlistRooms = 10
+
+func ValidateWaitlistPayload(r *http.Request) (*payloads.WaitlistPayload, error) {
+	var payload payloads.WaitlistPayload
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		return nil, errors.New("invalid request payload")
+	}
+
+	if payload.HotelId == uuid.Nil {
+		return nil, errors.New("hotel_id is required")
+	}
+	if payload.RoomType == "" {
+		return nil, errors.New("room_type is required")
+	}
+	if !payload.RoomType.IsValid() {
+		return nil, errors.New("room_type is not a valid room type code")
+	}
+	if payload.Quantity <= 0 {
+		return nil, errors.New("quantity must be positive")
+	}
+	if payload.Quantity > maxWaitlistRooms {
+		return nil, errors.New("quantity cannot be more than 10 rooms")
+	}
+	if payload.CheckIn.IsZero() {
+		return nil, errors.New("checkin date is required")
+	}
+	if payload.CheckOut.IsZero() {
+		return nil, errors.New("checkout date is required")
+	}
+	if !payload.CheckIn.Before(payload.CheckOut.Time) {
+		return nil, errors.New("checkin date must be before checkout date")
+	}
+	return &payload, nil
+}
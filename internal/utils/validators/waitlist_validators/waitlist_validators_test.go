@@ -0,0 +1,47 @@
+package waitlist_validators_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tktanisha/booking_system/internal/utils/validators/waitlist_validators"
+)
+
+func TestValidateWaitlistPayload(t *testing.T) {
+	hotel := `"hotel_id": "6f1c2a7e-3b1d-4c8e-9f0a-2d5e6b7c8a91"`
+	tests := []struct {
+		name     string
+		body     string
+		errorMsg string
+	}{
+		{"valid entry", `{` + hotel + `, "room_type": "suite", "quantity": 2, "checkin": "2030-05-01", "checkout": "2030-05-03"}`, ""},
+		{"missing hotel", `{"room_type": "suite", "quantity": 1, "checkin": "2030-05-01", "checkout": "2030-05-03"}`, "hotel_id is required"},
+		{"missing room type", `{` + hotel + `, "quantity": 1, "checkin": "2030-05-01", "checkout": "2030-05-03"}`, "room_type is required"},
+		{"zero quantity", `{` + hotel + `, "room_type": "suite", "checkin": "2030-05-01", "checkout": "2030-05-03"}`, "quantity must be positive"},
+		{"too many rooms", `{` + hotel + `, "room_type": "suite", "quantity": 11, "checkin": "2030-05-01", "checkout": "2030-05-03"}`, "quantity cannot be more than 10 rooms"},
+		{"missing check-in", `{` + hotel + `, "room_type": "suite", "quantity": 1, "checkout": "2030-05-03"}`, "checkin date is required"},
+		{"check-out before check-in", `{` + hotel + `, "room_type": "suite", "quantity": 1, "checkin": "2030-05-03", "checkout": "2030-05-01"}`, "checkin date must be before checkout date"},
+		{"invalid JSON", `{invalid`, "invalid request payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(tt.body))
+			payload, err := waitlist_validators.ValidateWaitlistPayload(req)
+			if tt.errorMsg == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if payload.Quantity != 2 || payload.CheckIn.Format("2006-01-02") != "2030-05-01" {
+					t.Errorf("unexpected payload %+v", payload)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.errorMsg {
+				t.Errorf("expected error %q, got %v", tt.errorMsg, err)
+			}
+		})
+	}
+}